@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/go-logr/zapr"
 	"go.uber.org/zap"
@@ -186,13 +187,22 @@ func (s *authorizationServer) authorize(ctx context.Context, userEmail, clusterI
 		return false, "", fmt.Errorf("cluster %s is missing '%s' label", cluster.Name, kubermaticv1.ProjectIDLabelKey)
 	}
 
+	project := &kubermaticv1.Project{}
+	if err := s.client.Get(ctx, types.NamespacedName{Name: projectID}, project); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return false, "", fmt.Errorf("getting project: %w", err)
+	}
+	if project.IsSuspended() {
+		return false, "project is suspended", nil
+	}
+
 	allMembers := &kubermaticv1.UserProjectBindingList{}
 	if err := s.client.List(ctx, allMembers); err != nil {
 		return false, "", fmt.Errorf("listing userProjectBinding: %w", err)
 	}
 
+	now := time.Now()
 	for _, member := range allMembers.Items {
-		if strings.EqualFold(member.Spec.UserEmail, userEmail) && member.Spec.ProjectID == projectID {
+		if strings.EqualFold(member.Spec.UserEmail, userEmail) && member.Spec.ProjectID == projectID && !member.Spec.IsExpired(now) {
 			return true, "user bound to cluster project", nil
 		}
 	}
@@ -206,7 +216,9 @@ func (s *authorizationServer) authorize(ctx context.Context, userEmail, clusterI
 	if len(allGroupBindings.Items) > 0 {
 		groupSet := sets.New[string]()
 		for _, gpb := range allGroupBindings.Items {
-			groupSet.Insert(gpb.Spec.Group)
+			if !gpb.Spec.IsExpired(now) {
+				groupSet.Insert(gpb.Spec.Group)
+			}
 		}
 
 		allUsers := &kubermaticv1.UserList{}
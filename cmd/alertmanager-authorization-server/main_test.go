@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 	"k8c.io/kubermatic/v2/pkg/test/fake"
 	"k8c.io/kubermatic/v2/pkg/test/generator"
@@ -91,6 +92,31 @@ func TestAuthorize(t *testing.T) {
 			expectedError:      false,
 			expectedAuthorized: true,
 		},
+		{
+			name:      "user is NOT authorized to access alertmanager of cluster in a suspended project",
+			userEmail: "bob@acme.com",
+			clusterID: generator.DefaultClusterID,
+			existingKubermaticObjects: []ctrlruntimeclient.Object{
+				genSuspendedProject(),
+				generator.GenDefaultUser(),
+				generator.GenDefaultOwnerBinding(),
+				generator.GenDefaultCluster(),
+			},
+			expectedError:      false,
+			expectedAuthorized: false,
+		},
+		{
+			name:      "admin user is authorized to access alertmanager of cluster in a suspended project",
+			userEmail: "john@acme.com",
+			clusterID: generator.DefaultClusterID,
+			existingKubermaticObjects: []ctrlruntimeclient.Object{
+				genSuspendedProject(),
+				generator.GenDefaultCluster(),
+				generator.GenAdminUser("John", "john@acme.com", true),
+			},
+			expectedError:      false,
+			expectedAuthorized: true,
+		},
 	}
 
 	log := kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar()
@@ -114,3 +140,9 @@ func TestAuthorize(t *testing.T) {
 		})
 	}
 }
+
+func genSuspendedProject() *kubermaticv1.Project {
+	project := generator.GenDefaultProject()
+	project.Spec.Suspension = &kubermaticv1.ProjectSuspension{Reason: "security incident"}
+	return project
+}
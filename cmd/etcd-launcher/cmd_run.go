@@ -154,7 +154,18 @@ func RunFunc(log *zap.SugaredLogger, opt *runOptions) cobraFuncE {
 				log.Infof("%v is a member", thisMember.GetPeerURLs())
 
 				if _, err := os.Stat(filepath.Join(e.DataDir, "member")); errors.Is(err, fs.ErrNotExist) {
-					if err := e.RemoveStaleMember(ctx, log, thisMember.ID); err != nil {
+					// never change the membership of an unhealthy cluster, as removing this
+					// member could cost the remaining members their quorum; learners do not
+					// count towards the quorum and can always be removed
+					if !thisMember.IsLearner {
+						if err := wait.PollUntilContextTimeout(ctx, 5*time.Second, 5*time.Minute, true, func(ctx context.Context) (bool, error) {
+							return e.IsClusterHealthy(ctx, log)
+						}); err != nil {
+							log.Panicw("cluster is not healthy, refusing to rejoin as new member", zap.Error(err))
+						}
+					}
+
+					if err := e.RemoveStaleMember(ctx, log, thisMember); err != nil {
 						log.Panicw("failed to remove stale membership to rejoin cluster as new member", zap.Error(err))
 					}
 
@@ -176,6 +187,14 @@ func RunFunc(log *zap.SugaredLogger, opt *runOptions) cobraFuncE {
 			log.Panicw("failed to start etcd cmd", zap.Error(err))
 		}
 
+		// members join as learners and need to be promoted once they have caught up;
+		// this also covers learners that were restarted before being promoted
+		if e.Exists() {
+			if err := e.PromoteLearner(ctx, log); err != nil {
+				log.Panicw("failed to promote learner to voting member", zap.Error(err))
+			}
+		}
+
 		if err = wait.PollUntilContextTimeout(ctx, 1*time.Second, 60*time.Second, false, func(ctx context.Context) (bool, error) {
 			return e.IsClusterHealthy(ctx, log)
 		}); err != nil {
@@ -25,6 +25,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -82,6 +83,8 @@ type Cluster struct {
 	initialMembers []string
 	usePeerTLSOnly bool
 	clusterSize    int
+
+	learners learnerTracker
 }
 
 func (e *Cluster) Init(ctx context.Context) (*kubermaticv1.Cluster, error) {
@@ -174,14 +177,23 @@ func (e *Cluster) LogInitialState(log *zap.SugaredLogger) {
 }
 
 func (e *Cluster) DeleteUnwantedDeadMembers(ctx context.Context, log *zap.SugaredLogger) (bool, error) {
-	unwantedMembers, err := e.getUnwantedMembers(ctx, log)
+	members, err := e.listMembers(ctx, log)
+	if err != nil {
+		log.Warnw("failed to list members", zap.Error(err))
+		return false, nil
+	}
+
+	unwantedMembers, err := e.getUnwantedMembers(members)
 	if err != nil {
 		log.Warnw("failed to get unwanted members", zap.Error(err))
 		return false, nil
 	}
 
+	appliedIndexes := e.learnerAppliedIndexes(ctx, log, members)
+	stuckLearners := e.learners.stuck(members, appliedIndexes, time.Now(), timeoutStuckLearner)
+
 	// we only need to reconcile if we have members that we shouldn't have
-	if len(unwantedMembers) == 0 {
+	if len(unwantedMembers) == 0 && len(stuckLearners) == 0 {
 		log.Debug("no unwanted members present")
 		return true, nil
 	}
@@ -198,6 +210,23 @@ func (e *Cluster) DeleteUnwantedDeadMembers(ctx context.Context, log *zap.Sugare
 		return false, nil
 	}
 
+	if len(stuckLearners) > 0 {
+		client, err := e.GetEtcdClient(ctx, log)
+		if err != nil {
+			return false, fmt.Errorf("can't find cluster client: %w", err)
+		}
+		defer closeClient(client, log)
+
+		if err := removeLearners(ctx, log, client, stuckLearners); err != nil {
+			return false, err
+		}
+
+		// stuck learners are gone now and must not be checked again
+		unwantedMembers = slices.DeleteFunc(unwantedMembers, func(member *etcdserverpb.Member) bool {
+			return slices.Contains(stuckLearners, member)
+		})
+	}
+
 	if err := e.removeDeadMembers(ctx, log, unwantedMembers); err != nil {
 		return false, err
 	}
@@ -230,30 +259,30 @@ func (e *Cluster) JoinCluster(ctx context.Context, log *zap.SugaredLogger) error
 
 	peerURLs = append(peerURLs, fmt.Sprintf("https://%s.etcd.%s.svc.cluster.local:2381", e.PodName, e.namespace))
 
-	ctx, cancelFunc := context.WithTimeout(ctx, timeoutAddMember)
-	defer cancelFunc()
+	defer closeClient(client, log)
 
-	if _, err := client.MemberAdd(ctx, peerURLs); err != nil {
-		closeClient(client, log)
-		return fmt.Errorf("add itself as a member: %w", err)
+	// join as a learner, which does not count towards the quorum until it has
+	// caught up with the leader and has been promoted, see PromoteLearner
+	member, err := addLearner(ctx, log, client, peerURLs, timeoutAddLearner)
+	if err != nil {
+		return fmt.Errorf("add itself as a learner: %w", err)
 	}
 
-	defer closeClient(client, log)
-
-	log.Info("joined etcd cluster successfully.")
+	log.Infow("joined etcd cluster successfully as learner.", "member-id", fmt.Sprintf("%x", member.ID))
 	return nil
 }
 
-func (e *Cluster) RemoveStaleMember(ctx context.Context, log *zap.SugaredLogger, memberID uint64) error {
+func (e *Cluster) RemoveStaleMember(ctx context.Context, log *zap.SugaredLogger, member *etcdserverpb.Member) error {
 	client, err := e.GetEtcdClient(ctx, log)
 	if err != nil {
 		return fmt.Errorf("can't find cluster client: %w", err)
 	}
 
-	log.Warnw("No data dir, removing stale membership to rejoin cluster as new member")
+	log.Warnw("No data dir, removing stale membership to rejoin cluster as new member", "learner", member.IsLearner)
 
-	_, err = client.MemberRemove(ctx, memberID)
-	if err != nil {
+	_, err = client.MemberRemove(ctx, member.ID)
+	// a stuck learner might have been removed by the leader in the meantime
+	if err != nil && !errors.Is(err, rpctypes.ErrMemberNotFound) {
 		closeClient(client, log)
 		return fmt.Errorf("failed to remove own member information from cluster before rejoining: %w", err)
 	}
@@ -501,13 +530,25 @@ func (e *Cluster) listMembers(ctx context.Context, log *zap.SugaredLogger) ([]*e
 	return resp.Members, err
 }
 
-func (e *Cluster) getUnwantedMembers(ctx context.Context, log *zap.SugaredLogger) ([]*etcdserverpb.Member, error) {
-	unwantedMembers := []*etcdserverpb.Member{}
+// learnerAppliedIndexes returns the applied raft index of all learners that can
+// be reached, so that learners which are still catching up are not removed.
+func (e *Cluster) learnerAppliedIndexes(ctx context.Context, log *zap.SugaredLogger, members []*etcdserverpb.Member) map[uint64]uint64 {
+	if !slices.ContainsFunc(members, (*etcdserverpb.Member).GetIsLearner) {
+		return nil
+	}
 
-	members, err := e.listMembers(ctx, log)
+	client, err := e.getClientWithEndpoints(ctx, log, clientEndpoints(e.clusterSize, e.namespace))
 	if err != nil {
-		return []*etcdserverpb.Member{}, err
+		log.Warnw("failed to get learner status", zap.Error(err))
+		return nil
 	}
+	defer closeClient(client, log)
+
+	return getLearnerAppliedIndexes(ctx, client, members)
+}
+
+func (e *Cluster) getUnwantedMembers(members []*etcdserverpb.Member) ([]*etcdserverpb.Member, error) {
+	unwantedMembers := []*etcdserverpb.Member{}
 
 	expectedMembers := peerHostsList(e.clusterSize, e.namespace)
 	for _, member := range members {
@@ -0,0 +1,294 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	client "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"k8c.io/kubermatic/v2/pkg/util/wait"
+)
+
+const (
+	// timeoutAddLearner is how long a new member waits for another learner to be
+	// promoted or removed, as etcd only allows a single learner at a time.
+	timeoutAddLearner = time.Minute * 2
+	// timeoutPromoteLearner is how long a learner may go without applying any new
+	// raft entries before the etcd-launcher gives up and restarts. Receiving a
+	// large snapshot does not advance the applied index, so this must be generous.
+	timeoutPromoteLearner = time.Minute * 10
+	// timeoutPromoteLearnerTotal is how long a learner gets to catch up with the
+	// leader at all, even if it is still making progress.
+	timeoutPromoteLearnerTotal = time.Hour
+	// timeoutStuckLearner is how long a learner may go without applying any new
+	// raft entries before the leader removes it from the cluster, e.g. because its
+	// pod never started. This is longer than timeoutPromoteLearner, so that the
+	// learner gets the chance to restart before it is removed.
+	timeoutStuckLearner = time.Minute * 15
+	// timeoutLearnerStatus is how long to wait for a learner's status.
+	timeoutLearnerStatus = time.Second * 5
+
+	intervalAddLearner     = time.Second * 5
+	intervalPromoteLearner = time.Second * 5
+)
+
+// PromoteLearner promotes this member to a voting member once it has caught up
+// with the leader's revision. It does nothing if this member is not a learner,
+// so it is safe to call after every start of etcd.
+func (e *Cluster) PromoteLearner(ctx context.Context, log *zap.SugaredLogger) error {
+	member, err := e.GetMemberByName(ctx, log, e.PodName)
+	if err != nil {
+		return fmt.Errorf("failed to check cluster membership: %w", err)
+	}
+
+	if member == nil || !member.IsLearner {
+		return nil
+	}
+
+	client, err := e.GetEtcdClient(ctx, log)
+	if err != nil {
+		return fmt.Errorf("can't find cluster client: %w", err)
+	}
+	defer closeClient(client, log)
+
+	return promoteLearner(ctx, log, client, member.ID, e.endpoint(), timeoutPromoteLearner)
+}
+
+// addLearner adds a new learner with the given peer URLs to the cluster. If
+// another learner is still catching up, it retries until the timeout is reached.
+func addLearner(ctx context.Context, log *zap.SugaredLogger, c *client.Client, peerURLs []string, timeout time.Duration) (*etcdserverpb.Member, error) {
+	var member *etcdserverpb.Member
+
+	err := wait.PollImmediateLog(ctx, log, intervalAddLearner, timeout, func(ctx context.Context) (error, error) {
+		ctx, cancelFunc := context.WithTimeout(ctx, timeoutAddMember)
+		defer cancelFunc()
+
+		resp, err := c.MemberAddAsLearner(ctx, peerURLs)
+		if errors.Is(err, rpctypes.ErrTooManyLearners) {
+			return errors.New("another learner is still catching up with the leader"), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		member = resp.Member
+
+		return nil, nil
+	})
+
+	return member, err
+}
+
+// promoteLearner waits for the learner to catch up with the leader's revision
+// and then promotes it to a voting member. It gives up once the learner has not
+// applied any new raft entries for the given timeout.
+func promoteLearner(ctx context.Context, log *zap.SugaredLogger, c *client.Client, memberID uint64, learnerEndpoint string, timeout time.Duration) error {
+	log = log.With("member-id", fmt.Sprintf("%x", memberID))
+	log.Info("member is a learner, waiting for it to catch up with the leader")
+
+	progress := learnerProgress{}
+
+	err := wait.PollImmediateLog(ctx, log, intervalPromoteLearner, timeoutPromoteLearnerTotal, func(ctx context.Context) (error, error) {
+		// the leader is asked first, so that a learner that has caught up with this
+		// revision is at most a few writes behind once it is promoted
+		leaderRevision, err := getLeaderRevision(ctx, c)
+		if err != nil {
+			return fmt.Errorf("failed to get leader revision: %w", err), nil
+		}
+
+		statusCtx, cancelFunc := context.WithTimeout(ctx, timeoutLearnerStatus)
+		status, err := c.Status(statusCtx, learnerEndpoint)
+		cancelFunc()
+
+		if err != nil {
+			if progress.observe(0, time.Now()) >= timeout {
+				return nil, fmt.Errorf("learner has not made any progress for %v: %w", timeout, err)
+			}
+			return fmt.Errorf("failed to get learner status: %w", err), nil
+		}
+
+		if progress.observe(status.RaftAppliedIndex, time.Now()) >= timeout {
+			return nil, fmt.Errorf("learner has not made any progress for %v, applied index is %d", timeout, status.RaftAppliedIndex)
+		}
+
+		if status.Header.Revision < leaderRevision {
+			return fmt.Errorf("learner is at revision %d, leader is at revision %d", status.Header.Revision, leaderRevision), nil
+		}
+
+		_, err = c.MemberPromote(ctx, memberID)
+		switch {
+		case err == nil:
+			return nil, nil
+		case errors.Is(err, rpctypes.ErrMemberLearnerNotReady):
+			return errors.New("leader does not consider the learner ready yet"), nil
+		case errors.Is(err, rpctypes.ErrMemberNotLearner):
+			// promoted in a previous attempt whose response got lost
+			return nil, nil
+		case errors.Is(err, rpctypes.ErrMemberNotFound):
+			return nil, errors.New("learner has been removed from the cluster")
+		default:
+			return fmt.Errorf("failed to promote learner: %w", err), nil
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to promote learner: %w", err)
+	}
+
+	log.Info("learner caught up with the leader and was promoted to a voting member")
+
+	return nil
+}
+
+// getLeaderRevision returns the revision of the current cluster leader.
+func getLeaderRevision(ctx context.Context, c *client.Client) (int64, error) {
+	var leaderID uint64
+	for _, endpoint := range c.Endpoints() {
+		status, err := c.Status(ctx, endpoint)
+		if err == nil && status.Leader != 0 {
+			leaderID = status.Leader
+			break
+		}
+	}
+
+	if leaderID == 0 {
+		return 0, errors.New("no member knows about a leader")
+	}
+
+	resp, err := c.MemberList(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, member := range resp.Members {
+		if member.ID != leaderID {
+			continue
+		}
+
+		if len(member.ClientURLs) == 0 {
+			return 0, errors.New("leader has no client URLs")
+		}
+
+		// we use the cluster FQDN endpoint url here. Using the IP endpoint will
+		// fail because the certificates don't include Pod IP addresses.
+		status, err := c.Status(ctx, member.ClientURLs[len(member.ClientURLs)-1])
+		if err != nil {
+			return 0, err
+		}
+
+		return status.Header.Revision, nil
+	}
+
+	return 0, fmt.Errorf("leader %x is not a cluster member", leaderID)
+}
+
+// learnerProgress remembers the highest raft index a learner has applied and
+// when that index last advanced.
+type learnerProgress struct {
+	appliedIndex uint64
+	since        time.Time
+}
+
+// observe records the learner's current applied index and returns for how long
+// the learner has not made any progress.
+func (p *learnerProgress) observe(appliedIndex uint64, now time.Time) time.Duration {
+	if p.since.IsZero() || appliedIndex > p.appliedIndex {
+		p.appliedIndex = appliedIndex
+		p.since = now
+	}
+
+	return now.Sub(p.since)
+}
+
+// learnerTracker remembers the progress of learners, so that learners which stop
+// catching up can be removed. A stuck learner would otherwise block all other
+// members from joining, as etcd only allows one learner at a time.
+type learnerTracker struct {
+	progress map[uint64]learnerProgress
+}
+
+// stuck returns all learners whose applied index has not advanced for at least
+// the given timeout. Learners missing from appliedIndexes, e.g. because they
+// cannot be reached, are not making any progress. Members that are no longer
+// learners are forgotten.
+func (t *learnerTracker) stuck(members []*etcdserverpb.Member, appliedIndexes map[uint64]uint64, now time.Time, timeout time.Duration) []*etcdserverpb.Member {
+	progress := map[uint64]learnerProgress{}
+	stuck := []*etcdserverpb.Member{}
+
+	for _, member := range members {
+		if !member.IsLearner {
+			continue
+		}
+
+		p := t.progress[member.ID]
+		if p.observe(appliedIndexes[member.ID], now) >= timeout {
+			stuck = append(stuck, member)
+		}
+		progress[member.ID] = p
+	}
+
+	t.progress = progress
+
+	return stuck
+}
+
+// getLearnerAppliedIndexes returns the applied raft index of all learners that
+// can be reached.
+func getLearnerAppliedIndexes(ctx context.Context, c *client.Client, members []*etcdserverpb.Member) map[uint64]uint64 {
+	appliedIndexes := map[uint64]uint64{}
+
+	for _, member := range members {
+		// learners without client URLs have not been started yet
+		if !member.IsLearner || len(member.ClientURLs) == 0 {
+			continue
+		}
+
+		ctx, cancelFunc := context.WithTimeout(ctx, timeoutLearnerStatus)
+		status, err := c.Status(ctx, member.ClientURLs[len(member.ClientURLs)-1])
+		cancelFunc()
+
+		if err == nil {
+			appliedIndexes[member.ID] = status.RaftAppliedIndex
+		}
+	}
+
+	return appliedIndexes
+}
+
+// removeLearners removes the given learners from the cluster. Learners do not
+// count towards the quorum, so this is safe even for an unhealthy cluster.
+func removeLearners(ctx context.Context, log *zap.SugaredLogger, c *client.Client, learners []*etcdserverpb.Member) error {
+	for _, learner := range learners {
+		log.Warnw("learner stopped catching up with the leader, removing from cluster", "member-name", learner.Name, "member-id", fmt.Sprintf("%x", learner.ID))
+
+		ctx, cancelFunc := context.WithTimeout(ctx, timeoutRemoveMember)
+		_, err := c.MemberRemove(ctx, learner.ID)
+		cancelFunc()
+
+		if err != nil && !errors.Is(err, rpctypes.ErrMemberNotFound) {
+			return fmt.Errorf("failed to remove learner %x: %w", learner.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+//go:build integration
+
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	client "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/embed"
+	"go.uber.org/zap"
+)
+
+type testMember struct {
+	name      string
+	peerURL   url.URL
+	clientURL url.URL
+}
+
+func newTestMember(t *testing.T, name string) testMember {
+	return testMember{
+		name:      name,
+		peerURL:   freeURL(t),
+		clientURL: freeURL(t),
+	}
+}
+
+func (m testMember) initialCluster() string {
+	return fmt.Sprintf("%s=%s", m.name, m.peerURL.String())
+}
+
+// freeURL returns a localhost URL with a port that is currently not in use.
+func freeURL(t *testing.T) url.URL {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to find free port: %v", err)
+	}
+	defer listener.Close()
+
+	return url.URL{Scheme: "http", Host: listener.Addr().String()}
+}
+
+func startMember(t *testing.T, member testMember, initialCluster []testMember, state string) *embed.Etcd {
+	cluster := []string{}
+	for _, m := range initialCluster {
+		cluster = append(cluster, m.initialCluster())
+	}
+
+	cfg := embed.NewConfig()
+	cfg.Name = member.name
+	cfg.Dir = t.TempDir()
+	cfg.LogLevel = "error"
+	cfg.ListenPeerUrls = []url.URL{member.peerURL}
+	cfg.AdvertisePeerUrls = []url.URL{member.peerURL}
+	cfg.ListenClientUrls = []url.URL{member.clientURL}
+	cfg.AdvertiseClientUrls = []url.URL{member.clientURL}
+	cfg.InitialCluster = strings.Join(cluster, ",")
+	cfg.InitialClusterToken = "learner-test"
+	cfg.ClusterState = state
+
+	server, err := embed.StartEtcd(cfg)
+	if err != nil {
+		t.Fatalf("Failed to start member %s: %v", member.name, err)
+	}
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func getMember(t *testing.T, ctx context.Context, c *client.Client, id uint64) *etcdserverpb.Member {
+	resp, err := c.MemberList(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list members: %v", err)
+	}
+
+	for _, member := range resp.Members {
+		if member.ID == id {
+			return member
+		}
+	}
+
+	return nil
+}
+
+func TestLearnerJoin(t *testing.T) {
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+
+	voters := []testMember{
+		newTestMember(t, "etcd-0"),
+		newTestMember(t, "etcd-1"),
+		newTestMember(t, "etcd-2"),
+	}
+
+	servers := []*embed.Etcd{}
+	for _, member := range voters {
+		servers = append(servers, startMember(t, member, voters, embed.ClusterStateFlagNew))
+	}
+
+	for _, server := range servers {
+		select {
+		case <-server.Server.ReadyNotify():
+		case <-time.After(time.Minute):
+			t.Fatal("Timed out waiting for the cluster to become ready")
+		}
+	}
+
+	endpoints := []string{}
+	for _, member := range voters {
+		endpoints = append(endpoints, member.clientURL.String())
+	}
+
+	c, err := client.New(client.Config{Endpoints: endpoints, DialTimeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer c.Close()
+
+	// give the learner something to catch up with
+	for i := range 100 {
+		if _, err := c.Put(ctx, fmt.Sprintf("key-%d", i), "value"); err != nil {
+			t.Fatalf("Failed to write key: %v", err)
+		}
+	}
+
+	learner := newTestMember(t, "etcd-3")
+
+	added, err := addLearner(ctx, log, c, []string{learner.peerURL.String()}, 10*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to add learner: %v", err)
+	}
+
+	if !added.IsLearner {
+		t.Fatal("Expected new member to be added as learner")
+	}
+
+	// etcd only allows a single learner, so a second member cannot join until
+	// the first one has been promoted
+	stuckLearner := newTestMember(t, "etcd-4")
+	if _, err := addLearner(ctx, log, c, []string{stuckLearner.peerURL.String()}, 2*time.Second); err == nil {
+		t.Fatal("Expected adding a second learner to fail")
+	}
+
+	startMember(t, learner, append(voters, learner), embed.ClusterStateFlagExisting)
+
+	if err := promoteLearner(ctx, log, c, added.ID, learner.clientURL.String(), time.Minute); err != nil {
+		t.Fatalf("Failed to promote learner: %v", err)
+	}
+
+	if member := getMember(t, ctx, c, added.ID); member == nil || member.IsLearner {
+		t.Fatalf("Expected member to be promoted, got %v", member)
+	}
+
+	// promoting twice is fine, e.g. if the response to the first attempt got lost
+	if err := promoteLearner(ctx, log, c, added.ID, learner.clientURL.String(), time.Minute); err != nil {
+		t.Fatalf("Failed to promote already promoted member: %v", err)
+	}
+
+	// a learner whose pod never starts is stuck and has to be removed, so that
+	// other members can join again
+	stuck, err := addLearner(ctx, log, c, []string{stuckLearner.peerURL.String()}, 10*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to add learner: %v", err)
+	}
+
+	if err := promoteLearner(ctx, log, c, stuck.ID, stuckLearner.clientURL.String(), 2*time.Second); err == nil {
+		t.Fatal("Expected promoting a learner that never started to fail")
+	}
+
+	tracker := learnerTracker{}
+	now := time.Now()
+
+	resp, err := c.MemberList(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list members: %v", err)
+	}
+
+	appliedIndexes := getLearnerAppliedIndexes(ctx, c, resp.Members)
+	if _, ok := appliedIndexes[stuck.ID]; ok {
+		t.Fatal("Expected no applied index for a learner that never started")
+	}
+
+	tracker.stuck(resp.Members, appliedIndexes, now, timeoutStuckLearner)
+	stuckLearners := tracker.stuck(resp.Members, appliedIndexes, now.Add(timeoutStuckLearner), timeoutStuckLearner)
+	if len(stuckLearners) != 1 || stuckLearners[0].ID != stuck.ID {
+		t.Fatalf("Expected learner %x to be stuck, got %v", stuck.ID, stuckLearners)
+	}
+
+	if err := removeLearners(ctx, log, c, stuckLearners); err != nil {
+		t.Fatalf("Failed to remove stuck learner: %v", err)
+	}
+
+	if member := getMember(t, ctx, c, stuck.ID); member != nil {
+		t.Fatalf("Expected stuck learner to be removed, got %v", member)
+	}
+
+	// removing an already removed learner must not fail
+	if err := removeLearners(ctx, log, c, stuckLearners); err != nil {
+		t.Fatalf("Failed to remove already removed learner: %v", err)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+func TestLearnerTrackerStuck(t *testing.T) {
+	const timeout = 10 * time.Minute
+
+	voter := &etcdserverpb.Member{ID: 1, Name: "etcd-0"}
+	learner := &etcdserverpb.Member{ID: 2, Name: "etcd-1", IsLearner: true}
+	otherLearner := &etcdserverpb.Member{ID: 3, IsLearner: true}
+	promoted := &etcdserverpb.Member{ID: 2, Name: "etcd-1"}
+
+	// the learner is reachable, but never advances; the other learner cannot be reached
+	appliedIndexes := map[uint64]uint64{learner.ID: 42}
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker := learnerTracker{}
+
+	if stuck := tracker.stuck([]*etcdserverpb.Member{voter, learner}, appliedIndexes, now, timeout); len(stuck) != 0 {
+		t.Fatalf("Expected no stuck learners when first seen, got %v", stuck)
+	}
+
+	now = now.Add(timeout / 2)
+	if stuck := tracker.stuck([]*etcdserverpb.Member{voter, learner, otherLearner}, appliedIndexes, now, timeout); len(stuck) != 0 {
+		t.Fatalf("Expected no stuck learners before the timeout, got %v", stuck)
+	}
+
+	now = now.Add(timeout / 2)
+	stuck := tracker.stuck([]*etcdserverpb.Member{voter, learner, otherLearner}, appliedIndexes, now, timeout)
+	if len(stuck) != 1 || stuck[0].ID != learner.ID {
+		t.Fatalf("Expected learner %d to be stuck, got %v", learner.ID, stuck)
+	}
+
+	// once promoted, a member must be forgotten, so that a later learner with
+	// the same ID is not considered to be stuck immediately
+	if stuck := tracker.stuck([]*etcdserverpb.Member{voter, promoted, otherLearner}, appliedIndexes, now, timeout); len(stuck) != 0 {
+		t.Fatalf("Expected no stuck learners after promotion, got %v", stuck)
+	}
+
+	if _, ok := tracker.progress[learner.ID]; ok {
+		t.Fatal("Expected promoted member to be forgotten")
+	}
+
+	now = now.Add(timeout / 2)
+	stuck = tracker.stuck([]*etcdserverpb.Member{voter, promoted, otherLearner}, appliedIndexes, now, timeout)
+	if len(stuck) != 1 || stuck[0].ID != otherLearner.ID {
+		t.Fatalf("Expected learner %d to be stuck, got %v", otherLearner.ID, stuck)
+	}
+}
+
+func TestLearnerTrackerKeepsProgressingLearner(t *testing.T) {
+	const timeout = 10 * time.Minute
+
+	voter := &etcdserverpb.Member{ID: 1, Name: "etcd-0"}
+	learner := &etcdserverpb.Member{ID: 2, Name: "etcd-1", IsLearner: true}
+	members := []*etcdserverpb.Member{voter, learner}
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker := learnerTracker{}
+
+	// a slow learner that keeps applying entries must be kept for much longer
+	// than the timeout, e.g. while catching up after receiving a large snapshot
+	for i := range 10 {
+		appliedIndexes := map[uint64]uint64{learner.ID: uint64(100 * (i + 1))}
+		if stuck := tracker.stuck(members, appliedIndexes, now, timeout); len(stuck) != 0 {
+			t.Fatalf("Expected progressing learner to be kept after %v, got %v", time.Duration(i)*timeout/2, stuck)
+		}
+
+		now = now.Add(timeout / 2)
+	}
+
+	// an unreachable learner does not make any progress
+	if stuck := tracker.stuck(members, nil, now, timeout); len(stuck) != 0 {
+		t.Fatalf("Expected no stuck learners before the timeout, got %v", stuck)
+	}
+
+	now = now.Add(timeout)
+	stuck := tracker.stuck(members, nil, now, timeout)
+	if len(stuck) != 1 || stuck[0].ID != learner.ID {
+		t.Fatalf("Expected learner %d to be stuck once it stopped making progress, got %v", learner.ID, stuck)
+	}
+}
+
+func TestLearnerProgressObserve(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	progress := learnerProgress{}
+
+	if stalled := progress.observe(10, now); stalled != 0 {
+		t.Fatalf("Expected no stall when first observed, got %v", stalled)
+	}
+
+	if stalled := progress.observe(10, now.Add(time.Minute)); stalled != time.Minute {
+		t.Fatalf("Expected a stall of %v, got %v", time.Minute, stalled)
+	}
+
+	// a failed status request must not reset the progress
+	if stalled := progress.observe(0, now.Add(2*time.Minute)); stalled != 2*time.Minute {
+		t.Fatalf("Expected a stall of %v, got %v", 2*time.Minute, stalled)
+	}
+
+	if stalled := progress.observe(11, now.Add(3*time.Minute)); stalled != 0 {
+		t.Fatalf("Expected no stall after progress, got %v", stalled)
+	}
+}
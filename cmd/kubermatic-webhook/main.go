@@ -45,6 +45,8 @@ import (
 	mlaadminsettingmutation "k8c.io/kubermatic/v2/pkg/webhook/mlaadminsetting/mutation"
 	policieswebhook "k8c.io/kubermatic/v2/pkg/webhook/policies"
 	policytemplatevalidation "k8c.io/kubermatic/v2/pkg/webhook/policytemplate/validation"
+	projectmutation "k8c.io/kubermatic/v2/pkg/webhook/project/mutation"
+	projectvalidation "k8c.io/kubermatic/v2/pkg/webhook/project/validation"
 	resourcequotavalidation "k8c.io/kubermatic/v2/pkg/webhook/resourcequota/validation"
 	seedwebhook "k8c.io/kubermatic/v2/pkg/webhook/seed"
 	uservalidation "k8c.io/kubermatic/v2/pkg/webhook/user/validation"
@@ -196,6 +198,15 @@ func main() {
 		log.Fatalw("Failed to setup user validation webhook", zap.Error(err))
 	}
 
+	// /////////////////////////////////////////
+	// setup Project webhooks
+
+	projectmutation.NewAdmissionHandler(log, mgr.GetScheme()).SetupWebhookWithManager(mgr)
+
+	if err := builder.WebhookManagedBy(mgr, &kubermaticv1.Project{}).WithValidator(projectvalidation.NewValidator()).Complete(); err != nil {
+		log.Fatalw("Failed to setup project validation webhook", zap.Error(err))
+	}
+
 	// /////////////////////////////////////////
 	// setup Resource Quota webhooks
 
@@ -28,11 +28,13 @@ import (
 	clustertemplatesynchronizer "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/cluster-template-synchronizer"
 	encryptionsecretsynchonizer "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/encryption-secret-synchronizer"
 	externalcluster "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/external-cluster"
+	externalclusterapplicationcontroller "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/external-cluster-application-controller"
 	httproutegatewaysync "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/httproute-gateway-sync"
 	kcstatuscontroller "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/kc-status-controller"
 	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/kubeone"
 	masterconstraintsynchronizer "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/master-constraint-controller"
 	masterconstrainttemplatecontroller "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/master-constraint-template-controller"
+	platformaudit "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/platform-audit"
 	policytemplatesynchronizer "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/policy-template-synchronizer"
 	presetsynchronizer "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/preset-synchronizer"
 	projectlabelsynchronizer "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/project-label-synchronizer"
@@ -42,6 +44,8 @@ import (
 	seedstatuscontroller "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/seed-status-controller"
 	seedsync "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/seed-sync"
 	serviceaccount "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/serviceaccount-projectbinding-controller"
+	staleclustercontroller "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/stale-cluster-controller"
+	updaterolloutcontroller "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/update-rollout-controller"
 	userprojectbinding "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/user-project-binding"
 	userprojectbindingsynchronizer "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/user-project-binding-synchronizer"
 	usersynchronizer "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/user-synchronizer"
@@ -84,6 +88,11 @@ func createAllControllers(ctrlCtx *controllerContext) error {
 		resourceQuotaControllerFactoryCreator(ctrlCtx),
 		policyTemplateSynchronizerFactoryCreator(ctrlCtx),
 		encryptionSecretSynchronizerFactoryCreator(ctrlCtx),
+		updateRolloutControllerFactoryCreator(ctrlCtx),
+	}
+
+	if ctrlCtx.platformAuditSink != nil {
+		controllerFactories = append(controllerFactories, platformAuditFactoryCreator(ctrlCtx))
 	}
 
 	// Create the user-ssh-key-synchronizer controller even DisableUserSSHKey feature is set
@@ -102,7 +111,7 @@ func createAllControllers(ctrlCtx *controllerContext) error {
 		//TODO: Find a better name
 		return fmt.Errorf("failed to create seedcontrollerlifecycle: %w", err)
 	}
-	if err := userprojectbinding.Add(ctrlCtx.ctx, ctrlCtx.mgr, ctrlCtx.log); err != nil {
+	if err := userprojectbinding.Add(ctrlCtx.ctx, ctrlCtx.mgr, ctrlCtx.log, ctrlCtx.expiredBindingRetention); err != nil {
 		return fmt.Errorf("failed to create user-project-binding controller: %w", err)
 	}
 
@@ -130,9 +139,15 @@ func createAllControllers(ctrlCtx *controllerContext) error {
 	if err := kubeone.Add(ctrlCtx.ctx, ctrlCtx.mgr, ctrlCtx.log, ctrlCtx.overwriteRegistry); err != nil {
 		return fmt.Errorf("failed to create kubeone controller: %w", err)
 	}
+	if err := externalclusterapplicationcontroller.Add(ctrlCtx.mgr, ctrlCtx.workerCount, ctrlCtx.log, ctrlCtx.configGetter, ctrlCtx.namespace, ctrlCtx.applicationCache, ctrlCtx.overwriteRegistry); err != nil {
+		return fmt.Errorf("failed to create external cluster application controller: %w", err)
+	}
 	if err := kcstatuscontroller.Add(ctrlCtx.ctx, ctrlCtx.mgr, 1, ctrlCtx.log, ctrlCtx.namespace, ctrlCtx.versions); err != nil {
 		return fmt.Errorf("failed to create kubermatic configuration controller: %w", err)
 	}
+	if err := staleclustercontroller.Add(ctrlCtx.mgr, ctrlCtx.log, ctrlCtx.seedsGetter, ctrlCtx.seedKubeconfigGetter); err != nil {
+		return fmt.Errorf("failed to create stale cluster controller: %w", err)
+	}
 
 	if ctrlCtx.featureGates.Enabled(features.HTTPRouteGatewaySync) {
 		if err := httproutegatewaysync.Add(ctrlCtx.ctx, ctrlCtx.mgr, ctrlCtx.log, ctrlCtx.namespace, ctrlCtx.httprouteWatchNamespaces); err != nil {
@@ -261,6 +276,18 @@ func projectSynchronizerFactoryCreator(ctrlCtx *controllerContext) seedcontrolle
 	}
 }
 
+func updateRolloutControllerFactoryCreator(ctrlCtx *controllerContext) seedcontrollerlifecycle.ControllerFactory {
+	return func(ctx context.Context, masterMgr manager.Manager, seedManagerMap map[string]manager.Manager) (string, error) {
+		return updaterolloutcontroller.ControllerName, updaterolloutcontroller.Add(
+			masterMgr,
+			seedManagerMap,
+			ctrlCtx.log,
+			ctrlCtx.namespace,
+			ctrlCtx.configGetter,
+		)
+	}
+}
+
 func applicationDefinitionSynchronizerFactoryCreator(ctrlCtx *controllerContext) seedcontrollerlifecycle.ControllerFactory {
 	return func(ctx context.Context, masterMgr manager.Manager, seedManagerMap map[string]manager.Manager) (string, error) {
 		return applicationdefinitionsynchronizer.ControllerName, applicationdefinitionsynchronizer.Add(
@@ -317,3 +344,14 @@ func encryptionSecretSynchronizerFactoryCreator(ctrlCtx *controllerContext) seed
 		)
 	}
 }
+
+func platformAuditFactoryCreator(ctrlCtx *controllerContext) seedcontrollerlifecycle.ControllerFactory {
+	return func(ctx context.Context, masterMgr manager.Manager, seedManagerMap map[string]manager.Manager) (string, error) {
+		return platformaudit.ControllerName, platformaudit.Add(
+			masterMgr,
+			seedManagerMap,
+			ctrlCtx.log,
+			ctrlCtx.platformAuditSink,
+		)
+	}
+}
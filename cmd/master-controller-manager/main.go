@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/go-logr/zapr"
 	"github.com/prometheus/client_golang/prometheus"
@@ -30,6 +31,7 @@ import (
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/collectors"
+	platformaudit "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/platform-audit"
 	"k8c.io/kubermatic/v2/pkg/defaulting"
 	"k8c.io/kubermatic/v2/pkg/features"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
@@ -66,6 +68,7 @@ type controllerRunOptions struct {
 	featureGates             features.FeatureGate
 	configFile               string
 	httprouteWatchNamespaces string
+	platformAudit            kubermaticv1.KubermaticPlatformAuditConfiguration
 
 	workerName string
 	namespace  string
@@ -87,6 +90,9 @@ type controllerContext struct {
 	overwriteRegistry        string
 	featureGates             features.FeatureGate
 	httprouteWatchNamespaces []string
+	platformAuditSink        platformaudit.Sink
+	expiredBindingRetention  time.Duration
+	applicationCache         string
 
 	configGetter provider.KubermaticConfigurationGetter
 }
@@ -112,6 +118,12 @@ func main() {
 	flag.Var(&runOpts.featureGates, "feature-gates", "A set of key=value pairs that describe feature gates for various features.")
 	flag.StringVar(&runOpts.configFile, "kubermatic-configuration-file", "", "(for development only) path to a KubermaticConfiguration YAML file")
 	flag.StringVar(&runOpts.httprouteWatchNamespaces, "httproute-watch-namespaces", "monitoring,mla", "Comma-separated list of namespaces to watch HTTPRoutes for Gateway listener sync")
+	flag.StringVar((*string)(&runOpts.platformAudit.Sink), "platform-audit-sink", "", "Where to send platform audit events to, one of \"file\", \"webhook\" or \"syslog\". Leave empty to disable the platform audit trail.")
+	flag.StringVar(&runOpts.platformAudit.FilePath, "platform-audit-file-path", "", "Path to the file platform audit events are appended to (when using the file sink).")
+	flag.StringVar(&runOpts.platformAudit.WebhookURL, "platform-audit-webhook-url", "", "URL platform audit events are POSTed to (when using the webhook sink).")
+	flag.StringVar(&runOpts.platformAudit.SyslogAddress, "platform-audit-syslog-address", "", "Address of the syslog server in the form network://host:port (when using the syslog sink); leave empty to use the local syslog daemon.")
+	flag.DurationVar(&ctrlCtx.expiredBindingRetention, "expired-binding-retention", 30*24*time.Hour, "How long expired UserProjectBindings and GroupProjectBindings are kept for auditing before they are deleted; 0 keeps them forever.")
+	flag.StringVar(&ctrlCtx.applicationCache, "application-cache", os.TempDir(), "Path to the Application cache directory, used to install Applications into external clusters.")
 	addFlags(flag.CommandLine)
 	flag.Parse()
 
@@ -148,6 +160,14 @@ func main() {
 		}
 	}
 
+	ctrlCtx.platformAuditSink, err = platformaudit.NewSink(runOpts.platformAudit)
+	if err != nil {
+		log.Fatalw("failed to create platform audit sink", zap.Error(err))
+	}
+	if ctrlCtx.platformAuditSink != nil {
+		defer ctrlCtx.platformAuditSink.Close()
+	}
+
 	// register the global error metric. Ensures that runtime.HandleError() increases the error metric
 	metrics.RegisterRuntimErrorMetricCounter("kubermatic_master_controller_manager", prometheus.DefaultRegisterer)
 
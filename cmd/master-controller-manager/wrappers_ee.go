@@ -29,8 +29,10 @@ import (
 	storagelocationcontroller "k8c.io/kubermatic/v2/pkg/ee/cluster-backup/master/storage-location-controller"
 	storagelocationsynccontroller "k8c.io/kubermatic/v2/pkg/ee/cluster-backup/master/sync-controller"
 	eemasterctrlmgr "k8c.io/kubermatic/v2/pkg/ee/cmd/master-controller-manager"
+	externalclusterpolicycontroller "k8c.io/kubermatic/v2/pkg/ee/external-cluster-policy-controller"
 	groupprojectbinding "k8c.io/kubermatic/v2/pkg/ee/group-project-binding/controller"
 	groupprojectbindingsyncer "k8c.io/kubermatic/v2/pkg/ee/group-project-binding/sync-controller"
+	policycompliancecontroller "k8c.io/kubermatic/v2/pkg/ee/policy-compliance-controller"
 	resourcequotadefaultcontroller "k8c.io/kubermatic/v2/pkg/ee/resource-quota/default-quota-controller"
 	resourcequotalabelownercontroller "k8c.io/kubermatic/v2/pkg/ee/resource-quota/label-owner-controller"
 	resourcequotamastercontroller "k8c.io/kubermatic/v2/pkg/ee/resource-quota/master-controller"
@@ -58,7 +60,7 @@ func setupControllers(ctrlCtx *controllerContext) error {
 		return fmt.Errorf("failed to create GroupProjectBinding sync controller: %w", err)
 	}
 
-	if err := groupprojectbinding.Add(ctrlCtx.mgr, ctrlCtx.log, ctrlCtx.workerCount, true); err != nil {
+	if err := groupprojectbinding.Add(ctrlCtx.mgr, ctrlCtx.log, ctrlCtx.workerCount, true, ctrlCtx.expiredBindingRetention); err != nil {
 		return fmt.Errorf("failed to create GroupProjectBinding controller: %w", err)
 	}
 
@@ -74,6 +76,10 @@ func setupControllers(ctrlCtx *controllerContext) error {
 		return fmt.Errorf("failed to create storage location controller: %w", err)
 	}
 
+	if err := externalclusterpolicycontroller.Add(ctrlCtx.mgr, ctrlCtx.workerCount, ctrlCtx.log); err != nil {
+		return fmt.Errorf("failed to create external cluster policy controller: %w", err)
+	}
+
 	return nil
 }
 
@@ -87,6 +93,14 @@ func setupLifecycleControllerCreators(ctrlCtx *controllerContext) []seedcontroll
 				ctrlCtx.workerCount,
 			)
 		},
+		func(ctx context.Context, masterMgr manager.Manager, seedManagerMap map[string]manager.Manager) (string, error) {
+			return policycompliancecontroller.ControllerName, policycompliancecontroller.Add(
+				masterMgr,
+				seedManagerMap,
+				ctrlCtx.log,
+				ctrlCtx.workerCount,
+			)
+		},
 	}
 }
 
@@ -29,6 +29,7 @@ import (
 	autoupdatecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/auto-update-controller"
 	cloudcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cloud"
 	clustercredentialscontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cluster-credentials-controller"
+	clusterhibernationcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cluster-hibernation-controller"
 	clusterphasecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cluster-phase-controller"
 	clusterstuckcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cluster-stuck-controller"
 	clustertemplatecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cluster-template-controller"
@@ -37,6 +38,8 @@ import (
 	constrainttemplatecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/constraint-template-controller"
 	defaultapplicationcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/default-application-controller"
 	encryptionatrestcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/encryption-at-rest-controller"
+	etcdmaintenancecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/etcd-maintenance-controller"
+	etcdstoragemigrationcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/etcd-storage-migration-controller"
 	etcdbackupcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/etcdbackup"
 	etcdrestorecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/etcdrestore"
 	eventratelimitenforcement "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/event-rate-limit-enforcement-controller"
@@ -52,6 +55,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/pvwatcher"
 	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/seedresourcesuptodatecondition"
 	updatecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/update-controller"
+	upgradepreflightcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/upgrade-preflight-controller"
 	"k8c.io/kubermatic/v2/pkg/features"
 )
 
@@ -62,10 +66,13 @@ var AllControllers = map[string]controllerCreator{
 	kubernetescontroller.ControllerName:                     createKubernetesController,
 	autoupdatecontroller.ControllerName:                     createAutoUpdateController,
 	updatecontroller.ControllerName:                         createUpdateController,
+	upgradepreflightcontroller.ControllerName:               createUpgradePreflightController,
 	addon.ControllerName:                                    createAddonController,
 	addoninstaller.ControllerName:                           createAddonInstallerController,
 	etcdbackupcontroller.ControllerName:                     createEtcdBackupController,
 	etcdrestorecontroller.ControllerName:                    createEtcdRestoreController,
+	etcdstoragemigrationcontroller.ControllerName:           createEtcdStorageMigrationController,
+	etcdmaintenancecontroller.ControllerName:                createEtcdMaintenanceController,
 	monitoring.ControllerName:                               createMonitoringController,
 	cloudcontroller.ControllerName:                          createCloudController,
 	seedresourcesuptodatecondition.ControllerName:           createSeedConditionUpToDateController,
@@ -80,6 +87,7 @@ var AllControllers = map[string]controllerCreator{
 	clustertemplatecontroller.ControllerName:                createClusterTemplateController,
 	projectcontroller.ControllerName:                        createProjectController,
 	clusterphasecontroller.ControllerName:                   createClusterPhaseController,
+	clusterhibernationcontroller.ControllerName:             createClusterHibernationController,
 	presetcontroller.ControllerName:                         createPresetController,
 	encryptionatrestcontroller.ControllerName:               createEncryptionAtRestController,
 	ipam.ControllerName:                                     createIPAMController,
@@ -237,6 +245,7 @@ func createAutoUpdateController(ctrlCtx *controllerContext) error {
 		ctrlCtx.runOptions.workerCount,
 		ctrlCtx.runOptions.workerName,
 		ctrlCtx.configGetter,
+		ctrlCtx.seedGetter,
 		ctrlCtx.clientProvider,
 		ctrlCtx.log,
 		ctrlCtx.versions,
@@ -249,6 +258,18 @@ func createUpdateController(ctrlCtx *controllerContext) error {
 		ctrlCtx.runOptions.workerCount,
 		ctrlCtx.runOptions.workerName,
 		ctrlCtx.configGetter,
+		ctrlCtx.seedGetter,
+		ctrlCtx.log,
+		ctrlCtx.versions,
+	)
+}
+
+func createUpgradePreflightController(ctrlCtx *controllerContext) error {
+	return upgradepreflightcontroller.Add(
+		ctrlCtx.mgr,
+		ctrlCtx.runOptions.workerCount,
+		ctrlCtx.runOptions.workerName,
+		ctrlCtx.clientProvider,
 		ctrlCtx.log,
 		ctrlCtx.versions,
 	)
@@ -263,6 +284,37 @@ func createClusterPhaseController(ctrlCtx *controllerContext) error {
 	)
 }
 
+func createClusterHibernationController(ctrlCtx *controllerContext) error {
+	return clusterhibernationcontroller.Add(
+		ctrlCtx.mgr,
+		ctrlCtx.runOptions.workerCount,
+		ctrlCtx.runOptions.workerName,
+		ctrlCtx.clientProvider,
+		ctrlCtx.log,
+	)
+}
+
+func createEtcdStorageMigrationController(ctrlCtx *controllerContext) error {
+	return etcdstoragemigrationcontroller.Add(
+		ctrlCtx.mgr,
+		ctrlCtx.runOptions.workerCount,
+		ctrlCtx.runOptions.workerName,
+		ctrlCtx.log,
+		ctrlCtx.versions,
+	)
+}
+
+func createEtcdMaintenanceController(ctrlCtx *controllerContext) error {
+	return etcdmaintenancecontroller.Add(
+		ctrlCtx.mgr,
+		ctrlCtx.runOptions.workerCount,
+		ctrlCtx.runOptions.workerName,
+		ctrlCtx.seedGetter,
+		ctrlCtx.log,
+		ctrlCtx.versions,
+	)
+}
+
 func createAddonController(ctrlCtx *controllerContext) error {
 	allAddons, err := addonutil.LoadAddonsFromDirectory(ctrlCtx.runOptions.addonsPath)
 	if err != nil {
@@ -413,6 +465,7 @@ func createClusterTemplateController(ctrlCtx *controllerContext) error {
 		ctrlCtx.log,
 		ctrlCtx.runOptions.workerName,
 		ctrlCtx.runOptions.namespace,
+		ctrlCtx.runOptions.seedName,
 		ctrlCtx.runOptions.workerCount,
 	)
 }
@@ -441,6 +494,8 @@ func createEncryptionAtRestController(ctrlCtx *controllerContext) error {
 }
 
 func createIPAMController(ctrlCtx *controllerContext) error {
+	ipam.MustRegisterMetrics(prometheus.DefaultRegisterer)
+
 	return ipam.Add(
 		ctrlCtx.mgr,
 		ctrlCtx.log,
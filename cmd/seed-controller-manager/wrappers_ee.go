@@ -23,6 +23,7 @@ import (
 	"flag"
 	"fmt"
 
+	clusterbackuppolicystatus "k8c.io/kubermatic/v2/pkg/ee/cluster-backup/seed/policy-status-controller"
 	clusterbackuprbac "k8c.io/kubermatic/v2/pkg/ee/cluster-backup/seed/rbac-controller"
 	eeseedctrlmgr "k8c.io/kubermatic/v2/pkg/ee/cmd/seed-controller-manager"
 	defaultpolicycontroller "k8c.io/kubermatic/v2/pkg/ee/default-policy-controller"
@@ -53,7 +54,7 @@ func setupControllers(ctrlCtx *controllerContext) error {
 		return fmt.Errorf("failed to create resource quota controller: %w", err)
 	}
 
-	if err := groupprojectbindingcontroller.Add(ctrlCtx.mgr, ctrlCtx.log, ctrlCtx.runOptions.workerCount, false); err != nil {
+	if err := groupprojectbindingcontroller.Add(ctrlCtx.mgr, ctrlCtx.log, ctrlCtx.runOptions.workerCount, false, 0); err != nil {
 		return fmt.Errorf("failed to create GroupProjectBinding controller: %w", err)
 	}
 
@@ -69,6 +70,10 @@ func setupControllers(ctrlCtx *controllerContext) error {
 		return fmt.Errorf("failed to create cluster-backup rbac controller: %w", err)
 	}
 
+	if err := clusterbackuppolicystatus.Add(ctrlCtx.mgr, ctrlCtx.log, ctrlCtx.runOptions.workerCount); err != nil {
+		return fmt.Errorf("failed to create cluster-backup policy status controller: %w", err)
+	}
+
 	if err := kyvernocontroller.Add(ctrlCtx.mgr, ctrlCtx.runOptions.workerCount, ctrlCtx.runOptions.workerName, ctrlCtx.runOptions.overwriteRegistry, ctrlCtx.clientProvider, ctrlCtx.seedGetter, ctrlCtx.configGetter, ctrlCtx.log, ctrlCtx.versions); err != nil {
 		return fmt.Errorf("failed to create Kyverno controller: %w", err)
 	}
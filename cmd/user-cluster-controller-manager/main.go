@@ -26,6 +26,7 @@ import (
 
 	"github.com/go-logr/zapr"
 	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	policyreportv1alpha2 "github.com/kyverno/kyverno/api/policyreport/v1alpha2"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"go.uber.org/zap"
 
@@ -40,6 +41,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/ipam"
 	kvvmieviction "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/kubevirt-vmi-eviction"
 	nodelabeler "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/node-labeler"
+	nodeupgradecontroller "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/node-upgrade-controller"
 	nodeversioncontroller "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/node-version-controller"
 	ownerbindingcreator "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/owner-binding-creator"
 	rbacusercluster "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/rbac"
@@ -304,6 +306,9 @@ func main() {
 	if err := kyvernov1.Install(mgr.GetScheme()); err != nil {
 		log.Fatalw("Failed to register scheme", zap.Stringer("api", kyvernov1.GroupVersion), zap.Error(err))
 	}
+	if err := policyreportv1alpha2.Install(mgr.GetScheme()); err != nil {
+		log.Fatalw("Failed to register scheme", zap.Stringer("api", policyreportv1alpha2.GroupVersion), zap.Error(err))
+	}
 
 	isPausedChecker := userclustercontrollermanager.NewClusterPausedChecker(seedMgr.GetClient(), runOp.clusterName)
 
@@ -399,6 +404,11 @@ func main() {
 	}
 	log.Info("Registered node-version controller")
 
+	if err := nodeupgradecontroller.Add(rootCtx, log, seedMgr, mgr, runOp.clusterName, isPausedChecker); err != nil {
+		log.Fatalw("Failed to register node-upgrade controller", zap.Error(err))
+	}
+	log.Info("Registered node-upgrade controller")
+
 	if err := clusterrolelabeler.Add(rootCtx, log, mgr, isPausedChecker); err != nil {
 		log.Fatalw("Failed to register clusterrolelabeler controller", zap.Error(err))
 	}
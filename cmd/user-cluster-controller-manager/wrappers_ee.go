@@ -26,8 +26,11 @@ import (
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	userclustercontrollermanager "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager"
+	restorecontroller "k8c.io/kubermatic/v2/pkg/ee/cluster-backup/user-cluster/restore-controller"
+	schedulecontroller "k8c.io/kubermatic/v2/pkg/ee/cluster-backup/user-cluster/schedule-controller"
 	velerocontroller "k8c.io/kubermatic/v2/pkg/ee/cluster-backup/user-cluster/velero-controller"
 	policybindingcontroller "k8c.io/kubermatic/v2/pkg/ee/policy-binding-controller"
+	policyreportcontroller "k8c.io/kubermatic/v2/pkg/ee/policy-report-controller"
 	resourceusagecontroller "k8c.io/kubermatic/v2/pkg/ee/resource-usage-controller"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
@@ -82,8 +85,14 @@ func setupSeedManager(restConfig *rest.Config, opt controllerRunOptions) (manage
 			// The cluster backup feature (EE) needs to read CBSL and their credentials from the kubermatic namespace,
 			// but only has permission to read exactly these 2 objects from the kubermatic namespace. For its own
 			// cluster namespace however, we are allowed to access all Secrets.
+			// ClusterBackupPolicies are readable in all namespaces, but only used from the kubermatic namespace.
 			ByObject: map[ctrlruntimeclient.Object]cache.ByObject{
 				&kubermaticv1.ClusterBackupStorageLocation{}: cbslCacheOptions,
+				&kubermaticv1.ClusterBackupPolicy{}: {
+					Namespaces: map[string]cache.Config{
+						resources.KubermaticNamespace: {},
+					},
+				},
 				&corev1.Secret{}: secretCacheOptions,
 			},
 		},
@@ -111,11 +120,23 @@ func setupControllers(
 		return fmt.Errorf("failed to create cluster-backup controller: %w", err)
 	}
 
+	if err := schedulecontroller.Add(seedMgr, userMgr, log, clusterName, clusterIsPaused); err != nil {
+		return fmt.Errorf("failed to create cluster-backup schedule controller: %w", err)
+	}
+
+	if err := restorecontroller.Add(seedMgr, userMgr, log, namespace, clusterName, clusterIsPaused); err != nil {
+		return fmt.Errorf("failed to create cluster-backup restore controller: %w", err)
+	}
+
 	// Only enable policy binding controller if Kyverno is enabled.
 	if kyvernoEnabled {
 		if err := policybindingcontroller.Add(seedMgr, userMgr, log, namespace, clusterName, clusterIsPaused); err != nil {
 			return fmt.Errorf("failed to create policy-binding controller: %w", err)
 		}
+
+		if err := policyreportcontroller.Add(seedMgr, userMgr, log, namespace, clusterIsPaused); err != nil {
+			return fmt.Errorf("failed to create policy-report controller: %w", err)
+		}
 	}
 
 	return nil
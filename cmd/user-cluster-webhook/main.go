@@ -34,8 +34,10 @@ import (
 	applicationinstallationvalidation "k8c.io/kubermatic/v2/pkg/webhook/application/applicationinstallation/validation"
 	machinemutation "k8c.io/kubermatic/v2/pkg/webhook/machine/mutation"
 	machinevalidation "k8c.io/kubermatic/v2/pkg/webhook/machine/validation"
+	objectquotavalidation "k8c.io/kubermatic/v2/pkg/webhook/objectquota/validation"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	ctrlruntime "sigs.k8s.io/controller-runtime"
@@ -129,7 +131,7 @@ func main() {
 	applicationinstallationmutation.NewAdmissionHandler(log, seedMgr.GetScheme(), seedMgr.GetClient()).SetupWebhookWithManager(seedMgr)
 
 	// Setup the validation admission handler for ApplicationInstallation CRDs in seed manager.
-	applicationinstallationvalidation.NewAdmissionHandler(log, seedMgr.GetScheme(), seedMgr.GetClient(), options.clusterName).SetupWebhookWithManager(seedMgr)
+	applicationinstallationvalidation.NewAdmissionHandler(log, seedMgr.GetScheme(), seedMgr.GetClient(), options.clusterName, options.projectID).SetupWebhookWithManager(seedMgr)
 
 	// Setup Machine Webhook in user manager.
 	machineValidator, err := machinevalidation.NewValidator(seedMgr.GetClient(), userMgr.GetClient(), log, options.caBundle, options.projectID, options.kubeVirtInfraNamespace)
@@ -140,6 +142,29 @@ func main() {
 		log.Fatalw("Failed to setup Machine validation webhook", zap.Error(err))
 	}
 
+	// Setup Service and PersistentVolumeClaim quota webhooks in user manager.
+	serviceValidator, err := objectquotavalidation.NewServiceValidator(seedMgr.GetClient(), log, options.projectID)
+	if err != nil {
+		log.Fatalw("Failed to setup Service quota validator", zap.Error(err))
+	}
+	if err := builder.WebhookManagedBy(userMgr, &corev1.Service{}).
+		WithValidator(serviceValidator).
+		WithValidatorCustomPath(resources.ServiceQuotaValidatingWebhookPath).
+		Complete(); err != nil {
+		log.Fatalw("Failed to setup Service quota validation webhook", zap.Error(err))
+	}
+
+	pvcValidator, err := objectquotavalidation.NewPersistentVolumeClaimValidator(seedMgr.GetClient(), log, options.projectID)
+	if err != nil {
+		log.Fatalw("Failed to setup PersistentVolumeClaim quota validator", zap.Error(err))
+	}
+	if err := builder.WebhookManagedBy(userMgr, &corev1.PersistentVolumeClaim{}).
+		WithValidator(pvcValidator).
+		WithValidatorCustomPath(resources.PersistentVolumeClaimQuotaValidatingWebhookPath).
+		Complete(); err != nil {
+		log.Fatalw("Failed to setup PersistentVolumeClaim quota validation webhook", zap.Error(err))
+	}
+
 	// The user-cluster controller installs the dedicated accelerator webhook configurations
 	// when the feature is activated. Handlers stay authoritative whenever those configurations
 	// call them, including during rolling updates and after sticky activation.
@@ -132,6 +132,22 @@ func NewTemplateData(
 		clusterVersion = cluster.Spec.Version.Semver()
 	}
 
+	cniPlugin := CNIPlugin{
+		Type:    cluster.Spec.CNIPlugin.Type.String(),
+		Version: cluster.Spec.CNIPlugin.Version,
+	}
+	pods := cluster.Spec.ClusterNetwork.Pods
+
+	// Until an in-place CNI migration has moved all nodes, the previous CNI plugin keeps
+	// running on its own pod network.
+	if source := kubermaticv1helper.CNIMigrationSource(cluster); source != nil {
+		cniPlugin = CNIPlugin{
+			Type:    source.Type.String(),
+			Version: source.Version,
+		}
+		pods = kubermaticv1.NetworkRanges{CIDRBlocks: source.PodsCIDRBlocks}
+	}
+
 	return &TemplateData{
 		DatacenterName: cluster.Spec.Cloud.DatacenterName,
 		Variables:      variables,
@@ -156,23 +172,20 @@ func NewTemplateData(
 				DNSDomain:            cluster.Spec.ClusterNetwork.DNSDomain,
 				DNSClusterIP:         dnsClusterIP,
 				DNSResolverIP:        dnsResolverIP,
-				PodCIDRBlocks:        cluster.Spec.ClusterNetwork.Pods.CIDRBlocks,
+				PodCIDRBlocks:        pods.CIDRBlocks,
 				ServiceCIDRBlocks:    cluster.Spec.ClusterNetwork.Services.CIDRBlocks,
 				ProxyMode:            cluster.Spec.ClusterNetwork.ProxyMode,
 				StrictArp:            ipvs.StrictArp,
 				DualStack:            cluster.IsDualStack(),
-				PodCIDRIPv4:          cluster.Spec.ClusterNetwork.Pods.GetIPv4CIDR(),
-				PodCIDRIPv6:          cluster.Spec.ClusterNetwork.Pods.GetIPv6CIDR(),
+				PodCIDRIPv4:          pods.GetIPv4CIDR(),
+				PodCIDRIPv6:          pods.GetIPv6CIDR(),
 				NodeCIDRMaskSizeIPv4: resources.GetClusterNodeCIDRMaskSizeIPv4(cluster),
 				NodeCIDRMaskSizeIPv6: resources.GetClusterNodeCIDRMaskSizeIPv6(cluster),
 				IPAMAllocations:      ipamAllocationsData,
 				NodePortRange:        cluster.Spec.ComponentsOverride.Apiserver.NodePortRange,
 			},
-			CNIPlugin: CNIPlugin{
-				Type:    cluster.Spec.CNIPlugin.Type.String(),
-				Version: cluster.Spec.CNIPlugin.Version,
-			},
-			CSI: csiOptions,
+			CNIPlugin: cniPlugin,
+			CSI:       csiOptions,
 			MLA: MLASettings{
 				MonitoringEnabled: cluster.Spec.MLA != nil && cluster.Spec.MLA.MonitoringEnabled,
 				LoggingEnabled:    cluster.Spec.MLA != nil && cluster.Spec.MLA.LoggingEnabled,
@@ -32,6 +32,7 @@ import (
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -103,10 +104,23 @@ func HandleAddonCleanup(ctx context.Context, applicationName string, seedCluster
 }
 
 // GetTemplateData fetches the related cluster object by the given cluster namespace, parses pre defined values to a template data struct.
+// If no KKP user cluster with the given name exists, the ExternalCluster of the same name is used instead.
 func GetTemplateData(ctx context.Context, seedClient ctrlruntimeclient.Client, clusterName string) (*TemplateData, error) {
 	cluster := &kubermaticv1.Cluster{}
 	if err := seedClient.Get(ctx, types.NamespacedName{Name: clusterName}, cluster); err != nil {
-		return nil, err
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		externalCluster := &kubermaticv1.ExternalCluster{}
+		if extErr := seedClient.Get(ctx, types.NamespacedName{Name: clusterName}, externalCluster); extErr != nil {
+			if apierrors.IsNotFound(extErr) || meta.IsNoMatchError(extErr) {
+				return nil, err
+			}
+			return nil, extErr
+		}
+
+		return getExternalClusterTemplateData(externalCluster)
 	}
 	var clusterVersion *semverlib.Version
 	if s := cluster.Status.Versions.ControlPlane.Semver(); s != nil {
@@ -137,6 +151,32 @@ func GetTemplateData(ctx context.Context, seedClient ctrlruntimeclient.Client, c
 	return nil, fmt.Errorf("failed to parse semver version for cluster %q", clusterName)
 }
 
+func getExternalClusterTemplateData(cluster *kubermaticv1.ExternalCluster) (*TemplateData, error) {
+	clusterVersion := cluster.Spec.Version.Semver()
+	if clusterVersion == nil {
+		return nil, fmt.Errorf("failed to parse semver version for cluster %q", cluster.Name)
+	}
+
+	data := &TemplateData{
+		Cluster: ClusterData{
+			Name:              cluster.Name,
+			HumanReadableName: cluster.Spec.HumanReadableName,
+			Version:           fmt.Sprintf("%d.%d.%d", clusterVersion.Major(), clusterVersion.Minor(), clusterVersion.Patch()),
+			MajorMinorVersion: fmt.Sprintf("%d.%d", clusterVersion.Major(), clusterVersion.Minor()),
+			Annotations:       cluster.Annotations,
+			Labels:            cluster.Labels,
+		},
+	}
+
+	// external clusters are not bound to the Kubernetes versions supported by KKP,
+	// so a missing autoscaler version must not prevent rendering the values
+	if tag, err := GetAutoscalerImageTag(data.Cluster.MajorMinorVersion); err == nil {
+		data.Cluster.AutoscalerVersion = tag
+	}
+
+	return data, nil
+}
+
 // RenderValueTemplate is rendering the given template data into the given map of values an error is returned when undefined values are used in the values map values.
 func RenderValueTemplate(applicationValues map[string]interface{}, templateData *TemplateData) (map[string]interface{}, error) {
 	yamlData, err := yaml.Marshal(applicationValues)
@@ -89,7 +89,32 @@ func TestGetTemplateData(t *testing.T) {
 			},
 		},
 		{
-			name:      "case 2: fetching template data should fail when cluster cannot be fetched",
+			name:      "case 2: fetching template data should fall back to an external cluster",
+			namespace: "test-external-cluster",
+			seedClient: fake.
+				NewClientBuilder().WithObjects(&kubermaticv1.ExternalCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "test-external-cluster",
+					Labels: map[string]string{"env": "prod"},
+				},
+				Spec: kubermaticv1.ExternalClusterSpec{
+					HumanReadableName: "imported-cluster",
+					Version:           *semver.NewSemverOrDie("v1.33.2"),
+				},
+			}).Build(),
+			want: &TemplateData{
+				ClusterData{
+					Name:              "test-external-cluster",
+					HumanReadableName: "imported-cluster",
+					Version:           "1.33.2",
+					MajorMinorVersion: "1.33",
+					AutoscalerVersion: "v1.33.3",
+					Labels:            map[string]string{"env": "prod"},
+				},
+			},
+		},
+		{
+			name:      "case 3: fetching template data should fail when cluster cannot be fetched",
 			namespace: clusterNamespace,
 			seedClient: fake.
 				NewClientBuilder().WithObjects().Build(),
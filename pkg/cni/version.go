@@ -187,3 +187,16 @@ func IsManagedByAppInfra(cniType kubermaticv1.CNIPluginType, cniVersion string)
 	}
 	return false
 }
+
+// IsInPlaceMigration returns true if the given CNI change is migrated in place by KKP, instead of
+// requiring the cluster's nodes to be recreated. This is only supported from Canal to Cilium
+// managed by the Applications infra.
+func IsInPlaceMigration(oldCNI, newCNI *kubermaticv1.CNIPluginSettings) bool {
+	if oldCNI == nil || newCNI == nil {
+		return false
+	}
+
+	return oldCNI.Type == kubermaticv1.CNIPluginTypeCanal &&
+		newCNI.Type == kubermaticv1.CNIPluginTypeCilium &&
+		IsManagedByAppInfra(newCNI.Type, newCNI.Version)
+}
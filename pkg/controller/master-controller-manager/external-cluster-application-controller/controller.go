@@ -0,0 +1,334 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalclusterapplicationcontroller
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/applications"
+	defaultapplicationcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/default-application-controller"
+	applicationinstallationcontroller "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/application-installation-controller"
+	applicationsresources "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/applications"
+	"k8c.io/kubermatic/v2/pkg/controller/util"
+	"k8c.io/kubermatic/v2/pkg/crd"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	kkpreconciling "k8c.io/kubermatic/v2/pkg/resources/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	ControllerName = "kkp-external-cluster-application-controller"
+
+	// resyncInterval is the interval in which external clusters are reconciled, as changes
+	// inside of them are not watched.
+	resyncInterval = 5 * time.Minute
+)
+
+var clusterScheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(clusterScheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(clusterScheme))
+	utilruntime.Must(appskubermaticv1.AddToScheme(clusterScheme))
+}
+
+type Reconciler struct {
+	ctrlruntimeclient.Client
+
+	log               *zap.SugaredLogger
+	recorder          events.EventRecorder
+	configGetter      provider.KubermaticConfigurationGetter
+	namespace         string
+	applicationCache  string
+	overwriteRegistry string
+}
+
+// Add creates the external cluster application controller. namespace is the KKP namespace,
+// which holds the credentials referenced by ApplicationDefinitions.
+func Add(mgr manager.Manager, numWorkers int, log *zap.SugaredLogger, configGetter provider.KubermaticConfigurationGetter, namespace, applicationCache, overwriteRegistry string) error {
+	reconciler := &Reconciler{
+		Client:            mgr.GetClient(),
+		log:               log.Named(ControllerName),
+		recorder:          mgr.GetEventRecorder(ControllerName),
+		configGetter:      configGetter,
+		namespace:         namespace,
+		applicationCache:  applicationCache,
+		overwriteRegistry: overwriteRegistry,
+	}
+
+	applicationsEnabled := predicate.NewPredicateFuncs(func(object ctrlruntimeclient.Object) bool {
+		cluster, ok := object.(*kubermaticv1.ExternalCluster)
+		return ok && cluster.Spec.IsApplicationsEnabled()
+	})
+
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{MaxConcurrentReconciles: numWorkers}).
+		For(&kubermaticv1.ExternalCluster{}, builder.WithPredicates(applicationsEnabled, predicate.GenerationChangedPredicate{})).
+		Watches(&appskubermaticv1.ApplicationDefinition{}, enqueueExternalClusters(reconciler, reconciler.log), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Build(reconciler)
+
+	return err
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("externalcluster", request.Name)
+	log.Debug("Processing")
+
+	cluster := &kubermaticv1.ExternalCluster{}
+	if err := r.Get(ctx, request.NamespacedName, cluster); err != nil {
+		return reconcile.Result{}, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	if !cluster.Spec.IsApplicationsEnabled() || cluster.DeletionTimestamp != nil || cluster.Spec.Pause {
+		return reconcile.Result{}, nil
+	}
+
+	if !kuberneteshelper.ExternalClusterReachable(cluster) {
+		log.Debug("External cluster is not reachable yet")
+		return reconcile.Result{RequeueAfter: resyncInterval}, nil
+	}
+
+	if err := r.reconcile(ctx, log, cluster); err != nil {
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "ApplicationReconcilingError", "Reconciling", err.Error())
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: resyncInterval}, nil
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.ExternalCluster) error {
+	cfg, err := kuberneteshelper.GetClusterRESTConfig(ctx, cluster, r)
+	if err != nil {
+		return fmt.Errorf("failed to get kubeconfig: %w", err)
+	}
+
+	clusterClient, err := ctrlruntimeclient.New(cfg, ctrlruntimeclient.Options{Scheme: clusterScheme})
+	if err != nil {
+		return fmt.Errorf("failed to create cluster client: %w", err)
+	}
+
+	if err := reconcileCRDs(ctx, clusterClient); err != nil {
+		return err
+	}
+
+	if err := r.reconcileDefaultApplications(ctx, log, cluster, clusterClient); err != nil {
+		return err
+	}
+
+	nodesAvailable, err := util.NodesAvailable(ctx, clusterClient)
+	if err != nil {
+		return fmt.Errorf("failed to check if nodes are available: %w", err)
+	}
+	if !nodesAvailable {
+		log.Debug("Waiting for nodes to be able to install applications")
+		return nil
+	}
+
+	return r.reconcileApplicationInstallations(ctx, log, cluster, cfg, clusterClient)
+}
+
+func reconcileCRDs(ctx context.Context, clusterClient ctrlruntimeclient.Client) error {
+	c, err := crd.CRDForObject(&appskubermaticv1.ApplicationInstallation{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appskubermaticv1.SchemeGroupVersion.String(),
+			Kind:       "ApplicationInstallation",
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get ApplicationInstallation CRD: %w", err)
+	}
+
+	creators := []kkpreconciling.NamedCustomResourceDefinitionReconcilerFactory{
+		applicationsresources.CRDReconciler(c),
+	}
+
+	if err := kkpreconciling.ReconcileCustomResourceDefinitions(ctx, creators, "", clusterClient); err != nil {
+		return fmt.Errorf("failed to reconcile CustomResourceDefinitions: %w", err)
+	}
+
+	return nil
+}
+
+// reconcileDefaultApplications creates the ApplicationInstallations for enforced ApplicationDefinitions and, once,
+// for default ApplicationDefinitions, so that default applications can be removed by the cluster owner.
+func (r *Reconciler) reconcileDefaultApplications(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.ExternalCluster, clusterClient ctrlruntimeclient.Client) error {
+	applicationDefinitions := &appskubermaticv1.ApplicationDefinitionList{}
+	if err := r.List(ctx, applicationDefinitions); err != nil {
+		return fmt.Errorf("failed to list ApplicationDefinitions: %w", err)
+	}
+
+	applicationNames := map[string]bool{}
+
+	var errs []error
+	for _, application := range defaultApplications(applicationDefinitions.Items, cluster.Status.DefaultApplicationsCreated) {
+		applicationNames[application.Name] = true
+
+		namespace, err := defaultapplicationcontroller.ApplicationInstallationNamespace(ctx, r.configGetter, application.Name)
+		if err != nil {
+			return err
+		}
+
+		if err := defaultapplicationcontroller.EnsureApplicationInstallation(ctx, log, clusterClient, application, namespace); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return kerrors.NewAggregate(errs)
+	}
+
+	if !cluster.Status.DefaultApplicationsCreated {
+		oldCluster := cluster.DeepCopy()
+		cluster.Status.DefaultApplicationsCreated = true
+		if err := r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+			return fmt.Errorf("failed to update external cluster status: %w", err)
+		}
+	}
+
+	if err := defaultapplicationcontroller.EnsureApplicationEnforcedAnnotationIsRemoved(ctx, clusterClient, applicationNames); err != nil {
+		return fmt.Errorf("failed to remove the enforced annotation from ApplicationInstallations: %w", err)
+	}
+
+	return nil
+}
+
+// defaultApplications returns the ApplicationDefinitions that have to be installed into an external cluster.
+// External clusters do not belong to a datacenter, so definitions with a datacenter selector are skipped.
+func defaultApplications(definitions []appskubermaticv1.ApplicationDefinition, ignoreDefaults bool) []appskubermaticv1.ApplicationDefinition {
+	var result []appskubermaticv1.ApplicationDefinition
+	for _, definition := range definitions {
+		if definition.DeletionTimestamp != nil || len(definition.Spec.Selector.Datacenters) > 0 {
+			continue
+		}
+
+		if definition.Spec.Enforced || (definition.Spec.Default && !ignoreDefaults) {
+			result = append(result, definition)
+		}
+	}
+
+	return result
+}
+
+func (r *Reconciler) reconcileApplicationInstallations(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.ExternalCluster, cfg *rest.Config, clusterClient ctrlruntimeclient.Client) error {
+	appInstallations := &appskubermaticv1.ApplicationInstallationList{}
+	if err := clusterClient.List(ctx, appInstallations); err != nil {
+		return fmt.Errorf("failed to list ApplicationInstallations: %w", err)
+	}
+
+	if len(appInstallations.Items) == 0 {
+		return nil
+	}
+
+	// Helm needs the kubeconfig as a file.
+	kubeconfig, err := kuberneteshelper.GetClusterKubeconfig(ctx, cluster, r)
+	if err != nil {
+		return fmt.Errorf("failed to get kubeconfig: %w", err)
+	}
+
+	kubeconfigPath := filepath.Join(r.applicationCache, fmt.Sprintf("kubeconfig-%s", cluster.Name))
+	if err := os.WriteFile(kubeconfigPath, kubeconfig, 0600); err != nil {
+		return fmt.Errorf("failed to write kubeconfig: %w", err)
+	}
+	defer os.Remove(kubeconfigPath)
+
+	// Events are recorded in the external cluster, next to the ApplicationInstallations.
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create cluster clientset: %w", err)
+	}
+
+	broadcaster := events.NewBroadcaster(&events.EventSinkImpl{Interface: clientset.EventsV1()})
+	if err := broadcaster.StartRecordingToSinkWithContext(ctx); err != nil {
+		return fmt.Errorf("failed to start event broadcaster: %w", err)
+	}
+	defer broadcaster.Shutdown()
+
+	recorder := broadcaster.NewRecorder(clusterScheme, ControllerName)
+
+	appInstaller := &applications.ApplicationManager{
+		ApplicationCache: r.applicationCache,
+		Kubeconfig:       kubeconfigPath,
+		SecretNamespace:  r.namespace,
+		ClusterName:      cluster.Name,
+	}
+
+	var errs []error
+	for _, appInstallation := range appInstallations.Items {
+		appLog := log.With("applicationinstallation", ctrlruntimeclient.ObjectKeyFromObject(&appInstallation))
+
+		if err := applicationinstallationcontroller.ReconcileApplicationInstallation(ctx, appLog, r, clusterClient, recorder, appInstaller, r.overwriteRegistry, &appInstallation); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile ApplicationInstallation %s/%s: %w", appInstallation.Namespace, appInstallation.Name, err))
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+func enqueueExternalClusters(client ctrlruntimeclient.Client, log *zap.SugaredLogger) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a ctrlruntimeclient.Object) []reconcile.Request {
+		var requests []reconcile.Request
+		application := a.(*appskubermaticv1.ApplicationDefinition)
+
+		if len(application.Spec.Selector.Datacenters) > 0 {
+			return requests
+		}
+
+		clusters := &kubermaticv1.ExternalClusterList{}
+		if err := client.List(ctx, clusters); err != nil {
+			log.Errorw("Failed to list external clusters", zap.Error(err))
+			utilruntime.HandleError(fmt.Errorf("failed to list external clusters: %w", err))
+			return requests
+		}
+
+		for _, cluster := range clusters.Items {
+			if cluster.Spec.IsApplicationsEnabled() {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(&cluster),
+				})
+			}
+		}
+
+		return requests
+	})
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalclusterapplicationcontroller
+
+import (
+	"testing"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func genApplicationDefinition(name string, defaulted, enforced bool, datacenters ...string) appskubermaticv1.ApplicationDefinition {
+	return appskubermaticv1.ApplicationDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: appskubermaticv1.ApplicationDefinitionSpec{
+			Default:  defaulted,
+			Enforced: enforced,
+			Selector: appskubermaticv1.DefaultingSelector{
+				Datacenters: datacenters,
+			},
+		},
+	}
+}
+
+func TestDefaultApplications(t *testing.T) {
+	deleted := genApplicationDefinition("deleted", true, true)
+	deleted.DeletionTimestamp = &metav1.Time{}
+
+	definitions := []appskubermaticv1.ApplicationDefinition{
+		genApplicationDefinition("regular", false, false),
+		genApplicationDefinition("default", true, false),
+		genApplicationDefinition("enforced", false, true),
+		genApplicationDefinition("enforced-in-datacenter", false, true, "dc-1"),
+		deleted,
+	}
+
+	testCases := []struct {
+		name           string
+		ignoreDefaults bool
+		expected       []string
+	}{
+		{
+			name:     "default and enforced applications are installed initially",
+			expected: []string{"default", "enforced"},
+		},
+		{
+			name:           "only enforced applications are installed once defaults have been created",
+			ignoreDefaults: true,
+			expected:       []string{"enforced"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var names []string
+			for _, definition := range defaultApplications(definitions, tc.ignoreDefaults) {
+				names = append(names, definition.Name)
+			}
+
+			if len(names) != len(tc.expected) {
+				t.Fatalf("Expected applications %v, got %v", tc.expected, names)
+			}
+			for i := range names {
+				if names[i] != tc.expected[i] {
+					t.Fatalf("Expected applications %v, got %v", tc.expected, names)
+				}
+			}
+		})
+	}
+}
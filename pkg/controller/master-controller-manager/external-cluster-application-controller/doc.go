@@ -0,0 +1,33 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package externalclusterapplicationcontroller contains a controller that makes KKP
+Applications available in ExternalClusters (KubeOne, bring-your-own and imported
+EKS/AKS/GKE clusters).
+
+External clusters have no user cluster controller manager, so the controller runs
+on the master and connects to each cluster using its KubeconfigReference. For every
+ExternalCluster with spec.applications.enabled it installs the ApplicationInstallation
+CRD, creates the default and enforced ApplicationInstallations (only for
+ApplicationDefinitions without a datacenter selector) and then installs every
+ApplicationInstallation using the same installer as for KKP user clusters, so the
+status is reported in the ApplicationInstallations in the same way.
+
+As the resources in the external cluster are not watched, the clusters are
+reconciled periodically.
+*/
+package externalclusterapplicationcontroller
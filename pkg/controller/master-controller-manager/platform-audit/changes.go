@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platformaudit
+
+import (
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// presetKind is the kind of Preset objects; unlike most other KKP resources
+// the SDK does not define a constant for it.
+const presetKind = "Preset"
+
+// The functions in this file compute the platform events for a single change
+// to an object. oldObj is nil when the object has been created, newObj is nil
+// when the object has been removed from the cluster.
+//
+// Deletions are reported as soon as the deletionTimestamp is set, because that
+// is when the deletion was requested; the final removal of the object (after
+// all finalizers have been removed) does not produce another event.
+
+func projectEvents(oldProject, newProject *kubermaticv1.Project) []Event {
+	event := func(action Action, project *kubermaticv1.Project) Event {
+		e := newEvent(action, kubermaticv1.ProjectKindName, project)
+		e.Project = project.Name
+		e.Details = map[string]string{"name": project.Spec.Name}
+
+		return e
+	}
+
+	switch {
+	case oldProject == nil:
+		return []Event{event(ActionProjectCreated, newProject)}
+
+	case newProject == nil || newProject.DeletionTimestamp != nil:
+		if oldProject.DeletionTimestamp != nil {
+			return nil
+		}
+
+		return []Event{event(ActionProjectDeleted, latest(oldProject, newProject))}
+
+	case oldProject.Spec.Name != newProject.Spec.Name:
+		e := event(ActionProjectRenamed, newProject)
+		e.Details["previousName"] = oldProject.Spec.Name
+
+		return []Event{e}
+	}
+
+	return nil
+}
+
+func userProjectBindingEvents(oldBinding, newBinding *kubermaticv1.UserProjectBinding) []Event {
+	event := func(action Action, binding *kubermaticv1.UserProjectBinding) Event {
+		e := newEvent(action, kubermaticv1.UserProjectBindingKind, binding)
+		e.Project = binding.Spec.ProjectID
+		e.Details = map[string]string{
+			"user": binding.Spec.UserEmail,
+			"role": rbac.ExtractGroupPrefix(binding.Spec.Group),
+		}
+
+		return e
+	}
+
+	switch {
+	case oldBinding == nil:
+		return []Event{event(ActionProjectMemberAdded, newBinding)}
+
+	case newBinding == nil || newBinding.DeletionTimestamp != nil:
+		if oldBinding.DeletionTimestamp != nil {
+			return nil
+		}
+
+		return []Event{event(ActionProjectMemberRemoved, latest(oldBinding, newBinding))}
+
+	case oldBinding.Spec.Group != newBinding.Spec.Group:
+		e := event(ActionProjectMemberRoleChanged, newBinding)
+		e.Details["previousRole"] = rbac.ExtractGroupPrefix(oldBinding.Spec.Group)
+
+		return []Event{e}
+	}
+
+	return nil
+}
+
+func groupProjectBindingEvents(oldBinding, newBinding *kubermaticv1.GroupProjectBinding) []Event {
+	event := func(action Action, binding *kubermaticv1.GroupProjectBinding) Event {
+		e := newEvent(action, kubermaticv1.GroupProjectBindingKind, binding)
+		e.Project = binding.Spec.ProjectID
+		e.Details = map[string]string{
+			"group": binding.Spec.Group,
+			"role":  binding.Spec.Role,
+		}
+
+		return e
+	}
+
+	switch {
+	case oldBinding == nil:
+		return []Event{event(ActionProjectGroupAdded, newBinding)}
+
+	case newBinding == nil || newBinding.DeletionTimestamp != nil:
+		if oldBinding.DeletionTimestamp != nil {
+			return nil
+		}
+
+		return []Event{event(ActionProjectGroupRemoved, latest(oldBinding, newBinding))}
+
+	case oldBinding.Spec.Role != newBinding.Spec.Role:
+		e := event(ActionProjectGroupRoleChanged, newBinding)
+		e.Details["previousRole"] = oldBinding.Spec.Role
+
+		return []Event{e}
+	}
+
+	return nil
+}
+
+func clusterEvents(oldCluster, newCluster *kubermaticv1.Cluster) []Event {
+	event := func(action Action, cluster *kubermaticv1.Cluster) Event {
+		e := newEvent(action, kubermaticv1.ClusterKindName, cluster)
+		e.Owner = cluster.Status.UserEmail
+		e.Project = cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+		e.Cluster = cluster.Name
+		e.Details = map[string]string{
+			"name":    cluster.Spec.HumanReadableName,
+			"version": cluster.Spec.Version.String(),
+		}
+
+		return e
+	}
+
+	switch {
+	case oldCluster == nil:
+		return []Event{event(ActionClusterCreated, newCluster)}
+
+	case newCluster == nil || newCluster.DeletionTimestamp != nil:
+		if oldCluster.DeletionTimestamp != nil {
+			return nil
+		}
+
+		return []Event{event(ActionClusterDeleted, latest(oldCluster, newCluster))}
+	}
+
+	var events []Event
+
+	if !oldCluster.Spec.Version.Equal(&newCluster.Spec.Version) {
+		e := event(ActionClusterUpgraded, newCluster)
+		e.Details["previousVersion"] = oldCluster.Spec.Version.String()
+
+		events = append(events, e)
+	}
+
+	if oldCluster.Spec.Pause != newCluster.Spec.Pause {
+		action := ActionClusterResumed
+		if newCluster.Spec.Pause {
+			action = ActionClusterPaused
+		}
+
+		e := event(action, newCluster)
+		if reason := newCluster.Spec.PauseReason; reason != "" {
+			e.Details["reason"] = reason
+		}
+
+		events = append(events, e)
+	}
+
+	return events
+}
+
+func presetEvents(oldPreset, newPreset *kubermaticv1.Preset) []Event {
+	// Presets contain cloud credentials, so the events deliberately do not
+	// include any details about the preset's spec.
+	event := func(action Action, preset *kubermaticv1.Preset) Event {
+		return newEvent(action, presetKind, preset)
+	}
+
+	switch {
+	case oldPreset == nil:
+		return []Event{event(ActionPresetCreated, newPreset)}
+
+	case newPreset == nil || newPreset.DeletionTimestamp != nil:
+		if oldPreset.DeletionTimestamp != nil {
+			return nil
+		}
+
+		return []Event{event(ActionPresetDeleted, latest(oldPreset, newPreset))}
+	}
+
+	var events []Event
+
+	if oldPreset.Spec.IsEnabled() != newPreset.Spec.IsEnabled() {
+		action := ActionPresetDisabled
+		if newPreset.Spec.IsEnabled() {
+			action = ActionPresetEnabled
+		}
+
+		events = append(events, event(action, newPreset))
+	}
+
+	oldSpec := oldPreset.Spec.DeepCopy()
+	oldSpec.Enabled = nil
+	newSpec := newPreset.Spec.DeepCopy()
+	newSpec.Enabled = nil
+
+	if !equality.Semantic.DeepEqual(oldSpec, newSpec) {
+		events = append(events, event(ActionPresetUpdated, newPreset))
+	}
+
+	return events
+}
+
+// latest returns newObj, unless it is nil because the object has already
+// been removed from the cluster.
+func latest[T any](oldObj, newObj *T) *T {
+	if newObj != nil {
+		return newObj
+	}
+
+	return oldObj
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platformaudit
+
+import (
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+	"k8c.io/kubermatic/v2/pkg/test/generator"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func actions(events []Event) []Action {
+	var result []Action
+	for _, e := range events {
+		result = append(result, e.Action)
+	}
+
+	return result
+}
+
+func deleting[T interface{ SetDeletionTimestamp(*metav1.Time) }](obj T) T {
+	now := metav1.Now()
+	obj.SetDeletionTimestamp(&now)
+
+	return obj
+}
+
+func TestProjectEvents(t *testing.T) {
+	project := func(name string) *kubermaticv1.Project {
+		p := generator.GenProject(name, kubermaticv1.ProjectActive, time.Now())
+		p.Name = "abc123"
+
+		return p
+	}
+
+	testcases := []struct {
+		name     string
+		old      *kubermaticv1.Project
+		new      *kubermaticv1.Project
+		expected []Action
+	}{
+		{
+			name:     "project created",
+			new:      project("foo"),
+			expected: []Action{ActionProjectCreated},
+		},
+		{
+			name:     "project renamed",
+			old:      project("foo"),
+			new:      project("bar"),
+			expected: []Action{ActionProjectRenamed},
+		},
+		{
+			name: "unrelated change",
+			old:  project("foo"),
+			new: func() *kubermaticv1.Project {
+				p := project("foo")
+				p.Status.Phase = kubermaticv1.ProjectInactive
+				return p
+			}(),
+		},
+		{
+			name:     "deletion requested",
+			old:      project("foo"),
+			new:      deleting(project("foo")),
+			expected: []Action{ActionProjectDeleted},
+		},
+		{
+			name: "deletion is only reported once",
+			old:  deleting(project("foo")),
+			new:  nil,
+		},
+		{
+			name:     "object removed without deletion timestamp",
+			old:      project("foo"),
+			new:      nil,
+			expected: []Action{ActionProjectDeleted},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			events := projectEvents(tc.old, tc.new)
+			if result := actions(events); !diff.SemanticallyEqual(tc.expected, result) {
+				t.Fatalf("Unexpected events:\n%v", diff.ObjectDiff(tc.expected, result))
+			}
+
+			for _, e := range events {
+				if e.Project != "abc123" {
+					t.Errorf("Expected event to reference project abc123, but got %q.", e.Project)
+				}
+			}
+		})
+	}
+}
+
+func TestUserProjectBindingEvents(t *testing.T) {
+	oldBinding := generator.GenBinding("abc123", "bob@example.com", "viewers")
+	newBinding := generator.GenBinding("abc123", "bob@example.com", "editors")
+
+	events := userProjectBindingEvents(oldBinding, newBinding)
+	if len(events) != 1 {
+		t.Fatalf("Expected exactly one event, got %d.", len(events))
+	}
+
+	expected := map[string]string{
+		"user":         "bob@example.com",
+		"role":         "editors",
+		"previousRole": "viewers",
+	}
+
+	if e := events[0]; e.Action != ActionProjectMemberRoleChanged || e.Project != "abc123" || !diff.SemanticallyEqual(expected, e.Details) {
+		t.Fatalf("Unexpected event: %+v", e)
+	}
+}
+
+func TestGroupProjectBindingEvents(t *testing.T) {
+	oldBinding := generator.GenGroupBinding("abc123", "developers", "viewers")
+	newBinding := generator.GenGroupBinding("abc123", "developers", "owners")
+
+	expected := []Action{ActionProjectGroupRoleChanged}
+	if result := actions(groupProjectBindingEvents(oldBinding, newBinding)); !diff.SemanticallyEqual(expected, result) {
+		t.Fatalf("Unexpected events:\n%v", diff.ObjectDiff(expected, result))
+	}
+
+	expected = []Action{ActionProjectGroupRemoved}
+	if result := actions(groupProjectBindingEvents(newBinding, deleting(newBinding.DeepCopy()))); !diff.SemanticallyEqual(expected, result) {
+		t.Fatalf("Unexpected events:\n%v", diff.ObjectDiff(expected, result))
+	}
+}
+
+func TestClusterEvents(t *testing.T) {
+	cluster := func(version string, paused bool) *kubermaticv1.Cluster {
+		c := generator.GenDefaultCluster()
+		c.Spec.Version = *semver.NewSemverOrDie(version)
+		c.Spec.Pause = paused
+		c.Status.UserEmail = "bob@example.com"
+
+		return c
+	}
+
+	testcases := []struct {
+		name     string
+		old      *kubermaticv1.Cluster
+		new      *kubermaticv1.Cluster
+		expected []Action
+	}{
+		{
+			name:     "cluster created",
+			new:      cluster("1.33.0", false),
+			expected: []Action{ActionClusterCreated},
+		},
+		{
+			name:     "cluster upgraded",
+			old:      cluster("1.33.0", false),
+			new:      cluster("1.34.1", false),
+			expected: []Action{ActionClusterUpgraded},
+		},
+		{
+			name:     "cluster paused",
+			old:      cluster("1.33.0", false),
+			new:      cluster("1.33.0", true),
+			expected: []Action{ActionClusterPaused},
+		},
+		{
+			name:     "cluster upgraded and resumed",
+			old:      cluster("1.33.0", true),
+			new:      cluster("1.34.1", false),
+			expected: []Action{ActionClusterUpgraded, ActionClusterResumed},
+		},
+		{
+			name:     "cluster deleted",
+			old:      cluster("1.33.0", false),
+			new:      deleting(cluster("1.33.0", false)),
+			expected: []Action{ActionClusterDeleted},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			events := clusterEvents(tc.old, tc.new)
+			if result := actions(events); !diff.SemanticallyEqual(tc.expected, result) {
+				t.Fatalf("Unexpected events:\n%v", diff.ObjectDiff(tc.expected, result))
+			}
+
+			for _, e := range events {
+				if e.Owner != "bob@example.com" {
+					t.Errorf("Expected owner to be the cluster owner, but got %q.", e.Owner)
+				}
+
+				if e.Action == ActionClusterUpgraded && e.Details["previousVersion"] != tc.old.Spec.Version.String() {
+					t.Errorf("Expected previousVersion %q, but got %q.", tc.old.Spec.Version.String(), e.Details["previousVersion"])
+				}
+			}
+		})
+	}
+}
+
+func TestPresetEvents(t *testing.T) {
+	preset := func(enabled bool, token string) *kubermaticv1.Preset {
+		p := generator.GenDefaultPreset()
+		p.Spec.Enabled = ptr.To(enabled)
+		p.Spec.Fake = &kubermaticv1.Fake{Token: token}
+
+		return p
+	}
+
+	testcases := []struct {
+		name     string
+		old      *kubermaticv1.Preset
+		new      *kubermaticv1.Preset
+		expected []Action
+	}{
+		{
+			name:     "preset disabled",
+			old:      preset(true, "a"),
+			new:      preset(false, "a"),
+			expected: []Action{ActionPresetDisabled},
+		},
+		{
+			name:     "credentials changed",
+			old:      preset(true, "a"),
+			new:      preset(true, "b"),
+			expected: []Action{ActionPresetUpdated},
+		},
+		{
+			name:     "preset enabled and changed",
+			old:      preset(false, "a"),
+			new:      preset(true, "b"),
+			expected: []Action{ActionPresetEnabled, ActionPresetUpdated},
+		},
+		{
+			name: "no change",
+			old:  preset(true, "a"),
+			new:  preset(true, "a"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			events := presetEvents(tc.old, tc.new)
+			if result := actions(events); !diff.SemanticallyEqual(tc.expected, result) {
+				t.Fatalf("Unexpected events:\n%v", diff.ObjectDiff(tc.expected, result))
+			}
+
+			for _, e := range events {
+				if len(e.Details) > 0 {
+					t.Errorf("Preset events must not contain details, but got %v.", e.Details)
+				}
+			}
+		})
+	}
+}
+
+func TestLastManager(t *testing.T) {
+	project := generator.GenDefaultProject()
+	project.ManagedFields = []metav1.ManagedFieldsEntry{
+		{Manager: "kubermatic-api", Time: &metav1.Time{Time: time.Unix(100, 0)}},
+		{Manager: "kubectl-edit", Time: &metav1.Time{Time: time.Unix(200, 0)}},
+		{Manager: "kkp-project-controller", Subresource: "status", Time: &metav1.Time{Time: time.Unix(300, 0)}},
+	}
+
+	if manager := lastManager(project); manager != "kubectl-edit" {
+		t.Fatalf("Expected manager to be kubectl-edit, but got %q.", manager)
+	}
+}
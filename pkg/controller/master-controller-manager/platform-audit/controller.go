@@ -0,0 +1,267 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platformaudit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	ControllerName = "kkp-platform-audit-controller"
+
+	// queueSize is the number of events that can be buffered while the sink
+	// is slow or unavailable. Once the queue is full, new events are dropped.
+	queueSize = 1000
+)
+
+var (
+	eventsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "kubermatic",
+		Subsystem: "platform_audit",
+		Name:      "events_total",
+		Help:      "The number of platform audit events that have been written to the sink",
+	}, []string{"action"})
+
+	sinkErrorsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "kubermatic",
+		Subsystem: "platform_audit",
+		Name:      "sink_errors_total",
+		Help:      "The number of platform audit events that could not be written to the sink",
+	})
+
+	droppedEventsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "kubermatic",
+		Subsystem: "platform_audit",
+		Name:      "dropped_events_total",
+		Help:      "The number of platform audit events that were dropped because the queue was full",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(eventsTotal, sinkErrorsTotal, droppedEventsTotal)
+}
+
+// Add creates the platform audit controller. It watches the master resources
+// on the masterManager and the Clusters on every seed manager and writes the
+// resulting events to the sink.
+func Add(
+	masterManager manager.Manager,
+	seedManagers map[string]manager.Manager,
+	log *zap.SugaredLogger,
+	sink Sink,
+) error {
+	log = log.Named(ControllerName)
+
+	e := &emitter{
+		log:   log,
+		sink:  sink,
+		queue: make(chan Event, queueSize),
+	}
+
+	if err := masterManager.Add(e); err != nil {
+		return fmt.Errorf("failed to add event emitter: %w", err)
+	}
+
+	masterWatcher := &watcher{
+		log:     log,
+		cache:   masterManager.GetCache(),
+		emitter: e,
+		watches: []watch{
+			watchFor(&kubermaticv1.Project{}, projectEvents),
+			watchFor(&kubermaticv1.UserProjectBinding{}, userProjectBindingEvents),
+			watchFor(&kubermaticv1.GroupProjectBinding{}, groupProjectBindingEvents),
+			watchFor(&kubermaticv1.Preset{}, presetEvents),
+		},
+	}
+
+	if err := masterManager.Add(masterWatcher); err != nil {
+		return fmt.Errorf("failed to add master watcher: %w", err)
+	}
+
+	for seedName, seedManager := range seedManagers {
+		seedWatcher := &watcher{
+			log:     log.With("seed", seedName),
+			cache:   seedManager.GetCache(),
+			seed:    seedName,
+			emitter: e,
+			watches: []watch{
+				watchFor(&kubermaticv1.Cluster{}, clusterEvents),
+			},
+		}
+
+		if err := seedManager.Add(seedWatcher); err != nil {
+			return fmt.Errorf("failed to add watcher for seed %q: %w", seedName, err)
+		}
+	}
+
+	return nil
+}
+
+// watch combines an object type with the function that computes the events
+// for changes to objects of this type.
+type watch struct {
+	object ctrlruntimeclient.Object
+	events func(oldObj, newObj any) []Event
+}
+
+func watchFor[T ctrlruntimeclient.Object](obj T, events func(oldObj, newObj T) []Event) watch {
+	return watch{
+		object: obj,
+		events: func(oldObj, newObj any) []Event {
+			// type assertions on nil interfaces result in typed nil pointers
+			o, _ := oldObj.(T)
+			n, _ := newObj.(T)
+
+			return events(o, n)
+		},
+	}
+}
+
+// watcher registers event handlers on the informers of a single cache and
+// hands the resulting events to the emitter.
+type watcher struct {
+	log     *zap.SugaredLogger
+	cache   cache.Cache
+	seed    string
+	emitter *emitter
+	watches []watch
+}
+
+func (w *watcher) Start(ctx context.Context) error {
+	for _, wt := range w.watches {
+		informer, err := w.cache.GetInformer(ctx, wt.object)
+		if err != nil {
+			return fmt.Errorf("failed to get informer for %T: %w", wt.object, err)
+		}
+
+		registration, err := informer.AddEventHandler(w.handler(wt))
+		if err != nil {
+			return fmt.Errorf("failed to add event handler for %T: %w", wt.object, err)
+		}
+
+		// The master cache is shared across restarts of the seed lifecycle
+		// controller, so the handler must not outlive this watcher.
+		defer func() {
+			if err := informer.RemoveEventHandler(registration); err != nil {
+				w.log.Errorw("Failed to remove event handler", zap.Error(err))
+			}
+		}()
+	}
+
+	<-ctx.Done()
+
+	return nil
+}
+
+func (w *watcher) handler(wt watch) toolscache.ResourceEventHandler {
+	return toolscache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj any, isInInitialList bool) {
+			// objects that already existed when the watcher was started
+			// have not been created just now
+			if isInInitialList {
+				return
+			}
+
+			w.emit(wt.events(nil, obj))
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			w.emit(wt.events(oldObj, newObj))
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+
+			w.emit(wt.events(obj, nil))
+		},
+	}
+}
+
+func (w *watcher) emit(events []Event) {
+	now := time.Now().UTC()
+
+	for _, event := range events {
+		event.Time = now
+		event.Seed = w.seed
+
+		w.emitter.enqueue(event)
+	}
+}
+
+// emitter decouples the informer event handlers from the potentially slow
+// sink by buffering events in a queue.
+type emitter struct {
+	log   *zap.SugaredLogger
+	sink  Sink
+	queue chan Event
+}
+
+func (e *emitter) enqueue(event Event) {
+	select {
+	case e.queue <- event:
+	default:
+		droppedEventsTotal.Inc()
+		e.log.Warnw("Event queue is full, dropping event", "action", event.Action, "id", event.ID)
+	}
+}
+
+func (e *emitter) Start(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event := <-e.queue:
+			e.write(ctx, event)
+		}
+	}
+}
+
+func (e *emitter) write(ctx context.Context, event Event) {
+	backoff := wait.Backoff{
+		Duration: time.Second,
+		Factor:   2,
+		Steps:    3,
+	}
+
+	var lastErr error
+
+	err := wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
+		lastErr = e.sink.Write(ctx, event)
+		return lastErr == nil, nil
+	})
+	if err != nil {
+		sinkErrorsTotal.Inc()
+		e.log.Errorw("Failed to write event", "action", event.Action, "id", event.ID, zap.Error(lastErr))
+		return
+	}
+
+	eventsTotal.WithLabelValues(string(event.Action)).Inc()
+}
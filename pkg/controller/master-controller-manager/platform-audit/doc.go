@@ -0,0 +1,27 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package platformaudit contains a controller that emits a normalized stream of
+platform events for changes to KKP resources, like clusters being created,
+upgraded or paused, project members being added or presets being changed.
+
+Events are derived from Projects, UserProjectBindings, GroupProjectBindings and
+Presets on the master cluster and from Clusters on all seeds. They are written as
+JSON documents (see Event) to a configurable Sink, so that they can be consumed
+by external SIEM systems without having to filter the Kubernetes audit log.
+*/
+package platformaudit
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platformaudit
+
+import (
+	"fmt"
+	"time"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SchemaVersion is the version of the Event JSON schema. It is included in
+// every event and will be bumped whenever incompatible changes are made.
+const SchemaVersion = "platformaudit.kubermatic.k8c.io/v1"
+
+// Action describes what happened to a resource.
+type Action string
+
+const (
+	ActionProjectCreated Action = "project.created"
+	ActionProjectRenamed Action = "project.renamed"
+	ActionProjectDeleted Action = "project.deleted"
+
+	ActionProjectMemberAdded       Action = "project.member.added"
+	ActionProjectMemberRoleChanged Action = "project.member.roleChanged"
+	ActionProjectMemberRemoved     Action = "project.member.removed"
+
+	ActionProjectGroupAdded       Action = "project.group.added"
+	ActionProjectGroupRoleChanged Action = "project.group.roleChanged"
+	ActionProjectGroupRemoved     Action = "project.group.removed"
+
+	ActionClusterCreated  Action = "cluster.created"
+	ActionClusterUpgraded Action = "cluster.upgraded"
+	ActionClusterPaused   Action = "cluster.paused"
+	ActionClusterResumed  Action = "cluster.resumed"
+	ActionClusterDeleted  Action = "cluster.deleted"
+
+	ActionPresetCreated  Action = "preset.created"
+	ActionPresetUpdated  Action = "preset.updated"
+	ActionPresetEnabled  Action = "preset.enabled"
+	ActionPresetDisabled Action = "preset.disabled"
+	ActionPresetDeleted  Action = "preset.deleted"
+)
+
+// Event is a single, normalized platform event. This struct is the
+// documented JSON schema of the event stream, see docs/platform-audit.md.
+type Event struct {
+	// SchemaVersion is always set to SchemaVersion.
+	SchemaVersion string `json:"schemaVersion"`
+	// ID uniquely identifies this event and can be used by consumers to
+	// deduplicate events.
+	ID string `json:"id"`
+	// Time is the time at which the change was observed.
+	Time time.Time `json:"time"`
+	// Action is what happened, e.g. "cluster.upgraded".
+	Action Action `json:"action"`
+	// Actor describes who performed the change.
+	Actor Actor `json:"actor"`
+	// Resource is the KKP resource that was changed.
+	Resource Resource `json:"resource"`
+	// Seed is the name of the seed cluster the resource lives on, only set
+	// for resources that exist on seeds (i.e. Clusters).
+	Seed string `json:"seed,omitempty"`
+	// Project is the ID of the project the resource belongs to, if any.
+	Project string `json:"project,omitempty"`
+	// Cluster is the ID of the user cluster the resource belongs to, if any.
+	Cluster string `json:"cluster,omitempty"`
+	// Owner is the email of the KKP user that owns the resource, if any. This
+	// is not necessarily the user who performed the change.
+	Owner string `json:"owner,omitempty"`
+	// Details contains action-specific information, like the old and new
+	// Kubernetes version of an upgraded cluster.
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// Actor describes who performed a change.
+type Actor struct {
+	// Manager is the name of the field manager that last modified the resource,
+	// e.g. "kubermatic-api" or "kubectl-edit".
+	Manager string `json:"manager,omitempty"`
+}
+
+// Resource identifies a KKP resource.
+type Resource struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	UID       string `json:"uid,omitempty"`
+}
+
+// newEvent returns an event for the given object, with the actor and resource
+// already filled in from the object's metadata.
+func newEvent(action Action, kind string, obj ctrlruntimeclient.Object) Event {
+	return Event{
+		SchemaVersion: SchemaVersion,
+		ID:            fmt.Sprintf("%s/%s/%s", obj.GetUID(), obj.GetResourceVersion(), action),
+		Action:        action,
+		Actor: Actor{
+			Manager: lastManager(obj),
+		},
+		Resource: Resource{
+			Kind:      kind,
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+			UID:       string(obj.GetUID()),
+		},
+	}
+}
+
+// lastManager returns the name of the field manager that most recently
+// changed the object's spec or metadata. Status updates are ignored, as they
+// are performed by KKP's own controllers.
+func lastManager(obj ctrlruntimeclient.Object) string {
+	var (
+		manager string
+		latest  time.Time
+	)
+
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Subresource != "" || entry.Time == nil {
+			continue
+		}
+
+		if manager == "" || !entry.Time.Time.Before(latest) {
+			manager = entry.Manager
+			latest = entry.Time.Time
+		}
+	}
+
+	return manager
+}
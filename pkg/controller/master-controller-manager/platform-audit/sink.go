@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platformaudit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/syslog"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+// Sink is the destination of platform audit events.
+type Sink interface {
+	// Write persists a single event.
+	Write(ctx context.Context, event Event) error
+	// Close releases all resources held by the sink.
+	Close() error
+}
+
+// NewSink returns the sink for the given configuration. If no sink is
+// configured, nil is returned.
+func NewSink(cfg kubermaticv1.KubermaticPlatformAuditConfiguration) (Sink, error) {
+	switch cfg.Sink {
+	case "":
+		return nil, nil
+	case kubermaticv1.PlatformAuditFileSink:
+		return toSink(newFileSink(cfg.FilePath))
+	case kubermaticv1.PlatformAuditWebhookSink:
+		return toSink(newWebhookSink(cfg.WebhookURL))
+	case kubermaticv1.PlatformAuditSyslogSink:
+		return toSink(newSyslogSink(cfg.SyslogAddress))
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", cfg.Sink)
+	}
+}
+
+// toSink prevents typed nil pointers from ending up in a non-nil Sink interface.
+func toSink[T Sink](sink T, err error) (Sink, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return sink, nil
+}
+
+// fileSink appends events as JSON lines to a file.
+type fileSink struct {
+	lock sync.Mutex
+	file *os.File
+}
+
+func newFileSink(filename string) (*fileSink, error) {
+	if filename == "" {
+		return nil, errors.New("no file path configured")
+	}
+
+	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return &fileSink{file: f}, nil
+}
+
+func (s *fileSink) Write(_ context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, err = s.file.Write(append(data, '\n'))
+
+	return err
+}
+
+func (s *fileSink) Close() error {
+	return s.file.Close()
+}
+
+// webhookSink sends each event as a JSON document to an HTTP endpoint.
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func newWebhookSink(webhookURL string) (*webhookSink, error) {
+	if webhookURL == "" {
+		return nil, errors.New("no webhook URL configured")
+	}
+
+	if _, err := url.ParseRequestURI(webhookURL); err != nil {
+		return nil, fmt.Errorf("invalid webhook URL: %w", err)
+	}
+
+	return &webhookSink{
+		url:    webhookURL,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+func (s *webhookSink) Write(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send event: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// drain the body to allow connection reuse
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *webhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// syslogSink sends each event as a JSON-encoded syslog message.
+type syslogSink struct {
+	writer *syslog.Writer
+}
+
+// newSyslogSink connects to the syslog server at the given address, which
+// must be in the form "network://host:port". An empty address connects to
+// the local syslog daemon.
+func newSyslogSink(address string) (*syslogSink, error) {
+	var network, raddr string
+
+	if address != "" {
+		u, err := url.Parse(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid syslog address: %w", err)
+		}
+
+		if u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid syslog address %q, must be in the form network://host:port", address)
+		}
+
+		network = u.Scheme
+		raddr = u.Host
+	}
+
+	writer, err := syslog.Dial(network, raddr, syslog.LOG_INFO|syslog.LOG_AUTH, "kubermatic-platform-audit")
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to syslog: %w", err)
+	}
+
+	return &syslogSink{writer: writer}, nil
+}
+
+func (s *syslogSink) Write(_ context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	return s.writer.Info(string(data))
+}
+
+func (s *syslogSink) Close() error {
+	return s.writer.Close()
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platformaudit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/generator"
+)
+
+func TestNewSink(t *testing.T) {
+	testcases := []struct {
+		name      string
+		cfg       kubermaticv1.KubermaticPlatformAuditConfiguration
+		expectErr bool
+	}{
+		{
+			name: "no sink configured",
+		},
+		{
+			name:      "file sink without path",
+			cfg:       kubermaticv1.KubermaticPlatformAuditConfiguration{Sink: kubermaticv1.PlatformAuditFileSink},
+			expectErr: true,
+		},
+		{
+			name:      "webhook sink with invalid URL",
+			cfg:       kubermaticv1.KubermaticPlatformAuditConfiguration{Sink: kubermaticv1.PlatformAuditWebhookSink, WebhookURL: "not a url"},
+			expectErr: true,
+		},
+		{
+			name:      "syslog sink with invalid address",
+			cfg:       kubermaticv1.KubermaticPlatformAuditConfiguration{Sink: kubermaticv1.PlatformAuditSyslogSink, SyslogAddress: "localhost:514"},
+			expectErr: true,
+		},
+		{
+			name:      "unknown sink",
+			cfg:       kubermaticv1.KubermaticPlatformAuditConfiguration{Sink: "kafka"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			sink, err := NewSink(tc.cfg)
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("Expected error = %v, but got %v.", tc.expectErr, err)
+			}
+
+			if sink != nil {
+				t.Fatalf("Expected no sink to be created, but got %T.", sink)
+			}
+		})
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "audit.log")
+
+	sink, err := NewSink(kubermaticv1.KubermaticPlatformAuditConfiguration{
+		Sink:     kubermaticv1.PlatformAuditFileSink,
+		FilePath: filename,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create sink: %v", err)
+	}
+
+	project := generator.GenDefaultProject()
+	for _, event := range projectEvents(nil, project) {
+		if err := sink.Write(context.Background(), event); err != nil {
+			t.Fatalf("Failed to write event: %v", err)
+		}
+	}
+	for _, event := range projectEvents(project, nil) {
+		if err := sink.Write(context.Background(), event); err != nil {
+			t.Fatalf("Failed to write event: %v", err)
+		}
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Failed to close sink: %v", err)
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		t.Fatalf("Failed to open audit log: %v", err)
+	}
+	defer f.Close()
+
+	var got []Action
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("Failed to decode line %q: %v", scanner.Text(), err)
+		}
+
+		if event.SchemaVersion != SchemaVersion {
+			t.Errorf("Expected schema version %q, but got %q.", SchemaVersion, event.SchemaVersion)
+		}
+
+		got = append(got, event.Action)
+	}
+
+	if len(got) != 2 || got[0] != ActionProjectCreated || got[1] != ActionProjectDeleted {
+		t.Fatalf("Unexpected events in audit log: %v", got)
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received []Event
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Expected JSON content type, but got %q.", ct)
+		}
+
+		var event Event
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if event.Action == ActionProjectDeleted {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		received = append(received, event)
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(kubermaticv1.KubermaticPlatformAuditConfiguration{
+		Sink:       kubermaticv1.PlatformAuditWebhookSink,
+		WebhookURL: server.URL,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create sink: %v", err)
+	}
+	defer sink.Close()
+
+	project := generator.GenDefaultProject()
+
+	if err := sink.Write(context.Background(), projectEvents(nil, project)[0]); err != nil {
+		t.Fatalf("Failed to write event: %v", err)
+	}
+
+	if err := sink.Write(context.Background(), projectEvents(project, nil)[0]); err == nil {
+		t.Fatal("Expected an error when the webhook rejects the event, but got none.")
+	}
+
+	if len(received) != 1 || received[0].Action != ActionProjectCreated {
+		t.Fatalf("Unexpected events received by webhook: %+v", received)
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"go.uber.org/zap"
 
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	predicateutil "k8c.io/kubermatic/v2/pkg/controller/util/predicate"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -29,8 +30,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 type resourcesController struct {
@@ -41,6 +44,10 @@ type resourcesController struct {
 	restMapper       meta.RESTMapper
 	providerName     string
 	objectType       ctrlruntimeclient.Object
+	namespace        string
+
+	// masterClient is used to look up the projects, which only live on the master cluster
+	masterClient ctrlruntimeclient.Client
 }
 
 // newResourcesController creates a new controller for managing RBAC for named resources that belong to project.
@@ -61,15 +68,23 @@ func newResourcesControllers(ctx context.Context, metrics *Metrics, mgr manager.
 			metrics:          metrics,
 			projectResources: resources,
 			client:           mgr.GetClient(),
+			masterClient:     mgr.GetClient(),
 			restMapper:       mgr.GetRESTMapper(),
 			providerName:     "master",
 			objectType:       clonedObject,
+			namespace:        resource.namespace,
 		}
 
 		// Create a new controller
 		_, err := builder.ControllerManagedBy(mgr).
 			Named("rbac_generator_resources").
 			For(clonedObject, builder.WithPredicates(predicateutil.Factory(resource.predicate))).
+			WatchesRawSource(source.Kind(
+				mgr.GetCache(),
+				&kubermaticv1.Project{},
+				handler.TypedEnqueueRequestsFromMapFunc(mc.enqueueProjectResources),
+				projectSuspensionChangedPredicate(),
+			)).
 			Build(mc)
 		if err != nil {
 			return nil, err
@@ -95,15 +110,23 @@ func newResourcesControllers(ctx context.Context, metrics *Metrics, mgr manager.
 				metrics:          metrics,
 				projectResources: resources,
 				client:           seedManager.GetClient(),
+				masterClient:     mgr.GetClient(),
 				restMapper:       seedManager.GetRESTMapper(),
 				providerName:     seedName,
 				objectType:       clonedObject,
+				namespace:        resource.namespace,
 			}
 
 			// Create a new controller
 			_, err := builder.ControllerManagedBy(seedManager).
 				Named(fmt.Sprintf("rbac_generator_resources_%s", seedName)).
 				For(clonedObject, builder.WithPredicates(predicateutil.Factory(resource.predicate))).
+				WatchesRawSource(source.Kind(
+					mgr.GetCache(),
+					&kubermaticv1.Project{},
+					handler.TypedEnqueueRequestsFromMapFunc(c.enqueueProjectResources),
+					projectSuspensionChangedPredicate(),
+				)).
 				Build(c)
 			if err != nil {
 				return nil, err
@@ -146,7 +169,18 @@ func (c *resourcesController) Reconcile(ctx context.Context, req reconcile.Reque
 }
 
 func (c *resourcesController) reconcile(ctx context.Context, obj ctrlruntimeclient.Object) error {
-	err := c.syncClusterScopedProjectResource(ctx, obj)
+	suspended, err := c.projectSuspended(ctx, obj)
+	if err != nil {
+		return fmt.Errorf("failed to check project suspension: %w", err)
+	}
+
+	// the bindings of suspended projects are revoked by the project controller and
+	// restored once the project is resumed
+	if suspended {
+		return nil
+	}
+
+	err = c.syncClusterScopedProjectResource(ctx, obj)
 	if err != nil {
 		return fmt.Errorf("failed to reconcile cluster-scoped resources: %w", err)
 	}
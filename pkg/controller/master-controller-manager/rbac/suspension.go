@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// revokeProjectBindings removes the groups of the given project from all ClusterRoleBindings
+// and RoleBindings on the master and all seeds, so that the members of a suspended project
+// lose their access. The subjects are added back by the regular reconciliation once the
+// project is resumed.
+func (c *projectController) revokeProjectBindings(ctx context.Context, projectName string) error {
+	if err := revokeProjectGroupBindings(ctx, c.client, projectName); err != nil {
+		return fmt.Errorf("failed to revoke bindings on master: %w", err)
+	}
+
+	for seedName, seedClient := range c.seedClientMap {
+		if err := revokeProjectGroupBindings(ctx, seedClient, projectName); err != nil {
+			return fmt.Errorf("failed to revoke bindings on seed %s: %w", seedName, err)
+		}
+	}
+
+	return nil
+}
+
+func revokeProjectGroupBindings(ctx context.Context, client ctrlruntimeclient.Client, projectName string) error {
+	groups := sets.New[string]()
+	for _, groupPrefix := range AllGroupsPrefixes {
+		groups.Insert(GenerateActualGroupNameFor(projectName, groupPrefix))
+	}
+
+	clusterRoleBindings := &rbacv1.ClusterRoleBindingList{}
+	if err := client.List(ctx, clusterRoleBindings); err != nil {
+		return fmt.Errorf("failed to list ClusterRoleBindings: %w", err)
+	}
+
+	for _, binding := range clusterRoleBindings.Items {
+		subjects, revoked := withoutGroups(binding.Subjects, groups)
+		if !revoked {
+			continue
+		}
+
+		binding.Subjects = subjects
+		if err := client.Update(ctx, &binding); err != nil {
+			return fmt.Errorf("failed to update ClusterRoleBinding %s: %w", binding.Name, err)
+		}
+	}
+
+	roleBindings := &rbacv1.RoleBindingList{}
+	if err := client.List(ctx, roleBindings); err != nil {
+		return fmt.Errorf("failed to list RoleBindings: %w", err)
+	}
+
+	for _, binding := range roleBindings.Items {
+		subjects, revoked := withoutGroups(binding.Subjects, groups)
+		if !revoked {
+			continue
+		}
+
+		binding.Subjects = subjects
+		if err := client.Update(ctx, &binding); err != nil {
+			return fmt.Errorf("failed to update RoleBinding %s/%s: %w", binding.Namespace, binding.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// withoutGroups returns the subjects without the given groups and whether any were removed.
+func withoutGroups(subjects []rbacv1.Subject, groups sets.Set[string]) ([]rbacv1.Subject, bool) {
+	remaining := []rbacv1.Subject{}
+	for _, subject := range subjects {
+		if subject.Kind == rbacv1.GroupKind && groups.Has(subject.Name) {
+			continue
+		}
+		remaining = append(remaining, subject)
+	}
+
+	return remaining, len(remaining) != len(subjects)
+}
+
+// projectSuspended returns true if the project owning the given object is suspended. Objects
+// of suspended projects are not synced, as their bindings are revoked by the project controller.
+func (c *resourcesController) projectSuspended(ctx context.Context, obj ctrlruntimeclient.Object) (bool, error) {
+	projectName, err := getProjectName(obj)
+	if err != nil {
+		// leave reporting objects without a project to the regular sync
+		return false, nil
+	}
+
+	project := &kubermaticv1.Project{}
+	if err := c.masterClient.Get(ctx, types.NamespacedName{Name: projectName}, project); err != nil {
+		return false, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	return project.IsSuspended(), nil
+}
+
+// enqueueProjectResources returns requests for all objects of the controller's type that
+// belong to the given project, so that their bindings are restored when it is resumed.
+func (c *resourcesController) enqueueProjectResources(ctx context.Context, project *kubermaticv1.Project) []reconcile.Request {
+	gvk, err := apiutil.GVKForObject(c.objectType, c.client.Scheme())
+	if err != nil {
+		c.log.Errorw("Failed to determine object kind", "project", project.Name, "error", err)
+		return nil
+	}
+
+	obj, err := c.client.Scheme().New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err != nil {
+		c.log.Errorw("Failed to create object list", "project", project.Name, "error", err)
+		return nil
+	}
+
+	list, ok := obj.(ctrlruntimeclient.ObjectList)
+	if !ok {
+		c.log.Errorw("Unexpected object list type", "project", project.Name, "type", fmt.Sprintf("%T", obj))
+		return nil
+	}
+
+	if err := c.client.List(ctx, list, ctrlruntimeclient.InNamespace(c.namespace)); err != nil {
+		c.log.Errorw("Failed to list objects", "project", project.Name, "error", err)
+		return nil
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		c.log.Errorw("Failed to extract objects", "project", project.Name, "error", err)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, item := range items {
+		object, ok := item.(ctrlruntimeclient.Object)
+		if !ok {
+			continue
+		}
+
+		if projectName, err := getProjectName(object); err != nil || projectName != project.Name {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(object)})
+	}
+
+	return requests
+}
+
+// projectSuspensionChangedPredicate only lets through projects that were suspended or resumed.
+func projectSuspensionChangedPredicate() predicate.TypedPredicate[*kubermaticv1.Project] {
+	return predicate.TypedFuncs[*kubermaticv1.Project]{
+		CreateFunc: func(event.TypedCreateEvent[*kubermaticv1.Project]) bool { return false },
+		UpdateFunc: func(e event.TypedUpdateEvent[*kubermaticv1.Project]) bool {
+			return e.ObjectOld.IsSuspended() != e.ObjectNew.IsSuspended()
+		},
+		DeleteFunc:  func(event.TypedDeleteEvent[*kubermaticv1.Project]) bool { return false },
+		GenericFunc: func(event.TypedGenericEvent[*kubermaticv1.Project]) bool { return false },
+	}
+}
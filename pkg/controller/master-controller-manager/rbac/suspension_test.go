@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestProjectSuspensionRevokesBindings(t *testing.T) {
+	ctx := context.Background()
+
+	project := &kubermaticv1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "thunderball", UID: types.UID("thunderballID")},
+		Spec:       kubermaticv1.ProjectSpec{Name: "thunderball"},
+		Status:     kubermaticv1.ProjectStatus{Phase: kubermaticv1.ProjectActive},
+	}
+	sharedBinding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "kubermatic:usersshkeys:owners"},
+		Subjects: []rbacv1.Subject{
+			{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "owners-thunderball"},
+			{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "owners-goldfinger"},
+		},
+		RoleRef: rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "kubermatic:usersshkeys:owners"},
+	}
+	seedBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "kubermatic:addon:editors", Namespace: "cluster-abcd"},
+		Subjects: []rbacv1.Subject{
+			{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "editors-thunderball"},
+			{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "bob@example.com"},
+		},
+		RoleRef: rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: "kubermatic:addon:editors"},
+	}
+
+	masterClient := fake.NewClientBuilder().WithObjects(project, sharedBinding).Build()
+	seedClient := fake.NewClientBuilder().WithObjects(seedBinding).Build()
+
+	target := projectController{
+		client:        masterClient,
+		restMapper:    getFakeRestMapper(t),
+		seedClientMap: map[string]ctrlruntimeclient.Client{"us-central1": seedClient},
+		log:           zap.NewNop().Sugar(),
+	}
+
+	setSuspension := func(suspension *kubermaticv1.ProjectSuspension) {
+		t.Helper()
+
+		current := &kubermaticv1.Project{}
+		if err := masterClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(project), current); err != nil {
+			t.Fatalf("failed to get project: %v", err)
+		}
+
+		current.Spec.Suspension = suspension
+		if err := masterClient.Update(ctx, current); err != nil {
+			t.Fatalf("failed to update project: %v", err)
+		}
+
+		if err := target.sync(ctx, ctrlruntimeclient.ObjectKeyFromObject(project)); err != nil {
+			t.Fatalf("failed to sync project: %v", err)
+		}
+	}
+
+	projectBindingName := generateRBACRoleNameForNamedResource(kubermaticv1.ProjectKindName, project.Name, "owners-thunderball")
+
+	setSuspension(nil)
+	assert.Equal(t, []string{"owners-thunderball"}, clusterRoleBindingGroups(t, masterClient, projectBindingName))
+
+	setSuspension(&kubermaticv1.ProjectSuspension{Reason: "budget exhausted"})
+	assert.Empty(t, clusterRoleBindingGroups(t, masterClient, projectBindingName), "project binding should have been revoked")
+	assert.Equal(t, []string{"owners-goldfinger"}, clusterRoleBindingGroups(t, masterClient, sharedBinding.Name), "only the suspended project should have been removed from the shared binding")
+
+	revokedSeedBinding := &rbacv1.RoleBinding{}
+	assert.NoError(t, seedClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(seedBinding), revokedSeedBinding))
+	assert.Equal(t, []rbacv1.Subject{seedBinding.Subjects[1]}, revokedSeedBinding.Subjects, "seed binding should only have kept the user")
+
+	setSuspension(nil)
+	assert.Equal(t, []string{"owners-thunderball"}, clusterRoleBindingGroups(t, masterClient, projectBindingName), "project binding should have been restored")
+}
+
+func TestSuspendedProjectResourcesAreNotSynced(t *testing.T) {
+	ctx := context.Background()
+
+	project := &kubermaticv1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "thunderball", UID: types.UID("thunderballID")},
+		Spec: kubermaticv1.ProjectSpec{
+			Name:       "thunderball",
+			Suspension: &kubermaticv1.ProjectSuspension{Reason: "budget exhausted"},
+		},
+	}
+	sshKey := &kubermaticv1.UserSSHKey{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       kubermaticv1.SSHKeyKind,
+			APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "key-abc",
+			UID:  types.UID("keyID"),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+					Kind:       kubermaticv1.ProjectKindName,
+					Name:       project.Name,
+					UID:        project.UID,
+				},
+			},
+		},
+	}
+
+	// the binding as left behind by the project controller when the project was suspended
+	revokedBinding := generateClusterRBACRoleBindingNamedResource(sshKey.Kind, sshKey.Name, "owners-thunderball", metav1.OwnerReference{
+		APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+		Kind:       sshKey.Kind,
+		UID:        sshKey.UID,
+		Name:       sshKey.Name,
+	})
+	revokedBinding.Subjects = []rbacv1.Subject{}
+
+	masterClient := fake.NewClientBuilder().WithObjects(project, sshKey, revokedBinding).Build()
+
+	target := resourcesController{
+		client:       masterClient,
+		masterClient: masterClient,
+		restMapper:   getFakeRestMapper(t),
+		objectType:   &kubermaticv1.UserSSHKey{},
+		log:          zap.NewNop().Sugar(),
+	}
+
+	reconcileKey := func() {
+		t.Helper()
+
+		if _, err := target.Reconcile(ctx, reconcile.Request{NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(sshKey)}); err != nil {
+			t.Fatalf("failed to reconcile: %v", err)
+		}
+	}
+
+	reconcileKey()
+	assert.Empty(t, clusterRoleBindingGroups(t, masterClient, revokedBinding.Name), "binding of a suspended project should not have been restored")
+
+	// resuming the project must enqueue its resources, so that the bindings are restored
+	assert.NoError(t, masterClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(project), project))
+	project.Spec.Suspension = nil
+	assert.NoError(t, masterClient.Update(ctx, project))
+
+	requests := target.enqueueProjectResources(ctx, project)
+	assert.Equal(t, []reconcile.Request{{NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(sshKey)}}, requests)
+
+	reconcileKey()
+	assert.Equal(t, []string{"owners-thunderball"}, clusterRoleBindingGroups(t, masterClient, revokedBinding.Name), "binding should have been restored")
+}
+
+func clusterRoleBindingGroups(t *testing.T, client ctrlruntimeclient.Client, name string) []string {
+	t.Helper()
+
+	binding := &rbacv1.ClusterRoleBinding{}
+	if err := client.Get(context.Background(), types.NamespacedName{Name: name}, binding); err != nil {
+		t.Fatalf("failed to get ClusterRoleBinding %s: %v", name, err)
+	}
+
+	var groups []string
+	for _, subject := range binding.Subjects {
+		if subject.Kind == rbacv1.GroupKind {
+			groups = append(groups, subject.Name)
+		}
+	}
+
+	return groups
+}
@@ -28,6 +28,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -67,24 +68,41 @@ func (c *projectController) sync(ctx context.Context, key ctrlruntimeclient.Obje
 	if err := ensureClusterRBACRoleForNamedResource(ctx, c.log, c.client, project.Name, kubermaticv1.ProjectResourceName, kubermaticv1.ProjectKindName, project.GetObjectMeta()); err != nil {
 		return fmt.Errorf("failed to ensure that the RBAC Role for project exists: %w", err)
 	}
-	if err := ensureClusterRBACRoleBindingForNamedResource(ctx, c.log, c.client, project.Name, kubermaticv1.ProjectResourceName, kubermaticv1.ProjectKindName, project.GetObjectMeta()); err != nil {
-		return fmt.Errorf("failed to ensure that the RBAC RoleBinding for the project exist: %w", err)
-	}
 	if err := c.ensureClusterRBACRoleForResources(ctx); err != nil {
 		return fmt.Errorf("failed to ensure that the RBAC ClusterRoles for project resources exist: %w", err)
 	}
-	if err := c.ensureClusterRBACRoleBindingForResources(ctx, project.Name); err != nil {
-		return fmt.Errorf("failed to ensure that the RBAC ClusterRoleBindings for project resources exist: %w", err)
-	}
 	if err := c.ensureRBACRoleForResources(ctx); err != nil {
 		return fmt.Errorf("failed to ensure that the RBAC Roles for project resources exist: %w", err)
 	}
-	if err := c.ensureRBACRoleBindingForResources(ctx, project.Name); err != nil {
-		return fmt.Errorf("failed to ensure that the RBAC RolesBindings for project resources exist: %w", err)
+	if project.IsSuspended() {
+		if err := c.revokeProjectBindings(ctx, project.Name); err != nil {
+			return fmt.Errorf("failed to revoke the RBAC bindings of the suspended project: %w", err)
+		}
+	} else if err := c.ensureProjectBindings(ctx, project); err != nil {
+		return err
 	}
 	if err := c.ensureProjectPhase(ctx, project, kubermaticv1.ProjectActive); err != nil {
 		return fmt.Errorf("failed to set project phase to active: %w", err)
 	}
+	if err := c.ensureProjectSuspensionStatus(ctx, project); err != nil {
+		return fmt.Errorf("failed to update project suspension status: %w", err)
+	}
+
+	return nil
+}
+
+// ensureProjectBindings binds the project groups to the project and its resources. This also
+// restores the bindings revoked while the project was suspended.
+func (c *projectController) ensureProjectBindings(ctx context.Context, project *kubermaticv1.Project) error {
+	if err := ensureClusterRBACRoleBindingForNamedResource(ctx, c.log, c.client, project.Name, kubermaticv1.ProjectResourceName, kubermaticv1.ProjectKindName, project.GetObjectMeta()); err != nil {
+		return fmt.Errorf("failed to ensure that the RBAC RoleBinding for the project exist: %w", err)
+	}
+	if err := c.ensureClusterRBACRoleBindingForResources(ctx, project.Name); err != nil {
+		return fmt.Errorf("failed to ensure that the RBAC ClusterRoleBindings for project resources exist: %w", err)
+	}
+	if err := c.ensureRBACRoleBindingForResources(ctx, project.Name); err != nil {
+		return fmt.Errorf("failed to ensure that the RBAC RolesBindings for project resources exist: %w", err)
+	}
 
 	return nil
 }
@@ -103,6 +121,33 @@ func (c *projectController) ensureProjectPhase(ctx context.Context, project *kub
 	return nil
 }
 
+// ensureProjectSuspensionStatus records the suspension configured in the spec in the
+// project status, keeping the original suspension time for as long as the suspension is
+// not changed.
+func (c *projectController) ensureProjectSuspensionStatus(ctx context.Context, project *kubermaticv1.Project) error {
+	var status *kubermaticv1.ProjectSuspensionStatus
+
+	if suspension := project.Spec.Suspension; suspension != nil {
+		status = &kubermaticv1.ProjectSuspensionStatus{
+			Reason:      suspension.Reason,
+			SuspendedBy: suspension.SuspendedBy,
+			SuspendedAt: metav1.Now(),
+		}
+
+		if current := project.Status.Suspension; current != nil && current.Reason == status.Reason && current.SuspendedBy == status.SuspendedBy {
+			status.SuspendedAt = current.SuspendedAt
+		}
+	}
+
+	if equality.Semantic.DeepEqual(project.Status.Suspension, status) {
+		return nil
+	}
+
+	oldProject := project.DeepCopy()
+	project.Status.Suspension = status
+	return c.client.Status().Patch(ctx, project, ctrlruntimeclient.MergeFrom(oldProject))
+}
+
 func (c *projectController) ensureClusterRBACRoleForResources(ctx context.Context) error {
 	for _, projectResource := range c.projectResources {
 		if len(projectResource.namespace) > 0 {
@@ -112,6 +112,83 @@ func TestEnsureProjectInitialized(t *testing.T) {
 	}
 }
 
+func TestEnsureProjectSuspensionStatus(t *testing.T) {
+	suspendedAt := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name              string
+		suspension        *kubermaticv1.ProjectSuspension
+		status            *kubermaticv1.ProjectSuspensionStatus
+		expectSuspended   bool
+		expectSuspendedBy string
+		expectUnchanged   bool
+	}{
+		{
+			name: "scenario 1: project that is not suspended has no suspension status",
+		},
+		{
+			name:              "scenario 2: suspending a project records the suspension",
+			suspension:        &kubermaticv1.ProjectSuspension{Reason: "budget exhausted", SuspendedBy: "admin@example.com"},
+			expectSuspended:   true,
+			expectSuspendedBy: "admin@example.com",
+		},
+		{
+			name:              "scenario 3: unchanged suspension keeps the original suspension time",
+			suspension:        &kubermaticv1.ProjectSuspension{Reason: "budget exhausted", SuspendedBy: "admin@example.com"},
+			status:            &kubermaticv1.ProjectSuspensionStatus{Reason: "budget exhausted", SuspendedBy: "admin@example.com", SuspendedAt: suspendedAt},
+			expectSuspended:   true,
+			expectSuspendedBy: "admin@example.com",
+			expectUnchanged:   true,
+		},
+		{
+			name:   "scenario 4: resuming a project clears the suspension",
+			status: &kubermaticv1.ProjectSuspensionStatus{Reason: "budget exhausted", SuspendedBy: "admin@example.com", SuspendedAt: suspendedAt},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			project := &kubermaticv1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "thunderball"},
+				Spec: kubermaticv1.ProjectSpec{
+					Name:       "thunderball",
+					Suspension: test.suspension,
+				},
+				Status: kubermaticv1.ProjectStatus{
+					Phase:      kubermaticv1.ProjectActive,
+					Suspension: test.status,
+				},
+			}
+			masterClient := kubermaticface.NewClientBuilder().WithObjects(project).Build()
+
+			target := projectController{
+				client:     masterClient,
+				restMapper: getFakeRestMapper(t),
+				log:        zap.NewNop().Sugar(),
+			}
+			err := target.ensureProjectSuspensionStatus(ctx, project)
+			assert.NoError(t, err)
+
+			updated := &kubermaticv1.Project{}
+			assert.NoError(t, masterClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(project), updated))
+
+			status := updated.Status.Suspension
+			if !test.expectSuspended {
+				assert.Nil(t, status)
+				return
+			}
+
+			if assert.NotNil(t, status) {
+				assert.Equal(t, test.suspension.Reason, status.Reason)
+				assert.Equal(t, test.expectSuspendedBy, status.SuspendedBy)
+				assert.Equal(t, test.expectUnchanged, status.SuspendedAt.Equal(&suspendedAt))
+			}
+		})
+	}
+}
+
 func TestEnsureProjectClusterRBACRoleBindingForResources(t *testing.T) {
 	tests := []struct {
 		name                                 string
@@ -1317,10 +1317,11 @@ func TestSyncProjectResourcesClusterWide(t *testing.T) {
 
 			// act
 			target := resourcesController{
-				client:     fakeMasterClusterClient,
-				restMapper: getFakeRestMapper(t),
-				objectType: test.dependantToSync.DeepCopyObject().(ctrlruntimeclient.Object),
-				log:        zap.NewNop().Sugar(),
+				client:       fakeMasterClusterClient,
+				masterClient: fakeMasterClusterClient,
+				restMapper:   getFakeRestMapper(t),
+				objectType:   test.dependantToSync.DeepCopyObject().(ctrlruntimeclient.Object),
+				log:          zap.NewNop().Sugar(),
 			}
 			objmeta, err := meta.Accessor(test.dependantToSync)
 			assert.NoError(t, err)
@@ -1561,10 +1562,11 @@ func TestSyncProjectResourcesNamespaced(t *testing.T) {
 			fakeMasterClusterClient := fake.NewClientBuilder().WithObjects(objs...).Build()
 			// act
 			target := resourcesController{
-				client:     fakeMasterClusterClient,
-				restMapper: getFakeRestMapper(t),
-				objectType: test.dependantToSync.DeepCopyObject().(ctrlruntimeclient.Object),
-				log:        zap.NewNop().Sugar(),
+				client:       fakeMasterClusterClient,
+				masterClient: fakeMasterClusterClient,
+				restMapper:   getFakeRestMapper(t),
+				objectType:   test.dependantToSync.DeepCopyObject().(ctrlruntimeclient.Object),
+				log:          zap.NewNop().Sugar(),
 			}
 			objmeta, err := meta.Accessor(test.dependantToSync)
 			assert.NoError(t, err)
@@ -3057,10 +3059,11 @@ func TestSyncClusterConstraintsRBAC(t *testing.T) {
 			fakeMasterClusterClient := fake.NewClientBuilder().WithObjects(objs...).Build()
 			// act
 			target := resourcesController{
-				client:     fakeMasterClusterClient,
-				restMapper: getFakeRestMapper(t),
-				objectType: test.dependantToSync.DeepCopyObject().(ctrlruntimeclient.Object),
-				log:        zap.NewNop().Sugar(),
+				client:       fakeMasterClusterClient,
+				masterClient: fakeMasterClusterClient,
+				restMapper:   getFakeRestMapper(t),
+				objectType:   test.dependantToSync.DeepCopyObject().(ctrlruntimeclient.Object),
+				log:          zap.NewNop().Sugar(),
 			}
 			objmeta, err := meta.Accessor(test.dependantToSync)
 			assert.NoError(t, err)
@@ -3487,10 +3490,11 @@ func TestSyncClusterAlertmanagerRBAC(t *testing.T) {
 			fakeSeedClusterClient := fake.NewClientBuilder().WithObjects(objs...).Build()
 			// act
 			target := resourcesController{
-				client:     fakeSeedClusterClient,
-				restMapper: getFakeRestMapper(t),
-				objectType: test.dependantToSync.DeepCopyObject().(ctrlruntimeclient.Object),
-				log:        zap.NewNop().Sugar(),
+				client:       fakeSeedClusterClient,
+				masterClient: fake.NewClientBuilder().Build(),
+				restMapper:   getFakeRestMapper(t),
+				objectType:   test.dependantToSync.DeepCopyObject().(ctrlruntimeclient.Object),
+				log:          zap.NewNop().Sugar(),
 			}
 			objmeta, err := meta.Accessor(test.dependantToSync)
 			assert.NoError(t, err)
@@ -3807,10 +3811,11 @@ func TestSyncClusterRuleGroupsRBAC(t *testing.T) {
 			fakeSeedClusterClient := fake.NewClientBuilder().WithObjects(objs...).Build()
 			// act
 			target := resourcesController{
-				client:     fakeSeedClusterClient,
-				restMapper: getFakeRestMapper(t),
-				objectType: test.dependantToSync.DeepCopyObject().(ctrlruntimeclient.Object),
-				log:        zap.NewNop().Sugar(),
+				client:       fakeSeedClusterClient,
+				masterClient: fake.NewClientBuilder().Build(),
+				restMapper:   getFakeRestMapper(t),
+				objectType:   test.dependantToSync.DeepCopyObject().(ctrlruntimeclient.Object),
+				log:          zap.NewNop().Sugar(),
 			}
 			objmeta, err := meta.Accessor(test.dependantToSync)
 			assert.NoError(t, err)
@@ -0,0 +1,398 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package staleclustercontroller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	"k8c.io/kubermatic/v2/pkg/controller/util/predicate"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// ControllerName is the name of this controller.
+	ControllerName = "kkp-stale-cluster-controller"
+
+	// resyncInterval is how often all clusters are evaluated, as they are not watched
+	// across all seeds.
+	resyncInterval = 10 * time.Minute
+)
+
+// UserClusterClientGetter returns a client for the user cluster of a cluster on the given seed.
+type UserClusterClientGetter func(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error)
+
+// Reconciler applies the stale cluster policy to the clusters on all seeds.
+type Reconciler struct {
+	ctrlruntimeclient.Client
+
+	log                     *zap.SugaredLogger
+	recorder                events.EventRecorder
+	seedsGetter             provider.SeedsGetter
+	seedClientGetter        provider.SeedClientGetter
+	userClusterClientGetter UserClusterClientGetter
+	httpClient              *http.Client
+	now                     func() time.Time
+}
+
+// Add creates a new stale cluster controller and sets up watches.
+func Add(
+	mgr manager.Manager,
+	log *zap.SugaredLogger,
+	seedsGetter provider.SeedsGetter,
+	seedKubeconfigGetter provider.SeedKubeconfigGetter,
+) error {
+	reconciler := &Reconciler{
+		Client:                  mgr.GetClient(),
+		log:                     log.Named(ControllerName),
+		recorder:                mgr.GetEventRecorder(ControllerName),
+		seedsGetter:             seedsGetter,
+		seedClientGetter:        kubernetesprovider.SeedClientGetterFactory(seedKubeconfigGetter),
+		userClusterClientGetter: externalUserClusterClient,
+		httpClient:              &http.Client{Timeout: 10 * time.Second},
+		now:                     time.Now,
+	}
+
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			// there is only a single policy to apply
+			MaxConcurrentReconciles: 1,
+		}).
+		For(&kubermaticv1.KubermaticSetting{}, builder.WithPredicates(predicate.ByName(kubermaticv1.GlobalSettingsName))).
+		Build(reconciler)
+
+	return err
+}
+
+// externalUserClusterClient connects to user clusters via their external address, as the
+// master cannot reach the cluster namespaces on the seeds.
+func externalUserClusterClient(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error) {
+	clientProvider, err := clusterclient.NewExternal(seedClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientProvider.GetClient(ctx, cluster)
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("settings", request.Name)
+	log.Debug("Reconciling")
+
+	settings := &kubermaticv1.KubermaticSetting{}
+	if err := r.Get(ctx, request.NamespacedName, settings); err != nil {
+		return reconcile.Result{}, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	seeds, err := r.seedsGetter()
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to get seeds: %w", err)
+	}
+
+	policy := settings.Spec.StaleClusterPolicy
+	if policy == nil || !policy.Enabled {
+		return reconcile.Result{}, r.forEachCluster(ctx, seeds, func(_ string, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) error {
+			return resetCluster(ctx, seedClient, cluster)
+		})
+	}
+
+	err = r.forEachCluster(ctx, seeds, func(seed string, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) error {
+		return r.reconcileCluster(ctx, log.With("seed", seed, "cluster", cluster.Name), settings, seed, seedClient, cluster)
+	})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: resyncInterval}, nil
+}
+
+// forEachCluster calls fn for every cluster on every seed. Errors do not stop the iteration,
+// so that a single broken cluster or seed does not affect all others.
+func (r *Reconciler) forEachCluster(ctx context.Context, seeds map[string]*kubermaticv1.Seed, fn func(seed string, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) error) error {
+	var errs []error
+
+	for _, seed := range seeds {
+		client, err := r.seedClientGetter(seed)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to create client for seed %s: %w", seed.Name, err))
+			continue
+		}
+
+		clusters := &kubermaticv1.ClusterList{}
+		if err := client.List(ctx, clusters); err != nil {
+			errs = append(errs, fmt.Errorf("failed to list clusters on seed %s: %w", seed.Name, err))
+			continue
+		}
+
+		for i := range clusters.Items {
+			cluster := &clusters.Items[i]
+			if err := fn(seed.Name, client, cluster); err != nil {
+				errs = append(errs, fmt.Errorf("cluster %s on seed %s: %w", cluster.Name, seed.Name, err))
+			}
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+func (r *Reconciler) reconcileCluster(ctx context.Context, log *zap.SugaredLogger, settings *kubermaticv1.KubermaticSetting, seed string, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) error {
+	if cluster.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if cluster.Labels[kubermaticv1.StaleClusterCleanupOptOutLabel] == "true" {
+		return resetCluster(ctx, seedClient, cluster)
+	}
+
+	policy := settings.Spec.StaleClusterPolicy
+	now := r.now()
+
+	status := &kubermaticv1.ClusterStalenessStatus{}
+	if cluster.Status.Staleness != nil {
+		status = cluster.Status.Staleness.DeepCopy()
+	}
+
+	// Paused clusters and the clusters of suspended projects are frozen on purpose; they are
+	// neither judged nor do they age until they are resumed.
+	frozen, err := r.frozen(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	if frozen {
+		status.LastActivityTime = &metav1.Time{Time: now}
+		return updateStatus(ctx, seedClient, cluster, status)
+	}
+
+	s, err := r.collectUserClusterSignals(ctx, seedClient, cluster)
+	if err != nil {
+		// an unreachable user cluster must not prevent the other criteria from being evaluated
+		log.Debugw("Failed to inspect user cluster", zap.Error(err))
+	}
+
+	// the cluster only becomes idle while it is positively observed without workloads, an
+	// unreachable cluster might be in use all along
+	if s.hasWorkloads == nil || *s.hasWorkloads || status.LastActivityTime == nil {
+		status.LastActivityTime = &metav1.Time{Time: now}
+	}
+
+	if policy.OwnerLeftProject {
+		s.ownerLeftProject, err = ownerLeftProject(ctx, r.Client, cluster, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	reasons, ok := evaluate(policy, cluster, s, status.LastActivityTime.Time, now)
+	if !ok {
+		// keep the current verdict until the user cluster can be inspected again
+		return updateStatus(ctx, seedClient, cluster, status)
+	}
+
+	switch {
+	case len(reasons) == 0:
+		if status.StaleSince != nil {
+			status.StaleSince = nil
+			status.Reasons = nil
+			status.ActionTaken = ""
+
+			r.notify(ctx, log, policy, seed, cluster, status, notificationActive, corev1.EventTypeNormal, "ClusterActive", "Cluster %s is no longer stale.", cluster.Name)
+		}
+
+	case status.StaleSince == nil:
+		status.StaleSince = &metav1.Time{Time: now}
+		status.Reasons = reasons
+
+		r.notify(ctx, log, policy, seed, cluster, status, notificationStale, corev1.EventTypeWarning, "ClusterStale", "Cluster %s is stale: %s.", cluster.Name, strings.Join(reasons, ", "))
+
+	default:
+		status.Reasons = reasons
+
+		// waking up a hibernated cluster manually restarts the grace period
+		if status.ActionTaken == kubermaticv1.StaleClusterActionHibernate && !hibernationRequested(cluster) {
+			status.StaleSince = &metav1.Time{Time: now}
+			status.ActionTaken = ""
+		}
+
+		if status.ActionTaken == "" && !now.Before(status.StaleSince.Add(durationOrDefault(policy.GracePeriod, defaultGracePeriod))) {
+			return r.takeAction(ctx, log, settings, seed, seedClient, cluster, status)
+		}
+	}
+
+	return updateStatus(ctx, seedClient, cluster, status)
+}
+
+func (r *Reconciler) takeAction(ctx context.Context, log *zap.SugaredLogger, settings *kubermaticv1.KubermaticSetting, seed string, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, status *kubermaticv1.ClusterStalenessStatus) error {
+	policy := settings.Spec.StaleClusterPolicy
+
+	switch policy.Action {
+	case kubermaticv1.StaleClusterActionHibernate:
+		if !hibernationRequested(cluster) {
+			log.Info("Hibernating stale cluster")
+
+			oldCluster := cluster.DeepCopy()
+			if cluster.Spec.Hibernation == nil {
+				cluster.Spec.Hibernation = &kubermaticv1.ClusterHibernationSettings{}
+			}
+			cluster.Spec.Hibernation.Hibernated = true
+
+			if err := seedClient.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+				return fmt.Errorf("failed to hibernate cluster: %w", err)
+			}
+		}
+
+		status.ActionTaken = kubermaticv1.StaleClusterActionHibernate
+		if err := updateStatus(ctx, seedClient, cluster, status); err != nil {
+			return err
+		}
+
+		r.notify(ctx, log, policy, seed, cluster, status, notificationHibernated, corev1.EventTypeNormal, "StaleClusterHibernated", "Hibernated stale cluster %s.", cluster.Name)
+
+	case kubermaticv1.StaleClusterActionDelete:
+		log.Info("Deleting stale cluster")
+
+		status.ActionTaken = kubermaticv1.StaleClusterActionDelete
+		if err := updateStatus(ctx, seedClient, cluster, status); err != nil {
+			return err
+		}
+
+		if settings.Spec.CleanupOptions.Enforced {
+			if err := kuberneteshelper.TryAddFinalizer(ctx, seedClient, cluster, kubermaticv1.InClusterLBCleanupFinalizer, kubermaticv1.InClusterPVCleanupFinalizer); err != nil {
+				return fmt.Errorf("failed to add cleanup finalizers: %w", err)
+			}
+		}
+
+		if err := seedClient.Delete(ctx, cluster); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete cluster: %w", err)
+		}
+
+		r.notify(ctx, log, policy, seed, cluster, status, notificationDeleted, corev1.EventTypeNormal, "StaleClusterDeleted", "Deleted stale cluster %s.", cluster.Name)
+
+	default:
+		return updateStatus(ctx, seedClient, cluster, status)
+	}
+
+	return nil
+}
+
+// notify emits an event on the cluster's project and sends a notification to the
+// configured webhook. Failures are only logged, as they must not block the policy.
+func (r *Reconciler) notify(ctx context.Context, log *zap.SugaredLogger, policy *kubermaticv1.StaleClusterPolicy, seed string, cluster *kubermaticv1.Cluster, status *kubermaticv1.ClusterStalenessStatus, event string, eventType, reason, messageFmt string, args ...interface{}) {
+	projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+
+	project := &kubermaticv1.Project{}
+	if err := r.Get(ctx, types.NamespacedName{Name: projectID}, project); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Warnw("Failed to get project", zap.Error(err))
+		}
+	} else {
+		r.recorder.Eventf(project, nil, eventType, reason, "Reconciling", messageFmt, args...)
+	}
+
+	if policy.WebhookURL == "" {
+		return
+	}
+
+	n := notification{
+		Event:       event,
+		Timestamp:   r.now().UTC(),
+		Seed:        seed,
+		Cluster:     cluster.Name,
+		ClusterName: cluster.Spec.HumanReadableName,
+		Project:     projectID,
+		Owner:       cluster.Status.UserEmail,
+		Reasons:     status.Reasons,
+	}
+
+	if status.StaleSince != nil {
+		n.StaleSince = &status.StaleSince.Time
+	}
+
+	if err := sendNotification(ctx, r.httpClient, policy.WebhookURL, n); err != nil {
+		log.Warnw("Failed to send stale cluster notification", zap.Error(err))
+	}
+}
+
+// frozen returns true if the cluster is paused or belongs to a suspended project.
+func (r *Reconciler) frozen(ctx context.Context, cluster *kubermaticv1.Cluster) (bool, error) {
+	if cluster.Spec.Pause {
+		return true, nil
+	}
+
+	projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+	if projectID == "" {
+		return false, nil
+	}
+
+	project := &kubermaticv1.Project{}
+	if err := r.Get(ctx, types.NamespacedName{Name: projectID}, project); err != nil {
+		return false, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	return project.IsSuspended(), nil
+}
+
+func hibernationRequested(cluster *kubermaticv1.Cluster) bool {
+	return cluster.Spec.Hibernation != nil && cluster.Spec.Hibernation.Hibernated
+}
+
+func updateStatus(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, status *kubermaticv1.ClusterStalenessStatus) error {
+	if equality.Semantic.DeepEqual(cluster.Status.Staleness, status) {
+		return nil
+	}
+
+	oldCluster := cluster.DeepCopy()
+	cluster.Status.Staleness = status
+
+	if err := client.Status().Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+		return fmt.Errorf("failed to update cluster status: %w", err)
+	}
+
+	return nil
+}
+
+// resetCluster removes all traces of the stale cluster policy from a cluster.
+func resetCluster(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) error {
+	if cluster.Status.Staleness == nil {
+		return nil
+	}
+
+	return updateStatus(ctx, client, cluster, nil)
+}
@@ -0,0 +1,536 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package staleclustercontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	projectID = "my-project"
+	owner     = "owner@example.com"
+)
+
+var (
+	now = time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+	healthy = kubermaticv1.ExtendedClusterHealth{
+		Apiserver:  kubermaticv1.HealthStatusUp,
+		Scheduler:  kubermaticv1.HealthStatusUp,
+		Controller: kubermaticv1.HealthStatusUp,
+		Etcd:       kubermaticv1.HealthStatusUp,
+	}
+)
+
+func genCluster(age time.Duration, modify func(*kubermaticv1.Cluster)) *kubermaticv1.Cluster {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "cluster",
+			CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			Labels: map[string]string{
+				kubermaticv1.ProjectIDLabelKey: projectID,
+			},
+		},
+		Status: kubermaticv1.ClusterStatus{
+			UserEmail:      owner,
+			ExtendedHealth: healthy,
+		},
+	}
+
+	if modify != nil {
+		modify(cluster)
+	}
+
+	return cluster
+}
+
+func genNode(name string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+}
+
+func genPod(namespace string, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod",
+			Namespace: namespace,
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func staleSince(d time.Duration, action kubermaticv1.StaleClusterAction) func(*kubermaticv1.Cluster) {
+	return func(c *kubermaticv1.Cluster) {
+		c.Status.Staleness = &kubermaticv1.ClusterStalenessStatus{
+			LastActivityTime: &metav1.Time{Time: now.Add(-30 * 24 * time.Hour)},
+			StaleSince:       &metav1.Time{Time: now.Add(-d)},
+			Reasons:          []string{"cluster has 0 node(s)"},
+			ActionTaken:      action,
+		}
+	}
+}
+
+func hibernated(c *kubermaticv1.Cluster) {
+	c.Spec.Hibernation = &kubermaticv1.ClusterHibernationSettings{Hibernated: true}
+	c.Status.Hibernation = &kubermaticv1.ClusterHibernationStatus{State: kubermaticv1.ClusterHibernationStateHibernated}
+}
+
+func TestReconcileCluster(t *testing.T) {
+	emptyClusterPolicy := &kubermaticv1.StaleClusterPolicy{
+		Enabled:  true,
+		MaxNodes: ptr.To[int32](0),
+	}
+
+	testCases := []struct {
+		name             string
+		policy           *kubermaticv1.StaleClusterPolicy
+		enforcedCleanup  bool
+		suspendedProject bool
+		cluster          *kubermaticv1.Cluster
+		userClusterObjs  []ctrlruntimeclient.Object
+		expectStale      bool
+		expectAction     kubermaticv1.StaleClusterAction
+		expectHibernated bool
+		expectDeleted    bool
+		expectEvent      bool
+		// expectActivity is the expected last activity time, if it is checked.
+		expectActivity time.Time
+	}{
+		{
+			name:        "cluster without nodes is flagged as stale",
+			policy:      emptyClusterPolicy,
+			cluster:     genCluster(30*24*time.Hour, nil),
+			expectStale: true,
+			expectEvent: true,
+		},
+		{
+			name:            "cluster with nodes is not stale",
+			policy:          emptyClusterPolicy,
+			cluster:         genCluster(30*24*time.Hour, nil),
+			userClusterObjs: []ctrlruntimeclient.Object{genNode("node-1")},
+		},
+		{
+			name:    "young cluster is not stale",
+			policy:  emptyClusterPolicy,
+			cluster: genCluster(24*time.Hour, nil),
+		},
+		{
+			name:   "opted out cluster is reset",
+			policy: emptyClusterPolicy,
+			cluster: genCluster(30*24*time.Hour, func(c *kubermaticv1.Cluster) {
+				staleSince(time.Hour, "")(c)
+				c.Labels[kubermaticv1.StaleClusterCleanupOptOutLabel] = "true"
+			}),
+		},
+		{
+			name:   "unreachable cluster keeps its verdict",
+			policy: emptyClusterPolicy,
+			cluster: genCluster(30*24*time.Hour, func(c *kubermaticv1.Cluster) {
+				staleSince(time.Hour, "")(c)
+				c.Status.ExtendedHealth = kubermaticv1.ExtendedClusterHealth{}
+			}),
+			userClusterObjs: []ctrlruntimeclient.Object{genNode("node-1")},
+			expectStale:     true,
+		},
+		{
+			name: "unreachable cluster is not considered idle",
+			policy: &kubermaticv1.StaleClusterPolicy{
+				Enabled:      true,
+				IdleDuration: &metav1.Duration{Duration: 24 * time.Hour},
+				Action:       kubermaticv1.StaleClusterActionDelete,
+			},
+			cluster: genCluster(30*24*time.Hour, func(c *kubermaticv1.Cluster) {
+				c.Status.Staleness = &kubermaticv1.ClusterStalenessStatus{
+					LastActivityTime: &metav1.Time{Time: now.Add(-30 * 24 * time.Hour)},
+				}
+				c.Status.ExtendedHealth = kubermaticv1.ExtendedClusterHealth{}
+			}),
+			expectActivity: now,
+		},
+		{
+			name: "unreachable idle cluster is not deleted after the grace period",
+			policy: &kubermaticv1.StaleClusterPolicy{
+				Enabled:      true,
+				IdleDuration: &metav1.Duration{Duration: 24 * time.Hour},
+				Action:       kubermaticv1.StaleClusterActionDelete,
+			},
+			cluster: genCluster(30*24*time.Hour, func(c *kubermaticv1.Cluster) {
+				staleSince(8*24*time.Hour, "")(c)
+				c.Status.ExtendedHealth = kubermaticv1.ExtendedClusterHealth{}
+			}),
+			expectStale:    true,
+			expectActivity: now,
+		},
+		{
+			name: "idle cluster is deleted after the grace period",
+			policy: &kubermaticv1.StaleClusterPolicy{
+				Enabled:      true,
+				IdleDuration: &metav1.Duration{Duration: 24 * time.Hour},
+				Action:       kubermaticv1.StaleClusterActionDelete,
+			},
+			enforcedCleanup: true,
+			cluster:         genCluster(30*24*time.Hour, staleSince(8*24*time.Hour, "")),
+			userClusterObjs: []ctrlruntimeclient.Object{genPod("default", corev1.PodSucceeded)},
+			expectStale:     true,
+			expectAction:    kubermaticv1.StaleClusterActionDelete,
+			expectDeleted:   true,
+			expectEvent:     true,
+			expectActivity:  now.Add(-30 * 24 * time.Hour),
+		},
+		{
+			name:   "hibernated cluster is not considered empty",
+			policy: emptyClusterPolicy,
+			cluster: genCluster(30*24*time.Hour, func(c *kubermaticv1.Cluster) {
+				hibernated(c)
+				c.Status.ExtendedHealth = kubermaticv1.ExtendedClusterHealth{}
+			}),
+			expectHibernated: true,
+			expectActivity:   now,
+		},
+		{
+			name: "hibernated idle cluster is not deleted after the grace period",
+			policy: &kubermaticv1.StaleClusterPolicy{
+				Enabled:      true,
+				IdleDuration: &metav1.Duration{Duration: 24 * time.Hour},
+				Action:       kubermaticv1.StaleClusterActionDelete,
+			},
+			enforcedCleanup: true,
+			cluster: genCluster(30*24*time.Hour, func(c *kubermaticv1.Cluster) {
+				staleSince(8*24*time.Hour, "")(c)
+				hibernated(c)
+			}),
+			expectStale:      true,
+			expectHibernated: true,
+			expectActivity:   now,
+		},
+		{
+			name: "paused cluster is not deleted after the grace period",
+			policy: &kubermaticv1.StaleClusterPolicy{
+				Enabled:  true,
+				MaxNodes: ptr.To[int32](0),
+				Action:   kubermaticv1.StaleClusterActionDelete,
+			},
+			enforcedCleanup: true,
+			cluster: genCluster(30*24*time.Hour, func(c *kubermaticv1.Cluster) {
+				staleSince(8*24*time.Hour, "")(c)
+				c.Spec.Pause = true
+			}),
+			expectStale:    true,
+			expectActivity: now,
+		},
+		{
+			name: "cluster in a suspended project is not deleted after the grace period",
+			policy: &kubermaticv1.StaleClusterPolicy{
+				Enabled:  true,
+				MaxNodes: ptr.To[int32](0),
+				Action:   kubermaticv1.StaleClusterActionDelete,
+			},
+			enforcedCleanup:  true,
+			suspendedProject: true,
+			cluster:          genCluster(30*24*time.Hour, staleSince(8*24*time.Hour, "")),
+			expectStale:      true,
+			expectActivity:   now,
+		},
+		{
+			name:             "cluster in a suspended project is not flagged",
+			policy:           emptyClusterPolicy,
+			suspendedProject: true,
+			cluster:          genCluster(30*24*time.Hour, nil),
+		},
+		{
+			name: "cluster running workloads again is no longer stale",
+			policy: &kubermaticv1.StaleClusterPolicy{
+				Enabled:      true,
+				IdleDuration: &metav1.Duration{Duration: 24 * time.Hour},
+			},
+			cluster: genCluster(30*24*time.Hour, staleSince(time.Hour, "")),
+			userClusterObjs: []ctrlruntimeclient.Object{
+				genPod("default", corev1.PodRunning),
+			},
+			expectEvent: true,
+		},
+		{
+			name: "system pods are not considered workloads",
+			policy: &kubermaticv1.StaleClusterPolicy{
+				Enabled:      true,
+				IdleDuration: &metav1.Duration{Duration: 24 * time.Hour},
+			},
+			cluster: genCluster(30*24*time.Hour, staleSince(time.Hour, "")),
+			userClusterObjs: []ctrlruntimeclient.Object{
+				genPod("kube-system", corev1.PodRunning),
+				genPod("default", corev1.PodSucceeded),
+			},
+			expectStale: true,
+		},
+		{
+			name: "stale cluster is not touched during the grace period",
+			policy: &kubermaticv1.StaleClusterPolicy{
+				Enabled:  true,
+				MaxNodes: ptr.To[int32](0),
+				Action:   kubermaticv1.StaleClusterActionDelete,
+			},
+			cluster:     genCluster(30*24*time.Hour, staleSince(24*time.Hour, "")),
+			expectStale: true,
+		},
+		{
+			name: "stale cluster is hibernated after the grace period",
+			policy: &kubermaticv1.StaleClusterPolicy{
+				Enabled:  true,
+				MaxNodes: ptr.To[int32](0),
+				Action:   kubermaticv1.StaleClusterActionHibernate,
+			},
+			cluster:          genCluster(30*24*time.Hour, staleSince(8*24*time.Hour, "")),
+			expectStale:      true,
+			expectAction:     kubermaticv1.StaleClusterActionHibernate,
+			expectHibernated: true,
+			expectEvent:      true,
+		},
+		{
+			name: "waking up a hibernated cluster restarts the grace period",
+			policy: &kubermaticv1.StaleClusterPolicy{
+				Enabled:  true,
+				MaxNodes: ptr.To[int32](0),
+				Action:   kubermaticv1.StaleClusterActionHibernate,
+			},
+			cluster:     genCluster(30*24*time.Hour, staleSince(8*24*time.Hour, kubermaticv1.StaleClusterActionHibernate)),
+			expectStale: true,
+		},
+		{
+			name: "stale cluster is deleted after the grace period",
+			policy: &kubermaticv1.StaleClusterPolicy{
+				Enabled:  true,
+				MaxNodes: ptr.To[int32](0),
+				Action:   kubermaticv1.StaleClusterActionDelete,
+			},
+			enforcedCleanup: true,
+			cluster:         genCluster(30*24*time.Hour, staleSince(8*24*time.Hour, "")),
+			expectStale:     true,
+			expectAction:    kubermaticv1.StaleClusterActionDelete,
+			expectDeleted:   true,
+			expectEvent:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			project := &kubermaticv1.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: projectID,
+				},
+			}
+
+			if tc.suspendedProject {
+				project.Spec.Suspension = &kubermaticv1.ProjectSuspension{Reason: "budget exhausted"}
+			}
+
+			settings := &kubermaticv1.KubermaticSetting{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: kubermaticv1.GlobalSettingsName,
+				},
+				Spec: kubermaticv1.SettingSpec{
+					StaleClusterPolicy: tc.policy,
+					CleanupOptions: kubermaticv1.CleanupOptions{
+						Enforced: tc.enforcedCleanup,
+					},
+				},
+			}
+
+			masterClient := fake.NewClientBuilder().WithObjects(project).Build()
+			seedClient := fake.NewClientBuilder().WithObjects(tc.cluster).WithStatusSubresource(tc.cluster).Build()
+			userClusterClient := fake.NewClientBuilder().WithObjects(tc.userClusterObjs...).Build()
+			recorder := events.NewFakeRecorder(10)
+
+			r := &Reconciler{
+				Client:   masterClient,
+				log:      zap.NewNop().Sugar(),
+				recorder: recorder,
+				userClusterClientGetter: func(_ context.Context, _ ctrlruntimeclient.Client, _ *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error) {
+					return userClusterClient, nil
+				},
+				now: func() time.Time { return now },
+			}
+
+			cluster := &kubermaticv1.Cluster{}
+			if err := seedClient.Get(ctx, types.NamespacedName{Name: tc.cluster.Name}, cluster); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			if err := r.reconcileCluster(ctx, r.log, settings, "seed", seedClient, cluster); err != nil {
+				t.Fatalf("Reconciling failed: %v", err)
+			}
+
+			if err := seedClient.Get(ctx, types.NamespacedName{Name: tc.cluster.Name}, cluster); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			if stale := cluster.Status.IsStale(); stale != tc.expectStale {
+				t.Errorf("Expected stale=%v, got %v (%+v).", tc.expectStale, stale, cluster.Status.Staleness)
+			}
+
+			if tc.expectStale && len(cluster.Status.Staleness.Reasons) == 0 {
+				t.Error("Expected stale cluster to have reasons, but got none.")
+			}
+
+			var action kubermaticv1.StaleClusterAction
+			if cluster.Status.Staleness != nil {
+				action = cluster.Status.Staleness.ActionTaken
+			}
+			if action != tc.expectAction {
+				t.Errorf("Expected action %q, got %q.", tc.expectAction, action)
+			}
+
+			if hibernated := hibernationRequested(cluster); hibernated != tc.expectHibernated {
+				t.Errorf("Expected hibernated=%v, got %v.", tc.expectHibernated, hibernated)
+			}
+
+			if deleted := cluster.DeletionTimestamp != nil; deleted != tc.expectDeleted {
+				t.Errorf("Expected deleted=%v, got %v.", tc.expectDeleted, deleted)
+			}
+
+			if tc.expectDeleted && !kuberneteshelper.HasFinalizer(cluster, kubermaticv1.InClusterLBCleanupFinalizer, kubermaticv1.InClusterPVCleanupFinalizer) {
+				t.Errorf("Expected cleanup finalizers to be added, got %v.", cluster.Finalizers)
+			}
+
+			if emitted := len(recorder.Events) > 0; emitted != tc.expectEvent {
+				t.Errorf("Expected event=%v, got %v.", tc.expectEvent, emitted)
+			}
+
+			if !tc.expectActivity.IsZero() {
+				if activity := cluster.Status.Staleness.LastActivityTime; activity == nil || !activity.Time.Equal(tc.expectActivity) {
+					t.Errorf("Expected last activity at %v, got %v.", tc.expectActivity, activity)
+				}
+			}
+		})
+	}
+}
+
+func TestOwnerLeftProject(t *testing.T) {
+	expired := &metav1.Time{Time: now.Add(-time.Hour)}
+
+	testCases := []struct {
+		name       string
+		objects    []ctrlruntimeclient.Object
+		expectLeft bool
+	}{
+		{
+			name: "owner is bound to the project",
+			objects: []ctrlruntimeclient.Object{
+				genUserBinding(nil),
+			},
+			expectLeft: false,
+		},
+		{
+			name: "owner binding has expired",
+			objects: []ctrlruntimeclient.Object{
+				genUserBinding(expired),
+			},
+			expectLeft: true,
+		},
+		{
+			name: "owner is bound via a group",
+			objects: []ctrlruntimeclient.Object{
+				genUser("developers"),
+				genGroupBinding("developers"),
+			},
+			expectLeft: false,
+		},
+		{
+			name: "owner is in no bound group",
+			objects: []ctrlruntimeclient.Object{
+				genUser("developers"),
+				genGroupBinding("admins"),
+			},
+			expectLeft: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithObjects(tc.objects...).Build()
+
+			left, err := ownerLeftProject(context.Background(), client, genCluster(30*24*time.Hour, nil), now)
+			if err != nil {
+				t.Fatalf("Failed to check project membership: %v", err)
+			}
+
+			if left != tc.expectLeft {
+				t.Errorf("Expected left=%v, got %v.", tc.expectLeft, left)
+			}
+		})
+	}
+}
+
+func genUserBinding(expiresAt *metav1.Time) *kubermaticv1.UserProjectBinding {
+	return &kubermaticv1.UserProjectBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "binding",
+		},
+		Spec: kubermaticv1.UserProjectBindingSpec{
+			UserEmail: owner,
+			ProjectID: projectID,
+			Group:     "owners-" + projectID,
+			ExpiresAt: expiresAt,
+		},
+	}
+}
+
+func genUser(groups ...string) *kubermaticv1.User {
+	return &kubermaticv1.User{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "user",
+		},
+		Spec: kubermaticv1.UserSpec{
+			Email:  owner,
+			Groups: groups,
+		},
+	}
+}
+
+func genGroupBinding(group string) *kubermaticv1.GroupProjectBinding {
+	return &kubermaticv1.GroupProjectBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "group-binding",
+		},
+		Spec: kubermaticv1.GroupProjectBindingSpec{
+			Group:     group,
+			ProjectID: projectID,
+			Role:      "editors",
+		},
+	}
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package staleclustercontroller contains a controller that detects abandoned clusters, as
+configured in the KubermaticSetting's `spec.staleClusterPolicy`.
+
+It regularly lists the clusters on all seeds and collects the number of nodes and whether
+any workloads are running from each user cluster. The time a cluster was last seen running
+workloads is kept in the cluster's status; a cluster that cannot be inspected, including a
+hibernated cluster, is never considered idle and keeps its current verdict until it is
+reachable again. Paused clusters and the clusters of suspended projects are not judged at all. A cluster is flagged as stale once it is older
+than the configured minimum age and matches all configured criteria, which can also
+include the cluster owner no longer being a member of the cluster's project.
+
+Flagging a cluster, a cluster becoming active again and any action taken are announced via
+events on the cluster's project and an optional webhook. Once a cluster has been stale for
+the grace period, it is hibernated or deleted if the policy says so. Clusters with the
+`kkp.k8c.io/skip-stale-cluster-cleanup=true` label are ignored entirely.
+*/
+package staleclustercontroller
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package staleclustercontroller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	notificationStale      = "Stale"
+	notificationActive     = "Active"
+	notificationHibernated = "Hibernated"
+	notificationDeleted    = "Deleted"
+)
+
+// notification is the payload sent to the configured webhook.
+type notification struct {
+	// Event is one of Stale, Active, Hibernated or Deleted.
+	Event       string     `json:"event"`
+	Timestamp   time.Time  `json:"timestamp"`
+	Seed        string     `json:"seed"`
+	Cluster     string     `json:"cluster"`
+	ClusterName string     `json:"clusterName"`
+	Project     string     `json:"project"`
+	Owner       string     `json:"owner,omitempty"`
+	Reasons     []string   `json:"reasons,omitempty"`
+	StaleSince  *time.Time `json:"staleSince,omitempty"`
+}
+
+func sendNotification(ctx context.Context, client *http.Client, url string, n notification) error {
+	data, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// drain the body to allow connection reuse
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
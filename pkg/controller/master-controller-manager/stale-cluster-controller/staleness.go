@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package staleclustercontroller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultMinAge      = 7 * 24 * time.Hour
+	defaultGracePeriod = 7 * 24 * time.Hour
+
+	// podListPageSize limits the number of pods fetched at once from a user cluster, as
+	// the search for workloads stops at the first one.
+	podListPageSize = 100
+)
+
+// systemNamespaces are ignored when looking for workloads in a user cluster, in addition
+// to all namespaces starting with "kube-".
+var systemNamespaces = sets.New(
+	resources.KubermaticNamespace,
+	resources.GatekeeperNamespace,
+	resources.CloudInitSettingsNamespace,
+	resources.UserClusterMLANamespace,
+	resources.ClusterBackupNamespaceName,
+)
+
+// signals are the observations a cluster is judged by.
+type signals struct {
+	// nodes is nil if the number of nodes in the user cluster is unknown.
+	nodes *int
+	// hasWorkloads is nil if it is unknown whether the user cluster runs workloads.
+	hasWorkloads *bool
+	// ownerLeftProject is true if the owner is no longer a member of the cluster's project.
+	ownerLeftProject bool
+}
+
+func durationOrDefault(d *metav1.Duration, def time.Duration) time.Duration {
+	if d == nil {
+		return def
+	}
+
+	return d.Duration
+}
+
+// evaluate returns the reasons why the cluster is stale, or nothing if the cluster is not
+// stale. ok is false if a configured criterion could not be evaluated because the user
+// cluster was not reachable, in which case the cluster must not be considered stale.
+func evaluate(policy *kubermaticv1.StaleClusterPolicy, cluster *kubermaticv1.Cluster, s signals, lastActivity time.Time, now time.Time) (reasons []string, ok bool) {
+	if now.Sub(cluster.CreationTimestamp.Time) < durationOrDefault(policy.MinAge, defaultMinAge) {
+		return nil, true
+	}
+
+	if policy.MaxNodes != nil {
+		if s.nodes == nil {
+			return nil, false
+		}
+		if *s.nodes > int(*policy.MaxNodes) {
+			return nil, true
+		}
+		reasons = append(reasons, fmt.Sprintf("cluster has %d node(s)", *s.nodes))
+	}
+
+	if policy.IdleDuration != nil {
+		if s.hasWorkloads == nil {
+			return nil, false
+		}
+		if now.Sub(lastActivity) < policy.IdleDuration.Duration {
+			return nil, true
+		}
+		reasons = append(reasons, fmt.Sprintf("no workloads since %s", lastActivity.UTC().Format(time.RFC3339)))
+	}
+
+	if policy.OwnerLeftProject {
+		if !s.ownerLeftProject {
+			return nil, true
+		}
+		reasons = append(reasons, fmt.Sprintf("owner %s is no longer a member of the project", cluster.Status.UserEmail))
+	}
+
+	return reasons, true
+}
+
+// collectUserClusterSignals counts the nodes and looks for workloads in the user cluster. If
+// the user cluster cannot be reached, both stay unknown.
+func (r *Reconciler) collectUserClusterSignals(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (signals, error) {
+	// A hibernated cluster has neither nodes nor running workloads, but only because it was
+	// hibernated on purpose, which says nothing about whether it is still in use.
+	if hibernationRequested(cluster) || (cluster.Status.Hibernation != nil && cluster.Status.Hibernation.State != "") {
+		return signals{}, nil
+	}
+
+	if !cluster.Status.ExtendedHealth.ControlPlaneHealthy() {
+		return signals{}, nil
+	}
+
+	client, err := r.userClusterClientGetter(ctx, seedClient, cluster)
+	if err != nil {
+		return signals{}, fmt.Errorf("failed to create user cluster client: %w", err)
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := client.List(ctx, nodes); err != nil {
+		return signals{}, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	workloads, err := findWorkloads(ctx, client)
+	if err != nil {
+		return signals{}, err
+	}
+
+	nodeCount := len(nodes.Items)
+
+	return signals{
+		nodes:        &nodeCount,
+		hasWorkloads: &workloads,
+	}, nil
+}
+
+// findWorkloads pages through the pods of the user cluster until it finds a workload.
+func findWorkloads(ctx context.Context, client ctrlruntimeclient.Client) (bool, error) {
+	opts := &ctrlruntimeclient.ListOptions{Limit: podListPageSize}
+
+	for {
+		pods := &corev1.PodList{}
+		if err := client.List(ctx, pods, opts); err != nil {
+			return false, fmt.Errorf("failed to list pods: %w", err)
+		}
+
+		if hasWorkloads(pods.Items) {
+			return true, nil
+		}
+
+		if pods.Continue == "" {
+			return false, nil
+		}
+
+		opts.Continue = pods.Continue
+	}
+}
+
+// hasWorkloads returns true if any pod outside the system namespaces is not finished.
+func hasWorkloads(pods []corev1.Pod) bool {
+	for _, pod := range pods {
+		if strings.HasPrefix(pod.Namespace, "kube-") || systemNamespaces.Has(pod.Namespace) {
+			continue
+		}
+
+		if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ownerLeftProject returns true if the cluster owner is neither bound to the cluster's
+// project directly nor via one of their groups. Expired bindings are not considered.
+func ownerLeftProject(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, now time.Time) (bool, error) {
+	owner := cluster.Status.UserEmail
+	projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+
+	if owner == "" || projectID == "" {
+		return false, nil
+	}
+
+	userBindings := &kubermaticv1.UserProjectBindingList{}
+	if err := client.List(ctx, userBindings); err != nil {
+		return false, fmt.Errorf("failed to list UserProjectBindings: %w", err)
+	}
+
+	for _, binding := range userBindings.Items {
+		if binding.Spec.ProjectID == projectID && strings.EqualFold(binding.Spec.UserEmail, owner) && !binding.Spec.IsExpired(now) {
+			return false, nil
+		}
+	}
+
+	users := &kubermaticv1.UserList{}
+	if err := client.List(ctx, users); err != nil {
+		return false, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	groups := sets.New[string]()
+	for _, user := range users.Items {
+		if strings.EqualFold(user.Spec.Email, owner) {
+			groups.Insert(user.Spec.Groups...)
+		}
+	}
+
+	if groups.Len() == 0 {
+		return true, nil
+	}
+
+	groupBindings := &kubermaticv1.GroupProjectBindingList{}
+	if err := client.List(ctx, groupBindings); err != nil {
+		return false, fmt.Errorf("failed to list GroupProjectBindings: %w", err)
+	}
+
+	for _, binding := range groupBindings.Items {
+		if binding.Spec.ProjectID == projectID && groups.Has(binding.Spec.Group) && !binding.Spec.IsExpired(now) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
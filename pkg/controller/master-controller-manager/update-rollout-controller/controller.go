@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterolloutcontroller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/util/predicate"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/version"
+	updaterollout "k8c.io/kubermatic/v2/pkg/version/rollout"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// ControllerName is the name of this controller.
+	ControllerName = "kkp-update-rollout-controller"
+)
+
+// Reconciler computes the rollout of automatic updates across all seeds.
+type Reconciler struct {
+	ctrlruntimeclient.Client
+
+	log          *zap.SugaredLogger
+	recorder     events.EventRecorder
+	configGetter provider.KubermaticConfigurationGetter
+	seedClients  kuberneteshelper.SeedClientMap
+	now          func() time.Time
+}
+
+// Add creates a new update rollout controller and sets up watches.
+func Add(
+	mgr manager.Manager,
+	seedManagers map[string]manager.Manager,
+	log *zap.SugaredLogger,
+	namespace string,
+	configGetter provider.KubermaticConfigurationGetter,
+) error {
+	reconciler := &Reconciler{
+		Client:       mgr.GetClient(),
+		log:          log.Named(ControllerName),
+		recorder:     mgr.GetEventRecorder(ControllerName),
+		configGetter: configGetter,
+		seedClients:  kuberneteshelper.SeedClientMap{},
+		now:          time.Now,
+	}
+
+	for seedName, seedManager := range seedManagers {
+		reconciler.seedClients[seedName] = seedManager.GetClient()
+	}
+
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			// there is only a single rollout to compute
+			MaxConcurrentReconciles: 1,
+		}).
+		For(&kubermaticv1.KubermaticConfiguration{}, builder.WithPredicates(predicate.ByNamespace(namespace))).
+		Build(reconciler)
+
+	return err
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("config", request.Name)
+	log.Debug("Reconciling")
+
+	config, err := r.configGetter(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to get KubermaticConfiguration: %w", err)
+	}
+
+	cfg := config.Spec.Versions.UpdateRollout
+	if cfg == nil {
+		return reconcile.Result{}, r.cleanup(ctx)
+	}
+
+	if err := r.reconcile(ctx, log, config, cfg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Clusters are not watched across all seeds, so the rollout is recomputed regularly.
+	return reconcile.Result{RequeueAfter: 1 * time.Minute}, nil
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, config *kubermaticv1.KubermaticConfiguration, cfg *kubermaticv1.UpdateRolloutConfiguration) error {
+	rollout, err := ensureRollout(ctx, r.Client)
+	if err != nil {
+		return err
+	}
+
+	clusters, err := r.listClusters(ctx)
+	if err != nil {
+		return err
+	}
+
+	status := computeStatus(log, cfg, version.NewFromConfiguration(config), clusters, rollout.Status, r.now())
+	r.emitEvents(rollout, status)
+
+	if err := updateStatus(ctx, r.Client, rollout, status); err != nil {
+		return fmt.Errorf("failed to update UpdateRollout status: %w", err)
+	}
+
+	for seedName, client := range r.seedClients {
+		seedRollout, err := ensureRollout(ctx, client)
+		if err != nil {
+			return fmt.Errorf("failed to ensure UpdateRollout on seed %s: %w", seedName, err)
+		}
+
+		if err := updateStatus(ctx, client, seedRollout, status); err != nil {
+			return fmt.Errorf("failed to update UpdateRollout status on seed %s: %w", seedName, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) listClusters(ctx context.Context) ([]seedCluster, error) {
+	var clusters []seedCluster
+
+	for seedName, client := range r.seedClients {
+		clusterList := &kubermaticv1.ClusterList{}
+		if err := client.List(ctx, clusterList); err != nil {
+			return nil, fmt.Errorf("failed to list clusters on seed %s: %w", seedName, err)
+		}
+
+		for i := range clusterList.Items {
+			clusters = append(clusters, seedCluster{
+				seed:    seedName,
+				cluster: &clusterList.Items[i],
+			})
+		}
+	}
+
+	return clusters, nil
+}
+
+func (r *Reconciler) emitEvents(rollout *kubermaticv1.UpdateRollout, status kubermaticv1.UpdateRolloutStatus) {
+	oldPhase := rollout.Status.Phase
+
+	switch {
+	case status.Phase == kubermaticv1.UpdateRolloutPhaseHalted && oldPhase != kubermaticv1.UpdateRolloutPhaseHalted:
+		r.recorder.Eventf(rollout, nil, corev1.EventTypeWarning, "RolloutHalted", "Reconciling", "Automatic updates are halted: %s", status.Message)
+
+	case status.Phase != kubermaticv1.UpdateRolloutPhaseHalted && oldPhase == kubermaticv1.UpdateRolloutPhaseHalted:
+		r.recorder.Eventf(rollout, nil, corev1.EventTypeNormal, "RolloutResumed", "Reconciling", "Automatic updates are resumed.")
+
+	case status.Phase == kubermaticv1.UpdateRolloutPhaseProgressing && (oldPhase != status.Phase || rollout.Status.ActiveWave != status.ActiveWave):
+		r.recorder.Eventf(rollout, nil, corev1.EventTypeNormal, "WaveStarted", "Reconciling", "Rolling out automatic updates to wave %s.", updaterollout.WaveName(status.ActiveWave))
+	}
+}
+
+func (r *Reconciler) cleanup(ctx context.Context) error {
+	for seedName, client := range r.seedClients {
+		if err := deleteRollout(ctx, client); err != nil {
+			return fmt.Errorf("failed to delete UpdateRollout on seed %s: %w", seedName, err)
+		}
+	}
+
+	if err := deleteRollout(ctx, r.Client); err != nil {
+		return fmt.Errorf("failed to delete UpdateRollout: %w", err)
+	}
+
+	return nil
+}
+
+func ensureRollout(ctx context.Context, client ctrlruntimeclient.Client) (*kubermaticv1.UpdateRollout, error) {
+	rollout := &kubermaticv1.UpdateRollout{}
+	err := client.Get(ctx, types.NamespacedName{Name: kubermaticv1.UpdateRolloutName}, rollout)
+	if err == nil {
+		return rollout, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get UpdateRollout: %w", err)
+	}
+
+	rollout = &kubermaticv1.UpdateRollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: kubermaticv1.UpdateRolloutName,
+		},
+	}
+
+	if err := client.Create(ctx, rollout); err != nil {
+		return nil, fmt.Errorf("failed to create UpdateRollout: %w", err)
+	}
+
+	return rollout, nil
+}
+
+func updateStatus(ctx context.Context, client ctrlruntimeclient.Client, rollout *kubermaticv1.UpdateRollout, status kubermaticv1.UpdateRolloutStatus) error {
+	if equality.Semantic.DeepEqual(rollout.Status, status) {
+		return nil
+	}
+
+	oldRollout := rollout.DeepCopy()
+	rollout.Status = status
+
+	return client.Status().Patch(ctx, rollout, ctrlruntimeclient.MergeFrom(oldRollout))
+}
+
+func deleteRollout(ctx context.Context, client ctrlruntimeclient.Client) error {
+	rollout := &kubermaticv1.UpdateRollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: kubermaticv1.UpdateRolloutName,
+		},
+	}
+
+	return ctrlruntimeclient.IgnoreNotFound(client.Delete(ctx, rollout))
+}
@@ -0,0 +1,372 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterolloutcontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/kubermatic/v2/pkg/version"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var (
+	healthy = kubermaticv1.ExtendedClusterHealth{
+		Apiserver:                    kubermaticv1.HealthStatusUp,
+		Scheduler:                    kubermaticv1.HealthStatusUp,
+		Controller:                   kubermaticv1.HealthStatusUp,
+		Etcd:                         kubermaticv1.HealthStatusUp,
+		CloudProviderInfrastructure:  kubermaticv1.HealthStatusUp,
+		UserClusterControllerManager: kubermaticv1.HealthStatusUp,
+	}
+
+	unhealthy = kubermaticv1.ExtendedClusterHealth{
+		Apiserver: kubermaticv1.HealthStatusDown,
+	}
+)
+
+func genCluster(name, wave, specVersion, statusVersion string, health kubermaticv1.ExtendedClusterHealth) *kubermaticv1.Cluster {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{},
+		},
+		Spec: kubermaticv1.ClusterSpec{
+			Version: *semver.NewSemverOrDie(specVersion),
+		},
+		Status: kubermaticv1.ClusterStatus{
+			Versions: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane: *semver.NewSemverOrDie(statusVersion),
+			},
+			ExtendedHealth: health,
+		},
+	}
+
+	if wave != "" {
+		cluster.Labels[kubermaticv1.DefaultUpdateWaveLabel] = wave
+	}
+
+	return cluster
+}
+
+func genConfig(rollout *kubermaticv1.UpdateRolloutConfiguration) *kubermaticv1.KubermaticConfiguration {
+	return &kubermaticv1.KubermaticConfiguration{
+		Spec: kubermaticv1.KubermaticConfigurationSpec{
+			Versions: kubermaticv1.KubermaticVersioningConfiguration{
+				Versions: []semver.Semver{
+					*semver.NewSemverOrDie("1.31.4"),
+					*semver.NewSemverOrDie("1.31.5"),
+				},
+				Updates: []kubermaticv1.Update{
+					{
+						From:                "1.31.4",
+						To:                  "1.31.5",
+						Automatic:           ptr.To(true),
+						AutomaticNodeUpdate: ptr.To(true),
+					},
+				},
+				UpdateRollout: rollout,
+			},
+		},
+	}
+}
+
+func TestComputeStatus(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	cfg := &kubermaticv1.UpdateRolloutConfiguration{}
+	updateManager := version.NewFromConfiguration(genConfig(cfg))
+
+	paused := genCluster("paused", "1", "1.31.4", "1.31.4", unhealthy)
+	paused.Spec.Pause = true
+
+	hibernated := genCluster("hibernated", "1", "1.31.4", "1.31.4", unhealthy)
+	hibernated.Spec.Hibernation = &kubermaticv1.ClusterHibernationSettings{Hibernated: true}
+	hibernated.Status.Hibernation = &kubermaticv1.ClusterHibernationStatus{State: kubermaticv1.ClusterHibernationStateHibernated}
+
+	wakingUp := genCluster("waking-up", "1", "1.31.4", "1.31.4", unhealthy)
+	wakingUp.Status.Hibernation = &kubermaticv1.ClusterHibernationStatus{State: kubermaticv1.ClusterHibernationStateWakingUp}
+
+	outdatedNodes := genCluster("outdated-nodes", "", "1.31.5", "1.31.5", healthy)
+	outdatedNodes.Status.Versions.OldestNodeVersion = semver.NewSemverOrDie("1.31.4")
+
+	updatedNodes := genCluster("updated-nodes", "", "1.31.5", "1.31.5", healthy)
+	updatedNodes.Status.Versions.OldestNodeVersion = semver.NewSemverOrDie("1.31.5")
+
+	testCases := []struct {
+		name                string
+		clusters            []*kubermaticv1.Cluster
+		oldStatus           kubermaticv1.UpdateRolloutStatus
+		expectedPhase       kubermaticv1.UpdateRolloutPhase
+		expectedActiveWave  string
+		expectedUnhealthy   []string
+		expectedPendingWave map[string]int
+	}{
+		{
+			name: "all clusters up-to-date",
+			clusters: []*kubermaticv1.Cluster{
+				genCluster("a", "1", "1.31.5", "1.31.5", healthy),
+				genCluster("b", "2", "1.31.5", "1.31.5", unhealthy),
+				genCluster("c", "", "1.31.5", "1.31.5", healthy),
+			},
+			expectedPhase:       kubermaticv1.UpdateRolloutPhaseIdle,
+			expectedActiveWave:  "1",
+			expectedPendingWave: map[string]int{"1": 0, "2": 0, "": 0},
+		},
+		{
+			name: "first wave is being updated",
+			clusters: []*kubermaticv1.Cluster{
+				genCluster("a", "1", "1.31.4", "1.31.4", healthy),
+				genCluster("b", "2", "1.31.4", "1.31.4", healthy),
+				genCluster("c", "", "1.31.4", "1.31.4", healthy),
+			},
+			expectedPhase:       kubermaticv1.UpdateRolloutPhaseProgressing,
+			expectedActiveWave:  "1",
+			expectedPendingWave: map[string]int{"1": 1, "2": 1, "": 1},
+		},
+		{
+			name: "update of first wave is not finished yet",
+			clusters: []*kubermaticv1.Cluster{
+				genCluster("a", "1", "1.31.5", "1.31.4", unhealthy),
+				genCluster("b", "2", "1.31.4", "1.31.4", healthy),
+			},
+			expectedPhase:       kubermaticv1.UpdateRolloutPhaseProgressing,
+			expectedActiveWave:  "1",
+			expectedUnhealthy:   []string{"a"},
+			expectedPendingWave: map[string]int{"1": 1, "2": 1},
+		},
+		{
+			name: "updated but unhealthy cluster blocks the next wave",
+			clusters: []*kubermaticv1.Cluster{
+				genCluster("a", "1", "1.31.5", "1.31.5", unhealthy),
+				genCluster("b", "2", "1.31.4", "1.31.4", healthy),
+			},
+			expectedPhase:       kubermaticv1.UpdateRolloutPhaseProgressing,
+			expectedActiveWave:  "1",
+			expectedUnhealthy:   []string{"a"},
+			expectedPendingWave: map[string]int{"1": 0, "2": 1},
+		},
+		{
+			name: "second wave starts once the first wave is done",
+			clusters: []*kubermaticv1.Cluster{
+				genCluster("a", "1", "1.31.5", "1.31.5", healthy),
+				genCluster("b", "2", "1.31.4", "1.31.4", healthy),
+				genCluster("c", "", "1.31.4", "1.31.4", unhealthy),
+				paused,
+			},
+			expectedPhase:       kubermaticv1.UpdateRolloutPhaseProgressing,
+			expectedActiveWave:  "2",
+			expectedPendingWave: map[string]int{"1": 0, "2": 1, "": 1},
+		},
+		{
+			name: "pending node update of the final wave keeps the rollout going",
+			clusters: []*kubermaticv1.Cluster{
+				genCluster("a", "1", "1.31.5", "1.31.5", healthy),
+				genCluster("b", "2", "1.31.5", "1.31.5", healthy),
+				outdatedNodes,
+			},
+			expectedPhase:       kubermaticv1.UpdateRolloutPhaseProgressing,
+			expectedActiveWave:  "",
+			expectedPendingWave: map[string]int{"1": 0, "2": 0, "": 1},
+		},
+		{
+			name: "rollout is idle once all nodes are updated",
+			clusters: []*kubermaticv1.Cluster{
+				genCluster("a", "1", "1.31.5", "1.31.5", healthy),
+				updatedNodes,
+			},
+			expectedPhase:       kubermaticv1.UpdateRolloutPhaseIdle,
+			expectedActiveWave:  "1",
+			expectedPendingWave: map[string]int{"1": 0, "": 0},
+		},
+		{
+			name: "cluster unhealthy for too long halts the rollout",
+			clusters: []*kubermaticv1.Cluster{
+				genCluster("a", "1", "1.31.5", "1.31.5", unhealthy),
+				genCluster("b", "2", "1.31.4", "1.31.4", healthy),
+			},
+			oldStatus: kubermaticv1.UpdateRolloutStatus{
+				UnhealthyClusters: []kubermaticv1.UpdateRolloutCluster{
+					{Seed: "europe", Name: "a", Wave: "1", UnhealthySince: metav1.NewTime(now.Add(-time.Hour))},
+				},
+			},
+			expectedPhase:       kubermaticv1.UpdateRolloutPhaseHalted,
+			expectedActiveWave:  "1",
+			expectedUnhealthy:   []string{"a"},
+			expectedPendingWave: map[string]int{"1": 0, "2": 1},
+		},
+		{
+			name: "hibernated clusters do not halt the rollout",
+			clusters: []*kubermaticv1.Cluster{
+				genCluster("a", "1", "1.31.5", "1.31.5", healthy),
+				genCluster("b", "2", "1.31.4", "1.31.4", healthy),
+				hibernated,
+				wakingUp,
+			},
+			oldStatus: kubermaticv1.UpdateRolloutStatus{
+				UnhealthyClusters: []kubermaticv1.UpdateRolloutCluster{
+					{Seed: "europe", Name: "hibernated", Wave: "1", UnhealthySince: metav1.NewTime(now.Add(-time.Hour))},
+				},
+			},
+			expectedPhase:       kubermaticv1.UpdateRolloutPhaseProgressing,
+			expectedActiveWave:  "2",
+			expectedPendingWave: map[string]int{"1": 0, "2": 1},
+		},
+		{
+			name: "rollout continues once the cluster is healthy again",
+			clusters: []*kubermaticv1.Cluster{
+				genCluster("a", "1", "1.31.5", "1.31.5", healthy),
+				genCluster("b", "2", "1.31.4", "1.31.4", healthy),
+			},
+			oldStatus: kubermaticv1.UpdateRolloutStatus{
+				Phase: kubermaticv1.UpdateRolloutPhaseHalted,
+				UnhealthyClusters: []kubermaticv1.UpdateRolloutCluster{
+					{Seed: "europe", Name: "a", Wave: "1", UnhealthySince: metav1.NewTime(now.Add(-time.Hour))},
+				},
+			},
+			expectedPhase:       kubermaticv1.UpdateRolloutPhaseProgressing,
+			expectedActiveWave:  "2",
+			expectedPendingWave: map[string]int{"1": 0, "2": 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var clusters []seedCluster
+			for _, c := range tc.clusters {
+				clusters = append(clusters, seedCluster{seed: "europe", cluster: c})
+			}
+
+			status := computeStatus(zap.NewNop().Sugar(), cfg, updateManager, clusters, tc.oldStatus, now)
+
+			if status.Phase != tc.expectedPhase {
+				t.Errorf("Expected phase %q, got %q (%s).", tc.expectedPhase, status.Phase, status.Message)
+			}
+
+			if status.ActiveWave != tc.expectedActiveWave {
+				t.Errorf("Expected active wave %q, got %q.", tc.expectedActiveWave, status.ActiveWave)
+			}
+
+			if len(status.UnhealthyClusters) != len(tc.expectedUnhealthy) {
+				t.Fatalf("Expected unhealthy clusters %v, got %+v.", tc.expectedUnhealthy, status.UnhealthyClusters)
+			}
+			for i, name := range tc.expectedUnhealthy {
+				if status.UnhealthyClusters[i].Name != name {
+					t.Fatalf("Expected unhealthy clusters %v, got %+v.", tc.expectedUnhealthy, status.UnhealthyClusters)
+				}
+			}
+
+			if len(status.Waves) != len(tc.expectedPendingWave) {
+				t.Fatalf("Expected %d waves, got %+v.", len(tc.expectedPendingWave), status.Waves)
+			}
+			for _, wave := range status.Waves {
+				if expected := tc.expectedPendingWave[wave.Name]; wave.PendingClusters != expected {
+					t.Errorf("Expected %d pending clusters in wave %q, got %d.", expected, wave.Name, wave.PendingClusters)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	testCases := []struct {
+		name            string
+		rollout         *kubermaticv1.UpdateRolloutConfiguration
+		expectedRollout bool
+	}{
+		{
+			name:            "rollout status is published to master and seed",
+			rollout:         &kubermaticv1.UpdateRolloutConfiguration{},
+			expectedRollout: true,
+		},
+		{
+			name:            "rollout status is removed when rollouts are disabled",
+			rollout:         nil,
+			expectedRollout: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			existing := &kubermaticv1.UpdateRollout{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: kubermaticv1.UpdateRolloutName,
+				},
+			}
+
+			masterClient := fake.NewClientBuilder().WithObjects(existing.DeepCopy()).WithStatusSubresource(existing).Build()
+			seedClient := fake.NewClientBuilder().
+				WithObjects(
+					existing.DeepCopy(),
+					genCluster("a", "1", "1.31.5", "1.31.5", healthy),
+					genCluster("b", "2", "1.31.4", "1.31.4", healthy),
+				).
+				WithStatusSubresource(existing).
+				Build()
+
+			r := &Reconciler{
+				Client:   masterClient,
+				log:      zap.NewNop().Sugar(),
+				recorder: events.NewFakeRecorder(10),
+				configGetter: func(_ context.Context) (*kubermaticv1.KubermaticConfiguration, error) {
+					return genConfig(tc.rollout), nil
+				},
+				seedClients: kuberneteshelper.SeedClientMap{"europe": seedClient},
+				now:         time.Now,
+			}
+
+			if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "kubermatic"}}); err != nil {
+				t.Fatalf("Reconciling failed: %v", err)
+			}
+
+			for name, client := range map[string]ctrlruntimeclient.Client{"master": masterClient, "seed": seedClient} {
+				rollout := &kubermaticv1.UpdateRollout{}
+				err := client.Get(ctx, types.NamespacedName{Name: kubermaticv1.UpdateRolloutName}, rollout)
+
+				if !tc.expectedRollout {
+					if !apierrors.IsNotFound(err) {
+						t.Errorf("Expected UpdateRollout on %s to be deleted, but got err=%v.", name, err)
+					}
+					continue
+				}
+
+				if err != nil {
+					t.Fatalf("Failed to get UpdateRollout on %s: %v", name, err)
+				}
+
+				if rollout.Status.Phase != kubermaticv1.UpdateRolloutPhaseProgressing || rollout.Status.ActiveWave != "2" {
+					t.Errorf("Expected %s to report wave 2 in progress, got %+v.", name, rollout.Status)
+				}
+			}
+		})
+	}
+}
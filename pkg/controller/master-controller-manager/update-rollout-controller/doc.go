@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package updaterolloutcontroller contains a controller that computes the rollout of
+automatic updates in waves, as configured in the KubermaticConfiguration's
+`spec.versions.updateRollout`.
+
+It lists the clusters on all seeds, assigns them to waves and determines the active
+wave, which is the first wave that still has clusters that are not updated or not
+healthy. The result is written into the status of the UpdateRollout object on the
+master cluster and copied to every seed, where the auto-update-controller only
+applies automatic updates to clusters of the active and previous waves.
+
+If a cluster of a started wave stays unhealthy for longer than the configured
+health timeout, the rollout is halted for all clusters. It continues automatically
+once the cluster is healthy again.
+
+Pending automatic node updates are determined from the oldest node version that
+is reported in the cluster status, as the controller has no access to the user
+clusters. A wave is considered done once all control planes and nodes are updated
+and all control planes are healthy.
+*/
+package updaterolloutcontroller
@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterolloutcontroller
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/version"
+	updaterollout "k8c.io/kubermatic/v2/pkg/version/rollout"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// seedCluster is a cluster together with the name of the seed it is running on.
+type seedCluster struct {
+	seed    string
+	cluster *kubermaticv1.Cluster
+}
+
+// hibernated returns true if the cluster is hibernated or on its way into or out of
+// hibernation, during which its control plane is expected to be unhealthy.
+func hibernated(cluster *kubermaticv1.Cluster) bool {
+	if cluster.Spec.Hibernation != nil && cluster.Spec.Hibernation.Hibernated {
+		return true
+	}
+
+	return cluster.Status.Hibernation != nil && cluster.Status.Hibernation.State != ""
+}
+
+// computeStatus assigns all clusters to waves and determines the active wave. Unhealthy
+// clusters of started waves are tracked and halt the rollout once they exceed the health timeout.
+func computeStatus(
+	log *zap.SugaredLogger,
+	cfg *kubermaticv1.UpdateRolloutConfiguration,
+	updateManager *version.Manager,
+	clusters []seedCluster,
+	oldStatus kubermaticv1.UpdateRolloutStatus,
+	now time.Time,
+) kubermaticv1.UpdateRolloutStatus {
+	waves := map[string]*kubermaticv1.UpdateRolloutWave{}
+	var unhealthy []kubermaticv1.UpdateRolloutCluster
+
+	for _, sc := range clusters {
+		cluster := sc.cluster
+
+		// paused, hibernated and deleted clusters do not receive updates and must not block the rollout
+		if cluster.DeletionTimestamp != nil || cluster.Spec.Pause || hibernated(cluster) {
+			continue
+		}
+
+		name := updaterollout.Wave(cfg, cluster, sc.seed)
+
+		wave, ok := waves[name]
+		if !ok {
+			wave = &kubermaticv1.UpdateRolloutWave{Name: name}
+			waves[name] = wave
+		}
+
+		wave.Clusters++
+
+		if updatePending(log, updateManager, cluster) {
+			wave.PendingClusters++
+		}
+
+		if !cluster.Status.ExtendedHealth.AllHealthy() {
+			wave.UnhealthyClusters++
+			unhealthy = append(unhealthy, kubermaticv1.UpdateRolloutCluster{
+				Seed: sc.seed,
+				Name: cluster.Name,
+				Wave: name,
+			})
+		}
+	}
+
+	names := make([]string, 0, len(waves))
+	pending := false
+	for name, wave := range waves {
+		names = append(names, name)
+		pending = pending || wave.PendingClusters > 0
+	}
+	updaterollout.SortWaves(cfg, names)
+
+	status := kubermaticv1.UpdateRolloutStatus{
+		Phase:          kubermaticv1.UpdateRolloutPhaseIdle,
+		LastUpdateTime: oldStatus.LastUpdateTime,
+	}
+
+	for _, name := range names {
+		status.Waves = append(status.Waves, *waves[name])
+	}
+
+	if len(status.Waves) > 0 {
+		// Without any pending updates, only the first wave may start the next rollout.
+		status.ActiveWave = status.Waves[0].Name
+	}
+
+	if pending {
+		status.Phase = kubermaticv1.UpdateRolloutPhaseProgressing
+
+		// the active wave is the first wave that is not completely updated and healthy
+		for _, wave := range status.Waves {
+			if wave.PendingClusters > 0 || wave.UnhealthyClusters > 0 {
+				status.ActiveWave = wave.Name
+				break
+			}
+		}
+
+		status.UnhealthyClusters = unhealthyInStartedWaves(cfg, unhealthy, status.ActiveWave, oldStatus.UnhealthyClusters, now)
+
+		var timedOut []string
+		for _, c := range status.UnhealthyClusters {
+			if now.Sub(c.UnhealthySince.Time) > updaterollout.HealthTimeout(cfg) {
+				timedOut = append(timedOut, fmt.Sprintf("%s/%s", c.Seed, c.Name))
+			}
+		}
+
+		if len(timedOut) > 0 {
+			status.Phase = kubermaticv1.UpdateRolloutPhaseHalted
+			status.Message = fmt.Sprintf("clusters have been unhealthy for more than %v: %s", updaterollout.HealthTimeout(cfg), strings.Join(timedOut, ", "))
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(oldStatus, status) {
+		status.LastUpdateTime = metav1.NewTime(now)
+	}
+
+	return status
+}
+
+// unhealthyInStartedWaves returns the unhealthy clusters of the active and all previous
+// waves, keeping the time they were first seen unhealthy from the previous status.
+func unhealthyInStartedWaves(
+	cfg *kubermaticv1.UpdateRolloutConfiguration,
+	unhealthy []kubermaticv1.UpdateRolloutCluster,
+	activeWave string,
+	previous []kubermaticv1.UpdateRolloutCluster,
+	now time.Time,
+) []kubermaticv1.UpdateRolloutCluster {
+	var result []kubermaticv1.UpdateRolloutCluster
+
+	for _, c := range unhealthy {
+		if updaterollout.CompareWaves(cfg, c.Wave, activeWave) > 0 {
+			continue
+		}
+
+		c.UnhealthySince = metav1.NewTime(now)
+		for _, p := range previous {
+			if p.Seed == c.Seed && p.Name == c.Name {
+				c.UnhealthySince = p.UnhealthySince
+				break
+			}
+		}
+
+		result = append(result, c)
+	}
+
+	slices.SortFunc(result, func(a, b kubermaticv1.UpdateRolloutCluster) int {
+		if a.Seed != b.Seed {
+			return strings.Compare(a.Seed, b.Seed)
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return result
+}
+
+// updatePending returns whether the control plane or the nodes of the cluster still have to be
+// updated, either because an automatic update applies or because a started update is not finished yet.
+func updatePending(log *zap.SugaredLogger, updateManager *version.Manager, cluster *kubermaticv1.Cluster) bool {
+	if !cluster.Spec.Version.Equal(&cluster.Status.Versions.ControlPlane) {
+		return true
+	}
+
+	update, err := updateManager.AutomaticControlplaneUpdate(cluster.Spec.Version.String())
+	if err != nil {
+		log.Warnw("Failed to determine automatic update", "cluster", cluster.Name, zap.Error(err))
+		return false
+	}
+
+	if update != nil && update.Version.String() != cluster.Spec.Version.String() {
+		return true
+	}
+
+	return nodeUpdatePending(log, updateManager, cluster)
+}
+
+// nodeUpdatePending returns whether an automatic node update applies to the oldest node of
+// the cluster. Clusters without nodes have no pending node updates.
+func nodeUpdatePending(log *zap.SugaredLogger, updateManager *version.Manager, cluster *kubermaticv1.Cluster) bool {
+	oldestNode := cluster.Status.Versions.OldestNodeVersion
+	if oldestNode == nil {
+		return false
+	}
+
+	update, err := updateManager.AutomaticNodeUpdate(oldestNode.String(), cluster.Status.Versions.ControlPlane.String())
+	if err != nil {
+		log.Warnw("Failed to determine automatic node update", "cluster", cluster.Name, zap.Error(err))
+		return false
+	}
+
+	return update != nil && update.Version.String() != oldestNode.String()
+}
@@ -19,6 +19,7 @@ package userprojectbindingsynchronizer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -110,6 +111,16 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
 	}
 
+	// expired bindings are kept on the master for auditing, but must not grant access on the seeds anymore
+	now := time.Now()
+	if userProjectBinding.Spec.IsExpired(now) {
+		if err := r.deleteExpiredFromSeeds(ctx, log, userProjectBinding); err != nil {
+			r.recorder.Eventf(userProjectBinding, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+			return reconcile.Result{}, fmt.Errorf("failed to remove expired userprojectbinding %s from seeds: %w", userProjectBinding.Name, err)
+		}
+		return reconcile.Result{}, nil
+	}
+
 	userProjectBindingReconcilerFactories := []reconciling.NamedUserProjectBindingReconcilerFactory{
 		userProjectBindingReconcilerFactory(userProjectBinding),
 	}
@@ -133,20 +144,45 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("reconciled userprojectbinding %s: %w", userProjectBinding.Name, err)
 	}
 
+	if userProjectBinding.Spec.ExpiresAt != nil {
+		return reconcile.Result{RequeueAfter: userProjectBinding.Spec.ExpiresAt.Sub(now)}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
 func (r *reconciler) handleDeletion(ctx context.Context, log *zap.SugaredLogger, userProjectBinding *kubermaticv1.UserProjectBinding) error {
-	err := r.seedClients.Each(ctx, log, func(_ string, seedClient ctrlruntimeclient.Client, log *zap.SugaredLogger) error {
-		return ctrlruntimeclient.IgnoreNotFound(seedClient.Delete(ctx, userProjectBinding))
-	})
-	if err != nil {
+	if err := r.deleteFromSeeds(ctx, log, userProjectBinding); err != nil {
 		return err
 	}
 
 	return kuberneteshelper.TryRemoveFinalizer(ctx, r.masterClient, userProjectBinding, cleanupFinalizer)
 }
 
+// deleteExpiredFromSeeds removes the copies of an expired binding from all seeds, except
+// for a seed that is also the master cluster.
+func (r *reconciler) deleteExpiredFromSeeds(ctx context.Context, log *zap.SugaredLogger, userProjectBinding *kubermaticv1.UserProjectBinding) error {
+	return r.seedClients.Each(ctx, log, func(_ string, seedClient ctrlruntimeclient.Client, log *zap.SugaredLogger) error {
+		seedBinding := &kubermaticv1.UserProjectBinding{}
+		if err := seedClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(userProjectBinding), seedBinding); err != nil {
+			return ctrlruntimeclient.IgnoreNotFound(err)
+		}
+
+		// see project-synchronizer's syncAllSeeds comment
+		if seedBinding.UID == userProjectBinding.UID {
+			return nil
+		}
+
+		return ctrlruntimeclient.IgnoreNotFound(seedClient.Delete(ctx, seedBinding))
+	})
+}
+
+func (r *reconciler) deleteFromSeeds(ctx context.Context, log *zap.SugaredLogger, userProjectBinding *kubermaticv1.UserProjectBinding) error {
+	return r.seedClients.Each(ctx, log, func(_ string, seedClient ctrlruntimeclient.Client, log *zap.SugaredLogger) error {
+		return ctrlruntimeclient.IgnoreNotFound(seedClient.Delete(ctx, userProjectBinding))
+	})
+}
+
 func enqueueUserProjectBindingsForSeed(client ctrlruntimeclient.Client, log *zap.SugaredLogger) handler.EventHandler {
 	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a ctrlruntimeclient.Object) []reconcile.Request {
 		var requests []reconcile.Request
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -54,9 +55,13 @@ type reconcileSyncProjectBinding struct {
 	ctrlruntimeclient.Client
 
 	log *zap.SugaredLogger
+
+	// expiredRetention is how long expired bindings are kept before they are deleted;
+	// 0 keeps them forever.
+	expiredRetention time.Duration
 }
 
-func Add(ctx context.Context, mgr manager.Manager, log *zap.SugaredLogger) error {
+func Add(ctx context.Context, mgr manager.Manager, log *zap.SugaredLogger, expiredRetention time.Duration) error {
 	// Add index on field "userProjectBinding.spec.userEmail" for using it as listing filter
 	if err := mgr.GetFieldIndexer().IndexField(ctx, &kubermaticv1.UserProjectBinding{}, userProjectBindingEmailKey,
 		func(rawObj ctrlruntimeclient.Object) []string {
@@ -69,7 +74,8 @@ func Add(ctx context.Context, mgr manager.Manager, log *zap.SugaredLogger) error
 	r := &reconcileSyncProjectBinding{
 		Client: mgr.GetClient(),
 
-		log: log,
+		log:              log,
+		expiredRetention: expiredRetention,
 	}
 
 	_, err := builder.ControllerManagedBy(mgr).
@@ -93,9 +99,11 @@ func (r *reconcileSyncProjectBinding) Reconcile(ctx context.Context, request rec
 	log := r.log.With("userprojectbinding", projectBinding.Name)
 	log.Debug("Reconciling")
 
-	err := r.reconcile(ctx, log, projectBinding)
+	if err := r.reconcile(ctx, log, projectBinding); err != nil {
+		return reconcile.Result{}, err
+	}
 
-	return reconcile.Result{}, err
+	return r.reconcileExpiry(ctx, log, projectBinding, time.Now())
 }
 
 func (r *reconcileSyncProjectBinding) reconcile(ctx context.Context, log *zap.SugaredLogger, projectBinding *kubermaticv1.UserProjectBinding) error {
@@ -142,6 +150,11 @@ func (r *reconcileSyncProjectBinding) reconcile(ctx context.Context, log *zap.Su
 		return r.ensureNotProjectOwnerForBinding(ctx, user, project, projectBinding)
 	}
 
+	if projectBinding.Spec.IsExpired(time.Now()) {
+		log.Debug("binding has expired, revoking project ownership")
+		return r.ensureNotProjectOwnerForBinding(ctx, user, project, projectBinding)
+	}
+
 	if err := r.ensureBindingIsOwnedByProject(ctx, project, projectBinding); err != nil {
 		return err
 	}
@@ -153,6 +166,32 @@ func (r *reconcileSyncProjectBinding) reconcile(ctx context.Context, log *zap.Su
 	return r.ensureNotProjectOwnerForBinding(ctx, user, project, projectBinding)
 }
 
+// reconcileExpiry requeues bindings until they expire and deletes expired bindings once
+// the retention period has passed.
+func (r *reconcileSyncProjectBinding) reconcileExpiry(ctx context.Context, log *zap.SugaredLogger, projectBinding *kubermaticv1.UserProjectBinding, now time.Time) (reconcile.Result, error) {
+	if projectBinding.Spec.ExpiresAt == nil || projectBinding.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	expiresAt := projectBinding.Spec.ExpiresAt.Time
+	if !projectBinding.Spec.IsExpired(now) {
+		return reconcile.Result{RequeueAfter: expiresAt.Sub(now)}, nil
+	}
+
+	if r.expiredRetention <= 0 {
+		return reconcile.Result{}, nil
+	}
+
+	deleteAt := expiresAt.Add(r.expiredRetention)
+	if now.Before(deleteAt) {
+		return reconcile.Result{RequeueAfter: deleteAt.Sub(now)}, nil
+	}
+
+	log.Infow("Deleting expired binding", "expiresAt", expiresAt)
+
+	return reconcile.Result{}, ctrlruntimeclient.IgnoreNotFound(r.Delete(ctx, projectBinding))
+}
+
 func (r *reconcileSyncProjectBinding) ensureBindingIsOwnedByProject(ctx context.Context, project *kubermaticv1.Project, projectBinding *kubermaticv1.UserProjectBinding) error {
 	oldBinding := projectBinding.DeepCopy()
 
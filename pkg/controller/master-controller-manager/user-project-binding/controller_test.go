@@ -19,6 +19,7 @@ package userprojectbinding
 import (
 	"context"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -290,3 +291,92 @@ func TestReconcileMarksBindingAsResolved(t *testing.T) {
 		t.Fatal("expected binding to be marked as resolved")
 	}
 }
+
+func TestReconcileExpiredOwnerBindingIsRevoked(t *testing.T) {
+	ctx := context.Background()
+
+	binding := test.CreateExpectedOwnerBinding("James Bond", thunderball)
+	binding.Spec.ExpiresAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	project := projectWithOwner(thunderball, jamesAsOwner)
+
+	kubermaticFakeClient := fake.
+		NewClientBuilder().
+		WithObjects(binding, project, jamesBond).
+		Build()
+
+	target := reconcileSyncProjectBinding{Client: kubermaticFakeClient}
+
+	if err := target.reconcile(ctx, zap.NewNop().Sugar(), binding); err != nil {
+		t.Fatal(err)
+	}
+
+	updatedProject := &kubermaticv1.Project{}
+	if err := kubermaticFakeClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(project), updatedProject); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(updatedProject.OwnerReferences) != 0 {
+		t.Fatalf("expected expired binding to no longer own the project, but got owners %v", updatedProject.OwnerReferences)
+	}
+}
+
+func TestReconcileExpiry(t *testing.T) {
+	now := time.Now()
+	retention := 24 * time.Hour
+
+	tests := []struct {
+		name            string
+		expiresAt       *metav1.Time
+		expectedRequeue time.Duration
+		expectDeleted   bool
+	}{
+		{
+			name: "binding without expiry",
+		},
+		{
+			name:            "requeue until the binding expires",
+			expiresAt:       &metav1.Time{Time: now.Add(4 * time.Hour)},
+			expectedRequeue: 4 * time.Hour,
+		},
+		{
+			name:            "expired binding is kept during retention",
+			expiresAt:       &metav1.Time{Time: now.Add(-time.Hour)},
+			expectedRequeue: retention - time.Hour,
+		},
+		{
+			name:          "expired binding is deleted after retention",
+			expiresAt:     &metav1.Time{Time: now.Add(-retention - time.Hour)},
+			expectDeleted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			binding := test.CreateExpectedEditorBinding("James Bond", thunderball)
+			binding.Spec.ExpiresAt = tt.expiresAt
+
+			kubermaticFakeClient := fake.
+				NewClientBuilder().
+				WithObjects(binding).
+				Build()
+
+			target := reconcileSyncProjectBinding{Client: kubermaticFakeClient, expiredRetention: retention}
+
+			result, err := target.reconcileExpiry(ctx, zap.NewNop().Sugar(), binding, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if result.RequeueAfter != tt.expectedRequeue {
+				t.Errorf("expected requeue after %v, but got %v", tt.expectedRequeue, result.RequeueAfter)
+			}
+
+			err = kubermaticFakeClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(binding), &kubermaticv1.UserProjectBinding{})
+			if deleted := apierrors.IsNotFound(err); deleted != tt.expectDeleted {
+				t.Errorf("expected deleted = %v, but got err: %v", tt.expectDeleted, err)
+			}
+		})
+	}
+}
@@ -240,12 +240,14 @@ func (r *Reconciler) cleanupDeletedConfiguration(ctx context.Context, config *ku
 		common.ResourceQuotaAdmissionWebhookName,
 		common.ResourceQuotaAcceleratorAccountingAdmissionWebhookName,
 		common.PolicyTemplateAdmissionWebhookName,
+		common.ProjectAdmissionWebhookName,
 	}
 
 	mutating := []string{
 		common.UserSSHKeyAdmissionWebhookName,
 		common.ExternalClusterAdmissionWebhookName,
 		common.ResourceQuotaAdmissionWebhookName,
+		common.ProjectAdmissionWebhookName,
 	}
 
 	for _, webhook := range validating {
@@ -702,6 +704,7 @@ func (r *Reconciler) reconcileValidatingWebhooks(ctx context.Context, config *ku
 		kubermatic.ResourceQuotaValidatingWebhookConfigurationReconciler(ctx, config, r.Client),
 		kubermatic.ResourceQuotaAcceleratorAccountingValidatingWebhookConfigurationReconciler(ctx, config, r.Client),
 		kubermatic.GroupProjectBindingValidatingWebhookConfigurationReconciler(ctx, config, r.Client),
+		kubermatic.ProjectValidatingWebhookConfigurationReconciler(ctx, config, r.Client),
 		common.PoliciesWebhookConfigurationReconciler(ctx, config, r.Client),
 		common.PolicyTemplateValidatingWebhookConfigurationReconciler(ctx, config, r.Client),
 	}
@@ -726,6 +729,7 @@ func (r *Reconciler) reconcileMutatingWebhooks(ctx context.Context, config *kube
 
 	reconcilers := []reconciling.NamedMutatingWebhookConfigurationReconcilerFactory{
 		kubermatic.ExternalClusterMutatingWebhookConfigurationReconciler(ctx, config, r.Client),
+		kubermatic.ProjectMutatingWebhookConfigurationReconciler(ctx, config, r.Client),
 		common.ApplicationDefinitionMutatingWebhookConfigurationReconciler(ctx, config, r.Client),
 	}
 
@@ -18,6 +18,7 @@ package kubermatic
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -34,6 +35,10 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// applicationCachePath is where the master-controller-manager downloads Applications
+// for external clusters to.
+const applicationCachePath = "/applications"
+
 func masterControllerManagerPodLabels() map[string]string {
 	return map[string]string{
 		common.NameLabel: common.MasterControllerManagerDeploymentName,
@@ -67,6 +72,7 @@ func MasterControllerManagerDeploymentReconciler(cfg *kubermaticv1.KubermaticCon
 				fmt.Sprintf("-pprof-listen-address=%s", *cfg.Spec.MasterController.PProfEndpoint),
 				fmt.Sprintf("-feature-gates=%s", common.StringifyFeatureGates(cfg)),
 				fmt.Sprintf("-overwrite-registry=%s", cfg.Spec.UserCluster.OverwriteRegistry),
+				fmt.Sprintf("-application-cache=%s", applicationCachePath),
 			}
 
 			if cfg.Spec.FeatureGates[features.HTTPRouteGatewaySync] && len(httprouteWatchNamespaces) > 0 {
@@ -83,6 +89,53 @@ func MasterControllerManagerDeploymentReconciler(cfg *kubermaticv1.KubermaticCon
 				args = append(args, fmt.Sprintf("-worker-name=%s", workerName))
 			}
 
+			// the root filesystem is read-only, so Applications for external clusters
+			// are downloaded into their own volume
+			volumes := []corev1.Volume{
+				{
+					Name: "applications",
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+			}
+			volumeMounts := []corev1.VolumeMount{
+				{
+					Name:      "applications",
+					MountPath: applicationCachePath,
+				},
+			}
+
+			if audit := cfg.Spec.MasterController.PlatformAudit; audit.Sink != "" {
+				args = append(args, fmt.Sprintf("-platform-audit-sink=%s", audit.Sink))
+
+				switch audit.Sink {
+				case kubermaticv1.PlatformAuditFileSink:
+					args = append(args, fmt.Sprintf("-platform-audit-file-path=%s", audit.FilePath))
+
+					// the root filesystem is read-only, so the audit log needs its own volume
+					volumes = append(volumes, corev1.Volume{
+						Name: "platform-audit",
+						VolumeSource: corev1.VolumeSource{
+							EmptyDir: &corev1.EmptyDirVolumeSource{},
+						},
+					})
+					volumeMounts = append(volumeMounts, corev1.VolumeMount{
+						Name:      "platform-audit",
+						MountPath: filepath.Dir(audit.FilePath),
+					})
+
+				case kubermaticv1.PlatformAuditWebhookSink:
+					args = append(args, fmt.Sprintf("-platform-audit-webhook-url=%s", audit.WebhookURL))
+
+				case kubermaticv1.PlatformAuditSyslogSink:
+					if audit.SyslogAddress != "" {
+						args = append(args, fmt.Sprintf("-platform-audit-syslog-address=%s", audit.SyslogAddress))
+					}
+				}
+			}
+
+			d.Spec.Template.Spec.Volumes = volumes
 			d.Spec.Template.Spec.SecurityContext = &common.PodSecurityContext
 			d.Spec.Template.Spec.Containers = []corev1.Container{
 				{
@@ -98,6 +151,7 @@ func MasterControllerManagerDeploymentReconciler(cfg *kubermaticv1.KubermaticCon
 							Protocol:      corev1.ProtocolTCP,
 						},
 					},
+					VolumeMounts:    volumeMounts,
 					Resources:       cfg.Spec.MasterController.Resources,
 					SecurityContext: &common.ContainerSecurityContext,
 				},
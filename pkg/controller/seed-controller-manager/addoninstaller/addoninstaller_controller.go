@@ -26,6 +26,7 @@ import (
 	"go.uber.org/zap"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
 	"k8c.io/kubermatic/v2/pkg/cni"
 	"k8c.io/kubermatic/v2/pkg/controller/util"
 	"k8c.io/kubermatic/v2/pkg/defaulting"
@@ -297,6 +298,9 @@ func (r *Reconciler) deleteAddon(ctx context.Context, log *zap.SugaredLogger, ad
 // skipAddonInstallation returns true if the addon installation should be skipped based on the Cluster spec, false otherwise.
 func skipAddonInstallation(addon kubermaticv1.Addon, cluster *kubermaticv1.Cluster) bool {
 	if cluster.Spec.CNIPlugin != nil {
+		if source := kubermaticv1helper.CNIMigrationSource(cluster); source != nil && addon.Name == source.Type.String() {
+			return false // keep the previous CNI until all nodes have been migrated
+		}
 		if addon.Name == string(kubermaticv1.CNIPluginTypeCanal) && cluster.Spec.CNIPlugin.Type == kubermaticv1.CNIPluginTypeCilium {
 			return true // skip Canal if Cilium is used
 		}
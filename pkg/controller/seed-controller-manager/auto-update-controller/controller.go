@@ -19,6 +19,7 @@ package autoupdatecontroller
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -27,13 +28,17 @@ import (
 	"k8c.io/kubermatic/v2/pkg/cluster/client"
 	"k8c.io/kubermatic/v2/pkg/controller/util"
 	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/util/maintenancewindow"
+	"k8c.io/kubermatic/v2/pkg/util/nodeupgrade"
 	"k8c.io/kubermatic/v2/pkg/version"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+	updaterollout "k8c.io/kubermatic/v2/pkg/version/rollout"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -51,6 +56,7 @@ type Reconciler struct {
 
 	workerName                    string
 	configGetter                  provider.KubermaticConfigurationGetter
+	seedGetter                    provider.SeedGetter
 	recorder                      events.EventRecorder
 	userClusterConnectionProvider *client.Provider
 	log                           *zap.SugaredLogger
@@ -63,6 +69,7 @@ func Add(
 	numWorkers int,
 	workerName string,
 	configGetter provider.KubermaticConfigurationGetter,
+	seedGetter provider.SeedGetter,
 	userClusterConnectionProvider *client.Provider,
 	log *zap.SugaredLogger,
 	versions kubermatic.Versions,
@@ -72,6 +79,7 @@ func Add(
 
 		workerName:                    workerName,
 		configGetter:                  configGetter,
+		seedGetter:                    seedGetter,
 		userClusterConnectionProvider: userClusterConnectionProvider,
 		recorder:                      mgr.GetEventRecorder(ControllerName),
 		log:                           log,
@@ -142,37 +150,123 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 		return nil, fmt.Errorf("failed to load KubermaticConfiguration: %w", err)
 	}
 
+	seed, err := r.seedGetter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seed: %w", err)
+	}
+
+	if cfg := config.Spec.Versions.UpdateRollout; cfg != nil {
+		allowed, reason, err := r.rolloutAllowsUpdates(ctx, cfg, cluster, seed)
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			log.Debugw("Automatic updates are held back by the update rollout", "reason", reason)
+
+			// the rollout status is not watched, so check again later
+			return &reconcile.Result{RequeueAfter: time.Minute}, nil
+		}
+	}
+
+	window, err := maintenancewindow.ForSeedCluster(cluster, seed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance window: %w", err)
+	}
+
 	updateManager := version.NewFromConfiguration(config)
+	now := time.Now()
+
+	var pendingUpdates []kubermaticv1.PendingUpdate
 
-	if err := r.controlPlaneUpgrade(ctx, log, cluster, updateManager); err != nil {
+	pending, err := r.controlPlaneUpgrade(ctx, log, cluster, updateManager, window, now)
+	if err != nil {
 		return nil, fmt.Errorf("failed to update the controlplane: %w", err)
 	}
+	if pending != nil {
+		pendingUpdates = append(pendingUpdates, *pending)
+	}
 
 	// nodeUpdate works based on the Cluster.Status.Versions.ControlPlane field, so it properly waits
 	// for the control plane to be upgraded before updating the nodes.
-	if err := r.nodeUpdate(ctx, log, cluster, updateManager); err != nil {
+	pendingNodeUpdates, err := r.nodeUpdate(ctx, log, cluster, updateManager, window, now)
+	if err != nil {
 		return nil, fmt.Errorf("failed to update the controlplane: %w", err)
 	}
+	pendingUpdates = append(pendingUpdates, pendingNodeUpdates...)
+
+	if err := r.updatePendingUpdates(ctx, cluster, pendingUpdates); err != nil {
+		return nil, fmt.Errorf("failed to update pending updates: %w", err)
+	}
+
+	// Come back once the maintenance window opens.
+	if len(pendingUpdates) > 0 {
+		return &reconcile.Result{RequeueAfter: pendingUpdates[0].NextWindowStart.Sub(now)}, nil
+	}
 
 	return nil, nil
 }
 
-func (r *Reconciler) nodeUpdate(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, updateManager *version.Manager) error {
+// rolloutAllowsUpdates checks the UpdateRollout status, which is copied to the seed by the
+// master-controller-manager, to decide whether the cluster's wave may receive automatic updates.
+func (r *Reconciler) rolloutAllowsUpdates(ctx context.Context, cfg *kubermaticv1.UpdateRolloutConfiguration, cluster *kubermaticv1.Cluster, seed *kubermaticv1.Seed) (bool, string, error) {
+	rollout := &kubermaticv1.UpdateRollout{}
+	if err := r.Get(ctx, types.NamespacedName{Name: kubermaticv1.UpdateRolloutName}, rollout); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, "", fmt.Errorf("failed to get UpdateRollout: %w", err)
+		}
+
+		rollout = nil
+	}
+
+	allowed, reason := updaterollout.Allowed(cfg, rollout, updaterollout.Wave(cfg, cluster, seed.Name))
+
+	return allowed, reason, nil
+}
+
+// updatePendingUpdates records the updates held back by the maintenance window in the
+// cluster status and emits an event for every newly held back update.
+func (r *Reconciler) updatePendingUpdates(ctx context.Context, cluster *kubermaticv1.Cluster, pendingUpdates []kubermaticv1.PendingUpdate) error {
+	for _, update := range pendingUpdates {
+		if _, ok := cluster.Status.PendingUpdates[kubermaticv1.PendingUpdateKey(update.Type, update.Name)]; ok {
+			continue
+		}
+
+		target := "the cluster"
+		if update.Type == kubermaticv1.PendingUpdateTypeMachineDeployment {
+			target = fmt.Sprintf("MachineDeployment %s/%s", metav1.NamespaceSystem, update.Name)
+		}
+
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "AutoUpdatePending", "Reconciling", "Automatic update of %s to version %q is pending until the maintenance window opens at %s.", target, update.To, update.NextWindowStart.UTC().Format(time.RFC3339))
+	}
+
+	return util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		maintenancewindow.SetPendingUpdates(c, []kubermaticv1.PendingUpdateType{
+			kubermaticv1.PendingUpdateTypeControlPlane,
+			kubermaticv1.PendingUpdateTypeMachineDeployment,
+		}, pendingUpdates)
+	})
+}
+
+func (r *Reconciler) nodeUpdate(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, updateManager *version.Manager, window *maintenancewindow.Window, now time.Time) ([]kubermaticv1.PendingUpdate, error) {
 	c, err := r.userClusterConnectionProvider.GetClient(ctx, cluster)
 	if err != nil {
-		return fmt.Errorf("failed to get usercluster client: %w", err)
+		return nil, fmt.Errorf("failed to get usercluster client: %w", err)
 	}
 
+	open, windowStart := window.IsOpen(now)
+	var pendingUpdates []kubermaticv1.PendingUpdate
+
 	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
 	// Kubermatic only creates MachineDeployments in the kube-system namespace, everything else is essentially unsupported
 	if err := c.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
-		return fmt.Errorf("failed to list MachineDeployments: %w", err)
+		return nil, fmt.Errorf("failed to list MachineDeployments: %w", err)
 	}
 
 	for _, md := range machineDeployments.Items {
 		targetVersion, err := updateManager.AutomaticNodeUpdate(md.Spec.Template.Spec.Versions.Kubelet, cluster.Status.Versions.ControlPlane.String())
 		if err != nil {
-			return fmt.Errorf("failed to get automatic update for machinedeployment %s/%s that has version %q: %w", md.Namespace, md.Name, md.Spec.Template.Spec.Versions.Kubelet, err)
+			return nil, fmt.Errorf("failed to get automatic update for machinedeployment %s/%s that has version %q: %w", md.Namespace, md.Name, md.Spec.Template.Spec.Versions.Kubelet, err)
 		}
 		if targetVersion == nil {
 			continue
@@ -181,6 +275,17 @@ func (r *Reconciler) nodeUpdate(ctx context.Context, log *zap.SugaredLogger, clu
 		target := targetVersion.Version.String()
 		old := md.Spec.Template.Spec.Versions.Kubelet
 
+		if old != target && !open {
+			pendingUpdates = append(pendingUpdates, kubermaticv1.PendingUpdate{
+				Type:            kubermaticv1.PendingUpdateTypeMachineDeployment,
+				Name:            md.Name,
+				From:            old,
+				To:              target,
+				NextWindowStart: metav1.NewTime(windowStart),
+			})
+			continue
+		}
+
 		if old != target {
 			oldMD := md.DeepCopy()
 			identifier := fmt.Sprintf("%s/%s", md.Namespace, md.Name)
@@ -188,30 +293,42 @@ func (r *Reconciler) nodeUpdate(ctx context.Context, log *zap.SugaredLogger, clu
 			log.Infow("Applying automatic update to MachineDeployment", "machinedeployment", identifier, "from", old, "to", target)
 
 			md.Spec.Template.Spec.Versions.Kubelet = target
+			nodeupgrade.ApplyToMachineDeployment(&md, cluster.Spec.NodeUpgradePolicy)
 			if err := c.Patch(ctx, &md, ctrlruntimeclient.MergeFrom(oldMD)); err != nil {
-				return fmt.Errorf("failed to update MachineDeployment: %w", err)
+				return nil, fmt.Errorf("failed to update MachineDeployment: %w", err)
 			}
 
 			r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "AutoUpdateMachineDeployment", "Reconciling", "Triggered automatic update of MachineDeployment %s to version %q", identifier, target)
 		}
 	}
 
-	return nil
+	return pendingUpdates, nil
 }
 
-func (r *Reconciler) controlPlaneUpgrade(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, updateManager *version.Manager) error {
+func (r *Reconciler) controlPlaneUpgrade(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, updateManager *version.Manager, window *maintenancewindow.Window, now time.Time) (*kubermaticv1.PendingUpdate, error) {
 	update, err := updateManager.AutomaticControlplaneUpdate(cluster.Spec.Version.String())
 	if err != nil {
-		return fmt.Errorf("failed to get automatic update for cluster for version %s: %w", cluster.Spec.Version.String(), err)
+		return nil, fmt.Errorf("failed to get automatic update for cluster for version %s: %w", cluster.Spec.Version.String(), err)
 	}
 	if update == nil {
-		return nil
+		return nil, nil
 	}
 	oldCluster := cluster.DeepCopy()
 
 	sver, err := semver.NewSemver(update.Version.String())
 	if err != nil {
-		return fmt.Errorf("failed to parse version %q: %w", update.Version.String(), err)
+		return nil, fmt.Errorf("failed to parse version %q: %w", update.Version.String(), err)
+	}
+
+	if open, windowStart := window.IsOpen(now); !open {
+		log.Debugw("Automatic control-plane upgrade is pending until the next maintenance window", "to", sver, "windowStart", windowStart)
+
+		return &kubermaticv1.PendingUpdate{
+			Type:            kubermaticv1.PendingUpdateTypeControlPlane,
+			From:            cluster.Spec.Version.String(),
+			To:              sver.String(),
+			NextWindowStart: metav1.NewTime(windowStart),
+		}, nil
 	}
 
 	log.Infow("Applying automatic control-plane upgrade", "from", oldCluster.Spec.Version, "to", cluster.Spec.Version)
@@ -221,7 +338,7 @@ func (r *Reconciler) controlPlaneUpgrade(ctx context.Context, log *zap.SugaredLo
 	// set here.
 	cluster.Spec.Version = *sver
 	if err := r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
-		return fmt.Errorf("failed to update cluster: %w", err)
+		return nil, fmt.Errorf("failed to update cluster: %w", err)
 	}
 
 	log.Infow("Applied automatic cluster upgrade", "from", oldCluster.Spec.Version, "to", cluster.Spec.Version)
@@ -234,8 +351,8 @@ func (r *Reconciler) controlPlaneUpgrade(ctx context.Context, log *zap.SugaredLo
 		c.Status.ExtendedHealth.Scheduler = kubermaticv1.HealthStatusDown
 	})
 	if err != nil {
-		return fmt.Errorf("failed to update cluster status: %w", err)
+		return nil, fmt.Errorf("failed to update cluster status: %w", err)
 	}
 
-	return nil
+	return nil, nil
 }
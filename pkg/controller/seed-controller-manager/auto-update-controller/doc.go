@@ -20,5 +20,16 @@ the cluster version and potentially to the MachineDeployments inside the userclu
 It will not itself reconcile any control plane components, this task is handled by
 other controllers that properly handle the version skew policy and are smart enough
 to update step-by-step.
+
+When the kubelet version of a MachineDeployment is updated, the surge settings of the
+cluster's node upgrade policy are applied to its rolling update strategy as well.
+
+Updates are only applied while the maintenance window of the cluster (or the default
+window of its datacenter) is open; until then they are recorded as pending updates
+in the cluster status.
+
+If update rollouts are configured in the KubermaticConfiguration, updates are only
+applied to clusters whose wave has been reached according to the UpdateRollout
+status, which is maintained by the master-controller-manager.
 */
 package autoupdatecontroller
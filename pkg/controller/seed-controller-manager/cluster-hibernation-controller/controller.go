@@ -0,0 +1,555 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterhibernationcontroller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	"k8c.io/kubermatic/v2/pkg/controller/util"
+	"k8c.io/kubermatic/v2/pkg/util/hibernation"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	ControllerName = "kkp-cluster-hibernation-controller"
+
+	// progressInterval is used while waiting for machines to disappear or for the control
+	// plane to become healthy again.
+	progressInterval = 10 * time.Second
+
+	// hibernatedInterval is used to periodically ensure that the control plane of a
+	// hibernated cluster stays scaled down.
+	hibernatedInterval = 5 * time.Minute
+)
+
+type UserClusterClientProvider interface {
+	GetClient(ctx context.Context, c *kubermaticv1.Cluster, options ...clusterclient.ConfigOption) (ctrlruntimeclient.Client, error)
+}
+
+type Reconciler struct {
+	ctrlruntimeclient.Client
+
+	workerName                    string
+	recorder                      events.EventRecorder
+	userClusterConnectionProvider UserClusterClientProvider
+	log                           *zap.SugaredLogger
+	now                           func() time.Time
+}
+
+// Add creates a new cluster hibernation controller.
+func Add(
+	mgr manager.Manager,
+	numWorkers int,
+	workerName string,
+	userClusterConnectionProvider UserClusterClientProvider,
+	log *zap.SugaredLogger,
+) error {
+	reconciler := &Reconciler{
+		Client: mgr.GetClient(),
+
+		workerName:                    workerName,
+		recorder:                      mgr.GetEventRecorder(ControllerName),
+		userClusterConnectionProvider: userClusterConnectionProvider,
+		log:                           log,
+		now:                           time.Now,
+	}
+
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: numWorkers,
+		}).
+		For(&kubermaticv1.Cluster{}).
+		Build(reconciler)
+
+	return err
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("cluster", request.Name)
+	log.Debug("Reconciling")
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := r.Get(ctx, request.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	// The ClusterReconcileWrapper cannot be used, as it skips hibernated clusters.
+	if cluster.Labels[kubermaticv1.WorkerNameLabelKey] != r.workerName {
+		return reconcile.Result{}, nil
+	}
+	if cluster.Spec.Pause {
+		return reconcile.Result{}, nil
+	}
+
+	result, err := r.reconcile(ctx, log, cluster)
+	if err != nil {
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+	}
+
+	return result, err
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (reconcile.Result, error) {
+	var next *time.Time
+
+	if cluster.DeletionTimestamp == nil && cluster.Spec.Hibernation != nil && cluster.Spec.Hibernation.Schedule != nil {
+		var err error
+		next, err = r.applySchedule(ctx, log, cluster)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	result, err := r.reconcileState(ctx, log, cluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if next != nil {
+		// requeue slightly after the transition, so that it is not missed
+		untilNext := next.Sub(r.now()) + time.Second
+
+		if result.RequeueAfter == 0 || untilNext < result.RequeueAfter {
+			result.RequeueAfter = untilNext
+		}
+	}
+
+	return result, nil
+}
+
+// applySchedule sets the desired hibernation state if a scheduled transition has passed
+// that was not yet applied to the cluster. It returns the time of the next transition.
+func (r *Reconciler) applySchedule(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*time.Time, error) {
+	schedule, err := hibernation.ParseSchedule(cluster.Spec.Hibernation.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hibernation schedule: %w", err)
+	}
+
+	now := r.now()
+	next := schedule.Next(now)
+
+	last := schedule.Last(now)
+	if last == nil {
+		return &next.Time, nil
+	}
+
+	var applied *metav1.Time
+	if cluster.Status.Hibernation != nil {
+		applied = cluster.Status.Hibernation.LastScheduledTransition
+	}
+	if applied != nil && !last.Time.After(applied.Time) {
+		return &next.Time, nil
+	}
+
+	if cluster.Spec.Hibernation.Hibernated != last.Hibernate {
+		oldCluster := cluster.DeepCopy()
+		cluster.Spec.Hibernation.Hibernated = last.Hibernate
+		if err := r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+			return nil, fmt.Errorf("failed to update hibernation: %w", err)
+		}
+
+		action := "woken up"
+		if last.Hibernate {
+			action = "hibernated"
+		}
+
+		log.Infow("Applying hibernation schedule", "hibernated", last.Hibernate)
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "HibernationScheduled", "Reconciling", "Cluster is %s according to its hibernation schedule", action)
+	}
+
+	err = util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		if c.Status.Hibernation == nil {
+			c.Status.Hibernation = &kubermaticv1.ClusterHibernationStatus{}
+		}
+		c.Status.Hibernation.LastScheduledTransition = &metav1.Time{Time: last.Time}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return &next.Time, nil
+}
+
+func (r *Reconciler) reconcileState(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (reconcile.Result, error) {
+	desired := cluster.DeletionTimestamp == nil && cluster.Spec.Hibernation != nil && cluster.Spec.Hibernation.Hibernated
+
+	var state kubermaticv1.ClusterHibernationState
+	if cluster.Status.Hibernation != nil {
+		state = cluster.Status.Hibernation.State
+	}
+
+	switch {
+	case cluster.DeletionTimestamp != nil && state != "":
+		return r.restoreForDeletion(ctx, log, cluster)
+
+	case desired && (state == "" || state == kubermaticv1.ClusterHibernationStateWakingUp):
+		log.Info("Hibernating cluster")
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "Hibernating", "Reconciling", "Scaling down MachineDeployments")
+		return reconcile.Result{Requeue: true}, r.setState(ctx, cluster, kubermaticv1.ClusterHibernationStateHibernating)
+
+	case desired && state == kubermaticv1.ClusterHibernationStateHibernating:
+		return r.hibernate(ctx, log, cluster)
+
+	case desired && state == kubermaticv1.ClusterHibernationStateHibernated:
+		if err := r.scaleDownControlPlane(ctx, cluster); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: hibernatedInterval}, nil
+
+	case !desired && (state == kubermaticv1.ClusterHibernationStateHibernating || state == kubermaticv1.ClusterHibernationStateHibernated):
+		log.Info("Waking up cluster")
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "WakingUp", "Reconciling", "Restoring control plane")
+		return reconcile.Result{Requeue: true}, r.setState(ctx, cluster, kubermaticv1.ClusterHibernationStateWakingUp)
+
+	case !desired && state == kubermaticv1.ClusterHibernationStateWakingUp:
+		return r.wakeUp(ctx, log, cluster)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// hibernate scales down all MachineDeployments and marks the cluster as hibernated once
+// all machines are gone.
+func (r *Reconciler) hibernate(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (reconcile.Result, error) {
+	userClusterClient, err := r.userClusterConnectionProvider.GetClient(ctx, cluster)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to get usercluster client: %w", err)
+	}
+
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	// Kubermatic only creates MachineDeployments in the kube-system namespace, everything else is essentially unsupported
+	if err := userClusterClient.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to list MachineDeployments: %w", err)
+	}
+
+	// remember the replicas before scaling anything down, so they are never lost
+	replicas := map[string]int32{}
+	for name, count := range cluster.Status.Hibernation.MachineDeploymentReplicas {
+		replicas[name] = count
+	}
+
+	for _, md := range machineDeployments.Items {
+		if _, ok := replicas[md.Name]; !ok {
+			replicas[md.Name] = ptr.Deref(md.Spec.Replicas, 1)
+		}
+	}
+
+	err = util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.Hibernation.MachineDeploymentReplicas = replicas
+	})
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	remaining := false
+	for _, md := range machineDeployments.Items {
+		if ptr.Deref(md.Spec.Replicas, 1) != 0 {
+			log.Debugw("Scaling down MachineDeployment", "machinedeployment", md.Name)
+
+			oldMD := md.DeepCopy()
+			md.Spec.Replicas = ptr.To[int32](0)
+			if err := userClusterClient.Patch(ctx, &md, ctrlruntimeclient.MergeFrom(oldMD)); err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed to scale down MachineDeployment %s: %w", md.Name, err)
+			}
+		}
+
+		if md.Status.Replicas > 0 {
+			remaining = true
+		}
+	}
+
+	if remaining {
+		return reconcile.Result{RequeueAfter: progressInterval}, nil
+	}
+
+	if err := r.scaleDownControlPlane(ctx, cluster); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err = util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.Hibernation.State = kubermaticv1.ClusterHibernationStateHibernated
+		c.Status.Hibernation.LastTransitionTime = metav1.Now()
+
+		// The health is not updated while the cluster is hibernated, so it must not claim the
+		// control plane is still up when the cluster is woken up again.
+		c.Status.ExtendedHealth.Apiserver = kubermaticv1.HealthStatusDown
+		c.Status.ExtendedHealth.Etcd = kubermaticv1.HealthStatusDown
+		c.Status.ExtendedHealth.Controller = kubermaticv1.HealthStatusDown
+		c.Status.ExtendedHealth.Scheduler = kubermaticv1.HealthStatusDown
+		if c.Status.ExtendedHealth.MachineController != "" {
+			c.Status.ExtendedHealth.MachineController = kubermaticv1.HealthStatusDown
+		}
+	})
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	log.Info("Cluster is hibernated")
+	r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "Hibernated", "Reconciling", "Cluster has been hibernated")
+
+	return reconcile.Result{RequeueAfter: hibernatedInterval}, nil
+}
+
+// scaleDownControlPlane scales all Deployments and StatefulSets in the cluster namespace
+// to zero. PersistentVolumeClaims are not touched, so etcd keeps its data.
+func (r *Reconciler) scaleDownControlPlane(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	namespace := cluster.Status.NamespaceName
+	if namespace == "" {
+		return nil
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments, ctrlruntimeclient.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed to list Deployments: %w", err)
+	}
+
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := r.List(ctx, statefulSets, ctrlruntimeclient.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed to list StatefulSets: %w", err)
+	}
+
+	// remember the replicas before scaling anything down, so the control plane can be restored at once
+	replicas := map[string]int32{}
+	for key, count := range cluster.Status.Hibernation.ControlPlaneReplicas {
+		replicas[key] = count
+	}
+
+	remember := func(key string, count *int32) {
+		if _, ok := replicas[key]; !ok && ptr.Deref(count, 1) != 0 {
+			replicas[key] = ptr.Deref(count, 1)
+		}
+	}
+
+	for _, deployment := range deployments.Items {
+		remember(controlPlaneReplicasKey("Deployment", deployment.Name), deployment.Spec.Replicas)
+	}
+
+	for _, statefulSet := range statefulSets.Items {
+		remember(controlPlaneReplicasKey("StatefulSet", statefulSet.Name), statefulSet.Spec.Replicas)
+	}
+
+	if !maps.Equal(replicas, cluster.Status.Hibernation.ControlPlaneReplicas) {
+		err := util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+			c.Status.Hibernation.ControlPlaneReplicas = replicas
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update status: %w", err)
+		}
+	}
+
+	for _, deployment := range deployments.Items {
+		if ptr.Deref(deployment.Spec.Replicas, 1) == 0 {
+			continue
+		}
+
+		oldDeployment := deployment.DeepCopy()
+		deployment.Spec.Replicas = ptr.To[int32](0)
+		if err := r.Patch(ctx, &deployment, ctrlruntimeclient.MergeFrom(oldDeployment)); err != nil {
+			return fmt.Errorf("failed to scale down Deployment %s: %w", deployment.Name, err)
+		}
+	}
+
+	for _, statefulSet := range statefulSets.Items {
+		if ptr.Deref(statefulSet.Spec.Replicas, 1) == 0 {
+			continue
+		}
+
+		oldStatefulSet := statefulSet.DeepCopy()
+		statefulSet.Spec.Replicas = ptr.To[int32](0)
+		if err := r.Patch(ctx, &statefulSet, ctrlruntimeclient.MergeFrom(oldStatefulSet)); err != nil {
+			return fmt.Errorf("failed to scale down StatefulSet %s: %w", statefulSet.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// restoreControlPlane scales the Deployments and StatefulSets in the cluster namespace back
+// to the replicas they had before the cluster was hibernated. This cannot be left to the
+// kubernetes controller, which scales etcd up one member at a time and only while it is
+// healthy, which it never is without a quorum.
+func (r *Reconciler) restoreControlPlane(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
+	namespace := cluster.Status.NamespaceName
+
+	for key, replicas := range cluster.Status.Hibernation.ControlPlaneReplicas {
+		kind, name, _ := strings.Cut(key, "/")
+
+		var (
+			obj          ctrlruntimeclient.Object
+			specReplicas **int32
+		)
+
+		switch kind {
+		case "Deployment":
+			deployment := &appsv1.Deployment{}
+			obj, specReplicas = deployment, &deployment.Spec.Replicas
+		case "StatefulSet":
+			statefulSet := &appsv1.StatefulSet{}
+			obj, specReplicas = statefulSet, &statefulSet.Spec.Replicas
+		default:
+			continue
+		}
+
+		if err := r.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get %s %s: %w", kind, name, err)
+		}
+
+		if ptr.Deref(*specReplicas, 1) != 0 {
+			// already restored
+			continue
+		}
+
+		log.Debugw("Scaling up control plane", "kind", kind, "name", name, "replicas", replicas)
+
+		oldObj := obj.DeepCopyObject().(ctrlruntimeclient.Object)
+		*specReplicas = ptr.To(replicas)
+		if err := r.Patch(ctx, obj, ctrlruntimeclient.MergeFrom(oldObj)); err != nil {
+			return fmt.Errorf("failed to scale up %s %s: %w", kind, name, err)
+		}
+	}
+
+	return nil
+}
+
+// restoreForDeletion restores the control plane of a hibernated cluster that is being deleted,
+// so that the resources inside the cluster can be cleaned up. The cluster is not woken up: the
+// kubernetes controller only cleans up clusters in deletion and the MachineDeployments are about
+// to be removed anyway, so there is nothing to wait for.
+func (r *Reconciler) restoreForDeletion(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (reconcile.Result, error) {
+	if err := r.restoreControlPlane(ctx, log, cluster); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err := util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.Hibernation.State = ""
+		c.Status.Hibernation.MachineDeploymentReplicas = nil
+		c.Status.Hibernation.ControlPlaneReplicas = nil
+		c.Status.Hibernation.LastTransitionTime = metav1.Now()
+	})
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	log.Info("Restored control plane of hibernated cluster for its deletion")
+
+	return reconcile.Result{}, nil
+}
+
+// wakeUp restores the control plane, waits for it to become healthy and then scales the
+// MachineDeployments back up.
+func (r *Reconciler) wakeUp(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (reconcile.Result, error) {
+	if err := r.restoreControlPlane(ctx, log, cluster); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	health := cluster.Status.ExtendedHealth
+	// MachineController is not deployed on Edge clusters, where its health status is empty.
+	if !health.ControlPlaneHealthy() || health.MachineController == kubermaticv1.HealthStatusDown {
+		return reconcile.Result{RequeueAfter: progressInterval}, nil
+	}
+
+	if len(cluster.Status.Hibernation.MachineDeploymentReplicas) > 0 {
+		userClusterClient, err := r.userClusterConnectionProvider.GetClient(ctx, cluster)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to get usercluster client: %w", err)
+		}
+
+		for name, replicas := range cluster.Status.Hibernation.MachineDeploymentReplicas {
+			md := &clusterv1alpha1.MachineDeployment{}
+			if err := userClusterClient.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: metav1.NamespaceSystem, Name: name}, md); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return reconcile.Result{}, fmt.Errorf("failed to get MachineDeployment %s: %w", name, err)
+			}
+
+			if ptr.Deref(md.Spec.Replicas, 1) != 0 {
+				// scaled manually in the meantime
+				continue
+			}
+
+			log.Debugw("Scaling up MachineDeployment", "machinedeployment", name, "replicas", replicas)
+
+			oldMD := md.DeepCopy()
+			md.Spec.Replicas = ptr.To(replicas)
+			if err := userClusterClient.Patch(ctx, md, ctrlruntimeclient.MergeFrom(oldMD)); err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed to scale up MachineDeployment %s: %w", name, err)
+			}
+		}
+	}
+
+	err := util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.Hibernation.State = ""
+		c.Status.Hibernation.MachineDeploymentReplicas = nil
+		c.Status.Hibernation.ControlPlaneReplicas = nil
+		c.Status.Hibernation.LastTransitionTime = metav1.Now()
+	})
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	log.Info("Cluster is woken up")
+	r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "WokenUp", "Reconciling", "Cluster has been woken up")
+
+	return reconcile.Result{}, nil
+}
+
+func controlPlaneReplicasKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+func (r *Reconciler) setState(ctx context.Context, cluster *kubermaticv1.Cluster, state kubermaticv1.ClusterHibernationState) error {
+	err := util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		if c.Status.Hibernation == nil {
+			c.Status.Hibernation = &kubermaticv1.ClusterHibernationStatus{}
+		}
+		c.Status.Hibernation.State = state
+		c.Status.Hibernation.LastTransitionTime = metav1.Now()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,478 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterhibernationcontroller
+
+import (
+	"context"
+	"maps"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var testScheme = fake.NewScheme()
+
+func init() {
+	utilruntime.Must(clusterv1alpha1.AddToScheme(testScheme))
+}
+
+type fakeClientProvider struct {
+	client ctrlruntimeclient.Client
+}
+
+func (p *fakeClientProvider) GetClient(_ context.Context, _ *kubermaticv1.Cluster, _ ...clusterclient.ConfigOption) (ctrlruntimeclient.Client, error) {
+	return p.client, nil
+}
+
+const clusterNamespace = "cluster-testcluster"
+
+func machineDeployment(replicas, statusReplicas int32) *clusterv1alpha1.MachineDeployment {
+	return &clusterv1alpha1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "workers",
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: clusterv1alpha1.MachineDeploymentSpec{
+			Replicas: ptr.To(replicas),
+		},
+		Status: clusterv1alpha1.MachineDeploymentStatus{
+			Replicas: statusReplicas,
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC) // Saturday
+
+	apiserver := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "apiserver",
+			Namespace: clusterNamespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](2),
+		},
+	}
+
+	etcd := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "etcd",
+			Namespace: clusterNamespace,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To[int32](3),
+		},
+	}
+
+	healthy := kubermaticv1.ExtendedClusterHealth{
+		Etcd:              kubermaticv1.HealthStatusUp,
+		Controller:        kubermaticv1.HealthStatusUp,
+		Apiserver:         kubermaticv1.HealthStatusUp,
+		Scheduler:         kubermaticv1.HealthStatusUp,
+		MachineController: kubermaticv1.HealthStatusUp,
+	}
+
+	savedControlPlaneReplicas := map[string]int32{"Deployment/apiserver": 2, "StatefulSet/etcd": 3}
+
+	testcases := []struct {
+		name                      string
+		deleted                   bool
+		settings                  *kubermaticv1.ClusterHibernationSettings
+		status                    *kubermaticv1.ClusterHibernationStatus
+		health                    kubermaticv1.ExtendedClusterHealth
+		machineDeployment         *clusterv1alpha1.MachineDeployment
+		controlPlaneDown          bool
+		expectedHibernated        bool
+		expectedState             kubermaticv1.ClusterHibernationState
+		expectedSavedReplicas     map[string]int32
+		expectedSavedControlPlane map[string]int32
+		expectedMDReplicas        int32
+		expectedControlPlaneDown  bool
+	}{
+		{
+			name:               "awake cluster without hibernation",
+			machineDeployment:  machineDeployment(3, 3),
+			expectedMDReplicas: 3,
+		},
+		{
+			name:               "start hibernating",
+			settings:           &kubermaticv1.ClusterHibernationSettings{Hibernated: true},
+			machineDeployment:  machineDeployment(3, 3),
+			expectedHibernated: true,
+			expectedState:      kubermaticv1.ClusterHibernationStateHibernating,
+			expectedMDReplicas: 3,
+		},
+		{
+			name:                  "scale down MachineDeployments",
+			settings:              &kubermaticv1.ClusterHibernationSettings{Hibernated: true},
+			status:                &kubermaticv1.ClusterHibernationStatus{State: kubermaticv1.ClusterHibernationStateHibernating},
+			machineDeployment:     machineDeployment(3, 3),
+			expectedHibernated:    true,
+			expectedState:         kubermaticv1.ClusterHibernationStateHibernating,
+			expectedSavedReplicas: map[string]int32{"workers": 3},
+			expectedMDReplicas:    0,
+		},
+		{
+			name:     "scale down control plane once machines are gone",
+			settings: &kubermaticv1.ClusterHibernationSettings{Hibernated: true},
+			status: &kubermaticv1.ClusterHibernationStatus{
+				State:                     kubermaticv1.ClusterHibernationStateHibernating,
+				MachineDeploymentReplicas: map[string]int32{"workers": 3},
+			},
+			health:                    healthy,
+			machineDeployment:         machineDeployment(0, 0),
+			expectedHibernated:        true,
+			expectedState:             kubermaticv1.ClusterHibernationStateHibernated,
+			expectedSavedReplicas:     map[string]int32{"workers": 3},
+			expectedSavedControlPlane: savedControlPlaneReplicas,
+			expectedMDReplicas:        0,
+			expectedControlPlaneDown:  true,
+		},
+		{
+			name:     "start waking up",
+			settings: &kubermaticv1.ClusterHibernationSettings{Hibernated: false},
+			status: &kubermaticv1.ClusterHibernationStatus{
+				State:                     kubermaticv1.ClusterHibernationStateHibernated,
+				MachineDeploymentReplicas: map[string]int32{"workers": 3},
+				ControlPlaneReplicas:      savedControlPlaneReplicas,
+			},
+			machineDeployment:         machineDeployment(0, 0),
+			controlPlaneDown:          true,
+			expectedState:             kubermaticv1.ClusterHibernationStateWakingUp,
+			expectedSavedReplicas:     map[string]int32{"workers": 3},
+			expectedSavedControlPlane: savedControlPlaneReplicas,
+			expectedMDReplicas:        0,
+			expectedControlPlaneDown:  true,
+		},
+		{
+			name:     "restore control plane at once and wait for it before scaling up",
+			settings: &kubermaticv1.ClusterHibernationSettings{Hibernated: false},
+			status: &kubermaticv1.ClusterHibernationStatus{
+				State:                     kubermaticv1.ClusterHibernationStateWakingUp,
+				MachineDeploymentReplicas: map[string]int32{"workers": 3},
+				ControlPlaneReplicas:      savedControlPlaneReplicas,
+			},
+			machineDeployment:         machineDeployment(0, 0),
+			controlPlaneDown:          true,
+			expectedState:             kubermaticv1.ClusterHibernationStateWakingUp,
+			expectedSavedReplicas:     map[string]int32{"workers": 3},
+			expectedSavedControlPlane: savedControlPlaneReplicas,
+			expectedMDReplicas:        0,
+		},
+		{
+			name:     "restore MachineDeployments",
+			settings: &kubermaticv1.ClusterHibernationSettings{Hibernated: false},
+			status: &kubermaticv1.ClusterHibernationStatus{
+				State:                     kubermaticv1.ClusterHibernationStateWakingUp,
+				MachineDeploymentReplicas: map[string]int32{"workers": 3},
+				ControlPlaneReplicas:      savedControlPlaneReplicas,
+			},
+			health:             healthy,
+			machineDeployment:  machineDeployment(0, 0),
+			expectedMDReplicas: 3,
+		},
+		{
+			name:     "deleted hibernated cluster gets its control plane back without waking up",
+			deleted:  true,
+			settings: &kubermaticv1.ClusterHibernationSettings{Hibernated: true},
+			status: &kubermaticv1.ClusterHibernationStatus{
+				State:                     kubermaticv1.ClusterHibernationStateHibernated,
+				MachineDeploymentReplicas: map[string]int32{"workers": 3},
+				ControlPlaneReplicas:      savedControlPlaneReplicas,
+			},
+			machineDeployment:  machineDeployment(0, 0),
+			controlPlaneDown:   true,
+			expectedHibernated: true,
+			expectedMDReplicas: 0,
+		},
+		{
+			name: "schedule hibernates cluster",
+			settings: &kubermaticv1.ClusterHibernationSettings{
+				Schedule: &kubermaticv1.ClusterHibernationSchedule{Hibernate: "0 20 * * 1-5", WakeUp: "0 7 * * 1-5"},
+			},
+			machineDeployment:  machineDeployment(3, 3),
+			expectedHibernated: true,
+			expectedState:      kubermaticv1.ClusterHibernationStateHibernating,
+			expectedMDReplicas: 3,
+		},
+		{
+			name: "manual wake up is kept until the next scheduled transition",
+			settings: &kubermaticv1.ClusterHibernationSettings{
+				Schedule: &kubermaticv1.ClusterHibernationSchedule{Hibernate: "0 20 * * 1-5", WakeUp: "0 7 * * 1-5"},
+			},
+			status: &kubermaticv1.ClusterHibernationStatus{
+				LastScheduledTransition: ptr.To(metav1.NewTime(time.Date(2026, 10, 16, 20, 0, 0, 0, time.UTC))),
+			},
+			machineDeployment:  machineDeployment(3, 3),
+			expectedMDReplicas: 3,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "testcluster",
+				},
+				Spec: kubermaticv1.ClusterSpec{
+					Hibernation: tt.settings,
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName:  clusterNamespace,
+					ExtendedHealth: tt.health,
+					Hibernation:    tt.status,
+				},
+			}
+
+			if tt.deleted {
+				cluster.Finalizers = []string{"test"}
+				cluster.DeletionTimestamp = &metav1.Time{Time: now}
+			}
+
+			apiserver := apiserver.DeepCopy()
+			etcd := etcd.DeepCopy()
+			if tt.controlPlaneDown {
+				apiserver.Spec.Replicas = ptr.To[int32](0)
+				etcd.Spec.Replicas = ptr.To[int32](0)
+			}
+
+			userClusterClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(tt.machineDeployment).Build()
+
+			rec := &Reconciler{
+				Client:                        fake.NewClientBuilder().WithObjects(cluster, apiserver, etcd).Build(),
+				userClusterConnectionProvider: &fakeClientProvider{client: userClusterClient},
+				log:                           zap.NewNop().Sugar(),
+				recorder:                      events.NewFakeRecorder(10),
+				now:                           func() time.Time { return now },
+			}
+
+			if _, err := rec.reconcile(ctx, rec.log, cluster); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			newCluster := &kubermaticv1.Cluster{}
+			if err := rec.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), newCluster); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			hibernated := newCluster.Spec.Hibernation != nil && newCluster.Spec.Hibernation.Hibernated
+			if hibernated != tt.expectedHibernated {
+				t.Errorf("Expected hibernated = %v, got %v.", tt.expectedHibernated, hibernated)
+			}
+
+			var (
+				state         kubermaticv1.ClusterHibernationState
+				savedReplicas map[string]int32
+				savedCP       map[string]int32
+			)
+			if newCluster.Status.Hibernation != nil {
+				state = newCluster.Status.Hibernation.State
+				savedReplicas = newCluster.Status.Hibernation.MachineDeploymentReplicas
+				savedCP = newCluster.Status.Hibernation.ControlPlaneReplicas
+			}
+
+			if state != tt.expectedState {
+				t.Errorf("Expected state %q, got %q.", tt.expectedState, state)
+			}
+
+			if len(savedReplicas) != len(tt.expectedSavedReplicas) || savedReplicas["workers"] != tt.expectedSavedReplicas["workers"] {
+				t.Errorf("Expected saved replicas %v, got %v.", tt.expectedSavedReplicas, savedReplicas)
+			}
+
+			if !maps.Equal(savedCP, tt.expectedSavedControlPlane) {
+				t.Errorf("Expected saved control plane replicas %v, got %v.", tt.expectedSavedControlPlane, savedCP)
+			}
+
+			md := &clusterv1alpha1.MachineDeployment{}
+			if err := userClusterClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(tt.machineDeployment), md); err != nil {
+				t.Fatalf("Failed to get MachineDeployment: %v", err)
+			}
+
+			if replicas := ptr.Deref(md.Spec.Replicas, 1); replicas != tt.expectedMDReplicas {
+				t.Errorf("Expected MachineDeployment to have %d replicas, got %d.", tt.expectedMDReplicas, replicas)
+			}
+
+			deployment := &appsv1.Deployment{}
+			if err := rec.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(apiserver), deployment); err != nil {
+				t.Fatalf("Failed to get Deployment: %v", err)
+			}
+
+			statefulSet := &appsv1.StatefulSet{}
+			if err := rec.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(etcd), statefulSet); err != nil {
+				t.Fatalf("Failed to get StatefulSet: %v", err)
+			}
+
+			controlPlaneDown := *deployment.Spec.Replicas == 0 && *statefulSet.Spec.Replicas == 0
+			if controlPlaneDown != tt.expectedControlPlaneDown {
+				t.Errorf("Expected control plane down = %v, got %v.", tt.expectedControlPlaneDown, controlPlaneDown)
+			}
+
+			if !tt.expectedControlPlaneDown && (*deployment.Spec.Replicas != 2 || *statefulSet.Spec.Replicas != 3) {
+				t.Errorf("Expected control plane to have 2 apiserver and 3 etcd replicas, got %d and %d.", *deployment.Spec.Replicas, *statefulSet.Spec.Replicas)
+			}
+
+			if tt.expectedControlPlaneDown && newCluster.Status.ExtendedHealth.ControlPlaneHealthy() {
+				t.Error("Expected control plane health to be reset, but it is still healthy.")
+			}
+		})
+	}
+}
+
+func TestHibernateAndWakeUp(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "testcluster",
+		},
+		Spec: kubermaticv1.ClusterSpec{
+			Hibernation: &kubermaticv1.ClusterHibernationSettings{Hibernated: true},
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: clusterNamespace,
+			ExtendedHealth: kubermaticv1.ExtendedClusterHealth{
+				Etcd:              kubermaticv1.HealthStatusUp,
+				Controller:        kubermaticv1.HealthStatusUp,
+				Apiserver:         kubermaticv1.HealthStatusUp,
+				Scheduler:         kubermaticv1.HealthStatusUp,
+				MachineController: kubermaticv1.HealthStatusUp,
+			},
+		},
+	}
+
+	etcd := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "etcd",
+			Namespace: clusterNamespace,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To[int32](3),
+		},
+	}
+
+	md := machineDeployment(3, 0)
+	userClusterClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(md).Build()
+
+	rec := &Reconciler{
+		Client:                        fake.NewClientBuilder().WithObjects(cluster, etcd).Build(),
+		userClusterConnectionProvider: &fakeClientProvider{client: userClusterClient},
+		log:                           zap.NewNop().Sugar(),
+		recorder:                      events.NewFakeRecorder(10),
+		now:                           time.Now,
+	}
+
+	// reconcile fetches the current cluster, reconciles it and returns the updated cluster
+	reconcile := func() *kubermaticv1.Cluster {
+		t.Helper()
+
+		current := &kubermaticv1.Cluster{}
+		if err := rec.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), current); err != nil {
+			t.Fatalf("Failed to get cluster: %v", err)
+		}
+
+		if _, err := rec.reconcile(ctx, rec.log, current); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if err := rec.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), current); err != nil {
+			t.Fatalf("Failed to get cluster: %v", err)
+		}
+
+		return current
+	}
+
+	etcdReplicas := func() int32 {
+		t.Helper()
+
+		statefulSet := &appsv1.StatefulSet{}
+		if err := rec.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(etcd), statefulSet); err != nil {
+			t.Fatalf("Failed to get StatefulSet: %v", err)
+		}
+
+		return ptr.Deref(statefulSet.Spec.Replicas, 1)
+	}
+
+	// Hibernating, then Hibernated once the (already gone) machines are scaled down
+	reconcile()
+	if current := reconcile(); !current.Status.IsHibernated() {
+		t.Fatalf("Expected cluster to be hibernated, got state %q.", current.Status.Hibernation.State)
+	}
+
+	if replicas := etcdReplicas(); replicas != 0 {
+		t.Fatalf("Expected etcd to be scaled down, but it has %d replicas.", replicas)
+	}
+
+	current := &kubermaticv1.Cluster{}
+	if err := rec.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), current); err != nil {
+		t.Fatalf("Failed to get cluster: %v", err)
+	}
+
+	oldCluster := current.DeepCopy()
+	current.Spec.Hibernation.Hibernated = false
+	if err := rec.Patch(ctx, current, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+		t.Fatalf("Failed to wake up cluster: %v", err)
+	}
+
+	// WakingUp, then etcd must be restored to its full size while it is still down,
+	// as it cannot become healthy with a single member out of three
+	reconcile()
+	if current := reconcile(); current.Status.Hibernation.State != kubermaticv1.ClusterHibernationStateWakingUp {
+		t.Fatalf("Expected cluster to be waking up, got state %q.", current.Status.Hibernation.State)
+	}
+
+	if replicas := etcdReplicas(); replicas != 3 {
+		t.Fatalf("Expected etcd to be restored to 3 replicas, but it has %d.", replicas)
+	}
+
+	// the kubernetes controller reports the control plane as healthy again
+	if err := rec.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), current); err != nil {
+		t.Fatalf("Failed to get cluster: %v", err)
+	}
+
+	oldCluster = current.DeepCopy()
+	current.Status.ExtendedHealth = cluster.Status.ExtendedHealth
+	if err := rec.Status().Patch(ctx, current, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+		t.Fatalf("Failed to update cluster health: %v", err)
+	}
+
+	if current := reconcile(); current.Status.Hibernation.State != "" {
+		t.Fatalf("Expected cluster to be awake, got state %q.", current.Status.Hibernation.State)
+	}
+
+	if err := userClusterClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(md), md); err != nil {
+		t.Fatalf("Failed to get MachineDeployment: %v", err)
+	}
+
+	if replicas := ptr.Deref(md.Spec.Replicas, 1); replicas != 3 {
+		t.Errorf("Expected MachineDeployment to be restored to 3 replicas, got %d.", replicas)
+	}
+}
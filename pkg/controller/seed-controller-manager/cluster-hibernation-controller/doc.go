@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package clusterhibernationcontroller contains a controller that hibernates and wakes up
+user clusters.
+
+To hibernate a cluster, the controller remembers the number of replicas of all
+MachineDeployments in the kube-system namespace of the user cluster and scales them
+to zero. Once all machines are gone, the cluster is marked as Hibernated: the
+Deployments and StatefulSets of the control plane (including etcd) in the cluster
+namespace are scaled to zero as well, while their replicas are remembered and their
+PersistentVolumeClaims are kept. Other controllers do not reconcile hibernated clusters.
+
+Waking up a cluster happens in reverse order: the cluster is marked as WakingUp and the
+control plane is scaled back to its previous number of replicas at once, as etcd cannot
+regain its quorum one member at a time. Once the control plane is healthy again, the
+MachineDeployments are scaled back to their previous number of replicas.
+
+When a hibernated cluster is deleted, its control plane is restored as well, so that
+the resources inside the cluster can be cleaned up, but the MachineDeployments are not.
+
+If the cluster has a hibernation schedule, the controller sets `spec.hibernation.hibernated`
+whenever the schedule's next hibernation or wake up time has passed.
+*/
+package clusterhibernationcontroller
@@ -95,6 +95,11 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 		return r.setClusterPhase(ctx, cluster, kubermaticv1.ClusterTerminating)
 	}
 
+	// a hibernated cluster has no running control plane or nodes
+	if cluster.Status.IsHibernated() {
+		return r.setClusterPhase(ctx, cluster, kubermaticv1.ClusterHibernated)
+	}
+
 	// if this cluster was never fully reconciled (yet), it is in Creating phase
 	if !util.IsClusterInitialized(cluster, r.versions) {
 		return r.setClusterPhase(ctx, cluster, kubermaticv1.ClusterCreating)
@@ -28,6 +28,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/resources"
 	utilcluster "k8c.io/kubermatic/v2/pkg/util/cluster"
 	"k8c.io/kubermatic/v2/pkg/util/workerlabel"
+	"k8c.io/kubermatic/v2/pkg/validation"
 	"k8c.io/reconciler/pkg/reconciling"
 
 	corev1 "k8s.io/api/core/v1"
@@ -35,6 +36,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -53,6 +55,7 @@ type reconciler struct {
 	workerName              string
 	recorder                events.EventRecorder
 	namespace               string
+	seedName                string
 	seedClient              ctrlruntimeclient.Client
 }
 
@@ -61,6 +64,7 @@ func Add(
 	log *zap.SugaredLogger,
 	workerName string,
 	namespace string,
+	seedName string,
 	numWorkers int,
 ) error {
 	workerSelector, err := workerlabel.LabelSelector(workerName)
@@ -74,6 +78,7 @@ func Add(
 		workerName:              workerName,
 		recorder:                mgr.GetEventRecorder(ControllerName),
 		namespace:               namespace,
+		seedName:                seedName,
 		seedClient:              mgr.GetClient(),
 	}
 
@@ -150,6 +155,10 @@ func (r *reconciler) createClusters(ctx context.Context, instance *kubermaticv1.
 			return fmt.Errorf("failed to get template %s: %w", instance.Spec.ClusterTemplateID, err)
 		}
 
+		if err := r.validateProjectRestrictions(ctx, template, instance); err != nil {
+			return err
+		}
+
 		for i := range instance.Spec.Replicas {
 			if err := r.createCluster(ctx, log, template, instance); err != nil {
 				created := i
@@ -169,6 +178,20 @@ func (r *reconciler) createClusters(ctx context.Context, instance *kubermaticv1.
 	return nil
 }
 
+// validateProjectRestrictions ensures that the template can be used for clusters in the target project.
+func (r *reconciler) validateProjectRestrictions(ctx context.Context, template *kubermaticv1.ClusterTemplate, instance *kubermaticv1.ClusterTemplateInstance) error {
+	project := &kubermaticv1.Project{}
+	if err := r.seedClient.Get(ctx, ctrlruntimeclient.ObjectKey{Name: instance.Spec.ProjectID}, project); err != nil {
+		return fmt.Errorf("failed to get project %s: %w", instance.Spec.ProjectID, err)
+	}
+
+	if errs := validation.ValidateProjectRestrictions(project, &template.Spec, nil, r.seedName, field.NewPath("spec")); len(errs) > 0 {
+		return fmt.Errorf("template %s violates the restrictions of project %s: %w", template.Name, project.Name, errs.ToAggregate())
+	}
+
+	return nil
+}
+
 func (r *reconciler) createCluster(ctx context.Context, log *zap.SugaredLogger, template *kubermaticv1.ClusterTemplate, instance *kubermaticv1.ClusterTemplateInstance) error {
 	// This is temporary cluster with cloud spec from the template.
 	// It holds credential for the new cluster
@@ -32,6 +32,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -42,13 +43,18 @@ func TestReconcile(t *testing.T) {
 		t.Fatalf("failed to build worker-name selector: %v", err)
 	}
 	seedNamespace := "namespace"
-	projectName := generator.GenDefaultProject().Name
+	project := generator.GenDefaultProject()
+	projectName := project.Name
+
+	restrictedProject := project.DeepCopy()
+	restrictedProject.Spec.AllowedDatacenters = []string{"eu-dc"}
 
 	testCases := []struct {
 		name                 string
 		namespacedName       types.NamespacedName
 		expectedClusters     []*kubermaticv1.Cluster
 		expectedGetErrStatus metav1.StatusReason
+		expectedReconcileErr bool
 		seedClient           ctrlruntimeclient.Client
 	}{
 		{
@@ -64,6 +70,7 @@ func TestReconcile(t *testing.T) {
 			seedClient: fake.
 				NewClientBuilder().
 				WithObjects(
+					project,
 					generator.GenClusterTemplate("ct1", "ctID1", projectName, kubermaticv1.UserClusterTemplateScope, "bob@acme.com"),
 					generator.GenClusterTemplate("ct2", "ctID2", "", kubermaticv1.GlobalClusterTemplateScope, "john@acme.com"),
 					generator.GenClusterTemplate("ct3", "ctID3", projectName, kubermaticv1.UserClusterTemplateScope, "john@acme.com"),
@@ -74,6 +81,22 @@ func TestReconcile(t *testing.T) {
 				).
 				Build(),
 		},
+		{
+			name: "scenario 2: does not generate clusters in datacenters forbidden by the project",
+			namespacedName: types.NamespacedName{
+				Name: "my-first-project-ID-ctID2",
+			},
+			expectedClusters:     []*kubermaticv1.Cluster{},
+			expectedReconcileErr: true,
+			seedClient: fake.
+				NewClientBuilder().
+				WithObjects(
+					restrictedProject,
+					generator.GenClusterTemplate("ct2", "ctID2", "", kubermaticv1.GlobalClusterTemplateScope, "john@acme.com"),
+					generator.GenClusterTemplateInstance(projectName, "ctID2", "bob@acme.com", 3),
+				).
+				Build(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -84,11 +107,12 @@ func TestReconcile(t *testing.T) {
 				log:                     kubermaticlog.Logger,
 				workerNameLabelSelector: workerSelector,
 				seedClient:              tc.seedClient,
+				recorder:                events.NewFakeRecorder(10),
 			}
 
 			request := reconcile.Request{NamespacedName: tc.namespacedName}
-			if _, err := r.Reconcile(ctx, request); err != nil {
-				t.Fatalf("reconciling failed: %v", err)
+			if _, err := r.Reconcile(ctx, request); (err != nil) != tc.expectedReconcileErr {
+				t.Fatalf("expected reconcile error = %v, got: %v", tc.expectedReconcileErr, err)
 			}
 
 			clusterTemplateLabelSelector := ctrlruntimeclient.MatchingLabels{kubermaticv1.ClusterTemplateInstanceLabelKey: tc.namespacedName.Name}
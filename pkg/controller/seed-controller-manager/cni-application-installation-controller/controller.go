@@ -86,6 +86,7 @@ type Reconciler struct {
 	versions                      kubermatic.Versions
 	systemAppEnforceInterval      int
 	overwriteRegistry             string
+	now                           func() time.Time
 }
 
 func Add(ctx context.Context, mgr manager.Manager, numWorkers int, workerName string, systemAppEnforceInterval int, userClusterConnectionProvider UserClusterClientProvider, log *zap.SugaredLogger, versions kubermatic.Versions, overwriteRegistry string) error {
@@ -98,6 +99,7 @@ func Add(ctx context.Context, mgr manager.Manager, numWorkers int, workerName st
 		log:                           log.Named(ControllerName),
 		versions:                      versions,
 		overwriteRegistry:             overwriteRegistry,
+		now:                           time.Now,
 	}
 
 	clusterEventPredicate := predicate.Funcs{
@@ -198,6 +200,12 @@ func (r *Reconciler) reconcile(ctx context.Context, logger *zap.SugaredLogger, c
 
 	log.Debug("Reconciling CNI")
 
+	// Pick up a requested CNI migration before installing Cilium, so that Cilium does not
+	// take over the networking of any node before it has been migrated.
+	if err := r.startCNIMigration(ctx, log, cluster); err != nil {
+		return &reconcile.Result{}, err
+	}
+
 	// Ensure legacy CNI addon is removed if it was deployed as older CNI version
 	requeueAfter, err := r.ensureLegacyCNIAddonIsRemoved(ctx, cluster)
 	if err != nil {
@@ -235,6 +243,10 @@ func (r *Reconciler) reconcile(ctx context.Context, logger *zap.SugaredLogger, c
 		}
 	}
 
+	if cluster.Status.CNIMigration.InProgress() {
+		return r.reconcileCNIMigration(ctx, log, cluster)
+	}
+
 	result := &reconcile.Result{}
 	if r.systemAppEnforceInterval != 0 {
 		// Reconciliation was successful, but requeue in systemAppEnforceInterval minutes if set.
@@ -255,6 +267,11 @@ func (r *Reconciler) ensureLegacyCNIAddonIsRemoved(ctx context.Context, cluster
 		addons = append(addons, "hubble")
 	}
 
+	return r.ensureAddonsAreRemoved(ctx, cluster, addons)
+}
+
+// ensureAddonsAreRemoved uninstalls the given addons and requeues after 5 seconds until they are gone.
+func (r *Reconciler) ensureAddonsAreRemoved(ctx context.Context, cluster *kubermaticv1.Cluster, addons []string) (time.Duration, error) {
 	requeueAfter := time.Duration(0)
 	for _, addon := range addons {
 		cniAddon := &kubermaticv1.Addon{
@@ -370,6 +387,9 @@ func ApplicationInstallationReconciler(cluster *kubermaticv1.Cluster, overwriteR
 				return app, fmt.Errorf("failed to merge CNI values: %w", err)
 			}
 			ensureCiliumNodeLocalDNSExcludeLocalAddress(cluster, values)
+			if err := setCNIMigrationValues(cluster, values); err != nil {
+				return app, fmt.Errorf("failed to set CNI migration values: %w", err)
+			}
 
 			// Set new values
 			rawValues, err := yaml.Marshal(values)
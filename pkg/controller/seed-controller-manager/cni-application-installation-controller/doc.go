@@ -19,5 +19,10 @@ Package cniapplicationinstallationcontroller contains a controller that watches
 Cluster resources, and if the CNI for the Cluster is managed by the Applications infra,
 reconciles ApplicationInstallation Resources in the user cluster
 with necessary CNI configuration in ApplicationInstallation's Values.
+
+It also drives the in-place migration of clusters from Canal to Cilium: Cilium is installed
+alongside Canal, the nodes are migrated one at a time (cordon, relabel, restart pods, uncordon)
+and Canal is removed once all nodes have been migrated. The progress is tracked in the
+Cluster's status.
 */
 package cniapplicationinstallationcontroller
@@ -0,0 +1,623 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cniapplicationinstallationcontroller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
+	"k8c.io/kubermatic/v2/pkg/controller/util"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// The in-place migration from Canal to Cilium follows the upstream migration guide, see
+// https://docs.cilium.io/en/stable/installation/k8s-install-migration/
+const (
+	// cniMigrationInterval is used while waiting for the migration to progress.
+	cniMigrationInterval = 10 * time.Second
+
+	// ciliumMigrationNodeLabel selects the nodes on which Cilium has taken over the networking.
+	ciliumMigrationNodeLabel = "io.cilium.migration/cilium-default"
+	// ciliumMigrationNodeConfigName is the name of the CiliumNodeConfig that lets Cilium take
+	// over the networking of the nodes selected by the ciliumMigrationNodeLabel.
+	ciliumMigrationNodeConfigName = "cilium-default"
+	// ciliumMigrationTunnelPort is the VXLAN port used by Cilium, as Canal's flannel already
+	// uses the default port. It is kept after the migration, as changing it would interrupt
+	// the pod network.
+	ciliumMigrationTunnelPort = 8473
+
+	// cniMigrationCordonedAnnotation marks nodes cordoned by the CNI migration, so that nodes
+	// cordoned by users are not uncordoned.
+	cniMigrationCordonedAnnotation = "cni-migration.k8c.io/cordoned"
+
+	ciliumDaemonSetName  = "cilium"
+	ciliumAgentPodLabel  = "k8s-app"
+	ciliumAgentPodValue  = "cilium"
+	podNodeNameFieldPath = "spec.nodeName"
+)
+
+// setCNIMigrationValues sets the Cilium values required during an in-place CNI migration.
+func setCNIMigrationValues(cluster *kubermaticv1.Cluster, values map[string]any) error {
+	var migrationValues map[string]any
+
+	switch {
+	case kubermaticv1helper.CNIMigrationSource(cluster) != nil:
+		// run alongside Canal and only take over the nodes selected by the CiliumNodeConfig
+		migrationValues = map[string]any{
+			"cni.customConf":                       true,
+			"cni.uninstall":                        false,
+			"operator.unmanagedPodWatcher.restart": false,
+			"policyEnforcementMode":                "never",
+			"bpf.hostLegacyRouting":                true,
+			"routingMode":                          "tunnel",
+			"tunnelProtocol":                       "vxlan",
+			"tunnelPort":                           int64(ciliumMigrationTunnelPort),
+		}
+
+	case cluster.Status.CNIMigration != nil && cluster.Status.CNIMigration.Phase == kubermaticv1.CNIMigrationPhaseRemovingCanal:
+		// all nodes use Cilium, so restore its regular configuration and restart the agents
+		migrationValues = map[string]any{
+			"cni.customConf":                       false,
+			"operator.unmanagedPodWatcher.restart": true,
+			"policyEnforcementMode":                "default",
+			"bpf.hostLegacyRouting":                false,
+			"rollOutCiliumPods":                    true,
+		}
+	}
+
+	for path, value := range migrationValues {
+		if err := unstructured.SetNestedField(values, value, strings.Split(path, ".")...); err != nil {
+			return fmt.Errorf("failed to set %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// startCNIMigration starts a migration requested via the CNIMigrationSourceAnnotation.
+func (r *Reconciler) startCNIMigration(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
+	annotation, ok := cluster.Annotations[kubermaticv1.CNIMigrationSourceAnnotation]
+	if !ok {
+		return nil
+	}
+
+	if !cluster.Status.CNIMigration.InProgress() {
+		source := kubermaticv1.CNIMigrationSource{}
+		if err := json.Unmarshal([]byte(annotation), &source); err != nil {
+			return fmt.Errorf("cannot unmarshal CNI migration source annotation: %w", err)
+		}
+
+		now := metav1.NewTime(r.now())
+		err := util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+			c.Status.CNIMigration = &kubermaticv1.CNIMigrationStatus{
+				Phase:              kubermaticv1.CNIMigrationPhaseInstallingCilium,
+				Source:             source,
+				StartTime:          now,
+				LastTransitionTime: now,
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("failed to start CNI migration: %w", err)
+		}
+
+		log.Infow("CNI migration started", "from", source.Type, "to", cluster.Spec.CNIPlugin.Type)
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "CNIMigrationStarted", "Reconciling", "Started migration from %s %s to %s %s", source.Type, source.Version, cluster.Spec.CNIPlugin.Type, cluster.Spec.CNIPlugin.Version)
+	}
+
+	oldCluster := cluster.DeepCopy()
+	delete(cluster.Annotations, kubermaticv1.CNIMigrationSourceAnnotation)
+
+	return r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster))
+}
+
+func (r *Reconciler) reconcileCNIMigration(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	migration := cluster.Status.CNIMigration
+	log = log.With("phase", migration.Phase)
+
+	userClusterClient, err := r.userClusterConnectionProvider.GetClient(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user cluster client: %w", err)
+	}
+
+	switch migration.Phase {
+	case kubermaticv1.CNIMigrationPhaseInstallingCilium:
+		return r.installCilium(ctx, log, cluster, userClusterClient)
+	case kubermaticv1.CNIMigrationPhaseMigratingNodes:
+		return r.migrateNodes(ctx, log, cluster, userClusterClient)
+	case kubermaticv1.CNIMigrationPhaseRemovingCanal:
+		return r.removeCanal(ctx, log, cluster, userClusterClient)
+	}
+
+	return &reconcile.Result{}, nil
+}
+
+// installCilium waits for Cilium to run alongside Canal and then allows it to take over the
+// networking of migrated nodes.
+func (r *Reconciler) installCilium(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, userClusterClient ctrlruntimeclient.Client) (*reconcile.Result, error) {
+	if message, err := ciliumRolloutMessage(ctx, userClusterClient); err != nil || message != "" {
+		return r.waitForCNIMigration(ctx, cluster, message, err)
+	}
+
+	if err := ensureCiliumMigrationNodeConfig(ctx, userClusterClient); err != nil {
+		if meta.IsNoMatchError(err) {
+			return r.waitForCNIMigration(ctx, cluster, "waiting for the CiliumNodeConfig CRD to be installed", nil)
+		}
+
+		return nil, err
+	}
+
+	return r.setCNIMigrationPhase(ctx, log, cluster, kubermaticv1.CNIMigrationPhaseMigratingNodes)
+}
+
+// migrateNodes migrates one node at a time to Cilium.
+func (r *Reconciler) migrateNodes(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, userClusterClient ctrlruntimeclient.Client) (*reconcile.Result, error) {
+	nodes := &corev1.NodeList{}
+	if err := userClusterClient.List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	nodeStatuses := syncNodeStatuses(cluster.Status.CNIMigration.Nodes, nodes.Items, metav1.NewTime(r.now()))
+	if !equality.Semantic.DeepEqual(nodeStatuses, cluster.Status.CNIMigration.Nodes) {
+		if err := r.updateCNIMigration(ctx, cluster, func(m *kubermaticv1.CNIMigrationStatus) {
+			m.Nodes = nodeStatuses
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	current := currentNode(nodeStatuses)
+	if current == nil {
+		return r.setCNIMigrationPhase(ctx, log, cluster, kubermaticv1.CNIMigrationPhaseRemovingCanal)
+	}
+
+	idx := slices.IndexFunc(nodes.Items, func(node corev1.Node) bool {
+		return node.Name == current.Name
+	})
+	node := &nodes.Items[idx]
+	log = log.With("node", node.Name)
+
+	switch current.Phase {
+	case kubermaticv1.CNIMigrationNodePhasePending:
+		if err := cordonAndLabelNode(ctx, userClusterClient, node); err != nil {
+			return nil, err
+		}
+
+		// restart the Cilium agent, so that it picks up the CiliumNodeConfig for this node
+		if err := deleteCiliumAgents(ctx, userClusterClient, node.Name); err != nil {
+			return nil, err
+		}
+
+		return r.setCNIMigrationNodePhase(ctx, log, cluster, node.Name, kubermaticv1.CNIMigrationNodePhaseMigrating)
+
+	case kubermaticv1.CNIMigrationNodePhaseMigrating:
+		ready, err := ciliumAgentRestarted(ctx, userClusterClient, node.Name, current.LastTransitionTime)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ready {
+			return r.waitForCNIMigration(ctx, cluster, fmt.Sprintf("waiting for the Cilium agent on node %s to take over", node.Name), nil)
+		}
+
+		return r.setCNIMigrationNodePhase(ctx, log, cluster, node.Name, kubermaticv1.CNIMigrationNodePhaseRestartingPods)
+
+	case kubermaticv1.CNIMigrationNodePhaseRestartingPods:
+		remaining, err := restartPods(ctx, userClusterClient, node.Name, current.LastTransitionTime)
+		if err != nil {
+			return nil, err
+		}
+
+		if remaining > 0 {
+			return r.waitForCNIMigration(ctx, cluster, fmt.Sprintf("waiting for %d pods on node %s to be restarted", remaining, node.Name), nil)
+		}
+
+		if err := uncordonNode(ctx, userClusterClient, node); err != nil {
+			return nil, err
+		}
+
+		return r.setCNIMigrationNodePhase(ctx, log, cluster, node.Name, kubermaticv1.CNIMigrationNodePhaseMigrated)
+	}
+
+	return &reconcile.Result{}, nil
+}
+
+// removeCanal waits for Cilium to run with its regular configuration and removes Canal.
+func (r *Reconciler) removeCanal(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, userClusterClient ctrlruntimeclient.Client) (*reconcile.Result, error) {
+	if message, err := ciliumRolloutMessage(ctx, userClusterClient); err != nil || message != "" {
+		return r.waitForCNIMigration(ctx, cluster, message, err)
+	}
+
+	if err := deleteCiliumMigrationNodeConfig(ctx, userClusterClient); err != nil {
+		return nil, err
+	}
+
+	source := cluster.Status.CNIMigration.Source
+	requeueAfter, err := r.ensureAddonsAreRemoved(ctx, cluster, []string{source.Type.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	if requeueAfter > 0 {
+		return r.waitForCNIMigration(ctx, cluster, fmt.Sprintf("waiting for the %s addon to be removed", source.Type), nil)
+	}
+
+	return r.setCNIMigrationPhase(ctx, log, cluster, kubermaticv1.CNIMigrationPhaseCompleted)
+}
+
+func (r *Reconciler) updateCNIMigration(ctx context.Context, cluster *kubermaticv1.Cluster, patch func(m *kubermaticv1.CNIMigrationStatus)) error {
+	err := util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		if c.Status.CNIMigration == nil {
+			c.Status.CNIMigration = &kubermaticv1.CNIMigrationStatus{}
+		}
+		patch(c.Status.CNIMigration)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update CNI migration status: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) setCNIMigrationPhase(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, phase kubermaticv1.CNIMigrationPhase) (*reconcile.Result, error) {
+	err := r.updateCNIMigration(ctx, cluster, func(m *kubermaticv1.CNIMigrationStatus) {
+		m.Phase = phase
+		m.Message = ""
+		m.LastTransitionTime = metav1.NewTime(r.now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infow("CNI migration progressed", "next", phase)
+	r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "CNIMigration"+string(phase), "Reconciling", "CNI migration entered phase %s", phase)
+
+	if phase == kubermaticv1.CNIMigrationPhaseCompleted {
+		return &reconcile.Result{}, nil
+	}
+
+	return &reconcile.Result{RequeueAfter: cniMigrationInterval}, nil
+}
+
+func (r *Reconciler) setCNIMigrationNodePhase(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, nodeName string, phase kubermaticv1.CNIMigrationNodePhase) (*reconcile.Result, error) {
+	err := r.updateCNIMigration(ctx, cluster, func(m *kubermaticv1.CNIMigrationStatus) {
+		for i := range m.Nodes {
+			if m.Nodes[i].Name == nodeName {
+				m.Nodes[i].Phase = phase
+				m.Nodes[i].LastTransitionTime = metav1.NewTime(r.now())
+			}
+		}
+		m.Message = ""
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infow("CNI migration of node progressed", "next", phase)
+	if phase == kubermaticv1.CNIMigrationNodePhaseMigrated {
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "CNIMigrationNodeMigrated", "Reconciling", "Node %s has been migrated to %s", nodeName, cluster.Spec.CNIPlugin.Type)
+	}
+
+	return &reconcile.Result{RequeueAfter: cniMigrationInterval}, nil
+}
+
+// waitForCNIMigration records what the migration is waiting for and requeues the cluster.
+func (r *Reconciler) waitForCNIMigration(ctx context.Context, cluster *kubermaticv1.Cluster, message string, err error) (*reconcile.Result, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.updateCNIMigration(ctx, cluster, func(m *kubermaticv1.CNIMigrationStatus) {
+		m.Message = message
+	}); err != nil {
+		return nil, err
+	}
+
+	return &reconcile.Result{RequeueAfter: cniMigrationInterval}, nil
+}
+
+// syncNodeStatuses adds new nodes as pending and drops nodes that no longer exist.
+func syncNodeStatuses(statuses []kubermaticv1.CNIMigrationNodeStatus, nodes []corev1.Node, now metav1.Time) []kubermaticv1.CNIMigrationNodeStatus {
+	result := []kubermaticv1.CNIMigrationNodeStatus{}
+
+	for _, node := range nodes {
+		idx := slices.IndexFunc(statuses, func(status kubermaticv1.CNIMigrationNodeStatus) bool {
+			return status.Name == node.Name
+		})
+
+		if idx >= 0 {
+			result = append(result, statuses[idx])
+		} else {
+			result = append(result, kubermaticv1.CNIMigrationNodeStatus{
+				Name:               node.Name,
+				Phase:              kubermaticv1.CNIMigrationNodePhasePending,
+				LastTransitionTime: now,
+			})
+		}
+	}
+
+	slices.SortFunc(result, func(a, b kubermaticv1.CNIMigrationNodeStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return result
+}
+
+// currentNode returns the node that is currently migrated, or the next pending node.
+func currentNode(statuses []kubermaticv1.CNIMigrationNodeStatus) *kubermaticv1.CNIMigrationNodeStatus {
+	var next *kubermaticv1.CNIMigrationNodeStatus
+
+	for i, status := range statuses {
+		switch status.Phase {
+		case kubermaticv1.CNIMigrationNodePhaseMigrating, kubermaticv1.CNIMigrationNodePhaseRestartingPods:
+			return &statuses[i]
+		case kubermaticv1.CNIMigrationNodePhasePending:
+			if next == nil {
+				next = &statuses[i]
+			}
+		}
+	}
+
+	return next
+}
+
+// ciliumRolloutMessage returns what the Cilium installation is waiting for, or an empty
+// string if Cilium has been rolled out with its current values.
+func ciliumRolloutMessage(ctx context.Context, userClusterClient ctrlruntimeclient.Client) (string, error) {
+	app := &appskubermaticv1.ApplicationInstallation{}
+	if err := userClusterClient.Get(ctx, types.NamespacedName{Namespace: cniPluginNamespace, Name: kubermaticv1.CNIPluginTypeCilium.String()}, app); err != nil {
+		return "", fmt.Errorf("failed to get Cilium ApplicationInstallation: %w", err)
+	}
+
+	ready := app.Status.Conditions[appskubermaticv1.Ready]
+	if ready.Status != corev1.ConditionTrue || ready.ObservedGeneration != app.Generation {
+		return "waiting for the Cilium ApplicationInstallation to be ready", nil
+	}
+
+	ds := &appsv1.DaemonSet{}
+	if err := userClusterClient.Get(ctx, types.NamespacedName{Namespace: cniPluginNamespace, Name: ciliumDaemonSetName}, ds); err != nil {
+		return "", fmt.Errorf("failed to get Cilium DaemonSet: %w", err)
+	}
+
+	desired := ds.Status.DesiredNumberScheduled
+	if ds.Status.ObservedGeneration < ds.Generation || ds.Status.UpdatedNumberScheduled != desired || ds.Status.NumberReady != desired {
+		return fmt.Sprintf("waiting for the Cilium agents to be rolled out (%d of %d ready)", ds.Status.NumberReady, desired), nil
+	}
+
+	return "", nil
+}
+
+func ciliumMigrationNodeConfig() *unstructured.Unstructured {
+	config := &unstructured.Unstructured{}
+	config.SetAPIVersion("cilium.io/v2")
+	config.SetKind("CiliumNodeConfig")
+	config.SetNamespace(cniPluginNamespace)
+	config.SetName(ciliumMigrationNodeConfigName)
+
+	return config
+}
+
+func ensureCiliumMigrationNodeConfig(ctx context.Context, userClusterClient ctrlruntimeclient.Client) error {
+	config := ciliumMigrationNodeConfig()
+	config.Object["spec"] = map[string]any{
+		"nodeSelector": map[string]any{
+			"matchLabels": map[string]any{
+				ciliumMigrationNodeLabel: "true",
+			},
+		},
+		// match the regular configuration of Cilium without the ebpf proxy mode, which
+		// Canal does not support
+		"defaults": map[string]any{
+			"write-cni-conf-when-ready": "/host/etc/cni/net.d/05-cilium.conflist",
+			"custom-cni-conf":           "false",
+			"cni-chaining-mode":         "portmap",
+			"cni-exclusive":             "false",
+		},
+	}
+
+	if err := userClusterClient.Create(ctx, config); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create CiliumNodeConfig: %w", err)
+	}
+
+	return nil
+}
+
+func deleteCiliumMigrationNodeConfig(ctx context.Context, userClusterClient ctrlruntimeclient.Client) error {
+	err := userClusterClient.Delete(ctx, ciliumMigrationNodeConfig())
+	if err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return fmt.Errorf("failed to delete CiliumNodeConfig: %w", err)
+	}
+
+	return nil
+}
+
+func cordonAndLabelNode(ctx context.Context, userClusterClient ctrlruntimeclient.Client, node *corev1.Node) error {
+	oldNode := node.DeepCopy()
+
+	if !node.Spec.Unschedulable {
+		node.Spec.Unschedulable = true
+		if node.Annotations == nil {
+			node.Annotations = map[string]string{}
+		}
+		node.Annotations[cniMigrationCordonedAnnotation] = ""
+	}
+
+	if node.Labels == nil {
+		node.Labels = map[string]string{}
+	}
+	node.Labels[ciliumMigrationNodeLabel] = "true"
+
+	if err := userClusterClient.Patch(ctx, node, ctrlruntimeclient.MergeFrom(oldNode)); err != nil {
+		return fmt.Errorf("failed to cordon and label node %s: %w", node.Name, err)
+	}
+
+	return nil
+}
+
+func uncordonNode(ctx context.Context, userClusterClient ctrlruntimeclient.Client, node *corev1.Node) error {
+	if _, ok := node.Annotations[cniMigrationCordonedAnnotation]; !ok {
+		return nil
+	}
+
+	oldNode := node.DeepCopy()
+	node.Spec.Unschedulable = false
+	delete(node.Annotations, cniMigrationCordonedAnnotation)
+
+	if err := userClusterClient.Patch(ctx, node, ctrlruntimeclient.MergeFrom(oldNode)); err != nil {
+		return fmt.Errorf("failed to uncordon node %s: %w", node.Name, err)
+	}
+
+	return nil
+}
+
+func listCiliumAgents(ctx context.Context, userClusterClient ctrlruntimeclient.Client, nodeName string) ([]corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := userClusterClient.List(ctx, pods,
+		ctrlruntimeclient.InNamespace(cniPluginNamespace),
+		ctrlruntimeclient.MatchingLabels{ciliumAgentPodLabel: ciliumAgentPodValue},
+		ctrlruntimeclient.MatchingFields{podNodeNameFieldPath: nodeName},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list Cilium agents: %w", err)
+	}
+
+	return pods.Items, nil
+}
+
+func deleteCiliumAgents(ctx context.Context, userClusterClient ctrlruntimeclient.Client, nodeName string) error {
+	pods, err := listCiliumAgents(ctx, userClusterClient, nodeName)
+	if err != nil {
+		return err
+	}
+
+	for i := range pods {
+		if err := userClusterClient.Delete(ctx, &pods[i]); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete Cilium agent %s: %w", pods[i].Name, err)
+		}
+	}
+
+	return nil
+}
+
+// ciliumAgentRestarted returns true if a Cilium agent that was started after the given time
+// is ready on the node.
+func ciliumAgentRestarted(ctx context.Context, userClusterClient ctrlruntimeclient.Client, nodeName string, since metav1.Time) (bool, error) {
+	pods, err := listCiliumAgents(ctx, userClusterClient, nodeName)
+	if err != nil {
+		return false, err
+	}
+
+	for _, pod := range pods {
+		if pod.DeletionTimestamp == nil && !pod.CreationTimestamp.Before(&since) && podReady(&pod) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// restartPods restarts all pods on the node that were started before the given time and
+// still use the previous CNI. Pods are evicted, so that PodDisruptionBudgets are respected,
+// while DaemonSet pods are deleted. It returns the number of pods that still need to be
+// restarted.
+func restartPods(ctx context.Context, userClusterClient ctrlruntimeclient.Client, nodeName string, since metav1.Time) (int, error) {
+	pods := &corev1.PodList{}
+	if err := userClusterClient.List(ctx, pods, ctrlruntimeclient.MatchingFields{podNodeNameFieldPath: nodeName}); err != nil {
+		return 0, fmt.Errorf("failed to list pods on node %s: %w", nodeName, err)
+	}
+
+	remaining := 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !needsRestart(pod, since) {
+			continue
+		}
+
+		remaining++
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		var err error
+		if ownedByDaemonSet(pod) {
+			err = userClusterClient.Delete(ctx, pod)
+		} else {
+			err = userClusterClient.SubResource("eviction").Create(ctx, pod, &policyv1.Eviction{})
+		}
+
+		// a PodDisruptionBudget does not allow the eviction right now
+		if apierrors.IsTooManyRequests(err) {
+			continue
+		}
+
+		if ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return 0, fmt.Errorf("failed to restart pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		}
+	}
+
+	return remaining, nil
+}
+
+func needsRestart(pod *corev1.Pod, since metav1.Time) bool {
+	// pods in the host network namespace do not depend on the CNI
+	if pod.Spec.HostNetwork {
+		return false
+	}
+
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+
+	return !pod.CreationTimestamp.After(since.Time)
+}
+
+func ownedByDaemonSet(pod *corev1.Pod) bool {
+	owner := metav1.GetControllerOf(pod)
+	return owner != nil && owner.Kind == "DaemonSet"
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cniapplicationinstallationcontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestSetCNIMigrationValues(t *testing.T) {
+	testCases := []struct {
+		name      string
+		migration *kubermaticv1.CNIMigrationStatus
+		expected  map[string]any
+	}{
+		{
+			name:     "no migration",
+			expected: map[string]any{"existing": "value"},
+		},
+		{
+			name: "migrating nodes",
+			migration: &kubermaticv1.CNIMigrationStatus{
+				Phase:  kubermaticv1.CNIMigrationPhaseMigratingNodes,
+				Source: kubermaticv1.CNIMigrationSource{Type: kubermaticv1.CNIPluginTypeCanal, Version: "v3.31"},
+			},
+			expected: map[string]any{
+				"existing": "value",
+				"cni": map[string]any{
+					"customConf": true,
+					"uninstall":  false,
+				},
+				"operator": map[string]any{
+					"unmanagedPodWatcher": map[string]any{
+						"restart": false,
+					},
+				},
+				"policyEnforcementMode": "never",
+				"bpf": map[string]any{
+					"hostLegacyRouting": true,
+				},
+				"routingMode":    "tunnel",
+				"tunnelProtocol": "vxlan",
+				"tunnelPort":     int64(ciliumMigrationTunnelPort),
+			},
+		},
+		{
+			name: "removing canal",
+			migration: &kubermaticv1.CNIMigrationStatus{
+				Phase:  kubermaticv1.CNIMigrationPhaseRemovingCanal,
+				Source: kubermaticv1.CNIMigrationSource{Type: kubermaticv1.CNIPluginTypeCanal, Version: "v3.31"},
+			},
+			expected: map[string]any{
+				"existing": "value",
+				"cni": map[string]any{
+					"customConf": false,
+				},
+				"operator": map[string]any{
+					"unmanagedPodWatcher": map[string]any{
+						"restart": true,
+					},
+				},
+				"policyEnforcementMode": "default",
+				"bpf": map[string]any{
+					"hostLegacyRouting": false,
+				},
+				"rollOutCiliumPods": true,
+			},
+		},
+		{
+			name: "completed",
+			migration: &kubermaticv1.CNIMigrationStatus{
+				Phase:  kubermaticv1.CNIMigrationPhaseCompleted,
+				Source: kubermaticv1.CNIMigrationSource{Type: kubermaticv1.CNIPluginTypeCanal, Version: "v3.31"},
+			},
+			expected: map[string]any{"existing": "value"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := genCluster("")
+			cluster.Status.CNIMigration = test.migration
+
+			values := map[string]any{"existing": "value"}
+			if err := setCNIMigrationValues(cluster, values); err != nil {
+				t.Fatalf("Failed to set values: %v", err)
+			}
+
+			if !diff.DeepEqual(test.expected, values) {
+				t.Fatalf("Values differ from expected ones:\n%v", diff.ObjectDiff(test.expected, values))
+			}
+		})
+	}
+}
+
+func TestSyncNodeStatuses(t *testing.T) {
+	now := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+
+	statuses := []kubermaticv1.CNIMigrationNodeStatus{
+		{Name: "node-b", Phase: kubermaticv1.CNIMigrationNodePhaseMigrated, LastTransitionTime: earlier},
+		{Name: "node-gone", Phase: kubermaticv1.CNIMigrationNodePhasePending, LastTransitionTime: earlier},
+	}
+	nodes := []corev1.Node{genNode("node-c"), genNode("node-b"), genNode("node-a")}
+
+	expected := []kubermaticv1.CNIMigrationNodeStatus{
+		{Name: "node-a", Phase: kubermaticv1.CNIMigrationNodePhasePending, LastTransitionTime: now},
+		{Name: "node-b", Phase: kubermaticv1.CNIMigrationNodePhaseMigrated, LastTransitionTime: earlier},
+		{Name: "node-c", Phase: kubermaticv1.CNIMigrationNodePhasePending, LastTransitionTime: now},
+	}
+
+	result := syncNodeStatuses(statuses, nodes, now)
+	if !diff.SemanticallyEqual(expected, result) {
+		t.Fatalf("Node statuses differ from expected ones:\n%v", diff.ObjectDiff(expected, result))
+	}
+
+	if current := currentNode(result); current == nil || current.Name != "node-a" {
+		t.Fatalf("Expected node-a to be migrated next, got %v", current)
+	}
+
+	result[2].Phase = kubermaticv1.CNIMigrationNodePhaseRestartingPods
+	if current := currentNode(result); current == nil || current.Name != "node-c" {
+		t.Fatalf("Expected node-c to be migrated first, got %v", current)
+	}
+}
+
+func TestMigrateNode(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cluster := genCluster("")
+	delete(cluster.Annotations, kubermaticv1.InitialCNIValuesRequestAnnotation)
+	cluster.Status.CNIMigration = &kubermaticv1.CNIMigrationStatus{
+		Phase:  kubermaticv1.CNIMigrationPhaseMigratingNodes,
+		Source: kubermaticv1.CNIMigrationSource{Type: kubermaticv1.CNIPluginTypeCanal, Version: "v3.31"},
+	}
+
+	node := genNode("node-a")
+	ciliumAgent := genPod(cniPluginNamespace, "cilium-old", node.Name, now.Add(-time.Hour))
+	ciliumAgent.Labels = map[string]string{ciliumAgentPodLabel: ciliumAgentPodValue}
+	workload := genPod("default", "workload", node.Name, now.Add(-time.Hour))
+	hostNetwork := genPod("default", "host-network", node.Name, now.Add(-time.Hour))
+	hostNetwork.Spec.HostNetwork = true
+
+	seedClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(cluster).Build()
+	userClusterClient := fake.
+		NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(&node, ciliumAgent, workload, hostNetwork).
+		WithIndex(&corev1.Pod{}, podNodeNameFieldPath, func(obj ctrlruntimeclient.Object) []string {
+			return []string{obj.(*corev1.Pod).Spec.NodeName}
+		}).
+		Build()
+
+	r := &Reconciler{
+		Client:                        seedClient,
+		recorder:                      &events.FakeRecorder{},
+		log:                           zap.NewNop().Sugar(),
+		versions:                      kubermatic.GetFakeVersions(),
+		userClusterConnectionProvider: newFakeClientProvider(userClusterClient),
+		now:                           func() time.Time { return now },
+	}
+
+	reconcileMigration := func(expected kubermaticv1.CNIMigrationNodePhase) {
+		t.Helper()
+
+		if _, err := r.reconcileCNIMigration(ctx, r.log, cluster); err != nil {
+			t.Fatalf("Failed to reconcile migration: %v", err)
+		}
+
+		if err := seedClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), cluster); err != nil {
+			t.Fatalf("Failed to get cluster: %v", err)
+		}
+
+		nodes := cluster.Status.CNIMigration.Nodes
+		if len(nodes) != 1 || nodes[0].Phase != expected {
+			t.Fatalf("Expected node to be in phase %s, got %+v", expected, nodes)
+		}
+	}
+
+	getNode := func() *corev1.Node {
+		t.Helper()
+
+		n := &corev1.Node{}
+		if err := userClusterClient.Get(ctx, types.NamespacedName{Name: node.Name}, n); err != nil {
+			t.Fatalf("Failed to get node: %v", err)
+		}
+
+		return n
+	}
+
+	// record the node, cordon and label the node and restart its Cilium agent
+	reconcileMigration(kubermaticv1.CNIMigrationNodePhaseMigrating)
+
+	n := getNode()
+	if !n.Spec.Unschedulable || n.Labels[ciliumMigrationNodeLabel] != "true" {
+		t.Fatalf("Expected node to be cordoned and labelled, got %+v", n)
+	}
+
+	if err := userClusterClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(ciliumAgent), ciliumAgent); !apierrors.IsNotFound(err) {
+		t.Fatalf("Expected old Cilium agent to be deleted, got %v", err)
+	}
+
+	// no new Cilium agent is ready yet
+	reconcileMigration(kubermaticv1.CNIMigrationNodePhaseMigrating)
+
+	newAgent := genPod(cniPluginNamespace, "cilium-new", node.Name, now)
+	newAgent.Labels = map[string]string{ciliumAgentPodLabel: ciliumAgentPodValue}
+	newAgent.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	if err := userClusterClient.Create(ctx, newAgent); err != nil {
+		t.Fatalf("Failed to create Cilium agent: %v", err)
+	}
+
+	reconcileMigration(kubermaticv1.CNIMigrationNodePhaseRestartingPods)
+
+	// the workload is evicted, the host network pod is left alone
+	reconcileMigration(kubermaticv1.CNIMigrationNodePhaseRestartingPods)
+
+	if err := userClusterClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(workload), workload); !apierrors.IsNotFound(err) {
+		t.Fatalf("Expected workload to be evicted, got %v", err)
+	}
+
+	if err := userClusterClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(hostNetwork), hostNetwork); err != nil {
+		t.Fatalf("Expected host network pod to remain: %v", err)
+	}
+
+	reconcileMigration(kubermaticv1.CNIMigrationNodePhaseMigrated)
+
+	n = getNode()
+	if n.Spec.Unschedulable {
+		t.Fatal("Expected node to be uncordoned")
+	}
+
+	if _, ok := n.Annotations[cniMigrationCordonedAnnotation]; ok {
+		t.Fatal("Expected cordon annotation to be removed")
+	}
+
+	// all nodes are migrated
+	if _, err := r.reconcileCNIMigration(ctx, r.log, cluster); err != nil {
+		t.Fatalf("Failed to reconcile migration: %v", err)
+	}
+
+	if err := seedClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), cluster); err != nil {
+		t.Fatalf("Failed to get cluster: %v", err)
+	}
+
+	if phase := cluster.Status.CNIMigration.Phase; phase != kubermaticv1.CNIMigrationPhaseRemovingCanal {
+		t.Fatalf("Expected migration to be in phase %s, got %s", kubermaticv1.CNIMigrationPhaseRemovingCanal, phase)
+	}
+}
+
+func TestCiliumMigrationNodeConfig(t *testing.T) {
+	ctx := context.Background()
+	userClusterClient := fake.NewClientBuilder().WithScheme(testScheme).Build()
+
+	// creating the config twice must not fail
+	for range 2 {
+		if err := ensureCiliumMigrationNodeConfig(ctx, userClusterClient); err != nil {
+			t.Fatalf("Failed to ensure CiliumNodeConfig: %v", err)
+		}
+	}
+
+	config := ciliumMigrationNodeConfig()
+	if err := userClusterClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(config), config); err != nil {
+		t.Fatalf("Failed to get CiliumNodeConfig: %v", err)
+	}
+
+	label, _, _ := unstructured.NestedString(config.Object, "spec", "nodeSelector", "matchLabels", ciliumMigrationNodeLabel)
+	if label != "true" {
+		t.Fatalf("Expected CiliumNodeConfig to select migrated nodes, got %v", config.Object["spec"])
+	}
+
+	for range 2 {
+		if err := deleteCiliumMigrationNodeConfig(ctx, userClusterClient); err != nil {
+			t.Fatalf("Failed to delete CiliumNodeConfig: %v", err)
+		}
+	}
+}
+
+func genNode(name string) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+}
+
+func genPod(namespace, name, nodeName string, created time.Time) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+		},
+	}
+}
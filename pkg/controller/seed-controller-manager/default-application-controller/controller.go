@@ -238,7 +238,7 @@ func (r *Reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluste
 		}
 	}
 
-	err = r.ensureApplicationEnforcedAnnotationIsRemoved(ctx, userClusterClient, applicationsNames)
+	err = EnsureApplicationEnforcedAnnotationIsRemoved(ctx, userClusterClient, applicationsNames)
 	if err != nil {
 		return &reconcile.Result{RequeueAfter: 10 * time.Second}, fmt.Errorf("failed to ensure the enforced annotation is removed from ApplicationInstallations whose ApplicationDefinitions do not have 'enforced' set to true: %w", err)
 	}
@@ -246,7 +246,9 @@ func (r *Reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluste
 	return nil, kerrors.NewAggregate(errors)
 }
 
-func (r *Reconciler) ensureApplicationEnforcedAnnotationIsRemoved(ctx context.Context, userClusterClient ctrlruntimeclient.Client, applicationNames map[string]bool) error {
+// EnsureApplicationEnforcedAnnotationIsRemoved sets the enforced annotation to false on all ApplicationInstallations
+// whose ApplicationDefinition is not contained in applicationNames.
+func EnsureApplicationEnforcedAnnotationIsRemoved(ctx context.Context, userClusterClient ctrlruntimeclient.Client, applicationNames map[string]bool) error {
 	existingApplicationList := &appskubermaticv1.ApplicationInstallationList{}
 	if err := userClusterClient.List(ctx, existingApplicationList); err != nil {
 		return fmt.Errorf("failed to list installed applications: %w", err)
@@ -271,17 +273,23 @@ func (r *Reconciler) ensureApplicationEnforcedAnnotationIsRemoved(ctx context.Co
 }
 
 func (r *Reconciler) ensureApplicationInstallation(ctx context.Context, userClusterClient ctrlruntimeclient.Client, application appskubermaticv1.ApplicationDefinition) error {
+	namespaceName, err := ApplicationInstallationNamespace(ctx, r.configGetter, application.Name)
+	if err != nil {
+		return err
+	}
+
+	return EnsureApplicationInstallation(ctx, r.log, userClusterClient, application, namespaceName)
+}
+
+// EnsureApplicationInstallation creates or updates the default/enforced ApplicationInstallation for the given
+// ApplicationDefinition in namespaceName, unless it already exists in another namespace.
+func EnsureApplicationInstallation(ctx context.Context, log *zap.SugaredLogger, userClusterClient ctrlruntimeclient.Client, application appskubermaticv1.ApplicationDefinition, namespaceName string) error {
 	// First check if the installation is already present to avoid to deploy an application twice in different namespaces by mistake
 	// for this we need to list all existing applications installations
 	existingApplicationList := &appskubermaticv1.ApplicationInstallationList{}
 	if err := userClusterClient.List(ctx, existingApplicationList); err != nil {
 		return fmt.Errorf("failed to list installed applications: %w", err)
 	}
-
-	namespaceName, err := r.getApplicationInstallationNamespace(ctx, application.Name)
-	if err != nil {
-		return err
-	}
 	var currentApplicationInstallation *appskubermaticv1.ApplicationInstallation
 	for _, existingApplication := range existingApplicationList.Items {
 		// if we find an application installation which is defaulted and enforced we found an existing resource
@@ -317,7 +325,7 @@ func (r *Reconciler) ensureApplicationInstallation(ctx context.Context, userClus
 	}
 
 	reconcilers := []reconciling.NamedApplicationInstallationReconcilerFactory{
-		ApplicationInstallationReconciler(r.log, application),
+		ApplicationInstallationReconciler(log, application),
 	}
 
 	return reconciling.ReconcileApplicationInstallations(ctx, reconcilers, namespaceName, userClusterClient)
@@ -410,9 +418,11 @@ func ApplicationInstallationReconciler(
 	}
 }
 
-func (r *Reconciler) getApplicationInstallationNamespace(ctx context.Context, applicationName string) (string, error) {
+// ApplicationInstallationNamespace returns the namespace default ApplicationInstallations are created in, which
+// is either the namespace configured in the KubermaticConfiguration or the name of the application.
+func ApplicationInstallationNamespace(ctx context.Context, configGetter provider.KubermaticConfigurationGetter, applicationName string) (string, error) {
 	namespaceName := applicationName
-	config, err := r.configGetter(ctx)
+	config, err := configGetter(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,435 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdmaintenancecontroller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/etcdrestore"
+	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
+	predicateutil "k8c.io/kubermatic/v2/pkg/controller/util/predicate"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/util/maintenancewindow"
+	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	ControllerName = "kkp-etcd-maintenance-controller"
+
+	// checkInterval is the interval in which the database usage is checked.
+	checkInterval = 5 * time.Minute
+
+	// defragmentationPause is the pause between defragmenting two members, so that the
+	// defragmented member can catch up before the next one is blocked.
+	defragmentationPause = time.Minute
+
+	// defragmentationTimeout is the maximum duration of defragmenting a single member.
+	defragmentationTimeout = 5 * time.Minute
+
+	// minDefragmentationSize is the database size below which members are never
+	// defragmented, as the reclaimed space is not worth blocking the member.
+	minDefragmentationSize = 100 << 20
+)
+
+type Reconciler struct {
+	ctrlruntimeclient.Client
+
+	workerName    string
+	seedGetter    provider.SeedGetter
+	newEtcdClient etcdClientFactory
+	recorder      events.EventRecorder
+	log           *zap.SugaredLogger
+	versions      kubermatic.Versions
+	now           func() time.Time
+}
+
+// Add creates a new etcd maintenance controller.
+func Add(
+	mgr manager.Manager,
+	numWorkers int,
+	workerName string,
+	seedGetter provider.SeedGetter,
+	log *zap.SugaredLogger,
+	versions kubermatic.Versions,
+) error {
+	reconciler := &Reconciler{
+		Client: mgr.GetClient(),
+
+		workerName:    workerName,
+		seedGetter:    seedGetter,
+		newEtcdClient: newEtcdClient(mgr.GetClient()),
+		recorder:      mgr.GetEventRecorder(ControllerName),
+		log:           log.Named(ControllerName),
+		versions:      versions,
+		now:           time.Now,
+	}
+
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: numWorkers,
+		}).
+		// clusters that disabled the maintenance are reconciled once more to clean up their status
+		For(&kubermaticv1.Cluster{}, builder.WithPredicates(predicateutil.Factory(func(o ctrlruntimeclient.Object) bool {
+			cluster := o.(*kubermaticv1.Cluster)
+			return cluster.Spec.ComponentsOverride.Etcd.Maintenance.IsEnabled() || hasMaintenanceStatus(cluster)
+		}))).
+		Build(reconciler)
+
+	return err
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("cluster", request.Name)
+	log.Debug("Reconciling")
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := r.Get(ctx, request.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	result, err := controllerutil.ClusterReconcileWrapper(
+		ctx,
+		r,
+		r.workerName,
+		cluster,
+		r.versions,
+		kubermaticv1.ClusterConditionNone,
+		func() (*reconcile.Result, error) {
+			return r.reconcile(ctx, log, cluster)
+		},
+	)
+
+	if result == nil || err != nil {
+		result = &reconcile.Result{}
+	}
+
+	if err != nil {
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+	}
+
+	return *result, err
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	settings := cluster.Spec.ComponentsOverride.Etcd.Maintenance
+	if !settings.IsEnabled() || cluster.DeletionTimestamp != nil {
+		return nil, r.cleanup(ctx, cluster)
+	}
+
+	if _, ok := cluster.Annotations[etcdrestore.ActiveRestoreAnnotationName]; ok {
+		log.Debug("Skipping etcd maintenance while etcd is restored")
+		return &reconcile.Result{RequeueAfter: checkInterval}, nil
+	}
+
+	if cluster.Status.EtcdStorageMigration.InProgress() {
+		log.Debug("Skipping etcd maintenance while the etcd volumes are migrated")
+		return &reconcile.Result{RequeueAfter: checkInterval}, nil
+	}
+
+	if cluster.Status.ExtendedHealth.Etcd != kubermaticv1.HealthStatusUp {
+		log.Debug("Skipping etcd maintenance while etcd is not healthy")
+		return &reconcile.Result{RequeueAfter: checkInterval}, nil
+	}
+
+	sts := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: resources.EtcdStatefulSetName}, sts); err != nil {
+		return nil, fmt.Errorf("failed to get etcd StatefulSet: %w", err)
+	}
+
+	memberNames := []string{}
+	for i := range ptr.Deref(sts.Spec.Replicas, 0) {
+		memberNames = append(memberNames, fmt.Sprintf("%s-%d", resources.EtcdStatefulSetName, i))
+	}
+
+	if len(memberNames) == 0 {
+		return &reconcile.Result{RequeueAfter: checkInterval}, nil
+	}
+
+	client, err := r.newEtcdClient(ctx, cluster, memberNames)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	members := map[string]*memberStatus{}
+	for _, name := range memberNames {
+		status, err := client.MemberStatus(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get status of etcd member %s: %w", name, err)
+		}
+		members[name] = status
+	}
+
+	quotaGB := quotaBackendGB(cluster)
+	quotaBytes := quotaGB << 30
+	warningThreshold := int64(ptr.Deref(settings.QuotaWarningThreshold, kubermaticv1.DefaultEtcdQuotaWarningThreshold))
+
+	var maxInUse int64
+	for _, member := range members {
+		maxInUse = max(maxInUse, member.dbSizeInUse)
+	}
+
+	usage := maxInUse * 100 / quotaBytes
+	quotaExceeded := usage >= warningThreshold
+
+	if err := r.updateStatus(ctx, cluster, memberNames, members, quotaBytes, usage, quotaGB, quotaExceeded); err != nil {
+		return nil, err
+	}
+
+	seed, err := r.seedGetter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seed: %w", err)
+	}
+
+	window, err := maintenancewindow.ForSeedCluster(cluster, seed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance window: %w", err)
+	}
+
+	windowOpen, _ := window.IsOpen(r.now())
+	threshold := int64(ptr.Deref(settings.DefragmentationThreshold, kubermaticv1.DefaultEtcdDefragmentationThreshold))
+
+	if name := nextDefragmentation(memberNames, members, threshold, warningThreshold, quotaBytes, windowOpen); name != "" {
+		return r.defragment(ctx, log.With("member", name), cluster, client, name, members[name])
+	}
+
+	if quotaExceeded && windowOpen && settings.MaxQuotaBackendGB != nil {
+		if err := r.growQuota(ctx, log, cluster, sts, quotaGB, *settings.MaxQuotaBackendGB); err != nil {
+			return nil, err
+		}
+	}
+
+	return &reconcile.Result{RequeueAfter: checkInterval}, nil
+}
+
+func (r *Reconciler) updateStatus(
+	ctx context.Context,
+	cluster *kubermaticv1.Cluster,
+	memberNames []string,
+	members map[string]*memberStatus,
+	quotaBytes, usage, quotaGB int64,
+	quotaExceeded bool,
+) error {
+	now := metav1.NewTime(r.now())
+
+	err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		lastDefragmentation := map[string]*metav1.Time{}
+		if c.Status.EtcdMaintenance != nil {
+			for _, member := range c.Status.EtcdMaintenance.Members {
+				lastDefragmentation[member.Name] = member.LastDefragmentationTime
+			}
+		}
+
+		status := &kubermaticv1.EtcdMaintenanceStatus{
+			QuotaBackendBytes: *resource.NewQuantity(quotaBytes, resource.BinarySI),
+			LastCheckTime:     now,
+		}
+
+		for _, name := range memberNames {
+			status.Members = append(status.Members, kubermaticv1.EtcdMemberDatabaseStatus{
+				Name:                    name,
+				DatabaseSize:            *resource.NewQuantity(members[name].dbSize, resource.BinarySI),
+				DatabaseSizeInUse:       *resource.NewQuantity(members[name].dbSizeInUse, resource.BinarySI),
+				LastDefragmentationTime: lastDefragmentation[name],
+			})
+		}
+
+		c.Status.EtcdMaintenance = status
+
+		if quotaExceeded {
+			controllerutil.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionEtcdQuotaSufficient, corev1.ConditionFalse,
+				kubermaticv1.ReasonClusterEtcdQuotaAlmostExhausted, fmt.Sprintf("etcd uses %d%% of its backend quota of %dGB", usage, quotaGB))
+		} else {
+			controllerutil.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionEtcdQuotaSufficient, corev1.ConditionTrue,
+				kubermaticv1.ReasonClusterEtcdQuotaSufficient, "")
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update etcd maintenance status: %w", err)
+	}
+
+	return nil
+}
+
+// nextDefragmentation returns the member to defragment next. Followers are defragmented
+// before the leader, to avoid repeated leader elections. Outside the maintenance window,
+// only members whose database already exceeds the quota warning threshold are defragmented.
+func nextDefragmentation(memberNames []string, members map[string]*memberStatus, threshold, warningThreshold, quotaBytes int64, windowOpen bool) string {
+	leader := ""
+
+	for _, name := range memberNames {
+		member := members[name]
+
+		if member.dbSize < minDefragmentationSize {
+			continue
+		}
+
+		if (member.dbSize-member.dbSizeInUse)*100/member.dbSize < threshold {
+			continue
+		}
+
+		if !windowOpen && member.dbSize*100/quotaBytes < warningThreshold {
+			continue
+		}
+
+		if !member.leader {
+			return name
+		}
+		leader = name
+	}
+
+	return leader
+}
+
+func (r *Reconciler) defragment(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, client etcdClient, name string, member *memberStatus) (*reconcile.Result, error) {
+	log.Infow("Defragmenting etcd member", "size", member.dbSize, "inUse", member.dbSizeInUse)
+
+	defragmentCtx, cancel := context.WithTimeout(ctx, defragmentationTimeout)
+	defer cancel()
+
+	if err := client.Defragment(defragmentCtx, name); err != nil {
+		return nil, fmt.Errorf("failed to defragment etcd member %s: %w", name, err)
+	}
+
+	now := metav1.NewTime(r.now())
+	err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		if c.Status.EtcdMaintenance == nil {
+			return
+		}
+
+		for i, m := range c.Status.EtcdMaintenance.Members {
+			if m.Name == name {
+				c.Status.EtcdMaintenance.Members[i].LastDefragmentationTime = &now
+			}
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update etcd maintenance status: %w", err)
+	}
+
+	unused := resource.NewQuantity(member.dbSize-member.dbSizeInUse, resource.BinarySI)
+	r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "EtcdDefragmented", "Reconciling", "Defragmented etcd member %s, reclaiming up to %s", name, unused.String())
+
+	return &reconcile.Result{RequeueAfter: defragmentationPause}, nil
+}
+
+// growQuota doubles the backend quota, up to the given maximum. If the etcd volumes are too
+// small for the new quota, their size is increased first and the quota is only changed once
+// the resulting storage migration has completed.
+func (r *Reconciler) growQuota(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, sts *appsv1.StatefulSet, quotaGB, maxQuotaGB int64) error {
+	if quotaGB >= maxQuotaGB {
+		log.Debugw("Backend quota has reached its maximum", "quota", quotaGB)
+		return nil
+	}
+
+	newQuotaGB := min(2*quotaGB, maxQuotaGB)
+	requiredDiskSize := resource.NewQuantity(2*newQuotaGB<<30, resource.BinarySI)
+
+	oldCluster := cluster.DeepCopy()
+	etcdSettings := &cluster.Spec.ComponentsOverride.Etcd
+
+	if diskSize := volumeSize(sts); diskSize.Cmp(*requiredDiskSize) < 0 {
+		if !cluster.Spec.Features[kubermaticv1.ClusterFeatureEtcdLauncher] {
+			r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "EtcdQuotaNotIncreased", "Reconciling", "The etcd volumes are too small for a backend quota of %dGB and can only be grown with the %s feature", newQuotaGB, kubermaticv1.ClusterFeatureEtcdLauncher)
+			return nil
+		}
+
+		if etcdSettings.DiskSize != nil && etcdSettings.DiskSize.Cmp(*requiredDiskSize) >= 0 {
+			// the storage migration has not yet been started or completed
+			return nil
+		}
+
+		etcdSettings.DiskSize = requiredDiskSize
+		if err := r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+			return fmt.Errorf("failed to grow etcd volumes: %w", err)
+		}
+
+		log.Infow("Growing etcd volumes to increase the backend quota", "diskSize", requiredDiskSize.String())
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "EtcdVolumesGrowing", "Reconciling", "Growing the etcd volumes to %s to increase the backend quota to %dGB", requiredDiskSize.String(), newQuotaGB)
+
+		return nil
+	}
+
+	etcdSettings.QuotaBackendGB = &newQuotaGB
+	if err := r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+		return fmt.Errorf("failed to increase etcd backend quota: %w", err)
+	}
+
+	log.Infow("Increased etcd backend quota", "from", quotaGB, "to", newQuotaGB)
+	r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "EtcdQuotaIncreased", "Reconciling", "Increased the etcd backend quota from %dGB to %dGB", quotaGB, newQuotaGB)
+
+	return nil
+}
+
+// cleanup removes the status and condition of a cluster that disabled the maintenance.
+func (r *Reconciler) cleanup(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	if !hasMaintenanceStatus(cluster) {
+		return nil
+	}
+
+	return controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.EtcdMaintenance = nil
+		delete(c.Status.Conditions, kubermaticv1.ClusterConditionEtcdQuotaSufficient)
+	})
+}
+
+func hasMaintenanceStatus(cluster *kubermaticv1.Cluster) bool {
+	_, hasCondition := cluster.Status.Conditions[kubermaticv1.ClusterConditionEtcdQuotaSufficient]
+	return cluster.Status.EtcdMaintenance != nil || hasCondition
+}
+
+func quotaBackendGB(cluster *kubermaticv1.Cluster) int64 {
+	if quota := cluster.Spec.ComponentsOverride.Etcd.QuotaBackendGB; quota != nil && *quota > 0 {
+		return *quota
+	}
+
+	return kubermaticv1.DefaultEtcdQuotaBackendGB
+}
+
+func volumeSize(sts *appsv1.StatefulSet) resource.Quantity {
+	for _, template := range sts.Spec.VolumeClaimTemplates {
+		if template.Name == "data" {
+			return template.Spec.Resources.Requests[corev1.ResourceStorage]
+		}
+	}
+
+	return resource.Quantity{}
+}
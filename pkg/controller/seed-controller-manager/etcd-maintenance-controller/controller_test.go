@@ -0,0 +1,302 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdmaintenancecontroller
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	clusterNamespace = "cluster-testcluster"
+
+	mib = int64(1) << 20
+)
+
+type fakeEtcdClient struct {
+	members      map[string]*memberStatus
+	defragmented []string
+}
+
+func (c *fakeEtcdClient) MemberStatus(_ context.Context, member string) (*memberStatus, error) {
+	return c.members[member], nil
+}
+
+func (c *fakeEtcdClient) Defragment(_ context.Context, member string) error {
+	c.defragmented = append(c.defragmented, member)
+	return nil
+}
+
+func (c *fakeEtcdClient) Close() error {
+	return nil
+}
+
+func etcdStatefulSet(diskSize string) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "etcd",
+			Namespace: clusterNamespace,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To[int32](3),
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "data"},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(diskSize)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// members returns the status of three members, with etcd-0 being the leader.
+func members(dbSize, dbSizeInUse int64) map[string]*memberStatus {
+	return map[string]*memberStatus{
+		"etcd-0": {dbSize: dbSize, dbSizeInUse: dbSizeInUse, leader: true},
+		"etcd-1": {dbSize: dbSize, dbSizeInUse: dbSizeInUse},
+		"etcd-2": {dbSize: dbSize, dbSizeInUse: dbSizeInUse},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC) // Monday
+
+	closedWindow := &kubermaticv1.MaintenanceWindow{Start: "Sat 21:00", Length: "4h"}
+
+	testcases := []struct {
+		name                   string
+		settings               *kubermaticv1.EtcdMaintenanceSettings
+		quotaBackendGB         *int64
+		window                 *kubermaticv1.MaintenanceWindow
+		launcher               bool
+		migration              *kubermaticv1.EtcdStorageMigrationStatus
+		diskSize               string
+		members                map[string]*memberStatus
+		expectedDefragmented   []string
+		expectedCondition      corev1.ConditionStatus
+		expectedQuotaBackendGB *int64
+		expectedDiskSize       string
+	}{
+		{
+			name:     "maintenance disabled",
+			diskSize: "5Gi",
+			members:  members(500*mib, 100*mib),
+		},
+		{
+			name:              "nothing to do",
+			settings:          &kubermaticv1.EtcdMaintenanceSettings{Enabled: true},
+			diskSize:          "5Gi",
+			members:           members(500*mib, 400*mib),
+			expectedCondition: corev1.ConditionTrue,
+		},
+		{
+			name:              "small databases are not defragmented",
+			settings:          &kubermaticv1.EtcdMaintenanceSettings{Enabled: true},
+			diskSize:          "5Gi",
+			members:           members(50*mib, 5*mib),
+			expectedCondition: corev1.ConditionTrue,
+		},
+		{
+			name:                 "defragment a follower first",
+			settings:             &kubermaticv1.EtcdMaintenanceSettings{Enabled: true},
+			diskSize:             "5Gi",
+			members:              members(500*mib, 100*mib),
+			expectedDefragmented: []string{"etcd-1"},
+			expectedCondition:    corev1.ConditionTrue,
+		},
+		{
+			name:     "defragment the leader last",
+			settings: &kubermaticv1.EtcdMaintenanceSettings{Enabled: true},
+			diskSize: "5Gi",
+			members: map[string]*memberStatus{
+				"etcd-0": {dbSize: 500 * mib, dbSizeInUse: 100 * mib, leader: true},
+				"etcd-1": {dbSize: 100 * mib, dbSizeInUse: 100 * mib},
+				"etcd-2": {dbSize: 100 * mib, dbSizeInUse: 100 * mib},
+			},
+			expectedDefragmented: []string{"etcd-0"},
+			expectedCondition:    corev1.ConditionTrue,
+		},
+		{
+			name:              "do not defragment outside the maintenance window",
+			settings:          &kubermaticv1.EtcdMaintenanceSettings{Enabled: true},
+			window:            closedWindow,
+			diskSize:          "5Gi",
+			members:           members(500*mib, 100*mib),
+			expectedCondition: corev1.ConditionTrue,
+		},
+		{
+			name:                 "defragment outside the maintenance window when close to the quota",
+			settings:             &kubermaticv1.EtcdMaintenanceSettings{Enabled: true},
+			window:               closedWindow,
+			diskSize:             "5Gi",
+			members:              members(1900*mib, 100*mib),
+			expectedDefragmented: []string{"etcd-1"},
+			expectedCondition:    corev1.ConditionTrue,
+		},
+		{
+			name:              "skip maintenance during a storage migration",
+			settings:          &kubermaticv1.EtcdMaintenanceSettings{Enabled: true},
+			migration:         &kubermaticv1.EtcdStorageMigrationStatus{Phase: kubermaticv1.EtcdStorageMigrationPhaseAddingMember},
+			diskSize:          "5Gi",
+			members:           members(500*mib, 100*mib),
+			expectedCondition: "",
+		},
+		{
+			name:              "warn about the quota",
+			settings:          &kubermaticv1.EtcdMaintenanceSettings{Enabled: true},
+			diskSize:          "5Gi",
+			members:           members(1700*mib, 1700*mib),
+			expectedCondition: corev1.ConditionFalse,
+		},
+		{
+			name:                   "increase the quota",
+			settings:               &kubermaticv1.EtcdMaintenanceSettings{Enabled: true, MaxQuotaBackendGB: ptr.To[int64](8)},
+			quotaBackendGB:         ptr.To[int64](2),
+			launcher:               true,
+			diskSize:               "10Gi",
+			members:                members(1700*mib, 1700*mib),
+			expectedCondition:      corev1.ConditionFalse,
+			expectedQuotaBackendGB: ptr.To[int64](4),
+		},
+		{
+			name:                   "grow the volumes before increasing the quota",
+			settings:               &kubermaticv1.EtcdMaintenanceSettings{Enabled: true, MaxQuotaBackendGB: ptr.To[int64](8)},
+			quotaBackendGB:         ptr.To[int64](2),
+			launcher:               true,
+			diskSize:               "5Gi",
+			members:                members(1700*mib, 1700*mib),
+			expectedCondition:      corev1.ConditionFalse,
+			expectedQuotaBackendGB: ptr.To[int64](2),
+			expectedDiskSize:       "8Gi",
+		},
+		{
+			name:                   "do not exceed the maximum quota",
+			settings:               &kubermaticv1.EtcdMaintenanceSettings{Enabled: true, MaxQuotaBackendGB: ptr.To[int64](2)},
+			quotaBackendGB:         ptr.To[int64](2),
+			launcher:               true,
+			diskSize:               "10Gi",
+			members:                members(1700*mib, 1700*mib),
+			expectedCondition:      corev1.ConditionFalse,
+			expectedQuotaBackendGB: ptr.To[int64](2),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "testcluster",
+				},
+				Spec: kubermaticv1.ClusterSpec{
+					Features:          map[string]bool{kubermaticv1.ClusterFeatureEtcdLauncher: tt.launcher},
+					MaintenanceWindow: tt.window,
+					ComponentsOverride: kubermaticv1.ComponentSettings{
+						Etcd: kubermaticv1.EtcdStatefulSetSettings{
+							QuotaBackendGB: tt.quotaBackendGB,
+							Maintenance:    tt.settings,
+						},
+					},
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName:        clusterNamespace,
+					ExtendedHealth:       kubermaticv1.ExtendedClusterHealth{Etcd: kubermaticv1.HealthStatusUp},
+					EtcdStorageMigration: tt.migration,
+					// left over from a previous check
+					EtcdMaintenance: &kubermaticv1.EtcdMaintenanceStatus{},
+				},
+			}
+
+			etcd := &fakeEtcdClient{members: tt.members}
+
+			rec := &Reconciler{
+				Client:     fake.NewClientBuilder().WithObjects(cluster, etcdStatefulSet(tt.diskSize)).Build(),
+				seedGetter: func() (*kubermaticv1.Seed, error) { return &kubermaticv1.Seed{}, nil },
+				newEtcdClient: func(context.Context, *kubermaticv1.Cluster, []string) (etcdClient, error) {
+					return etcd, nil
+				},
+				log:      zap.NewNop().Sugar(),
+				recorder: events.NewFakeRecorder(10),
+				now:      func() time.Time { return now },
+			}
+
+			if _, err := rec.reconcile(ctx, rec.log, cluster); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !slices.Equal(etcd.defragmented, tt.expectedDefragmented) {
+				t.Errorf("Expected defragmented members %v, got %v.", tt.expectedDefragmented, etcd.defragmented)
+			}
+
+			newCluster := &kubermaticv1.Cluster{}
+			if err := rec.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), newCluster); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			condition := newCluster.Status.Conditions[kubermaticv1.ClusterConditionEtcdQuotaSufficient]
+			if condition.Status != tt.expectedCondition {
+				t.Errorf("Expected condition status %q, got %q.", tt.expectedCondition, condition.Status)
+			}
+
+			if tt.settings == nil && newCluster.Status.EtcdMaintenance != nil {
+				t.Error("Expected etcd maintenance status to be removed.")
+			}
+
+			if len(tt.expectedDefragmented) > 0 {
+				for _, member := range newCluster.Status.EtcdMaintenance.Members {
+					if (member.LastDefragmentationTime != nil) != slices.Contains(tt.expectedDefragmented, member.Name) {
+						t.Errorf("Unexpected defragmentation time %v for member %s.", member.LastDefragmentationTime, member.Name)
+					}
+				}
+			}
+
+			quota := newCluster.Spec.ComponentsOverride.Etcd.QuotaBackendGB
+			if ptr.Deref(quota, 0) != ptr.Deref(tt.expectedQuotaBackendGB, 0) {
+				t.Errorf("Expected quota %v GB, got %v GB.", ptr.Deref(tt.expectedQuotaBackendGB, 0), ptr.Deref(quota, 0))
+			}
+
+			diskSize := newCluster.Spec.ComponentsOverride.Etcd.DiskSize
+			if tt.expectedDiskSize == "" {
+				if diskSize != nil {
+					t.Errorf("Expected disk size to not be set, got %s.", diskSize.String())
+				}
+			} else if diskSize == nil || diskSize.Cmp(resource.MustParse(tt.expectedDiskSize)) != 0 {
+				t.Errorf("Expected disk size %s, got %v.", tt.expectedDiskSize, diskSize)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package etcdmaintenancecontroller contains a controller that defragments etcd members and
+manages the etcd backend quota of user clusters that enable the automatic etcd maintenance.
+
+The controller periodically checks the database of every etcd member. The database file
+only shrinks when a member is defragmented, so a database that is mostly unused space can
+still hit the backend quota, which turns etcd read-only. Members whose database exceeds the
+configured share of unused space are defragmented one at a time, followers first, inside the
+cluster's maintenance window. A member is defragmented outside the window if its database
+already exceeds the quota warning threshold.
+
+Independent of that, the EtcdQuotaSufficient condition is set to false once the part of the
+database that is in use exceeds the quota warning threshold, as defragmentation cannot help
+anymore. If a maximum quota is configured, the controller then doubles the backend quota
+inside the maintenance window, after growing the etcd volumes via a storage migration if
+needed.
+*/
+package etcdmaintenancecontroller
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdmaintenancecontroller
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// memberStatus is the database usage of a single etcd member.
+type memberStatus struct {
+	dbSize      int64
+	dbSizeInUse int64
+	leader      bool
+}
+
+// etcdClient is the part of the etcd maintenance API used by the controller, with members
+// addressed by their name.
+type etcdClient interface {
+	MemberStatus(ctx context.Context, member string) (*memberStatus, error)
+	Defragment(ctx context.Context, member string) error
+	Close() error
+}
+
+type etcdClientFactory func(ctx context.Context, cluster *kubermaticv1.Cluster, members []string) (etcdClient, error)
+
+// newEtcdClient returns a factory for etcd clients that authenticate with the client
+// certificate of the kube-apiserver.
+func newEtcdClient(seedClient ctrlruntimeclient.Client) etcdClientFactory {
+	return func(ctx context.Context, cluster *kubermaticv1.Cluster, members []string) (etcdClient, error) {
+		namespace := cluster.Status.NamespaceName
+
+		secret := &corev1.Secret{}
+		if err := seedClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: resources.ApiserverEtcdClientCertificateSecretName}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get etcd client certificate: %w", err)
+		}
+
+		cert, err := tls.X509KeyPair(secret.Data[resources.ApiserverEtcdClientCertificateCertSecretKey], secret.Data[resources.ApiserverEtcdClientCertificateKeySecretKey])
+		if err != nil {
+			return nil, fmt.Errorf("invalid etcd client certificate: %w", err)
+		}
+
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(secret.Data[resources.CACertSecretKey]) {
+			return nil, errors.New("etcd client certificate Secret contains no valid CA certificate")
+		}
+
+		endpoints := []string{}
+		for _, member := range members {
+			endpoints = append(endpoints, memberEndpoint(namespace, member))
+		}
+
+		client, err := clientv3.New(clientv3.Config{
+			Endpoints:   endpoints,
+			DialTimeout: 5 * time.Second,
+			TLS: &tls.Config{
+				Certificates: []tls.Certificate{cert},
+				RootCAs:      rootCAs,
+				MinVersion:   tls.VersionTLS12,
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to etcd: %w", err)
+		}
+
+		return &clusterClient{client: client, namespace: namespace}, nil
+	}
+}
+
+func memberEndpoint(namespace, member string) string {
+	return fmt.Sprintf("https://%s.%s.%s.svc.cluster.local:2379", member, resources.EtcdServiceName, namespace)
+}
+
+type clusterClient struct {
+	client    *clientv3.Client
+	namespace string
+}
+
+func (c *clusterClient) MemberStatus(ctx context.Context, member string) (*memberStatus, error) {
+	resp, err := c.client.Status(ctx, memberEndpoint(c.namespace, member))
+	if err != nil {
+		return nil, err
+	}
+
+	return &memberStatus{
+		dbSize:      resp.DbSize,
+		dbSizeInUse: resp.DbSizeInUse,
+		leader:      resp.Header != nil && resp.Header.MemberId == resp.Leader,
+	}, nil
+}
+
+func (c *clusterClient) Defragment(ctx context.Context, member string) error {
+	_, err := c.client.Defragment(ctx, memberEndpoint(c.namespace, member))
+	return err
+}
+
+func (c *clusterClient) Close() error {
+	return c.client.Close()
+}
@@ -0,0 +1,488 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdstoragemigrationcontroller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/etcdrestore"
+	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
+	predicateutil "k8c.io/kubermatic/v2/pkg/controller/util/predicate"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/kubectl/pkg/util/podutils"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	ControllerName = "kkp-etcd-storage-migration-controller"
+
+	// progressInterval is used while waiting for etcd members during a migration.
+	progressInterval = 15 * time.Second
+
+	// volumeName is the name of the volume claim template of the etcd StatefulSet.
+	volumeName = "data"
+)
+
+type Reconciler struct {
+	ctrlruntimeclient.Client
+
+	workerName string
+	recorder   events.EventRecorder
+	log        *zap.SugaredLogger
+	versions   kubermatic.Versions
+	now        func() time.Time
+}
+
+// Add creates a new etcd storage migration controller.
+func Add(
+	mgr manager.Manager,
+	numWorkers int,
+	workerName string,
+	log *zap.SugaredLogger,
+	versions kubermatic.Versions,
+) error {
+	reconciler := &Reconciler{
+		Client: mgr.GetClient(),
+
+		workerName: workerName,
+		recorder:   mgr.GetEventRecorder(ControllerName),
+		log:        log.Named(ControllerName),
+		versions:   versions,
+		now:        time.Now,
+	}
+
+	enqueueCluster := controllerutil.EnqueueClusterForNamespacedObject(mgr.GetClient())
+
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: numWorkers,
+		}).
+		For(&kubermaticv1.Cluster{}).
+		Watches(&appsv1.StatefulSet{}, enqueueCluster, builder.WithPredicates(predicateutil.ByName(resources.EtcdStatefulSetName))).
+		Watches(&corev1.Pod{}, enqueueCluster, builder.WithPredicates(predicateutil.ByLabel(resources.AppLabelKey, resources.EtcdStatefulSetName))).
+		Build(reconciler)
+
+	return err
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("cluster", request.Name)
+	log.Debug("Reconciling")
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := r.Get(ctx, request.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	result, err := controllerutil.ClusterReconcileWrapper(
+		ctx,
+		r,
+		r.workerName,
+		cluster,
+		r.versions,
+		kubermaticv1.ClusterConditionNone,
+		func() (*reconcile.Result, error) {
+			return r.reconcile(ctx, log, cluster)
+		},
+	)
+
+	if result == nil || err != nil {
+		result = &reconcile.Result{}
+	}
+
+	if err != nil {
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+	}
+
+	return *result, err
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	if cluster.DeletionTimestamp != nil || cluster.Status.NamespaceName == "" {
+		return nil, nil
+	}
+
+	sts := &appsv1.StatefulSet{}
+	key := types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: resources.EtcdStatefulSetName}
+	if err := r.Get(ctx, key, sts); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get etcd StatefulSet: %w", err)
+		}
+		sts = nil
+	}
+
+	migration := cluster.Status.EtcdStorageMigration
+	if !migration.InProgress() {
+		if sts == nil {
+			return nil, nil
+		}
+		return r.startMigration(ctx, log, cluster, sts)
+	}
+
+	log = log.With("phase", migration.Phase)
+
+	// the StatefulSet is only expected to vanish while it is being recreated
+	if sts == nil && migration.Phase != kubermaticv1.EtcdStorageMigrationPhaseRecreatingStatefulSet {
+		return r.waitFor(ctx, cluster, "waiting for the etcd StatefulSet to be created")
+	}
+
+	switch migration.Phase {
+	case kubermaticv1.EtcdStorageMigrationPhaseRecreatingStatefulSet:
+		return r.recreateStatefulSet(ctx, log, cluster, sts)
+	case kubermaticv1.EtcdStorageMigrationPhaseAddingMember:
+		return r.addMember(ctx, log, cluster, sts)
+	case kubermaticv1.EtcdStorageMigrationPhaseReplacingMembers:
+		return r.replaceMembers(ctx, log, cluster, sts)
+	case kubermaticv1.EtcdStorageMigrationPhaseRemovingMember:
+		return r.removeMember(ctx, log, cluster, sts)
+	default:
+		return nil, fmt.Errorf("unknown etcd storage migration phase %q", migration.Phase)
+	}
+}
+
+// startMigration compares the volume claim template of the etcd StatefulSet with the
+// desired storage and starts a migration if they differ and it is safe to do so.
+func (r *Reconciler) startMigration(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, sts *appsv1.StatefulSet) (*reconcile.Result, error) {
+	currentClass, currentSize, ok := volumeClaimTemplate(sts)
+	if !ok {
+		return nil, nil
+	}
+
+	settings := cluster.Spec.ComponentsOverride.Etcd
+
+	targetClass := currentClass
+	if settings.StorageClass != "" {
+		targetClass = settings.StorageClass
+	}
+
+	targetSize := currentSize
+	if settings.DiskSize != nil {
+		targetSize = *settings.DiskSize
+	}
+
+	if targetClass == currentClass && targetSize.Cmp(currentSize) == 0 {
+		return nil, nil
+	}
+
+	if !cluster.Spec.Features[kubermaticv1.ClusterFeatureEtcdLauncher] {
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "EtcdStorageMigrationUnsupported", "Reconciling", "Changing the etcd storage class or disk size of an existing cluster requires the %s feature", kubermaticv1.ClusterFeatureEtcdLauncher)
+		return nil, nil
+	}
+
+	if reason := preflightCheck(cluster, sts); reason != "" {
+		log.Debugw("Postponing etcd storage migration", "reason", reason)
+		return nil, nil
+	}
+
+	now := metav1.NewTime(r.now())
+	err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.EtcdStorageMigration = &kubermaticv1.EtcdStorageMigrationStatus{
+			Phase:              kubermaticv1.EtcdStorageMigrationPhaseRecreatingStatefulSet,
+			StorageClass:       targetClass,
+			DiskSize:           targetSize,
+			ClusterSize:        *sts.Spec.Replicas,
+			StartTime:          now,
+			LastTransitionTime: now,
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start etcd storage migration: %w", err)
+	}
+
+	log.Infow("Starting etcd storage migration", "storageClass", targetClass, "diskSize", targetSize.String())
+	r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "EtcdStorageMigrationStarted", "Reconciling", "Migrating etcd volumes to storage class %q with a size of %s", targetClass, targetSize.String())
+
+	return &reconcile.Result{RequeueAfter: progressInterval}, nil
+}
+
+// preflightCheck returns the reason why a migration cannot be started right now, or an
+// empty string if it is safe to start it.
+func preflightCheck(cluster *kubermaticv1.Cluster, sts *appsv1.StatefulSet) string {
+	if _, ok := cluster.Annotations[etcdrestore.ActiveRestoreAnnotationName]; ok {
+		return "an etcd restore is in progress"
+	}
+	if cluster.Spec.Hibernation != nil && cluster.Spec.Hibernation.Hibernated {
+		return "cluster is hibernating"
+	}
+	if cluster.Status.ExtendedHealth.Etcd != kubermaticv1.HealthStatusUp {
+		return "etcd is not healthy"
+	}
+	if sts.Spec.Replicas == nil || !isScaledTo(sts, *sts.Spec.Replicas) {
+		return "not all etcd members are ready"
+	}
+
+	return ""
+}
+
+// recreateStatefulSet deletes the etcd StatefulSet while keeping its pods running, so that
+// the kubernetes controller recreates it with the new volume claim template.
+func (r *Reconciler) recreateStatefulSet(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, sts *appsv1.StatefulSet) (*reconcile.Result, error) {
+	migration := cluster.Status.EtcdStorageMigration
+
+	if sts == nil || sts.DeletionTimestamp != nil {
+		return r.waitFor(ctx, cluster, "waiting for the etcd StatefulSet to be recreated")
+	}
+
+	if class, size, ok := volumeClaimTemplate(sts); !ok || class != migration.StorageClass || size.Cmp(migration.DiskSize) != 0 {
+		log.Info("Deleting etcd StatefulSet to update its volume claim template")
+
+		if err := r.Delete(ctx, sts, ctrlruntimeclient.PropagationPolicy(metav1.DeletePropagationOrphan)); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed to delete etcd StatefulSet: %w", err)
+		}
+
+		return r.waitFor(ctx, cluster, "waiting for the etcd StatefulSet to be recreated")
+	}
+
+	return r.setPhase(ctx, log, cluster, kubermaticv1.EtcdStorageMigrationPhaseAddingMember)
+}
+
+// addMember waits for the additional member to join the etcd cluster.
+func (r *Reconciler) addMember(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, sts *appsv1.StatefulSet) (*reconcile.Result, error) {
+	migration := cluster.Status.EtcdStorageMigration
+
+	if !isScaledTo(sts, migration.ClusterSize+1) || cluster.Status.ExtendedHealth.Etcd != kubermaticv1.HealthStatusUp {
+		return r.waitFor(ctx, cluster, "waiting for the additional etcd member to become ready")
+	}
+
+	return r.setPhase(ctx, log, cluster, kubermaticv1.EtcdStorageMigrationPhaseReplacingMembers)
+}
+
+// replaceMembers replaces the original members one at a time.
+func (r *Reconciler) replaceMembers(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, sts *appsv1.StatefulSet) (*reconcile.Result, error) {
+	migration := cluster.Status.EtcdStorageMigration
+
+	for i := range migration.ClusterSize {
+		member := fmt.Sprintf("%s-%d", resources.EtcdStatefulSetName, i)
+		if !slices.Contains(migration.MigratedMembers, member) {
+			return r.replaceMember(ctx, log.With("member", member), cluster, sts, member)
+		}
+	}
+
+	return r.setPhase(ctx, log, cluster, kubermaticv1.EtcdStorageMigrationPhaseRemovingMember)
+}
+
+func (r *Reconciler) replaceMember(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, sts *appsv1.StatefulSet, member string) (*reconcile.Result, error) {
+	migration := cluster.Status.EtcdStorageMigration
+
+	// Never take down a member unless all other members are healthy. The member is
+	// recorded before anything is deleted, so that the replacement is continued even if
+	// etcd is degraded while the member is missing.
+	if migration.CurrentMember != member {
+		if !isScaledTo(sts, migration.ClusterSize+1) || cluster.Status.ExtendedHealth.Etcd != kubermaticv1.HealthStatusUp {
+			return r.waitFor(ctx, cluster, "waiting for all etcd members to become ready")
+		}
+
+		err := r.updateMigration(ctx, cluster, func(m *kubermaticv1.EtcdStorageMigrationStatus) {
+			m.CurrentMember = member
+			m.Message = fmt.Sprintf("replacing etcd member %s", member)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		log.Info("Replacing etcd member")
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "EtcdStorageMigrationReplacingMember", "Reconciling", "Replacing etcd member %s", member)
+
+		return &reconcile.Result{RequeueAfter: progressInterval}, nil
+	}
+
+	namespace := cluster.Status.NamespaceName
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: volumeClaimName(member)}, pvc); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get volume: %w", err)
+		}
+		pvc = nil
+	}
+
+	pod := &corev1.Pod{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: member}, pod); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get pod: %w", err)
+		}
+		pod = nil
+	}
+
+	podActive := pod != nil && pod.DeletionTimestamp == nil
+
+	switch {
+	// the member runs on a new volume, wait for it to rejoin the etcd cluster
+	case pvc != nil && pvc.DeletionTimestamp == nil && volumeMatches(pvc.Spec, migration):
+		if !podActive || !podutils.IsPodReady(pod) || cluster.Status.ExtendedHealth.Etcd != kubermaticv1.HealthStatusUp {
+			return r.waitFor(ctx, cluster, fmt.Sprintf("waiting for etcd member %s to rejoin the cluster", member))
+		}
+
+		err := r.updateMigration(ctx, cluster, func(m *kubermaticv1.EtcdStorageMigrationStatus) {
+			m.MigratedMembers = append(m.MigratedMembers, member)
+			m.CurrentMember = ""
+			m.Message = ""
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		log.Info("Replaced etcd member")
+
+		return &reconcile.Result{RequeueAfter: progressInterval}, nil
+
+	// the member still runs on its old volume
+	case pvc != nil && pvc.DeletionTimestamp == nil:
+		log.Infow("Deleting volume of etcd member", "volume", pvc.Name)
+
+		if err := r.Delete(ctx, pvc); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed to delete volume: %w", err)
+		}
+		if podActive {
+			if err := r.Delete(ctx, pod); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+				return nil, fmt.Errorf("failed to delete pod: %w", err)
+			}
+		}
+
+	// A pod that was recreated before its old volume was gone cannot start and the
+	// StatefulSet controller only creates a new volume together with a new pod.
+	case podActive && pod.Status.Phase == corev1.PodPending:
+		log.Info("Deleting pending pod of etcd member")
+
+		if err := r.Delete(ctx, pod); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed to delete pod: %w", err)
+		}
+	}
+
+	return r.waitFor(ctx, cluster, fmt.Sprintf("waiting for the volume of etcd member %s to be replaced", member))
+}
+
+// removeMember waits for the additional member to be removed and deletes its volume, so
+// that a later scale up does not reuse its data.
+func (r *Reconciler) removeMember(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, sts *appsv1.StatefulSet) (*reconcile.Result, error) {
+	migration := cluster.Status.EtcdStorageMigration
+
+	if !isScaledTo(sts, migration.ClusterSize) {
+		return r.waitFor(ctx, cluster, "waiting for the additional etcd member to be removed")
+	}
+
+	member := fmt.Sprintf("%s-%d", resources.EtcdStatefulSetName, migration.ClusterSize)
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	pvc.Name = volumeClaimName(member)
+	pvc.Namespace = cluster.Status.NamespaceName
+
+	if err := r.Delete(ctx, pvc); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return nil, fmt.Errorf("failed to delete volume of the additional etcd member: %w", err)
+	}
+
+	if _, err := r.setPhase(ctx, log, cluster, kubermaticv1.EtcdStorageMigrationPhaseCompleted); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (r *Reconciler) updateMigration(ctx context.Context, cluster *kubermaticv1.Cluster, patch func(m *kubermaticv1.EtcdStorageMigrationStatus)) error {
+	err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		if c.Status.EtcdStorageMigration == nil {
+			c.Status.EtcdStorageMigration = &kubermaticv1.EtcdStorageMigrationStatus{}
+		}
+		patch(c.Status.EtcdStorageMigration)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update etcd storage migration status: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) setPhase(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, phase kubermaticv1.EtcdStorageMigrationPhase) (*reconcile.Result, error) {
+	err := r.updateMigration(ctx, cluster, func(m *kubermaticv1.EtcdStorageMigrationStatus) {
+		m.Phase = phase
+		m.Message = ""
+		m.LastTransitionTime = metav1.NewTime(r.now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infow("etcd storage migration progressed", "next", phase)
+	r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "EtcdStorageMigration"+string(phase), "Reconciling", "etcd storage migration entered phase %s", phase)
+
+	return &reconcile.Result{RequeueAfter: progressInterval}, nil
+}
+
+// waitFor records what the migration is waiting for and requeues the cluster.
+func (r *Reconciler) waitFor(ctx context.Context, cluster *kubermaticv1.Cluster, message string) (*reconcile.Result, error) {
+	err := r.updateMigration(ctx, cluster, func(m *kubermaticv1.EtcdStorageMigrationStatus) {
+		m.Message = message
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &reconcile.Result{RequeueAfter: progressInterval}, nil
+}
+
+// isScaledTo returns true if the StatefulSet is supposed to have the given number of
+// replicas and all of them are up-to-date and ready.
+func isScaledTo(sts *appsv1.StatefulSet, replicas int32) bool {
+	return sts.Spec.Replicas != nil &&
+		*sts.Spec.Replicas == replicas &&
+		sts.Status.ObservedGeneration >= sts.Generation &&
+		sts.Status.Replicas == replicas &&
+		sts.Status.ReadyReplicas == replicas
+}
+
+func volumeClaimTemplate(sts *appsv1.StatefulSet) (string, resource.Quantity, bool) {
+	for _, template := range sts.Spec.VolumeClaimTemplates {
+		if template.Name == volumeName && template.Spec.StorageClassName != nil {
+			return *template.Spec.StorageClassName, template.Spec.Resources.Requests[corev1.ResourceStorage], true
+		}
+	}
+
+	return "", resource.Quantity{}, false
+}
+
+func volumeMatches(spec corev1.PersistentVolumeClaimSpec, migration *kubermaticv1.EtcdStorageMigrationStatus) bool {
+	size := spec.Resources.Requests[corev1.ResourceStorage]
+
+	return spec.StorageClassName != nil && *spec.StorageClassName == migration.StorageClass && size.Cmp(migration.DiskSize) == 0
+}
+
+func volumeClaimName(member string) string {
+	return fmt.Sprintf("%s-%s", volumeName, member)
+}
@@ -0,0 +1,455 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdstoragemigrationcontroller
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const clusterNamespace = "cluster-testcluster"
+
+var (
+	oldSize = resource.MustParse("5Gi")
+	newSize = resource.MustParse("20Gi")
+)
+
+func etcdStatefulSet(storageClass string, size resource.Quantity, replicas, ready int32) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "etcd",
+			Namespace: clusterNamespace,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(replicas),
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "data"},
+					Spec:       volumeSpec(storageClass, size),
+				},
+			},
+		},
+		Status: appsv1.StatefulSetStatus{
+			Replicas:      replicas,
+			ReadyReplicas: ready,
+		},
+	}
+}
+
+func volumeSpec(storageClass string, size resource.Quantity) corev1.PersistentVolumeClaimSpec {
+	return corev1.PersistentVolumeClaimSpec{
+		StorageClassName: ptr.To(storageClass),
+		Resources: corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceStorage: size},
+		},
+	}
+}
+
+func volume(member, storageClass string, size resource.Quantity) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "data-" + member,
+			Namespace: clusterNamespace,
+		},
+		Spec: volumeSpec(storageClass, size),
+	}
+}
+
+func pod(member string, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      member,
+			Namespace: clusterNamespace,
+		},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name               string
+		launcher           bool
+		storageClass       string
+		diskSize           *resource.Quantity
+		health             kubermaticv1.HealthStatus
+		migration          *kubermaticv1.EtcdStorageMigrationStatus
+		objects            []ctrlruntimeclient.Object
+		expectedMigration  *kubermaticv1.EtcdStorageMigrationStatus
+		expectedDeleted    []ctrlruntimeclient.Object
+		expectedNotDeleted []ctrlruntimeclient.Object
+	}{
+		{
+			name:         "no migration without changes",
+			launcher:     true,
+			storageClass: "kubermatic-fast",
+			health:       kubermaticv1.HealthStatusUp,
+			objects:      []ctrlruntimeclient.Object{etcdStatefulSet("kubermatic-fast", oldSize, 3, 3)},
+		},
+		{
+			name:         "no migration without etcd-launcher",
+			storageClass: "premium",
+			health:       kubermaticv1.HealthStatusUp,
+			objects:      []ctrlruntimeclient.Object{etcdStatefulSet("kubermatic-fast", oldSize, 3, 3)},
+		},
+		{
+			name:         "no migration while etcd is unhealthy",
+			launcher:     true,
+			storageClass: "premium",
+			health:       kubermaticv1.HealthStatusDown,
+			objects:      []ctrlruntimeclient.Object{etcdStatefulSet("kubermatic-fast", oldSize, 3, 3)},
+		},
+		{
+			name:         "start migration",
+			launcher:     true,
+			storageClass: "premium",
+			diskSize:     &newSize,
+			health:       kubermaticv1.HealthStatusUp,
+			objects:      []ctrlruntimeclient.Object{etcdStatefulSet("kubermatic-fast", oldSize, 3, 3)},
+			expectedMigration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:        kubermaticv1.EtcdStorageMigrationPhaseRecreatingStatefulSet,
+				StorageClass: "premium",
+				DiskSize:     newSize,
+				ClusterSize:  3,
+			},
+		},
+		{
+			name:         "delete StatefulSet with outdated volume claim template",
+			launcher:     true,
+			storageClass: "premium",
+			health:       kubermaticv1.HealthStatusUp,
+			migration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:        kubermaticv1.EtcdStorageMigrationPhaseRecreatingStatefulSet,
+				StorageClass: "premium",
+				DiskSize:     newSize,
+				ClusterSize:  3,
+			},
+			objects:         []ctrlruntimeclient.Object{etcdStatefulSet("kubermatic-fast", oldSize, 3, 3)},
+			expectedDeleted: []ctrlruntimeclient.Object{&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "etcd", Namespace: clusterNamespace}}},
+			expectedMigration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:        kubermaticv1.EtcdStorageMigrationPhaseRecreatingStatefulSet,
+				StorageClass: "premium",
+				DiskSize:     newSize,
+				ClusterSize:  3,
+				Message:      "waiting for the etcd StatefulSet to be recreated",
+			},
+		},
+		{
+			name:         "add member once the StatefulSet was recreated",
+			launcher:     true,
+			storageClass: "premium",
+			health:       kubermaticv1.HealthStatusUp,
+			migration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:        kubermaticv1.EtcdStorageMigrationPhaseRecreatingStatefulSet,
+				StorageClass: "premium",
+				DiskSize:     newSize,
+				ClusterSize:  3,
+			},
+			objects: []ctrlruntimeclient.Object{etcdStatefulSet("premium", newSize, 3, 3)},
+			expectedMigration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:        kubermaticv1.EtcdStorageMigrationPhaseAddingMember,
+				StorageClass: "premium",
+				DiskSize:     newSize,
+				ClusterSize:  3,
+			},
+		},
+		{
+			name:         "select the next member to replace",
+			launcher:     true,
+			storageClass: "premium",
+			health:       kubermaticv1.HealthStatusUp,
+			migration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:           kubermaticv1.EtcdStorageMigrationPhaseReplacingMembers,
+				StorageClass:    "premium",
+				DiskSize:        newSize,
+				ClusterSize:     3,
+				MigratedMembers: []string{"etcd-0"},
+			},
+			objects: []ctrlruntimeclient.Object{
+				etcdStatefulSet("premium", newSize, 4, 4),
+				volume("etcd-1", "kubermatic-fast", oldSize),
+				pod("etcd-1", true),
+			},
+			expectedNotDeleted: []ctrlruntimeclient.Object{volume("etcd-1", "", oldSize), pod("etcd-1", true)},
+			expectedMigration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:           kubermaticv1.EtcdStorageMigrationPhaseReplacingMembers,
+				StorageClass:    "premium",
+				DiskSize:        newSize,
+				ClusterSize:     3,
+				MigratedMembers: []string{"etcd-0"},
+				CurrentMember:   "etcd-1",
+				Message:         "replacing etcd member etcd-1",
+			},
+		},
+		{
+			name:         "do not replace a member while not all members are ready",
+			launcher:     true,
+			storageClass: "premium",
+			health:       kubermaticv1.HealthStatusUp,
+			migration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:        kubermaticv1.EtcdStorageMigrationPhaseReplacingMembers,
+				StorageClass: "premium",
+				DiskSize:     newSize,
+				ClusterSize:  3,
+			},
+			objects: []ctrlruntimeclient.Object{
+				etcdStatefulSet("premium", newSize, 4, 3),
+				volume("etcd-0", "kubermatic-fast", oldSize),
+				pod("etcd-0", true),
+			},
+			expectedNotDeleted: []ctrlruntimeclient.Object{volume("etcd-0", "", oldSize), pod("etcd-0", true)},
+			expectedMigration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:        kubermaticv1.EtcdStorageMigrationPhaseReplacingMembers,
+				StorageClass: "premium",
+				DiskSize:     newSize,
+				ClusterSize:  3,
+				Message:      "waiting for all etcd members to become ready",
+			},
+		},
+		{
+			name:         "delete volume and pod of the current member",
+			launcher:     true,
+			storageClass: "premium",
+			health:       kubermaticv1.HealthStatusUp,
+			migration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:         kubermaticv1.EtcdStorageMigrationPhaseReplacingMembers,
+				StorageClass:  "premium",
+				DiskSize:      newSize,
+				ClusterSize:   3,
+				CurrentMember: "etcd-0",
+			},
+			objects: []ctrlruntimeclient.Object{
+				etcdStatefulSet("premium", newSize, 4, 4),
+				volume("etcd-0", "kubermatic-fast", oldSize),
+				pod("etcd-0", true),
+			},
+			expectedDeleted: []ctrlruntimeclient.Object{volume("etcd-0", "", oldSize), pod("etcd-0", true)},
+			expectedMigration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:         kubermaticv1.EtcdStorageMigrationPhaseReplacingMembers,
+				StorageClass:  "premium",
+				DiskSize:      newSize,
+				ClusterSize:   3,
+				CurrentMember: "etcd-0",
+				Message:       "waiting for the volume of etcd member etcd-0 to be replaced",
+			},
+		},
+		{
+			name:         "wait for the replaced member to rejoin",
+			launcher:     true,
+			storageClass: "premium",
+			health:       kubermaticv1.HealthStatusDown,
+			migration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:         kubermaticv1.EtcdStorageMigrationPhaseReplacingMembers,
+				StorageClass:  "premium",
+				DiskSize:      newSize,
+				ClusterSize:   3,
+				CurrentMember: "etcd-0",
+			},
+			objects: []ctrlruntimeclient.Object{
+				etcdStatefulSet("premium", newSize, 4, 3),
+				volume("etcd-0", "premium", newSize),
+				pod("etcd-0", false),
+			},
+			expectedNotDeleted: []ctrlruntimeclient.Object{volume("etcd-0", "", newSize), pod("etcd-0", false)},
+			expectedMigration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:         kubermaticv1.EtcdStorageMigrationPhaseReplacingMembers,
+				StorageClass:  "premium",
+				DiskSize:      newSize,
+				ClusterSize:   3,
+				CurrentMember: "etcd-0",
+				Message:       "waiting for etcd member etcd-0 to rejoin the cluster",
+			},
+		},
+		{
+			name:         "record the replaced member",
+			launcher:     true,
+			storageClass: "premium",
+			health:       kubermaticv1.HealthStatusUp,
+			migration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:         kubermaticv1.EtcdStorageMigrationPhaseReplacingMembers,
+				StorageClass:  "premium",
+				DiskSize:      newSize,
+				ClusterSize:   3,
+				CurrentMember: "etcd-0",
+			},
+			objects: []ctrlruntimeclient.Object{
+				etcdStatefulSet("premium", newSize, 4, 4),
+				volume("etcd-0", "premium", newSize),
+				pod("etcd-0", true),
+			},
+			expectedMigration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:           kubermaticv1.EtcdStorageMigrationPhaseReplacingMembers,
+				StorageClass:    "premium",
+				DiskSize:        newSize,
+				ClusterSize:     3,
+				MigratedMembers: []string{"etcd-0"},
+			},
+		},
+		{
+			name:         "remove the additional member after all members were replaced",
+			launcher:     true,
+			storageClass: "premium",
+			health:       kubermaticv1.HealthStatusUp,
+			migration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:           kubermaticv1.EtcdStorageMigrationPhaseReplacingMembers,
+				StorageClass:    "premium",
+				DiskSize:        newSize,
+				ClusterSize:     3,
+				MigratedMembers: []string{"etcd-0", "etcd-1", "etcd-2"},
+			},
+			objects: []ctrlruntimeclient.Object{etcdStatefulSet("premium", newSize, 4, 4)},
+			expectedMigration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:           kubermaticv1.EtcdStorageMigrationPhaseRemovingMember,
+				StorageClass:    "premium",
+				DiskSize:        newSize,
+				ClusterSize:     3,
+				MigratedMembers: []string{"etcd-0", "etcd-1", "etcd-2"},
+			},
+		},
+		{
+			name:         "complete migration",
+			launcher:     true,
+			storageClass: "premium",
+			health:       kubermaticv1.HealthStatusUp,
+			migration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:           kubermaticv1.EtcdStorageMigrationPhaseRemovingMember,
+				StorageClass:    "premium",
+				DiskSize:        newSize,
+				ClusterSize:     3,
+				MigratedMembers: []string{"etcd-0", "etcd-1", "etcd-2"},
+			},
+			objects: []ctrlruntimeclient.Object{
+				etcdStatefulSet("premium", newSize, 3, 3),
+				volume("etcd-3", "premium", newSize),
+			},
+			expectedDeleted: []ctrlruntimeclient.Object{volume("etcd-3", "", newSize)},
+			expectedMigration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:           kubermaticv1.EtcdStorageMigrationPhaseCompleted,
+				StorageClass:    "premium",
+				DiskSize:        newSize,
+				ClusterSize:     3,
+				MigratedMembers: []string{"etcd-0", "etcd-1", "etcd-2"},
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "testcluster",
+				},
+				Spec: kubermaticv1.ClusterSpec{
+					Features: map[string]bool{kubermaticv1.ClusterFeatureEtcdLauncher: tt.launcher},
+					ComponentsOverride: kubermaticv1.ComponentSettings{
+						Etcd: kubermaticv1.EtcdStatefulSetSettings{
+							StorageClass: tt.storageClass,
+							DiskSize:     tt.diskSize,
+						},
+					},
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName:        clusterNamespace,
+					ExtendedHealth:       kubermaticv1.ExtendedClusterHealth{Etcd: tt.health},
+					EtcdStorageMigration: tt.migration,
+				},
+			}
+
+			rec := &Reconciler{
+				Client:   fake.NewClientBuilder().WithObjects(append(tt.objects, cluster)...).Build(),
+				log:      zap.NewNop().Sugar(),
+				recorder: events.NewFakeRecorder(10),
+				now:      func() time.Time { return now },
+			}
+
+			if _, err := rec.reconcile(ctx, rec.log, cluster); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			newCluster := &kubermaticv1.Cluster{}
+			if err := rec.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), newCluster); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			migration := newCluster.Status.EtcdStorageMigration
+			if migration != nil {
+				// timestamps are not compared
+				migration = migration.DeepCopy()
+				migration.StartTime = metav1.Time{}
+				migration.LastTransitionTime = metav1.Time{}
+			}
+
+			if !migrationEqual(migration, tt.expectedMigration) {
+				t.Errorf("Expected migration status\n%+v\ngot\n%+v", tt.expectedMigration, migration)
+			}
+
+			for _, obj := range tt.expectedDeleted {
+				if err := rec.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, obj); !apierrors.IsNotFound(err) {
+					t.Errorf("Expected %T %s to be deleted, got %v.", obj, obj.GetName(), err)
+				}
+			}
+
+			for _, obj := range tt.expectedNotDeleted {
+				if err := rec.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, obj); err != nil {
+					t.Errorf("Expected %T %s to exist, got %v.", obj, obj.GetName(), err)
+				}
+			}
+		})
+	}
+}
+
+func migrationEqual(a, b *kubermaticv1.EtcdStorageMigrationStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Phase == b.Phase &&
+		a.StorageClass == b.StorageClass &&
+		a.DiskSize.Cmp(b.DiskSize) == 0 &&
+		a.ClusterSize == b.ClusterSize &&
+		slices.Equal(a.MigratedMembers, b.MigratedMembers) &&
+		a.CurrentMember == b.CurrentMember &&
+		a.Message == b.Message
+}
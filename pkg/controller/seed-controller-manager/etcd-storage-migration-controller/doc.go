@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package etcdstoragemigrationcontroller contains a controller that moves the etcd members
+of a user cluster to a new StorageClass or disk size without downtime.
+
+Volume claim templates of a StatefulSet are immutable, so changes to
+`spec.componentsOverride.etcd.storageClass` and `diskSize` are otherwise only applied to
+new clusters. For clusters using the etcd-launcher, the controller instead performs a
+rolling member replacement:
+
+ 1. The etcd StatefulSet is deleted without its pods and recreated by the kubernetes
+    controller with the new volume claim template.
+ 2. One additional member is added, which is created on the new storage, so that the
+    cluster keeps its fault tolerance during the migration.
+ 3. The original members are replaced one at a time by deleting their volume and pod.
+    The etcd-launcher notices the missing data directory and rejoins the etcd cluster
+    as a fresh member, once the remaining members are healthy.
+ 4. The additional member is removed again.
+
+A replacement is only started while etcd is healthy and all members are ready. The
+progress is reported in `status.etcdStorageMigration`.
+*/
+package etcdstoragemigrationcontroller
@@ -24,6 +24,7 @@ import (
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/machine"
+	"k8c.io/kubermatic/v2/pkg/util/nodeupgrade"
 	"k8c.io/kubermatic/v2/pkg/validation/nodeupdate"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 	"k8c.io/machine-controller/sdk/providerconfig"
@@ -94,6 +95,9 @@ func CompleteMachineDeployment(md *clusterv1alpha1.MachineDeployment, cluster *k
 		md.Spec.Template.Spec.Versions.Kubelet = cluster.Spec.Version.String()
 	}
 
+	// use the cluster's node upgrade policy for rolling out future node upgrades
+	nodeupgrade.ApplyToMachineDeployment(md, cluster.Spec.NodeUpgradePolicy)
+
 	if len(cluster.Spec.MachineNetworks) > 0 {
 		if md.Spec.Template.Annotations == nil {
 			md.Spec.Template.Annotations = make(map[string]string)
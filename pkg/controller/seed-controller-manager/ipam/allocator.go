@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"context"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Allocator allocates prefixes or address ranges of an IPAM pool for clusters.
+type Allocator interface {
+	// Allocate sets the CIDR ("type=prefix") or the addresses ("type=range") of the
+	// IPAMAllocation of the cluster. It is called on every reconciliation and must
+	// return the same result for a cluster as long as the pool does not change.
+	Allocate(ctx context.Context, cluster *kubermaticv1.Cluster, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings, ipamAllocation *kubermaticv1.IPAMAllocation) error
+
+	// Release frees everything that has been allocated for the cluster, even if
+	// the IPAMAllocation does not exist anymore.
+	Release(ctx context.Context, clusterName string) error
+}
+
+// newAllocator returns the allocator for the pool in the given datacenter.
+func (r *Reconciler) newAllocator(ctx context.Context, ipamPool *kubermaticv1.IPAMPool, dc string) (Allocator, error) {
+	if ipamPool.Spec.Backend != nil {
+		return newBackendAllocator(ctx, r, ipamPool)
+	}
+
+	dcIPAMPoolUsageMap, err := r.compileCurrentAllocationsForPoolInDatacenter(ctx, ipamPool, dc, ipamPool.Spec.Datacenters[dc])
+	if err != nil {
+		return nil, err
+	}
+
+	return &internalAllocator{poolName: ipamPool.Name, dcIPAMPoolUsageMap: dcIPAMPoolUsageMap}, nil
+}
+
+// newBackendAllocator returns the allocator for the external backend of the pool.
+func newBackendAllocator(ctx context.Context, client ctrlruntimeclient.Reader, ipamPool *kubermaticv1.IPAMPool) (Allocator, error) {
+	switch {
+	case ipamPool.Spec.Backend.NetBox != nil:
+		return newNetBoxAllocator(ctx, client, ipamPool.Name, ipamPool.Spec.Backend.NetBox)
+	default:
+		return nil, fmt.Errorf("no backend configured for IPAM pool %s", ipamPool.Name)
+	}
+}
+
+// internalAllocator allocates from the pool CIDR, based on the IPAMAllocations
+// that exist in the seed cluster.
+type internalAllocator struct {
+	poolName           string
+	dcIPAMPoolUsageMap sets.Set[string]
+}
+
+var _ Allocator = &internalAllocator{}
+
+func (a *internalAllocator) Allocate(_ context.Context, _ *kubermaticv1.Cluster, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings, ipamAllocation *kubermaticv1.IPAMAllocation) error {
+	switch dcIPAMPoolCfg.Type {
+	case kubermaticv1.IPAMPoolAllocationTypeRange:
+		ipsAllocated, err := getIPsFromAddressRanges(ipamAllocation.Spec.Addresses)
+		if err != nil {
+			return err
+		}
+
+		newIPRangeToAllocate := dcIPAMPoolCfg.AllocationRange - len(ipsAllocated)
+
+		addresses, err := findFirstFreeRangesOfPool(a.poolName, string(dcIPAMPoolCfg.PoolCIDR), newIPRangeToAllocate, a.dcIPAMPoolUsageMap)
+		if err != nil {
+			return err
+		}
+		ipamAllocation.Spec.Addresses = append(ipamAllocation.Spec.Addresses, addresses...)
+	case kubermaticv1.IPAMPoolAllocationTypePrefix:
+		subnetCIDR, err := findFirstFreeSubnetOfPool(a.poolName, string(dcIPAMPoolCfg.PoolCIDR), string(ipamAllocation.Spec.CIDR), dcIPAMPoolCfg.AllocationPrefix, a.dcIPAMPoolUsageMap)
+		if err != nil {
+			return err
+		}
+		ipamAllocation.Spec.CIDR = kubermaticv1.SubnetCIDR(subnetCIDR)
+	}
+
+	return nil
+}
+
+func (a *internalAllocator) Release(_ context.Context, _ string) error {
+	// allocations are only tracked as IPAMAllocations, which are
+	// deleted together with the cluster namespace
+	return nil
+}
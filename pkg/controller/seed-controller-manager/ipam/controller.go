@@ -18,7 +18,9 @@ package ipam
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
 
 	"go.uber.org/zap"
 
@@ -32,7 +34,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/events"
@@ -46,6 +50,10 @@ import (
 
 const (
 	ControllerName = "kkp-ipam-controller"
+
+	// externalAllocationsCleanupFinalizer indicates that allocations in external
+	// IPAM backends still need to be released.
+	externalAllocationsCleanupFinalizer = "kubermatic.k8c.io/cleanup-external-ipam-allocations"
 )
 
 // Reconciler stores all components required for the IPAM controller.
@@ -67,6 +75,10 @@ func Add(
 	configGetter provider.KubermaticConfigurationGetter,
 	versions kubermatic.Versions,
 ) error {
+	if err := addPoolStatusController(mgr, log); err != nil {
+		return fmt.Errorf("failed to create pool status controller: %w", err)
+	}
+
 	log = log.Named(ControllerName)
 
 	reconciler := &Reconciler{
@@ -132,8 +144,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if cluster.DeletionTimestamp != nil {
-		log.Debug("Cluster is in deletion, skipping")
-		return reconcile.Result{}, nil
+		if !kuberneteshelper.HasFinalizer(cluster, externalAllocationsCleanupFinalizer) {
+			log.Debug("Cluster is in deletion, skipping")
+			return reconcile.Result{}, nil
+		}
+
+		if err := r.releaseExternalAllocations(ctx, log, cluster); err != nil {
+			r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "ReleasingIPAMAllocationsFailed", "Releasing", err.Error())
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{}, kuberneteshelper.TryRemoveFinalizer(ctx, r, cluster, externalAllocationsCleanupFinalizer)
 	}
 
 	// Add a wrapping here so we can emit an event on error
@@ -145,7 +166,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		r.versions,
 		kubermaticv1.ClusterConditionIPAMControllerReconcilingSuccess,
 		func() (*reconcile.Result, error) {
-			return r.reconcile(ctx, cluster)
+			result, err := r.reconcile(ctx, cluster)
+			if condErr := r.updateAllocationsCondition(ctx, cluster, err); condErr != nil {
+				return nil, kerrors.NewAggregate([]error{err, condErr})
+			}
+
+			return result, err
 		},
 	)
 
@@ -180,6 +206,15 @@ func (r *Reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluste
 			if !isClusterDCConfigured {
 				// There is an allocation for a datacenter that is not present
 				// in the IPAM pool spec anymore, so delete it
+				if ipamPool.Spec.Backend != nil {
+					allocator, err := newBackendAllocator(ctx, r, &ipamPool)
+					if err != nil {
+						return nil, err
+					}
+					if err := allocator.Release(ctx, cluster.Name); err != nil {
+						return nil, err
+					}
+				}
 				if err := r.Delete(ctx, ipamAllocation); err != nil && !apierrors.IsNotFound(err) {
 					return nil, err
 				}
@@ -187,18 +222,47 @@ func (r *Reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluste
 		} else if !apierrors.IsNotFound(err) {
 			return nil, err
 		}
+		allocationExists := err == nil
 
 		if !isClusterDCConfigured {
 			// This IPAM pool is not relevant to cluster, so skip it
 			continue
 		}
 
-		dcIPAMPoolUsageMap, err := r.compileCurrentAllocationsForPoolInDatacenter(ctx, ipamPool.Name, clusterDC, dcIPAMPoolCfg)
+		if ipamPool.DeletionTimestamp != nil {
+			// The IPAM pool is being deleted and its external allocations are
+			// released, so nothing must be allocated anymore
+			continue
+		}
+
+		reservation := findReservation(&ipamPool, cluster.Name, clusterDC)
+
+		// Existing allocations are kept even if the cluster does not match the
+		// selector anymore, so only new allocations need to be checked
+		if reservation == nil && !allocationExists {
+			matches, err := clusterMatchesPool(&ipamPool, cluster)
+			if err != nil {
+				return nil, err
+			}
+			if !matches {
+				// This IPAM pool is not relevant to cluster, so skip it
+				continue
+			}
+		}
+
+		allocator, err := r.newAllocator(ctx, &ipamPool, clusterDC)
 		if err != nil {
 			return nil, err
 		}
 
-		err = r.ensureIPAMAllocation(ctx, cluster, &ipamPool, dcIPAMPoolCfg, dcIPAMPoolUsageMap, ipamAllocation)
+		// ensure that allocations in external backends are released when the cluster is deleted
+		if ipamPool.Spec.Backend != nil {
+			if err := kuberneteshelper.TryAddFinalizer(ctx, r, cluster, externalAllocationsCleanupFinalizer); err != nil {
+				return nil, fmt.Errorf("failed to add finalizer: %w", err)
+			}
+		}
+
+		err = r.ensureIPAMAllocation(ctx, cluster, &ipamPool, dcIPAMPoolCfg, allocator, reservation, ipamAllocation)
 		if err != nil {
 			return nil, err
 		}
@@ -207,7 +271,53 @@ func (r *Reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluste
 	return nil, nil
 }
 
-func (r *Reconciler) compileCurrentAllocationsForPoolInDatacenter(ctx context.Context, ipamPoolName, dc string, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings) (sets.Set[string], error) {
+// updateAllocationsCondition sets a condition on the cluster explaining why it is
+// blocked on IPAM allocation. Once the allocation succeeds, the condition is set
+// to true again.
+func (r *Reconciler) updateAllocationsCondition(ctx context.Context, cluster *kubermaticv1.Cluster, reconcileErr error) error {
+	var (
+		status     = corev1.ConditionTrue
+		reason     string
+		message    string
+		exhausted  *poolExhaustedError
+		backendErr *backendError
+	)
+
+	switch {
+	case errors.As(reconcileErr, &exhausted):
+		status = corev1.ConditionFalse
+		reason = kubermaticv1.ReasonClusterIPAMPoolExhausted
+		message = exhausted.Error()
+
+	case errors.Is(reconcileErr, errIncompatiblePool):
+		status = corev1.ConditionFalse
+		reason = kubermaticv1.ReasonClusterIPAMPoolIncompatible
+		message = reconcileErr.Error()
+
+	case errors.As(reconcileErr, &backendErr):
+		status = corev1.ConditionFalse
+		reason = kubermaticv1.ReasonClusterIPAMBackendError
+		message = backendErr.Error()
+
+	case reconcileErr != nil:
+		// other errors are transient and already reflected in the
+		// IPAMControllerReconciledSuccessfully condition
+		return nil
+	}
+
+	// do not add the condition to clusters that never had any allocation problems
+	if _, exists := cluster.Status.Conditions[kubermaticv1.ClusterConditionIPAMAllocationsReady]; !exists && status == corev1.ConditionTrue {
+		return nil
+	}
+
+	return util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		util.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionIPAMAllocationsReady, status, reason, message)
+	})
+}
+
+func (r *Reconciler) compileCurrentAllocationsForPoolInDatacenter(ctx context.Context, ipamPool *kubermaticv1.IPAMPool, dc string, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings) (sets.Set[string], error) {
+	ipamPoolName := ipamPool.Name
+
 	dcIPAMPoolUsageMap := sets.New[string]()
 	// Check for exclusions in the configuration to mark them as "not free"
 	switch dcIPAMPoolCfg.Type {
@@ -225,6 +335,29 @@ func (r *Reconciler) compileCurrentAllocationsForPoolInDatacenter(ctx context.Co
 		}
 	}
 
+	// Reservations are "not free" for any cluster, even if the reserved cluster
+	// does not exist (yet)
+	for _, reservation := range ipamPool.Spec.Reservations {
+		if reservation.Datacenter != dc {
+			continue
+		}
+
+		switch dcIPAMPoolCfg.Type {
+		case kubermaticv1.IPAMPoolAllocationTypeRange:
+			reservedIPs, err := getIPsFromAddressRanges(reservation.Addresses)
+			if err != nil {
+				return nil, err
+			}
+			dcIPAMPoolUsageMap.Insert(reservedIPs...)
+		case kubermaticv1.IPAMPoolAllocationTypePrefix:
+			_, reservedSubnet, err := net.ParseCIDR(string(reservation.CIDR))
+			if err != nil {
+				return nil, err
+			}
+			dcIPAMPoolUsageMap.Insert(reservedSubnet.String())
+		}
+	}
+
 	// List all IPAM allocations
 	ipamAllocationList := &kubermaticv1.IPAMAllocationList{}
 	err := r.List(ctx, ipamAllocationList)
@@ -267,9 +400,9 @@ func (r *Reconciler) compileCurrentAllocationsForPoolInDatacenter(ctx context.Co
 	return dcIPAMPoolUsageMap, nil
 }
 
-func (r *Reconciler) ensureIPAMAllocation(ctx context.Context, cluster *kubermaticv1.Cluster, ipamPool *kubermaticv1.IPAMPool, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings, dcIPAMPoolUsageMap sets.Set[string], ipamAllocation *kubermaticv1.IPAMAllocation) error {
+func (r *Reconciler) ensureIPAMAllocation(ctx context.Context, cluster *kubermaticv1.Cluster, ipamPool *kubermaticv1.IPAMPool, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings, allocator Allocator, reservation *kubermaticv1.IPAMPoolReservation, ipamAllocation *kubermaticv1.IPAMAllocation) error {
 	creators := []reconciling.NamedIPAMAllocationReconcilerFactory{
-		IPAMAllocationReconciler(ipamAllocation, cluster, ipamPool, dcIPAMPoolCfg, dcIPAMPoolUsageMap),
+		IPAMAllocationReconciler(ctx, ipamAllocation, cluster, ipamPool, dcIPAMPoolCfg, allocator, reservation),
 	}
 
 	if err := reconciling.ReconcileIPAMAllocations(ctx, creators, cluster.Status.NamespaceName, r); err != nil {
@@ -279,7 +412,9 @@ func (r *Reconciler) ensureIPAMAllocation(ctx context.Context, cluster *kubermat
 }
 
 // IPAMAllocationReconciler returns the function to reconcile the IPAMAllocation.
-func IPAMAllocationReconciler(ipamAllocation *kubermaticv1.IPAMAllocation, cluster *kubermaticv1.Cluster, ipamPool *kubermaticv1.IPAMPool, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings, dcIPAMPoolUsageMap sets.Set[string]) reconciling.NamedIPAMAllocationReconcilerFactory {
+// If a reservation is given, the allocation always matches the reservation,
+// otherwise the allocator is used.
+func IPAMAllocationReconciler(ctx context.Context, ipamAllocation *kubermaticv1.IPAMAllocation, cluster *kubermaticv1.Cluster, ipamPool *kubermaticv1.IPAMPool, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings, allocator Allocator, reservation *kubermaticv1.IPAMPoolReservation) reconciling.NamedIPAMAllocationReconcilerFactory {
 	return func() (string, reconciling.IPAMAllocationReconciler) {
 		return ipamPool.Name, func(ipamAllocation *kubermaticv1.IPAMAllocation) (*kubermaticv1.IPAMAllocation, error) {
 			kuberneteshelper.EnsureUniqueOwnerReference(ipamAllocation, metav1.OwnerReference{
@@ -291,29 +426,77 @@ func IPAMAllocationReconciler(ipamAllocation *kubermaticv1.IPAMAllocation, clust
 			ipamAllocation.Spec.Type = dcIPAMPoolCfg.Type
 			ipamAllocation.Spec.DC = cluster.Spec.Cloud.DatacenterName
 
-			switch dcIPAMPoolCfg.Type {
-			case kubermaticv1.IPAMPoolAllocationTypeRange:
-				ipsAllocated, err := getIPsFromAddressRanges(ipamAllocation.Spec.Addresses)
-				if err != nil {
-					return nil, err
+			if reservation != nil {
+				switch dcIPAMPoolCfg.Type {
+				case kubermaticv1.IPAMPoolAllocationTypeRange:
+					ipamAllocation.Spec.Addresses = reservation.Addresses
+				case kubermaticv1.IPAMPoolAllocationTypePrefix:
+					ipamAllocation.Spec.CIDR = reservation.CIDR
 				}
 
-				newIPRangeToAllocate := dcIPAMPoolCfg.AllocationRange - len(ipsAllocated)
+				return ipamAllocation, nil
+			}
 
-				addresses, err := findFirstFreeRangesOfPool(ipamPool.Name, string(dcIPAMPoolCfg.PoolCIDR), newIPRangeToAllocate, dcIPAMPoolUsageMap)
-				if err != nil {
-					return nil, err
-				}
-				ipamAllocation.Spec.Addresses = append(ipamAllocation.Spec.Addresses, addresses...)
-			case kubermaticv1.IPAMPoolAllocationTypePrefix:
-				subnetCIDR, err := findFirstFreeSubnetOfPool(ipamPool.Name, string(dcIPAMPoolCfg.PoolCIDR), string(ipamAllocation.Spec.CIDR), dcIPAMPoolCfg.AllocationPrefix, dcIPAMPoolUsageMap)
-				if err != nil {
-					return nil, err
-				}
-				ipamAllocation.Spec.CIDR = kubermaticv1.SubnetCIDR(subnetCIDR)
+			if err := allocator.Allocate(ctx, cluster, dcIPAMPoolCfg, ipamAllocation); err != nil {
+				return nil, err
 			}
 
 			return ipamAllocation, nil
 		}
 	}
 }
+
+// releaseExternalAllocations releases the allocations of the cluster in all
+// IPAM pools with an external backend.
+func (r *Reconciler) releaseExternalAllocations(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
+	ipamPoolList := &kubermaticv1.IPAMPoolList{}
+	if err := r.List(ctx, ipamPoolList); err != nil {
+		return fmt.Errorf("failed to list IPAM pools: %w", err)
+	}
+
+	var errs []error
+	for _, ipamPool := range ipamPoolList.Items {
+		if ipamPool.Spec.Backend == nil {
+			continue
+		}
+
+		allocator, err := newBackendAllocator(ctx, r, &ipamPool)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		log.Debugw("Releasing external allocations", "pool", ipamPool.Name)
+		if err := allocator.Release(ctx, cluster.Name); err != nil {
+			errs = append(errs, fmt.Errorf("failed to release allocations of IPAM pool %s: %w", ipamPool.Name, err))
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// findReservation returns the reservation of the pool for the given cluster, if any.
+func findReservation(ipamPool *kubermaticv1.IPAMPool, clusterName, dc string) *kubermaticv1.IPAMPoolReservation {
+	for i, reservation := range ipamPool.Spec.Reservations {
+		if reservation.Cluster == clusterName && reservation.Datacenter == dc {
+			return &ipamPool.Spec.Reservations[i]
+		}
+	}
+
+	return nil
+}
+
+// clusterMatchesPool returns true if the pool has no cluster selector or if the
+// selector matches the cluster labels.
+func clusterMatchesPool(ipamPool *kubermaticv1.IPAMPool, cluster *kubermaticv1.Cluster) (bool, error) {
+	if ipamPool.Spec.ClusterSelector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(ipamPool.Spec.ClusterSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid cluster selector in IPAM pool %s: %w", ipamPool.Name, err)
+	}
+
+	return selector.Matches(labels.Set(cluster.Labels)), nil
+}
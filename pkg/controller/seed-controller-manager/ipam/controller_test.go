@@ -45,6 +45,12 @@ func generateTestCluster(clusterName, dc string) *kubermaticv1.Cluster {
 	}
 }
 
+func generateTestClusterWithLabels(clusterName, dc string, labels map[string]string) *kubermaticv1.Cluster {
+	cluster := generateTestCluster(clusterName, dc)
+	cluster.Labels = labels
+	return cluster
+}
+
 func TestReconcileCluster(t *testing.T) {
 	testCases := []struct {
 		name                       string
@@ -1047,6 +1053,261 @@ func TestReconcileCluster(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "cluster selector: skip cluster not matching the selector",
+			cluster: generateTestClusterWithLabels("test-cluster-2", "test-dc-1", map[string]string{"env": "dev"}),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.IPAMPool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-pool-1",
+					},
+					Spec: kubermaticv1.IPAMPoolSpec{
+						Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+							"test-dc-1": {
+								Type:             "prefix",
+								PoolCIDR:         "192.168.1.0/28",
+								AllocationPrefix: 30,
+							},
+						},
+						ClusterSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"env": "prod"},
+						},
+					},
+				},
+			},
+			expectedClusterAllocations: &kubermaticv1.IPAMAllocationList{
+				Items: []kubermaticv1.IPAMAllocation{},
+			},
+		},
+		{
+			name:    "cluster selector: allocate for cluster matching the selector",
+			cluster: generateTestClusterWithLabels("test-cluster-2", "test-dc-1", map[string]string{"env": "prod"}),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.IPAMPool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-pool-1",
+					},
+					Spec: kubermaticv1.IPAMPoolSpec{
+						Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+							"test-dc-1": {
+								Type:             "prefix",
+								PoolCIDR:         "192.168.1.0/28",
+								AllocationPrefix: 30,
+							},
+						},
+						ClusterSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"env": "prod"},
+						},
+					},
+				},
+			},
+			expectedClusterAllocations: &kubermaticv1.IPAMAllocationList{
+				Items: []kubermaticv1.IPAMAllocation{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            "test-pool-1",
+							Namespace:       fmt.Sprintf("cluster-%s", "test-cluster-2"),
+							ResourceVersion: "1",
+							OwnerReferences: []metav1.OwnerReference{{APIVersion: "kubermatic.k8c.io/v1", Kind: "IPAMPool", Name: "test-pool-1"}},
+						},
+						Spec: kubermaticv1.IPAMAllocationSpec{
+							Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+							DC:   "test-dc-1",
+							CIDR: "192.168.1.0/30",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "cluster selector: keep existing allocation of cluster not matching the selector anymore",
+			cluster: generateTestClusterWithLabels("test-cluster-2", "test-dc-1", map[string]string{"env": "dev"}),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.IPAMPool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-pool-1",
+					},
+					Spec: kubermaticv1.IPAMPoolSpec{
+						Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+							"test-dc-1": {
+								Type:             "prefix",
+								PoolCIDR:         "192.168.1.0/28",
+								AllocationPrefix: 30,
+							},
+						},
+						ClusterSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"env": "prod"},
+						},
+					},
+				},
+				&kubermaticv1.IPAMAllocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "test-pool-1",
+						Namespace:       fmt.Sprintf("cluster-%s", "test-cluster-2"),
+						ResourceVersion: "1",
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "kubermatic.k8c.io/v1", Kind: "IPAMPool", Name: "test-pool-1"}},
+					},
+					Spec: kubermaticv1.IPAMAllocationSpec{
+						Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+						DC:   "test-dc-1",
+						CIDR: "192.168.1.4/30",
+					},
+				},
+			},
+			expectedClusterAllocations: &kubermaticv1.IPAMAllocationList{
+				Items: []kubermaticv1.IPAMAllocation{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            "test-pool-1",
+							Namespace:       fmt.Sprintf("cluster-%s", "test-cluster-2"),
+							ResourceVersion: "1",
+							OwnerReferences: []metav1.OwnerReference{{APIVersion: "kubermatic.k8c.io/v1", Kind: "IPAMPool", Name: "test-pool-1"}},
+						},
+						Spec: kubermaticv1.IPAMAllocationSpec{
+							Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+							DC:   "test-dc-1",
+							CIDR: "192.168.1.4/30",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "reservation: allocate reserved prefix regardless of the cluster selector",
+			cluster: generateTestClusterWithLabels("test-cluster-1", "test-dc-1", map[string]string{"env": "dev"}),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.IPAMPool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-pool-1",
+					},
+					Spec: kubermaticv1.IPAMPoolSpec{
+						Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+							"test-dc-1": {
+								Type:             "prefix",
+								PoolCIDR:         "192.168.1.0/28",
+								AllocationPrefix: 30,
+							},
+						},
+						ClusterSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"env": "prod"},
+						},
+						Reservations: []kubermaticv1.IPAMPoolReservation{
+							{Cluster: "test-cluster-1", Datacenter: "test-dc-1", CIDR: "192.168.1.0/30"},
+						},
+					},
+				},
+			},
+			expectedClusterAllocations: &kubermaticv1.IPAMAllocationList{
+				Items: []kubermaticv1.IPAMAllocation{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            "test-pool-1",
+							Namespace:       fmt.Sprintf("cluster-%s", "test-cluster-1"),
+							ResourceVersion: "1",
+							OwnerReferences: []metav1.OwnerReference{{APIVersion: "kubermatic.k8c.io/v1", Kind: "IPAMPool", Name: "test-pool-1"}},
+						},
+						Spec: kubermaticv1.IPAMAllocationSpec{
+							Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+							DC:   "test-dc-1",
+							CIDR: "192.168.1.0/30",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "reservation: replace existing allocation with reserved prefix",
+			cluster: generateTestCluster("test-cluster-1", "test-dc-1"),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.IPAMPool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-pool-1",
+					},
+					Spec: kubermaticv1.IPAMPoolSpec{
+						Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+							"test-dc-1": {
+								Type:             "prefix",
+								PoolCIDR:         "192.168.1.0/28",
+								AllocationPrefix: 30,
+							},
+						},
+						Reservations: []kubermaticv1.IPAMPoolReservation{
+							{Cluster: "test-cluster-1", Datacenter: "test-dc-1", CIDR: "192.168.1.0/30"},
+						},
+					},
+				},
+				&kubermaticv1.IPAMAllocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "test-pool-1",
+						Namespace:       fmt.Sprintf("cluster-%s", "test-cluster-1"),
+						ResourceVersion: "1",
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "kubermatic.k8c.io/v1", Kind: "IPAMPool", Name: "test-pool-1"}},
+					},
+					Spec: kubermaticv1.IPAMAllocationSpec{
+						Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+						DC:   "test-dc-1",
+						CIDR: "192.168.1.8/30",
+					},
+				},
+			},
+			expectedClusterAllocations: &kubermaticv1.IPAMAllocationList{
+				Items: []kubermaticv1.IPAMAllocation{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            "test-pool-1",
+							Namespace:       fmt.Sprintf("cluster-%s", "test-cluster-1"),
+							ResourceVersion: "2",
+							OwnerReferences: []metav1.OwnerReference{{APIVersion: "kubermatic.k8c.io/v1", Kind: "IPAMPool", Name: "test-pool-1"}},
+						},
+						Spec: kubermaticv1.IPAMAllocationSpec{
+							Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+							DC:   "test-dc-1",
+							CIDR: "192.168.1.0/30",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "reservation: skip reserved prefix for other clusters",
+			cluster: generateTestCluster("test-cluster-2", "test-dc-1"),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.IPAMPool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-pool-1",
+					},
+					Spec: kubermaticv1.IPAMPoolSpec{
+						Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+							"test-dc-1": {
+								Type:             "prefix",
+								PoolCIDR:         "192.168.1.0/28",
+								AllocationPrefix: 30,
+							},
+						},
+						Reservations: []kubermaticv1.IPAMPoolReservation{
+							{Cluster: "test-cluster-1", Datacenter: "test-dc-1", CIDR: "192.168.1.0/30"},
+						},
+					},
+				},
+			},
+			expectedClusterAllocations: &kubermaticv1.IPAMAllocationList{
+				Items: []kubermaticv1.IPAMAllocation{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            "test-pool-1",
+							Namespace:       fmt.Sprintf("cluster-%s", "test-cluster-2"),
+							ResourceVersion: "1",
+							OwnerReferences: []metav1.OwnerReference{{APIVersion: "kubermatic.k8c.io/v1", Kind: "IPAMPool", Name: "test-pool-1"}},
+						},
+						Spec: kubermaticv1.IPAMAllocationSpec{
+							Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+							DC:   "test-dc-1",
+							CIDR: "192.168.1.4/30",
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -27,6 +27,31 @@ var (
 	errIncompatiblePool = errors.New("pool is incompatible with a current cluster allocation")
 )
 
+// poolExhaustedError is returned when there is not enough free space left
+// in a pool for a new allocation.
+type poolExhaustedError struct {
+	message string
+}
+
+func (e *poolExhaustedError) Error() string {
+	return e.message
+}
+
+// backendError is returned when an external IPAM backend is misconfigured,
+// cannot be reached or rejects a request.
+type backendError struct {
+	backend string
+	err     error
+}
+
+func (e *backendError) Error() string {
+	return fmt.Sprintf("%s backend: %v", e.backend, e.err)
+}
+
+func (e *backendError) Unwrap() error {
+	return e.err
+}
+
 func ipToInt(ip net.IP) (*big.Int, int) {
 	val := &big.Int{}
 	val.SetBytes([]byte(ip))
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+var (
+	poolLabels = []string{"pool", "datacenter", "type"}
+
+	poolTotal = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "ipam_pool",
+		Name:      "total",
+		Help:      "The number of prefixes or addresses in the IPAM pool",
+	}, poolLabels)
+
+	poolAllocated = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "ipam_pool",
+		Name:      "allocated",
+		Help:      "The number of prefixes or addresses of the IPAM pool that are allocated to clusters",
+	}, poolLabels)
+
+	poolExcluded = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "ipam_pool",
+		Name:      "excluded",
+		Help:      "The number of prefixes or addresses of the IPAM pool that are excluded from allocation",
+	}, poolLabels)
+
+	poolFree = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "ipam_pool",
+		Name:      "free",
+		Help:      "The number of prefixes or addresses of the IPAM pool that are available for new allocations",
+	}, poolLabels)
+
+	poolUtilizationThreshold = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "ipam_pool",
+		Name:      "utilization_threshold_ratio",
+		Help:      "The configured utilization threshold of the IPAM pool, between 0 and 1",
+	}, poolLabels)
+)
+
+func MustRegisterMetrics(c prometheus.Registerer) {
+	c.MustRegister(poolTotal)
+	c.MustRegister(poolAllocated)
+	c.MustRegister(poolExcluded)
+	c.MustRegister(poolFree)
+	c.MustRegister(poolUtilizationThreshold)
+}
+
+func setPoolMetrics(pool, dc string, status *kubermaticv1.IPAMPoolDatacenterStatus, threshold int) {
+	labels := []string{pool, dc, status.Type.String()}
+
+	poolTotal.WithLabelValues(labels...).Set(float64(status.Total))
+	poolAllocated.WithLabelValues(labels...).Set(float64(status.Allocated))
+	poolExcluded.WithLabelValues(labels...).Set(float64(status.Excluded))
+	poolFree.WithLabelValues(labels...).Set(float64(status.Free))
+	poolUtilizationThreshold.WithLabelValues(labels...).Set(float64(threshold) / 100)
+}
+
+func deletePoolMetrics(pool, dc string) {
+	labels := prometheus.Labels{"pool": pool}
+	if dc != "" {
+		labels["datacenter"] = dc
+	}
+
+	poolTotal.DeletePartialMatch(labels)
+	poolAllocated.DeletePartialMatch(labels)
+	poolExcluded.DeletePartialMatch(labels)
+	poolFree.DeletePartialMatch(labels)
+	poolUtilizationThreshold.DeletePartialMatch(labels)
+}
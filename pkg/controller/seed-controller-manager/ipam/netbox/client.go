@@ -0,0 +1,281 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package netbox contains a minimal client for the IPAM API of NetBox, covering
+// only what is required to reserve and release prefixes and IP addresses.
+package netbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Client talks to the REST API of a single NetBox instance.
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient returns a new NetBox client. If httpClient is nil, a client with a
+// reasonable timeout is used.
+func NewClient(baseURL, token string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      token,
+		httpClient: httpClient,
+	}
+}
+
+// Prefix is a prefix object in NetBox.
+type Prefix struct {
+	ID          int    `json:"id"`
+	Prefix      string `json:"prefix"`
+	Description string `json:"description"`
+}
+
+// IPAddress is an IP address object in NetBox. Address contains the
+// IP address including the mask of its parent prefix, e.g. "10.0.0.1/24".
+type IPAddress struct {
+	ID          int    `json:"id"`
+	Address     string `json:"address"`
+	Description string `json:"description"`
+}
+
+// IP returns the IP address without its mask.
+func (a IPAddress) IP() string {
+	ip, _, _ := strings.Cut(a.Address, "/")
+	return ip
+}
+
+// APIError is returned when NetBox responds with an unexpected status code.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// IsInsufficientSpace returns true if the error was caused by NetBox not
+// having enough space left in a prefix for the requested allocation.
+func IsInsufficientSpace(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict
+}
+
+// GetPrefix returns the prefix with the given CIDR, or nil if it does not exist.
+func (c *Client) GetPrefix(ctx context.Context, cidr string) (*Prefix, error) {
+	prefixes := []Prefix{}
+	if err := c.list(ctx, "/api/ipam/prefixes/", url.Values{"prefix": {cidr}}, &prefixes); err != nil {
+		return nil, err
+	}
+
+	switch len(prefixes) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &prefixes[0], nil
+	default:
+		return nil, fmt.Errorf("found %d prefixes %s, cannot choose one", len(prefixes), cidr)
+	}
+}
+
+// ListPrefixes returns all prefixes with the given description.
+func (c *Client) ListPrefixes(ctx context.Context, description string) ([]Prefix, error) {
+	prefixes := []Prefix{}
+	if err := c.list(ctx, "/api/ipam/prefixes/", url.Values{"description": {description}}, &prefixes); err != nil {
+		return nil, err
+	}
+
+	return prefixes, nil
+}
+
+// CreateAvailablePrefix creates the first available child prefix with the given length
+// in the parent prefix.
+func (c *Client) CreateAvailablePrefix(ctx context.Context, parentID int, prefixLength int, description string) (*Prefix, error) {
+	body := map[string]any{
+		"prefix_length": prefixLength,
+		"description":   description,
+	}
+
+	prefix := &Prefix{}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/api/ipam/prefixes/%d/available-prefixes/", parentID), body, prefix); err != nil {
+		return nil, err
+	}
+
+	return prefix, nil
+}
+
+// DeletePrefix deletes the prefix. Deleting a prefix that does not exist is not an error.
+func (c *Client) DeletePrefix(ctx context.Context, id int) error {
+	return c.delete(ctx, fmt.Sprintf("/api/ipam/prefixes/%d/", id))
+}
+
+// ListIPAddresses returns all IP addresses with the given description.
+func (c *Client) ListIPAddresses(ctx context.Context, description string) ([]IPAddress, error) {
+	addresses := []IPAddress{}
+	if err := c.list(ctx, "/api/ipam/ip-addresses/", url.Values{"description": {description}}, &addresses); err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
+
+// CreateAvailableIPAddresses creates the given number of available IP addresses in the
+// parent prefix. NetBox allocates them in ascending order.
+func (c *Client) CreateAvailableIPAddresses(ctx context.Context, parentID int, count int, description string) ([]IPAddress, error) {
+	body := make([]map[string]any, count)
+	for i := range body {
+		body[i] = map[string]any{"description": description}
+	}
+
+	addresses := []IPAddress{}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/api/ipam/prefixes/%d/available-ips/", parentID), body, &addresses); err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
+
+// DeleteIPAddress deletes the IP address. Deleting an IP address that does not exist is not an error.
+func (c *Client) DeleteIPAddress(ctx context.Context, id int) error {
+	return c.delete(ctx, fmt.Sprintf("/api/ipam/ip-addresses/%d/", id))
+}
+
+type listResponse struct {
+	Next    *string         `json:"next"`
+	Results json.RawMessage `json:"results"`
+}
+
+// list fetches all pages of a list endpoint and appends the results to out,
+// which must be a pointer to a slice.
+func (c *Client) list(ctx context.Context, path string, query url.Values, out any) error {
+	query.Set("limit", strconv.Itoa(100))
+	next := fmt.Sprintf("%s%s?%s", c.baseURL, path, query.Encode())
+
+	results := []json.RawMessage{}
+	for next != "" {
+		response := listResponse{}
+		if err := c.request(ctx, http.MethodGet, next, nil, &response); err != nil {
+			return err
+		}
+
+		page := []json.RawMessage{}
+		if err := json.Unmarshal(response.Results, &page); err != nil {
+			return fmt.Errorf("failed to decode results: %w", err)
+		}
+		results = append(results, page...)
+
+		next = ""
+		if response.Next != nil {
+			next = *response.Next
+		}
+	}
+
+	encoded, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(encoded, out)
+}
+
+func (c *Client) delete(ctx context.Context, path string) error {
+	err := c.do(ctx, http.MethodDelete, path, nil, nil)
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	return c.request(ctx, method, c.baseURL+path, body, out)
+}
+
+func (c *Client) request(ctx context.Context, method, endpoint string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Token "+c.token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s request failed: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &APIError{StatusCode: resp.StatusCode, Message: errorMessage(resp.Body)}
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// errorMessage extracts the "detail" field NetBox uses for error
+// messages and falls back to the raw body.
+func errorMessage(body io.Reader) string {
+	data, _ := io.ReadAll(io.LimitReader(body, 4096))
+
+	detail := struct {
+		Detail string `json:"detail"`
+	}{}
+	if err := json.Unmarshal(data, &detail); err == nil && detail.Detail != "" {
+		return detail.Detail
+	}
+
+	return strings.TrimSpace(string(data))
+}
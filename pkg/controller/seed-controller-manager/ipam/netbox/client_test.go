@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netbox_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/ipam/netbox"
+	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/ipam/netbox/fake"
+)
+
+const testToken = "test-token"
+
+func TestPrefixes(t *testing.T) {
+	ctx := context.Background()
+
+	server := fake.NewServer(testToken)
+	defer server.Close()
+
+	pool := server.AddPrefix("10.0.0.0/24", "")
+	server.AddPrefix("10.0.0.0/26", "someone else")
+
+	client := netbox.NewClient(server.URL+"/", testToken, nil)
+
+	parent, err := client.GetPrefix(ctx, "10.0.0.0/24")
+	if err != nil {
+		t.Fatalf("Failed to get prefix: %v", err)
+	}
+	if parent == nil || parent.ID != pool.ID {
+		t.Fatalf("Expected prefix %d, got %+v.", pool.ID, parent)
+	}
+
+	missing, err := client.GetPrefix(ctx, "10.1.0.0/24")
+	if err != nil {
+		t.Fatalf("Failed to get prefix: %v", err)
+	}
+	if missing != nil {
+		t.Fatalf("Expected no prefix, got %+v.", missing)
+	}
+
+	expected := []string{"10.0.0.64/26", "10.0.0.128/26", "10.0.0.192/26"}
+	for _, cidr := range expected {
+		created, err := client.CreateAvailablePrefix(ctx, parent.ID, 26, "test")
+		if err != nil {
+			t.Fatalf("Failed to create prefix: %v", err)
+		}
+		if created.Prefix != cidr {
+			t.Fatalf("Expected prefix %s, got %s.", cidr, created.Prefix)
+		}
+	}
+
+	_, err = client.CreateAvailablePrefix(ctx, parent.ID, 26, "test")
+	if !netbox.IsInsufficientSpace(err) {
+		t.Fatalf("Expected insufficient space error, got %v.", err)
+	}
+
+	prefixes, err := client.ListPrefixes(ctx, "test")
+	if err != nil {
+		t.Fatalf("Failed to list prefixes: %v", err)
+	}
+	if len(prefixes) != len(expected) {
+		t.Fatalf("Expected %d prefixes, got %d.", len(expected), len(prefixes))
+	}
+
+	for _, prefix := range prefixes {
+		if err := client.DeletePrefix(ctx, prefix.ID); err != nil {
+			t.Fatalf("Failed to delete prefix: %v", err)
+		}
+	}
+
+	// deleting again must not fail
+	if err := client.DeletePrefix(ctx, prefixes[0].ID); err != nil {
+		t.Fatalf("Failed to delete prefix a second time: %v", err)
+	}
+
+	if remaining := server.Prefixes(); len(remaining) != 2 {
+		t.Fatalf("Expected 2 remaining prefixes, got %v.", remaining)
+	}
+}
+
+func TestIPAddresses(t *testing.T) {
+	ctx := context.Background()
+
+	server := fake.NewServer(testToken)
+	defer server.Close()
+
+	pool := server.AddPrefix("10.0.0.0/16", "")
+	server.AddIPAddress("10.0.0.2/16", "someone else")
+
+	client := netbox.NewClient(server.URL, testToken, nil)
+
+	// more than a single page of results
+	created, err := client.CreateAvailableIPAddresses(ctx, pool.ID, 150, "test")
+	if err != nil {
+		t.Fatalf("Failed to create IP addresses: %v", err)
+	}
+	if len(created) != 150 {
+		t.Fatalf("Expected 150 IP addresses, got %d.", len(created))
+	}
+	if first := created[0].IP(); first != "10.0.0.1" {
+		t.Fatalf("Expected first IP address to be 10.0.0.1, got %s.", first)
+	}
+	if second := created[1].IP(); second != "10.0.0.3" {
+		t.Fatalf("Expected second IP address to be 10.0.0.3, got %s.", second)
+	}
+
+	addresses, err := client.ListIPAddresses(ctx, "test")
+	if err != nil {
+		t.Fatalf("Failed to list IP addresses: %v", err)
+	}
+	if len(addresses) != 150 {
+		t.Fatalf("Expected 150 IP addresses, got %d.", len(addresses))
+	}
+
+	for i, address := range addresses {
+		if address.ID != created[i].ID {
+			t.Fatalf("Expected IP address %d to be %v, got %v.", i, created[i], address)
+		}
+		if err := client.DeleteIPAddress(ctx, address.ID); err != nil {
+			t.Fatalf("Failed to delete IP address: %v", err)
+		}
+	}
+
+	if remaining := server.IPAddresses(); len(remaining) != 1 {
+		t.Fatalf("Expected 1 remaining IP address, got %v.", remaining)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	ctx := context.Background()
+
+	server := fake.NewServer(testToken)
+	defer server.Close()
+
+	pool := server.AddPrefix("10.0.0.0/30", "")
+
+	testCases := []struct {
+		name           string
+		token          string
+		failing        bool
+		expectedStatus int
+	}{
+		{
+			name:           "invalid token",
+			token:          "wrong-token",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "server error",
+			token:          testToken,
+			failing:        true,
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "insufficient space",
+			token:          testToken,
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server.SetFailing(tc.failing)
+			defer server.SetFailing(false)
+
+			client := netbox.NewClient(server.URL, tc.token, nil)
+
+			// a /30 only has 2 usable addresses
+			_, err := client.CreateAvailableIPAddresses(ctx, pool.ID, 3, "test")
+
+			var apiErr *netbox.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Expected API error, got %v.", err)
+			}
+			if apiErr.StatusCode != tc.expectedStatus {
+				t.Fatalf("Expected status %d, got %d (%s).", tc.expectedStatus, apiErr.StatusCode, apiErr.Message)
+			}
+			if netbox.IsInsufficientSpace(err) != (tc.expectedStatus == http.StatusConflict) {
+				t.Fatalf("Unexpected result of IsInsufficientSpace for %v.", err)
+			}
+		})
+	}
+}
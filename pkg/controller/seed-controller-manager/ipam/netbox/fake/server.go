@@ -0,0 +1,365 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-memory fake of the NetBox IPAM API for tests.
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+
+	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/ipam/netbox"
+)
+
+var (
+	availablePrefixesPath = regexp.MustCompile(`^/api/ipam/prefixes/(\d+)/available-prefixes/$`)
+	availableIPsPath      = regexp.MustCompile(`^/api/ipam/prefixes/(\d+)/available-ips/$`)
+	prefixPath            = regexp.MustCompile(`^/api/ipam/prefixes/(\d+)/$`)
+	ipAddressPath         = regexp.MustCompile(`^/api/ipam/ip-addresses/(\d+)/$`)
+)
+
+// Server is a fake NetBox instance. Like NetBox, it never allocates the network
+// and broadcast addresses of IPv4 prefixes as IP addresses.
+type Server struct {
+	*httptest.Server
+
+	token string
+
+	lock      sync.Mutex
+	nextID    int
+	failing   bool
+	prefixes  map[int]netbox.Prefix
+	addresses map[int]netbox.IPAddress
+}
+
+// NewServer starts a new fake NetBox that accepts the given API token.
+// The server must be closed by the caller.
+func NewServer(token string) *Server {
+	s := &Server{
+		token:     token,
+		nextID:    1,
+		prefixes:  map[int]netbox.Prefix{},
+		addresses: map[int]netbox.IPAddress{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+
+	return s
+}
+
+// AddPrefix creates a prefix and returns it.
+func (s *Server) AddPrefix(cidr, description string) netbox.Prefix {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.addPrefix(netip.MustParsePrefix(cidr), description)
+}
+
+// AddIPAddress creates an IP address (in the form "10.0.0.1/24") and returns it.
+func (s *Server) AddIPAddress(address, description string) netbox.IPAddress {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.addIPAddress(netip.MustParsePrefix(address), description)
+}
+
+// SetFailing makes all following requests fail with an internal server error.
+func (s *Server) SetFailing(failing bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.failing = failing
+}
+
+// Prefixes returns all prefixes, ordered by ID.
+func (s *Server) Prefixes() []netbox.Prefix {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return sortedByID(s.prefixes, func(p netbox.Prefix) int { return p.ID })
+}
+
+// IPAddresses returns all IP addresses, ordered by ID.
+func (s *Server) IPAddresses() []netbox.IPAddress {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return sortedByID(s.addresses, func(a netbox.IPAddress) int { return a.ID })
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if r.Header.Get("Authorization") != "Token "+s.token {
+		writeJSON(w, http.StatusForbidden, map[string]string{"detail": "Invalid token"})
+		return
+	}
+
+	if s.failing {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"detail": "Internal server error"})
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/ipam/prefixes/":
+		s.listPrefixes(w, r)
+	case r.Method == http.MethodGet && r.URL.Path == "/api/ipam/ip-addresses/":
+		s.listIPAddresses(w, r)
+	case r.Method == http.MethodPost && availablePrefixesPath.MatchString(r.URL.Path):
+		s.createAvailablePrefix(w, r, pathID(availablePrefixesPath, r.URL.Path))
+	case r.Method == http.MethodPost && availableIPsPath.MatchString(r.URL.Path):
+		s.createAvailableIPs(w, r, pathID(availableIPsPath, r.URL.Path))
+	case r.Method == http.MethodDelete && prefixPath.MatchString(r.URL.Path):
+		deleteObject(w, s.prefixes, pathID(prefixPath, r.URL.Path))
+	case r.Method == http.MethodDelete && ipAddressPath.MatchString(r.URL.Path):
+		deleteObject(w, s.addresses, pathID(ipAddressPath, r.URL.Path))
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]string{"detail": "Not found."})
+	}
+}
+
+func (s *Server) listPrefixes(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	results := []any{}
+	for _, prefix := range sortedByID(s.prefixes, func(p netbox.Prefix) int { return p.ID }) {
+		if query.Has("prefix") && query.Get("prefix") != prefix.Prefix {
+			continue
+		}
+		if query.Has("description") && query.Get("description") != prefix.Description {
+			continue
+		}
+		results = append(results, prefix)
+	}
+
+	writePage(w, r, results)
+}
+
+func (s *Server) listIPAddresses(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	results := []any{}
+	for _, address := range sortedByID(s.addresses, func(a netbox.IPAddress) int { return a.ID }) {
+		if query.Has("description") && query.Get("description") != address.Description {
+			continue
+		}
+		results = append(results, address)
+	}
+
+	writePage(w, r, results)
+}
+
+func (s *Server) createAvailablePrefix(w http.ResponseWriter, r *http.Request, parentID int) {
+	parent, ok := s.prefixes[parentID]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"detail": "Not found."})
+		return
+	}
+
+	request := struct {
+		PrefixLength int    `json:"prefix_length"`
+		Description  string `json:"description"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"detail": err.Error()})
+		return
+	}
+
+	parentPrefix := netip.MustParsePrefix(parent.Prefix)
+	if request.PrefixLength < parentPrefix.Bits() || request.PrefixLength > parentPrefix.Addr().BitLen() {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"detail": "Invalid prefix length"})
+		return
+	}
+
+	for candidate := netip.PrefixFrom(parentPrefix.Addr(), request.PrefixLength); parentPrefix.Contains(candidate.Addr()); candidate = nextPrefix(candidate) {
+		if !s.prefixUsed(parent.ID, candidate) {
+			writeJSON(w, http.StatusCreated, s.addPrefix(candidate, request.Description))
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusConflict, map[string]string{"detail": "Insufficient space is available to accommodate the requested prefix size(s)"})
+}
+
+func (s *Server) createAvailableIPs(w http.ResponseWriter, r *http.Request, parentID int) {
+	parent, ok := s.prefixes[parentID]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"detail": "Not found."})
+		return
+	}
+
+	requests := []struct {
+		Description string `json:"description"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&requests); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"detail": err.Error()})
+		return
+	}
+
+	parentPrefix := netip.MustParsePrefix(parent.Prefix)
+	first, last := parentPrefix.Addr(), lastAddr(parentPrefix)
+	if parentPrefix.Addr().Is4() && parentPrefix.Bits() < 31 {
+		first, last = first.Next(), last.Prev()
+	}
+
+	used := map[netip.Addr]bool{}
+	for _, address := range s.addresses {
+		used[netip.MustParsePrefix(address.Address).Addr()] = true
+	}
+
+	free := []netip.Addr{}
+	for addr := first; len(free) < len(requests) && addr.IsValid() && addr.Compare(last) <= 0; addr = addr.Next() {
+		if !used[addr] {
+			free = append(free, addr)
+		}
+	}
+
+	if len(free) < len(requests) {
+		writeJSON(w, http.StatusConflict, map[string]string{"detail": fmt.Sprintf("Insufficient space is available to accommodate %d new IP address(es)", len(requests))})
+		return
+	}
+
+	created := []netbox.IPAddress{}
+	for i, addr := range free {
+		created = append(created, s.addIPAddress(netip.PrefixFrom(addr, parentPrefix.Bits()), requests[i].Description))
+	}
+
+	writeJSON(w, http.StatusCreated, created)
+}
+
+// deleteObject deletes the object with the given ID and writes the response.
+func deleteObject[T any](w http.ResponseWriter, objects map[int]T, id int) {
+	if _, ok := objects[id]; !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"detail": "Not found."})
+		return
+	}
+
+	delete(objects, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// prefixUsed returns true if the candidate overlaps with any prefix other than the parent.
+func (s *Server) prefixUsed(parentID int, candidate netip.Prefix) bool {
+	for _, prefix := range s.prefixes {
+		if prefix.ID != parentID && netip.MustParsePrefix(prefix.Prefix).Overlaps(candidate) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) addPrefix(prefix netip.Prefix, description string) netbox.Prefix {
+	created := netbox.Prefix{ID: s.nextID, Prefix: prefix.Masked().String(), Description: description}
+	s.prefixes[created.ID] = created
+	s.nextID++
+
+	return created
+}
+
+func (s *Server) addIPAddress(address netip.Prefix, description string) netbox.IPAddress {
+	created := netbox.IPAddress{ID: s.nextID, Address: address.String(), Description: description}
+	s.addresses[created.ID] = created
+	s.nextID++
+
+	return created
+}
+
+// writePage writes a paginated list response, honoring the limit and offset parameters.
+func writePage(w http.ResponseWriter, r *http.Request, results []any) {
+	query := r.URL.Query()
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 50
+	}
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	offset = min(offset, len(results))
+	end := min(offset+limit, len(results))
+
+	var next *string
+	if end < len(results) {
+		query.Set("offset", strconv.Itoa(end))
+		nextURL := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path, RawQuery: query.Encode()}
+		nextStr := nextURL.String()
+		next = &nextStr
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"count":   len(results),
+		"next":    next,
+		"results": results[offset:end],
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func pathID(pattern *regexp.Regexp, path string) int {
+	id, _ := strconv.Atoi(pattern.FindStringSubmatch(path)[1])
+	return id
+}
+
+func sortedByID[T any](objects map[int]T, id func(T) int) []T {
+	sorted := make([]T, 0, len(objects))
+	for _, o := range objects {
+		sorted = append(sorted, o)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return id(sorted[i]) < id(sorted[j])
+	})
+
+	return sorted
+}
+
+func nextPrefix(prefix netip.Prefix) netip.Prefix {
+	size := new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+	return netip.PrefixFrom(addToAddr(prefix.Addr(), size), prefix.Bits())
+}
+
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	size := new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+	return addToAddr(prefix.Masked().Addr(), size.Sub(size, big.NewInt(1)))
+}
+
+// addToAddr adds delta to the address. If the result is outside of the
+// address space, an invalid address is returned.
+func addToAddr(addr netip.Addr, delta *big.Int) netip.Addr {
+	bytes := addr.AsSlice()
+	val := new(big.Int).SetBytes(bytes)
+	val.Add(val, delta)
+
+	if val.BitLen() > addr.BitLen() {
+		return netip.Addr{}
+	}
+
+	val.FillBytes(bytes)
+	result, _ := netip.AddrFromSlice(bytes)
+
+	return result
+}
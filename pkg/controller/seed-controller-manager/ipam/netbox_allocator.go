@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"context"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/ipam/netbox"
+	"k8c.io/kubermatic/v2/pkg/provider"
+
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// netboxAllocator allocates child prefixes or IP addresses of the pool CIDR
+// prefix in NetBox. NetBox objects are assigned to clusters via their description.
+type netboxAllocator struct {
+	client   *netbox.Client
+	poolName string
+}
+
+var _ Allocator = &netboxAllocator{}
+
+func newNetBoxAllocator(ctx context.Context, client ctrlruntimeclient.Reader, poolName string, cfg *kubermaticv1.IPAMPoolNetBoxBackend) (*netboxAllocator, error) {
+	token, err := provider.SecretKeySelectorValueFuncFactory(ctx, client)(&cfg.TokenReference, cfg.TokenReference.Key)
+	if err != nil {
+		return nil, &backendError{backend: "NetBox", err: fmt.Errorf("failed to get API token: %w", err)}
+	}
+
+	return &netboxAllocator{
+		client:   netbox.NewClient(cfg.URL, token, nil),
+		poolName: poolName,
+	}, nil
+}
+
+func (a *netboxAllocator) description(clusterName string) string {
+	return fmt.Sprintf("Kubermatic IPAM pool %s, cluster %s", a.poolName, clusterName)
+}
+
+func (a *netboxAllocator) Allocate(ctx context.Context, cluster *kubermaticv1.Cluster, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings, ipamAllocation *kubermaticv1.IPAMAllocation) error {
+	switch dcIPAMPoolCfg.Type {
+	case kubermaticv1.IPAMPoolAllocationTypeRange:
+		return a.allocateRange(ctx, cluster, dcIPAMPoolCfg, ipamAllocation)
+	case kubermaticv1.IPAMPoolAllocationTypePrefix:
+		return a.allocatePrefix(ctx, cluster, dcIPAMPoolCfg, ipamAllocation)
+	default:
+		return fmt.Errorf("unknown allocation type %q", dcIPAMPoolCfg.Type)
+	}
+}
+
+func (a *netboxAllocator) allocatePrefix(ctx context.Context, cluster *kubermaticv1.Cluster, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings, ipamAllocation *kubermaticv1.IPAMAllocation) error {
+	description := a.description(cluster.Name)
+
+	existing, err := a.client.ListPrefixes(ctx, description)
+	if err != nil {
+		return &backendError{backend: "NetBox", err: fmt.Errorf("failed to list prefixes: %w", err)}
+	}
+
+	if len(existing) > 0 {
+		ipamAllocation.Spec.CIDR = kubermaticv1.SubnetCIDR(existing[0].Prefix)
+		return nil
+	}
+
+	parent, err := a.parentPrefix(ctx, dcIPAMPoolCfg)
+	if err != nil {
+		return err
+	}
+
+	prefix, err := a.client.CreateAvailablePrefix(ctx, parent.ID, dcIPAMPoolCfg.AllocationPrefix, description)
+	if err != nil {
+		if netbox.IsInsufficientSpace(err) {
+			return &poolExhaustedError{message: fmt.Sprintf("there is no free subnet available for IPAM Pool \"%s\" in NetBox", a.poolName)}
+		}
+		return &backendError{backend: "NetBox", err: fmt.Errorf("failed to create prefix: %w", err)}
+	}
+
+	ipamAllocation.Spec.CIDR = kubermaticv1.SubnetCIDR(prefix.Prefix)
+
+	return nil
+}
+
+func (a *netboxAllocator) allocateRange(ctx context.Context, cluster *kubermaticv1.Cluster, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings, ipamAllocation *kubermaticv1.IPAMAllocation) error {
+	description := a.description(cluster.Name)
+
+	existing, err := a.client.ListIPAddresses(ctx, description)
+	if err != nil {
+		return &backendError{backend: "NetBox", err: fmt.Errorf("failed to list IP addresses: %w", err)}
+	}
+
+	if missing := dcIPAMPoolCfg.AllocationRange - len(existing); missing > 0 {
+		parent, err := a.parentPrefix(ctx, dcIPAMPoolCfg)
+		if err != nil {
+			return err
+		}
+
+		created, err := a.client.CreateAvailableIPAddresses(ctx, parent.ID, missing, description)
+		if err != nil {
+			if netbox.IsInsufficientSpace(err) {
+				return &poolExhaustedError{message: fmt.Sprintf("there is no enough free IPs available for IPAM pool \"%s\" in NetBox", a.poolName)}
+			}
+			return &backendError{backend: "NetBox", err: fmt.Errorf("failed to create IP addresses: %w", err)}
+		}
+
+		existing = append(existing, created...)
+	}
+
+	ips := make([]string, len(existing))
+	for i, address := range existing {
+		ips[i] = address.IP()
+	}
+
+	ipamAllocation.Spec.Addresses = addressRangesFromIPs(ips)
+
+	return nil
+}
+
+func (a *netboxAllocator) parentPrefix(ctx context.Context, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings) (*netbox.Prefix, error) {
+	parent, err := a.client.GetPrefix(ctx, string(dcIPAMPoolCfg.PoolCIDR))
+	if err != nil {
+		return nil, &backendError{backend: "NetBox", err: fmt.Errorf("failed to get prefix %s: %w", dcIPAMPoolCfg.PoolCIDR, err)}
+	}
+	if parent == nil {
+		return nil, &backendError{backend: "NetBox", err: fmt.Errorf("prefix %s does not exist", dcIPAMPoolCfg.PoolCIDR)}
+	}
+
+	return parent, nil
+}
+
+func (a *netboxAllocator) Release(ctx context.Context, clusterName string) error {
+	description := a.description(clusterName)
+
+	prefixes, err := a.client.ListPrefixes(ctx, description)
+	if err != nil {
+		return &backendError{backend: "NetBox", err: fmt.Errorf("failed to list prefixes: %w", err)}
+	}
+
+	addresses, err := a.client.ListIPAddresses(ctx, description)
+	if err != nil {
+		return &backendError{backend: "NetBox", err: fmt.Errorf("failed to list IP addresses: %w", err)}
+	}
+
+	var errs []error
+	for _, prefix := range prefixes {
+		if err := a.client.DeletePrefix(ctx, prefix.ID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete prefix %s: %w", prefix.Prefix, err))
+		}
+	}
+	for _, address := range addresses {
+		if err := a.client.DeleteIPAddress(ctx, address.ID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete IP address %s: %w", address.Address, err))
+		}
+	}
+
+	if err := kerrors.NewAggregate(errs); err != nil {
+		return &backendError{backend: "NetBox", err: err}
+	}
+
+	return nil
+}
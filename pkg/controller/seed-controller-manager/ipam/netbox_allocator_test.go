@@ -0,0 +1,274 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/ipam/netbox"
+	netboxfake "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/ipam/netbox/fake"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/machine-controller/sdk/providerconfig"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const netboxTestToken = "test-token"
+
+func genNetBoxPool(url string, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings) *kubermaticv1.IPAMPool {
+	return &kubermaticv1.IPAMPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-pool",
+		},
+		Spec: kubermaticv1.IPAMPoolSpec{
+			Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+				"test-dc": dcIPAMPoolCfg,
+			},
+			Backend: &kubermaticv1.IPAMPoolBackend{
+				NetBox: &kubermaticv1.IPAMPoolNetBoxBackend{
+					URL: url,
+					TokenReference: providerconfig.GlobalSecretKeySelector{
+						ObjectReference: corev1.ObjectReference{Name: "netbox", Namespace: "kubermatic"},
+						Key:             "token",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNetBoxBackend(t *testing.T) {
+	testCases := []struct {
+		name              string
+		dcIPAMPoolCfg     kubermaticv1.IPAMPoolDatacenterSettings
+		netboxPrefixes    []string
+		netboxIPAddresses []string
+		failing           bool
+		expectedSpec      kubermaticv1.IPAMAllocationSpec
+		expectedReason    string
+	}{
+		{
+			name: "prefix: skip prefixes used in NetBox",
+			dcIPAMPoolCfg: kubermaticv1.IPAMPoolDatacenterSettings{
+				Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+				PoolCIDR:         "10.0.0.0/24",
+				AllocationPrefix: 26,
+			},
+			netboxPrefixes: []string{"10.0.0.0/24", "10.0.0.0/26"},
+			expectedSpec: kubermaticv1.IPAMAllocationSpec{
+				Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+				DC:   "test-dc",
+				CIDR: "10.0.0.64/26",
+			},
+		},
+		{
+			name: "range: skip IP addresses used in NetBox",
+			dcIPAMPoolCfg: kubermaticv1.IPAMPoolDatacenterSettings{
+				Type:            kubermaticv1.IPAMPoolAllocationTypeRange,
+				PoolCIDR:        "10.0.0.0/28",
+				AllocationRange: 4,
+			},
+			netboxPrefixes:    []string{"10.0.0.0/28"},
+			netboxIPAddresses: []string{"10.0.0.2/28"},
+			expectedSpec: kubermaticv1.IPAMAllocationSpec{
+				Type:      kubermaticv1.IPAMPoolAllocationTypeRange,
+				DC:        "test-dc",
+				Addresses: []string{"10.0.0.1-10.0.0.1", "10.0.0.3-10.0.0.5"},
+			},
+		},
+		{
+			name: "prefix: exhausted in NetBox",
+			dcIPAMPoolCfg: kubermaticv1.IPAMPoolDatacenterSettings{
+				Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+				PoolCIDR:         "10.0.0.0/25",
+				AllocationPrefix: 26,
+			},
+			netboxPrefixes: []string{"10.0.0.0/25", "10.0.0.0/26", "10.0.0.64/26"},
+			expectedReason: kubermaticv1.ReasonClusterIPAMPoolExhausted,
+		},
+		{
+			name: "prefix: pool CIDR missing in NetBox",
+			dcIPAMPoolCfg: kubermaticv1.IPAMPoolDatacenterSettings{
+				Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+				PoolCIDR:         "10.0.0.0/24",
+				AllocationPrefix: 26,
+			},
+			expectedReason: kubermaticv1.ReasonClusterIPAMBackendError,
+		},
+		{
+			name: "range: NetBox is failing",
+			dcIPAMPoolCfg: kubermaticv1.IPAMPoolDatacenterSettings{
+				Type:            kubermaticv1.IPAMPoolAllocationTypeRange,
+				PoolCIDR:        "10.0.0.0/28",
+				AllocationRange: 4,
+			},
+			netboxPrefixes: []string{"10.0.0.0/28"},
+			failing:        true,
+			expectedReason: kubermaticv1.ReasonClusterIPAMBackendError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := netboxfake.NewServer(netboxTestToken)
+			defer server.Close()
+
+			for _, prefix := range tc.netboxPrefixes {
+				server.AddPrefix(prefix, "")
+			}
+			for _, address := range tc.netboxIPAddresses {
+				server.AddIPAddress(address, "")
+			}
+			server.SetFailing(tc.failing)
+
+			cluster := generateTestCluster("test-cluster", "test-dc")
+
+			reconciler := &Reconciler{
+				Client: fake.
+					NewClientBuilder().
+					WithObjects(
+						cluster,
+						genNetBoxPool(server.URL, tc.dcIPAMPoolCfg),
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{Name: "netbox", Namespace: "kubermatic"},
+							Data:       map[string][]byte{"token": []byte(netboxTestToken)},
+						},
+					).
+					Build(),
+			}
+
+			_, err := reconciler.reconcile(ctx, cluster)
+			if condErr := reconciler.updateAllocationsCondition(ctx, cluster, err); condErr != nil {
+				t.Fatalf("Failed to update condition: %v", condErr)
+			}
+
+			if tc.expectedReason != "" {
+				assert.Error(t, err)
+
+				condition := cluster.Status.Conditions[kubermaticv1.ClusterConditionIPAMAllocationsReady]
+				assert.Equal(t, corev1.ConditionFalse, condition.Status)
+				assert.Equal(t, tc.expectedReason, condition.Reason)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			// reconciling again must not allocate anything new
+			_, err = reconciler.reconcile(ctx, cluster)
+			assert.NoError(t, err)
+
+			ipamAllocation := &kubermaticv1.IPAMAllocation{}
+			assert.NoError(t, reconciler.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: "test-pool"}, ipamAllocation))
+			assert.Equal(t, tc.expectedSpec, ipamAllocation.Spec)
+
+			assert.NoError(t, reconciler.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), cluster))
+			assert.Contains(t, cluster.Finalizers, externalAllocationsCleanupFinalizer)
+
+			assert.NoError(t, reconciler.releaseExternalAllocations(ctx, kubermaticlog.Logger, cluster))
+
+			// only the objects that have been created by the test remain
+			assert.Len(t, server.Prefixes(), len(tc.netboxPrefixes))
+			assert.Len(t, server.IPAddresses(), len(tc.netboxIPAddresses))
+		})
+	}
+}
+
+func TestNetBoxAllocatorErrors(t *testing.T) {
+	server := netboxfake.NewServer(netboxTestToken)
+	defer server.Close()
+	server.SetFailing(true)
+
+	allocator := &netboxAllocator{
+		client:   netbox.NewClient(server.URL, netboxTestToken, nil),
+		poolName: "test-pool",
+	}
+
+	err := allocator.Release(context.Background(), "test-cluster")
+
+	var backendErr *backendError
+	if !errors.As(err, &backendErr) {
+		t.Fatalf("Expected backend error, got %v.", err)
+	}
+}
+
+func TestNetBoxPoolDeletion(t *testing.T) {
+	ctx := context.Background()
+
+	server := netboxfake.NewServer(netboxTestToken)
+	defer server.Close()
+	server.AddPrefix("10.0.0.0/24", "")
+
+	cluster := generateTestCluster("test-cluster", "test-dc")
+	pool := genNetBoxPool(server.URL, kubermaticv1.IPAMPoolDatacenterSettings{
+		Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+		PoolCIDR:         "10.0.0.0/24",
+		AllocationPrefix: 26,
+	})
+
+	client := fake.
+		NewClientBuilder().
+		WithObjects(
+			cluster,
+			pool,
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "netbox", Namespace: "kubermatic"},
+				Data:       map[string][]byte{"token": []byte(netboxTestToken)},
+			},
+		).
+		Build()
+
+	reconciler := &Reconciler{Client: client}
+	poolReconciler := &PoolStatusReconciler{
+		Client:   client,
+		log:      kubermaticlog.Logger,
+		recorder: &events.FakeRecorder{},
+	}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: pool.Name}}
+
+	_, err := poolReconciler.Reconcile(ctx, request)
+	assert.NoError(t, err)
+
+	_, err = reconciler.reconcile(ctx, cluster)
+	assert.NoError(t, err)
+	assert.Len(t, server.Prefixes(), 2)
+
+	// the pool is deleted before the cluster
+	assert.NoError(t, client.Get(ctx, request.NamespacedName, pool))
+	assert.Contains(t, pool.Finalizers, externalAllocationsCleanupFinalizer)
+	assert.NoError(t, client.Delete(ctx, pool))
+
+	_, err = poolReconciler.Reconcile(ctx, request)
+	assert.NoError(t, err)
+
+	// only the pool CIDR remains in NetBox
+	assert.Len(t, server.Prefixes(), 1)
+	assert.True(t, apierrors.IsNotFound(client.Get(ctx, request.NamespacedName, pool)))
+}
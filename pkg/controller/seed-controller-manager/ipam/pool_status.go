@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	PoolStatusControllerName = "kkp-ipam-pool-status-controller"
+
+	// defaultUtilizationThreshold is used when no utilization threshold is configured
+	// for a datacenter in an IPAMPool.
+	defaultUtilizationThreshold = 90
+)
+
+// PoolStatusReconciler keeps the utilization status and metrics of IPAMPools up to date.
+type PoolStatusReconciler struct {
+	ctrlruntimeclient.Client
+
+	log      *zap.SugaredLogger
+	recorder events.EventRecorder
+}
+
+func addPoolStatusController(mgr manager.Manager, log *zap.SugaredLogger) error {
+	reconciler := &PoolStatusReconciler{
+		Client:   mgr.GetClient(),
+		log:      log.Named(PoolStatusControllerName),
+		recorder: mgr.GetEventRecorder(PoolStatusControllerName),
+	}
+
+	// IPAMAllocations are always named after the IPAMPool they belong to
+	enqueuePoolForAllocation := handler.EnqueueRequestsFromMapFunc(func(_ context.Context, a ctrlruntimeclient.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: a.GetName()}}}
+	})
+
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(PoolStatusControllerName).
+		For(&kubermaticv1.IPAMPool{}).
+		Watches(&kubermaticv1.IPAMAllocation{}, enqueuePoolForAllocation).
+		Build(reconciler)
+
+	return err
+}
+
+func (r *PoolStatusReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("pool", request.Name)
+	log.Debug("Processing")
+
+	pool := &kubermaticv1.IPAMPool{}
+	if err := r.Get(ctx, request.NamespacedName, pool); err != nil {
+		if apierrors.IsNotFound(err) {
+			deletePoolMetrics(request.Name, "")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if pool.DeletionTimestamp != nil {
+		deletePoolMetrics(pool.Name, "")
+
+		if !kuberneteshelper.HasFinalizer(pool, externalAllocationsCleanupFinalizer) {
+			return reconcile.Result{}, nil
+		}
+
+		if err := r.releaseExternalAllocations(ctx, log, pool); err != nil {
+			r.recorder.Eventf(pool, nil, corev1.EventTypeWarning, "ReleasingIPAMAllocationsFailed", "Releasing", err.Error())
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{}, kuberneteshelper.TryRemoveFinalizer(ctx, r, pool, externalAllocationsCleanupFinalizer)
+	}
+
+	// ensure that allocations in external backends are released when the pool is
+	// deleted before the clusters that use it
+	if pool.Spec.Backend != nil {
+		if err := kuberneteshelper.TryAddFinalizer(ctx, r, pool, externalAllocationsCleanupFinalizer); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+	}
+
+	return reconcile.Result{}, r.reconcile(ctx, log, pool)
+}
+
+// releaseExternalAllocations releases the allocations of all clusters in the
+// datacenters of the pool from its external backend.
+func (r *PoolStatusReconciler) releaseExternalAllocations(ctx context.Context, log *zap.SugaredLogger, pool *kubermaticv1.IPAMPool) error {
+	if pool.Spec.Backend == nil {
+		return nil
+	}
+
+	allocator, err := newBackendAllocator(ctx, r, pool)
+	if err != nil {
+		return err
+	}
+
+	clusterList := &kubermaticv1.ClusterList{}
+	if err := r.List(ctx, clusterList); err != nil {
+		return fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	var errs []error
+	for _, cluster := range clusterList.Items {
+		if _, ok := pool.Spec.Datacenters[cluster.Spec.Cloud.DatacenterName]; !ok {
+			continue
+		}
+
+		log.Debugw("Releasing external allocations", "cluster", cluster.Name)
+		if err := allocator.Release(ctx, cluster.Name); err != nil {
+			errs = append(errs, fmt.Errorf("failed to release allocations of cluster %s: %w", cluster.Name, err))
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+func (r *PoolStatusReconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, pool *kubermaticv1.IPAMPool) error {
+	ipamAllocationList := &kubermaticv1.IPAMAllocationList{}
+	if err := r.List(ctx, ipamAllocationList); err != nil {
+		return fmt.Errorf("failed to list IPAM allocations: %w", err)
+	}
+
+	oldPool := pool.DeepCopy()
+	newStatus := map[string]kubermaticv1.IPAMPoolDatacenterStatus{}
+
+	for dc, dcIPAMPoolCfg := range pool.Spec.Datacenters {
+		status, err := calculateDatacenterUtilization(pool, dc, ipamAllocationList.Items)
+		if err != nil {
+			// the webhook should have prevented this
+			log.Errorw("Failed to calculate pool utilization", "datacenter", dc, zap.Error(err))
+			continue
+		}
+
+		threshold := dcIPAMPoolCfg.UtilizationThreshold
+		if threshold == 0 {
+			threshold = defaultUtilizationThreshold
+		}
+
+		oldStatus := pool.Status.Datacenters[dc]
+		status.Conditions = oldStatus.Conditions
+
+		condition := capacityCondition(status, threshold)
+		condition.ObservedGeneration = pool.Generation
+
+		oldCondition := meta.FindStatusCondition(oldStatus.Conditions, kubermaticv1.IPAMPoolConditionCapacityAvailable)
+		if condition.Status == metav1.ConditionFalse && (oldCondition == nil || oldCondition.Status != metav1.ConditionFalse) {
+			r.recorder.Eventf(pool, nil, corev1.EventTypeWarning, condition.Reason, "CalculatingUtilization", "Datacenter %s: %s", dc, condition.Message)
+		}
+
+		meta.SetStatusCondition(&status.Conditions, condition)
+		newStatus[dc] = *status
+
+		setPoolMetrics(pool.Name, dc, status, threshold)
+	}
+
+	// cleanup metrics for datacenters that have been removed from the pool
+	for dc := range pool.Status.Datacenters {
+		if _, exists := newStatus[dc]; !exists {
+			deletePoolMetrics(pool.Name, dc)
+		}
+	}
+
+	pool.Status.Datacenters = newStatus
+	if equality.Semantic.DeepEqual(oldPool.Status, pool.Status) {
+		return nil
+	}
+
+	return r.Status().Patch(ctx, pool, ctrlruntimeclient.MergeFrom(oldPool))
+}
+
+func capacityCondition(status *kubermaticv1.IPAMPoolDatacenterStatus, threshold int) metav1.Condition {
+	utilization := utilizationPercentage(status)
+
+	condition := metav1.Condition{
+		Type:    kubermaticv1.IPAMPoolConditionCapacityAvailable,
+		Status:  metav1.ConditionTrue,
+		Reason:  kubermaticv1.IPAMPoolReasonBelowThreshold,
+		Message: fmt.Sprintf("%.0f%% of the pool is in use, threshold is %d%%.", utilization, threshold),
+	}
+
+	switch {
+	case status.Free == 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = kubermaticv1.IPAMPoolReasonExhausted
+		condition.Message = fmt.Sprintf("The pool is exhausted, no free %s left.", unitName(status.Type))
+
+	case utilization >= float64(threshold):
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = kubermaticv1.IPAMPoolReasonThresholdExceeded
+		condition.Message = fmt.Sprintf("%.0f%% of the pool is in use, exceeding the threshold of %d%%; %d free %s left.", utilization, threshold, status.Free, unitName(status.Type))
+	}
+
+	return condition
+}
+
+func unitName(allocationType kubermaticv1.IPAMPoolAllocationType) string {
+	if allocationType == kubermaticv1.IPAMPoolAllocationTypePrefix {
+		return "prefixes"
+	}
+
+	return "addresses"
+}
@@ -99,5 +99,5 @@ func findFirstFreeSubnetOfPool(poolName, poolCIDR, currentAllocatedCIDR string,
 		}
 	}
 
-	return currentAllocatedCIDR, fmt.Errorf("there is no free subnet available for IPAM Pool \"%s\"", poolName)
+	return currentAllocatedCIDR, &poolExhaustedError{message: fmt.Sprintf("there is no free subnet available for IPAM Pool \"%s\"", poolName)}
 }
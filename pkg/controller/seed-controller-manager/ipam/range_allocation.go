@@ -17,9 +17,11 @@ limitations under the License.
 package ipam
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -95,7 +97,7 @@ func findFirstFreeRangesOfPool(poolName, poolCIDR string, allocationRange int, d
 	}
 
 	if allocationRange > len(rangeFreeIPs) {
-		return nil, fmt.Errorf("there is no enough free IPs available for IPAM pool \"%s\"", poolName)
+		return nil, &poolExhaustedError{message: fmt.Sprintf("there is no enough free IPs available for IPAM pool \"%s\"", poolName)}
 	}
 
 	if len(rangeFreeIPs) > 0 {
@@ -119,3 +121,30 @@ func findFirstFreeRangesOfPool(poolName, poolCIDR string, allocationRange int, d
 
 	return addressRanges, nil
 }
+
+// addressRangesFromIPs returns the given IPs as a sorted list of address ranges,
+// merging consecutive IPs into a single range.
+func addressRangesFromIPs(ips []string) []string {
+	parsed := make([]net.IP, 0, len(ips))
+	for _, ip := range ips {
+		if p := net.ParseIP(ip); p != nil {
+			parsed = append(parsed, checkIPv4(p))
+		}
+	}
+
+	sort.Slice(parsed, func(i, j int) bool {
+		return bytes.Compare(parsed[i], parsed[j]) < 0
+	})
+
+	addressRanges := []string{}
+	for i := 0; i < len(parsed); {
+		first := parsed[i]
+		last := first
+		for i++; i < len(parsed) && isTheNextIP(parsed[i].String(), last.String()); i++ {
+			last = parsed[i]
+		}
+		addressRanges = append(addressRanges, fmt.Sprintf("%s-%s", first, last))
+	}
+
+	return addressRanges
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"sort"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// calculateDatacenterUtilization returns the utilization of the pool in the
+// given datacenter, based on all allocations in the seed. Allocations outside
+// of the pool CIDR (e.g. left over after a pool change) are ignored. Reservations
+// that are not allocated yet are counted as excluded.
+func calculateDatacenterUtilization(pool *kubermaticv1.IPAMPool, dc string, allocations []kubermaticv1.IPAMAllocation) (*kubermaticv1.IPAMPoolDatacenterStatus, error) {
+	dcIPAMPoolCfg := pool.Spec.Datacenters[dc]
+
+	relevant := []kubermaticv1.IPAMAllocation{}
+	for _, allocation := range allocations {
+		if allocation.Name == pool.Name && allocation.Spec.DC == dc && allocation.Spec.Type == dcIPAMPoolCfg.Type {
+			relevant = append(relevant, allocation)
+		}
+	}
+
+	reservations := []kubermaticv1.IPAMPoolReservation{}
+	for _, reservation := range pool.Spec.Reservations {
+		if reservation.Datacenter == dc {
+			reservations = append(reservations, reservation)
+		}
+	}
+
+	switch dcIPAMPoolCfg.Type {
+	case kubermaticv1.IPAMPoolAllocationTypePrefix:
+		return calculatePrefixUtilization(dcIPAMPoolCfg, relevant, reservations)
+	case kubermaticv1.IPAMPoolAllocationTypeRange:
+		return calculateRangeUtilization(dcIPAMPoolCfg, relevant, reservations)
+	default:
+		return nil, fmt.Errorf("unknown allocation type %q", dcIPAMPoolCfg.Type)
+	}
+}
+
+func calculatePrefixUtilization(dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings, allocations []kubermaticv1.IPAMAllocation, reservations []kubermaticv1.IPAMPoolReservation) (*kubermaticv1.IPAMPoolDatacenterStatus, error) {
+	_, poolSubnet, err := net.ParseCIDR(string(dcIPAMPoolCfg.PoolCIDR))
+	if err != nil {
+		return nil, err
+	}
+
+	poolPrefix, bits := poolSubnet.Mask.Size()
+	if dcIPAMPoolCfg.AllocationPrefix < poolPrefix || dcIPAMPoolCfg.AllocationPrefix > bits {
+		return nil, errors.New("invalid prefix for subnet")
+	}
+
+	status := &kubermaticv1.IPAMPoolDatacenterStatus{
+		Type:  kubermaticv1.IPAMPoolAllocationTypePrefix,
+		Total: capInt64(new(big.Int).Lsh(big.NewInt(1), uint(dcIPAMPoolCfg.AllocationPrefix-poolPrefix))),
+	}
+
+	seen := sets.New[string]()
+	used := []*net.IPNet{}
+
+	for _, excluded := range dcIPAMPoolCfg.ExcludePrefixes {
+		_, subnet, err := net.ParseCIDR(string(excluded))
+		if err != nil {
+			return nil, err
+		}
+		if !poolSubnet.Contains(subnet.IP) || seen.Has(subnet.String()) {
+			continue
+		}
+		seen.Insert(subnet.String())
+		used = append(used, subnet)
+		status.Excluded++
+	}
+
+	for _, allocation := range allocations {
+		_, subnet, err := net.ParseCIDR(string(allocation.Spec.CIDR))
+		if err != nil {
+			// allocations are created by the controller, but might
+			// be incomplete if the pool was exhausted
+			continue
+		}
+		if !poolSubnet.Contains(subnet.IP) || seen.Has(subnet.String()) {
+			continue
+		}
+		seen.Insert(subnet.String())
+		used = append(used, subnet)
+		status.Allocated++
+	}
+
+	for _, reservation := range reservations {
+		_, subnet, err := net.ParseCIDR(string(reservation.CIDR))
+		if err != nil {
+			continue
+		}
+		if !poolSubnet.Contains(subnet.IP) || seen.Has(subnet.String()) {
+			continue
+		}
+		seen.Insert(subnet.String())
+		used = append(used, subnet)
+		status.Excluded++
+	}
+
+	status.Free = max(status.Total-status.Allocated-status.Excluded, 0)
+
+	if largest := largestFreeSubnet(poolSubnet, dcIPAMPoolCfg.AllocationPrefix, used); largest != nil {
+		status.LargestFreeBlock = largest.String()
+	}
+
+	return status, nil
+}
+
+// largestFreeSubnet returns the largest subnet within block that does not
+// overlap with any of the used subnets and is not smaller than the allocation
+// prefix. If multiple subnets of the same size exist, the first one is returned.
+func largestFreeSubnet(block *net.IPNet, allocationPrefix int, used []*net.IPNet) *net.IPNet {
+	if !overlapsAny(block, used) {
+		return block
+	}
+
+	prefix, bits := block.Mask.Size()
+	if prefix >= allocationPrefix {
+		return nil
+	}
+
+	lower := &net.IPNet{IP: block.IP, Mask: net.CIDRMask(prefix+1, bits)}
+	upper, _ := nextSubnet(lower, prefix+1)
+
+	lowerFree := largestFreeSubnet(lower, allocationPrefix, used)
+	upperFree := largestFreeSubnet(upper, allocationPrefix, used)
+
+	switch {
+	case lowerFree == nil:
+		return upperFree
+	case upperFree == nil:
+		return lowerFree
+	}
+
+	lowerPrefix, _ := lowerFree.Mask.Size()
+	upperPrefix, _ := upperFree.Mask.Size()
+	if upperPrefix < lowerPrefix {
+		return upperFree
+	}
+
+	return lowerFree
+}
+
+func overlapsAny(subnet *net.IPNet, others []*net.IPNet) bool {
+	for _, other := range others {
+		if subnet.Contains(other.IP) || other.Contains(subnet.IP) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func calculateRangeUtilization(dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings, allocations []kubermaticv1.IPAMAllocation, reservations []kubermaticv1.IPAMPoolReservation) (*kubermaticv1.IPAMPoolDatacenterStatus, error) {
+	_, poolSubnet, err := net.ParseCIDR(string(dcIPAMPoolCfg.PoolCIDR))
+	if err != nil {
+		return nil, err
+	}
+
+	poolPrefix, bits := poolSubnet.Mask.Size()
+	firstIP, lastIP := addressRange(poolSubnet)
+
+	status := &kubermaticv1.IPAMPoolDatacenterStatus{
+		Type:  kubermaticv1.IPAMPoolAllocationTypeRange,
+		Total: capInt64(new(big.Int).Lsh(big.NewInt(1), uint(bits-poolPrefix))),
+	}
+
+	seen := sets.New[string]()
+	used := []*big.Int{}
+
+	markUsed := func(ips []string) int64 {
+		var count int64
+		for _, ip := range ips {
+			parsed := net.ParseIP(ip)
+			if parsed == nil || !poolSubnet.Contains(parsed) || seen.Has(parsed.String()) {
+				continue
+			}
+			seen.Insert(parsed.String())
+			ipInt, _ := ipToInt(checkIPv4(parsed))
+			used = append(used, ipInt)
+			count++
+		}
+		return count
+	}
+
+	excludedIPs, err := getIPsFromAddressRanges(dcIPAMPoolCfg.ExcludeRanges)
+	if err != nil {
+		return nil, err
+	}
+	status.Excluded = markUsed(excludedIPs)
+
+	for _, allocation := range allocations {
+		allocatedIPs, err := getIPsFromAddressRanges(allocation.Spec.Addresses)
+		if err != nil {
+			continue
+		}
+		status.Allocated += markUsed(allocatedIPs)
+	}
+
+	for _, reservation := range reservations {
+		reservedIPs, err := getIPsFromAddressRanges(reservation.Addresses)
+		if err != nil {
+			continue
+		}
+		status.Excluded += markUsed(reservedIPs)
+	}
+
+	status.Free = max(status.Total-status.Allocated-status.Excluded, 0)
+
+	// find the largest gap between the used addresses
+	sort.Slice(used, func(i, j int) bool {
+		return used[i].Cmp(used[j]) < 0
+	})
+
+	first, _ := ipToInt(checkIPv4(firstIP))
+	last, _ := ipToInt(checkIPv4(lastIP))
+	one := big.NewInt(1)
+
+	var largestStart, largestEnd, largestSize *big.Int
+
+	considerGap := func(start, end *big.Int) {
+		if start.Cmp(end) > 0 {
+			return
+		}
+		size := new(big.Int).Sub(end, start)
+		if largestSize == nil || size.Cmp(largestSize) > 0 {
+			largestStart, largestEnd, largestSize = start, end, size
+		}
+	}
+
+	next := first
+	for _, ip := range used {
+		considerGap(next, new(big.Int).Sub(ip, one))
+		next = new(big.Int).Add(ip, one)
+	}
+	considerGap(next, last)
+
+	if largestSize != nil {
+		status.LargestFreeBlock = fmt.Sprintf("%s-%s", intToIP(largestStart, bits), intToIP(largestEnd, bits))
+	}
+
+	return status, nil
+}
+
+// utilizationPercentage returns how much of the pool is allocated or excluded.
+func utilizationPercentage(status *kubermaticv1.IPAMPoolDatacenterStatus) float64 {
+	if status.Total == 0 {
+		return 100
+	}
+
+	return float64(status.Allocated+status.Excluded) / float64(status.Total) * 100
+}
+
+func capInt64(val *big.Int) int64 {
+	if !val.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return val.Int64()
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"math"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func genPrefixAllocation(pool, dc, cidr string) kubermaticv1.IPAMAllocation {
+	return kubermaticv1.IPAMAllocation{
+		ObjectMeta: metav1.ObjectMeta{Name: pool},
+		Spec: kubermaticv1.IPAMAllocationSpec{
+			Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+			DC:   dc,
+			CIDR: kubermaticv1.SubnetCIDR(cidr),
+		},
+	}
+}
+
+func genRangeAllocation(pool, dc string, addresses ...string) kubermaticv1.IPAMAllocation {
+	return kubermaticv1.IPAMAllocation{
+		ObjectMeta: metav1.ObjectMeta{Name: pool},
+		Spec: kubermaticv1.IPAMAllocationSpec{
+			Type:      kubermaticv1.IPAMPoolAllocationTypeRange,
+			DC:        dc,
+			Addresses: addresses,
+		},
+	}
+}
+
+func TestCalculateDatacenterUtilization(t *testing.T) {
+	testCases := []struct {
+		name         string
+		cfg          kubermaticv1.IPAMPoolDatacenterSettings
+		reservations []kubermaticv1.IPAMPoolReservation
+		allocations  []kubermaticv1.IPAMAllocation
+		expected     kubermaticv1.IPAMPoolDatacenterStatus
+	}{
+		{
+			name: "prefix: empty pool",
+			cfg: kubermaticv1.IPAMPoolDatacenterSettings{
+				Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+				PoolCIDR:         "192.168.0.0/24",
+				AllocationPrefix: 28,
+			},
+			expected: kubermaticv1.IPAMPoolDatacenterStatus{
+				Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+				Total:            16,
+				Free:             16,
+				LargestFreeBlock: "192.168.0.0/24",
+			},
+		},
+		{
+			name: "prefix: allocations and exclusions",
+			cfg: kubermaticv1.IPAMPoolDatacenterSettings{
+				Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+				PoolCIDR:         "192.168.0.0/24",
+				AllocationPrefix: 26,
+				ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"192.168.0.128/26", "10.0.0.0/26"},
+			},
+			allocations: []kubermaticv1.IPAMAllocation{
+				genPrefixAllocation("test-pool", "test-dc", "192.168.0.0/26"),
+				// different pool, datacenter or outside of the pool CIDR
+				genPrefixAllocation("other-pool", "test-dc", "192.168.0.64/26"),
+				genPrefixAllocation("test-pool", "other-dc", "192.168.0.64/26"),
+				genPrefixAllocation("test-pool", "test-dc", "172.16.0.0/26"),
+			},
+			expected: kubermaticv1.IPAMPoolDatacenterStatus{
+				Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+				Total:            4,
+				Allocated:        1,
+				Excluded:         1,
+				Free:             2,
+				LargestFreeBlock: "192.168.0.64/26",
+			},
+		},
+		{
+			name: "prefix: largest free block is not the first one",
+			cfg: kubermaticv1.IPAMPoolDatacenterSettings{
+				Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+				PoolCIDR:         "192.168.0.0/24",
+				AllocationPrefix: 28,
+			},
+			allocations: []kubermaticv1.IPAMAllocation{
+				genPrefixAllocation("test-pool", "test-dc", "192.168.0.16/28"),
+			},
+			expected: kubermaticv1.IPAMPoolDatacenterStatus{
+				Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+				Total:            16,
+				Allocated:        1,
+				Free:             15,
+				LargestFreeBlock: "192.168.0.128/25",
+			},
+		},
+		{
+			name: "prefix: exhausted",
+			cfg: kubermaticv1.IPAMPoolDatacenterSettings{
+				Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+				PoolCIDR:         "192.168.0.0/25",
+				AllocationPrefix: 26,
+			},
+			allocations: []kubermaticv1.IPAMAllocation{
+				genPrefixAllocation("test-pool", "test-dc", "192.168.0.0/26"),
+				genPrefixAllocation("test-pool", "test-dc", "192.168.0.64/26"),
+			},
+			expected: kubermaticv1.IPAMPoolDatacenterStatus{
+				Type:      kubermaticv1.IPAMPoolAllocationTypePrefix,
+				Total:     2,
+				Allocated: 2,
+			},
+		},
+		{
+			name: "prefix: huge IPv6 pool is capped",
+			cfg: kubermaticv1.IPAMPoolDatacenterSettings{
+				Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+				PoolCIDR:         "2001:db8::/32",
+				AllocationPrefix: 112,
+			},
+			expected: kubermaticv1.IPAMPoolDatacenterStatus{
+				Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+				Total:            math.MaxInt64,
+				Free:             math.MaxInt64,
+				LargestFreeBlock: "2001:db8::/32",
+			},
+		},
+		{
+			name: "prefix: reservations",
+			cfg: kubermaticv1.IPAMPoolDatacenterSettings{
+				Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+				PoolCIDR:         "192.168.0.0/24",
+				AllocationPrefix: 26,
+			},
+			reservations: []kubermaticv1.IPAMPoolReservation{
+				{Cluster: "cluster-a", Datacenter: "test-dc", CIDR: "192.168.0.0/26"},
+				{Cluster: "cluster-b", Datacenter: "test-dc", CIDR: "192.168.0.192/26"},
+				{Cluster: "cluster-c", Datacenter: "other-dc", CIDR: "192.168.0.64/26"},
+			},
+			allocations: []kubermaticv1.IPAMAllocation{
+				// the allocation of the reserved cluster is only counted once
+				genPrefixAllocation("test-pool", "test-dc", "192.168.0.0/26"),
+			},
+			expected: kubermaticv1.IPAMPoolDatacenterStatus{
+				Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+				Total:            4,
+				Allocated:        1,
+				Excluded:         1,
+				Free:             2,
+				LargestFreeBlock: "192.168.0.64/26",
+			},
+		},
+		{
+			name: "range: allocations and exclusions",
+			cfg: kubermaticv1.IPAMPoolDatacenterSettings{
+				Type:            kubermaticv1.IPAMPoolAllocationTypeRange,
+				PoolCIDR:        "192.168.1.0/28",
+				AllocationRange: 4,
+				ExcludeRanges:   []string{"192.168.1.0", "192.168.1.15"},
+			},
+			allocations: []kubermaticv1.IPAMAllocation{
+				genRangeAllocation("test-pool", "test-dc", "192.168.1.1-192.168.1.4"),
+				genRangeAllocation("test-pool", "test-dc", "192.168.1.6-192.168.1.7", "192.168.1.10-192.168.1.11"),
+			},
+			expected: kubermaticv1.IPAMPoolDatacenterStatus{
+				Type:             kubermaticv1.IPAMPoolAllocationTypeRange,
+				Total:            16,
+				Allocated:        8,
+				Excluded:         2,
+				Free:             6,
+				LargestFreeBlock: "192.168.1.12-192.168.1.14",
+			},
+		},
+		{
+			name: "range: reservations",
+			cfg: kubermaticv1.IPAMPoolDatacenterSettings{
+				Type:            kubermaticv1.IPAMPoolAllocationTypeRange,
+				PoolCIDR:        "192.168.1.0/28",
+				AllocationRange: 2,
+			},
+			reservations: []kubermaticv1.IPAMPoolReservation{
+				{Cluster: "cluster-a", Datacenter: "test-dc", Addresses: []string{"192.168.1.0-192.168.1.1"}},
+				{Cluster: "cluster-b", Datacenter: "test-dc", Addresses: []string{"192.168.1.14", "192.168.1.15"}},
+			},
+			allocations: []kubermaticv1.IPAMAllocation{
+				genRangeAllocation("test-pool", "test-dc", "192.168.1.0-192.168.1.1"),
+				genRangeAllocation("test-pool", "test-dc", "192.168.1.2-192.168.1.3"),
+			},
+			expected: kubermaticv1.IPAMPoolDatacenterStatus{
+				Type:             kubermaticv1.IPAMPoolAllocationTypeRange,
+				Total:            16,
+				Allocated:        4,
+				Excluded:         2,
+				Free:             10,
+				LargestFreeBlock: "192.168.1.4-192.168.1.13",
+			},
+		},
+		{
+			name: "range: exhausted",
+			cfg: kubermaticv1.IPAMPoolDatacenterSettings{
+				Type:            kubermaticv1.IPAMPoolAllocationTypeRange,
+				PoolCIDR:        "192.168.1.0/30",
+				AllocationRange: 2,
+			},
+			allocations: []kubermaticv1.IPAMAllocation{
+				genRangeAllocation("test-pool", "test-dc", "192.168.1.0-192.168.1.1"),
+				genRangeAllocation("test-pool", "test-dc", "192.168.1.2-192.168.1.3"),
+			},
+			expected: kubermaticv1.IPAMPoolDatacenterStatus{
+				Type:      kubermaticv1.IPAMPoolAllocationTypeRange,
+				Total:     4,
+				Allocated: 4,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pool := &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pool"},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters:  map[string]kubermaticv1.IPAMPoolDatacenterSettings{"test-dc": tc.cfg},
+					Reservations: tc.reservations,
+				},
+			}
+
+			status, err := calculateDatacenterUtilization(pool, "test-dc", tc.allocations)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !diff.SemanticallyEqual(tc.expected, *status) {
+				t.Fatalf("Unexpected status:\n%v", diff.ObjectDiff(tc.expected, *status))
+			}
+		})
+	}
+}
+
+func TestCapacityCondition(t *testing.T) {
+	testCases := []struct {
+		name           string
+		status         kubermaticv1.IPAMPoolDatacenterStatus
+		threshold      int
+		expectedStatus metav1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:           "below threshold",
+			status:         kubermaticv1.IPAMPoolDatacenterStatus{Total: 10, Allocated: 5, Excluded: 3, Free: 2},
+			threshold:      90,
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: kubermaticv1.IPAMPoolReasonBelowThreshold,
+		},
+		{
+			name:           "threshold reached",
+			status:         kubermaticv1.IPAMPoolDatacenterStatus{Total: 10, Allocated: 8, Excluded: 1, Free: 1},
+			threshold:      90,
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: kubermaticv1.IPAMPoolReasonThresholdExceeded,
+		},
+		{
+			name:           "exhausted",
+			status:         kubermaticv1.IPAMPoolDatacenterStatus{Total: 10, Allocated: 10},
+			threshold:      100,
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: kubermaticv1.IPAMPoolReasonExhausted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			condition := capacityCondition(&tc.status, tc.threshold)
+			if condition.Status != tc.expectedStatus || condition.Reason != tc.expectedReason {
+				t.Fatalf("Expected %s/%s, but got %s/%s (%s).", tc.expectedStatus, tc.expectedReason, condition.Status, condition.Reason, condition.Message)
+			}
+		})
+	}
+}
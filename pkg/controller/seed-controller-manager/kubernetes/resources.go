@@ -63,6 +63,7 @@ import (
 	"k8c.io/reconciler/pkg/reconciling"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -1261,12 +1262,29 @@ func (r *Reconciler) ensurePodDisruptionBudgets(ctx context.Context, c *kubermat
 
 // GetCronJobReconcilers returns all CronJobReconcilers that are currently in use.
 func GetCronJobReconcilers(data *resources.TemplateData) []reconciling.NamedCronJobReconcilerFactory {
-	return []reconciling.NamedCronJobReconcilerFactory{
-		etcd.CronJobReconciler(data),
+	creators := []reconciling.NamedCronJobReconcilerFactory{}
+
+	// with the automatic etcd maintenance, members are defragmented by the
+	// etcd maintenance controller instead
+	if !data.Cluster().Spec.ComponentsOverride.Etcd.Maintenance.IsEnabled() {
+		creators = append(creators, etcd.CronJobReconciler(data))
 	}
+
+	return creators
 }
 
 func (r *Reconciler) ensureCronJobs(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	if c.Spec.ComponentsOverride.Etcd.Maintenance.IsEnabled() {
+		if err := r.Delete(ctx, &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resources.EtcdDefragCronJobName,
+				Namespace: c.Status.NamespaceName,
+			},
+		}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to ensure the etcd defragger CronJob is removed/not present: %w", err)
+		}
+	}
+
 	creators := GetCronJobReconcilers(data)
 
 	if err := reconciling.ReconcileCronJobs(ctx, creators, c.Status.NamespaceName, r); err != nil {
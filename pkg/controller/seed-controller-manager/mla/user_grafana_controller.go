@@ -373,6 +373,7 @@ func (r *userGrafanaController) ensureGrafanaUser(ctx context.Context, user *kub
 
 func getProjectRolesForUser(ctx context.Context, client ctrlruntimeclient.Client, user *kubermaticv1.User) (map[string]grafanasdk.RoleType, error) {
 	projectMap := make(map[string]grafanasdk.RoleType)
+	now := time.Now()
 
 	// get projects/roles by userProjectBinding
 	upbList := &kubermaticv1.UserProjectBindingList{}
@@ -380,7 +381,7 @@ func getProjectRolesForUser(ctx context.Context, client ctrlruntimeclient.Client
 		return projectMap, err
 	}
 	for _, upb := range upbList.Items {
-		if upb.Spec.UserEmail == user.Spec.Email {
+		if upb.Spec.UserEmail == user.Spec.Email && !upb.Spec.IsExpired(now) {
 			projectMap[upb.Spec.ProjectID] = groupToRole[rbac.ExtractGroupPrefix(upb.Spec.Group)]
 		}
 	}
@@ -392,7 +393,7 @@ func getProjectRolesForUser(ctx context.Context, client ctrlruntimeclient.Client
 	}
 	userGroups := sets.New(user.Spec.Groups...)
 	for _, gpb := range gpbList.Items {
-		if userGroups.Has(gpb.Spec.Group) {
+		if userGroups.Has(gpb.Spec.Group) && !gpb.Spec.IsExpired(now) {
 			role := groupToRole[gpb.Spec.Role]
 
 			if upbRole, ok := projectMap[gpb.Spec.ProjectID]; ok && role != upbRole {
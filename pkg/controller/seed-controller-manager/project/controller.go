@@ -100,6 +100,10 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, proj
 		return fmt.Errorf("failed to add finalizer: %w", err)
 	}
 
+	if err := r.reconcileSuspension(ctx, log, project); err != nil {
+		return fmt.Errorf("failed to reconcile project suspension: %w", err)
+	}
+
 	return nil
 }
 
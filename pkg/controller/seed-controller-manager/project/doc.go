@@ -24,5 +24,10 @@ takes care of synchronizing the project deletion to all seeds (i.e.
 you delete a project on the master, the project-synchronizer controller
 then deletes the projects on all seeds, and then this controller cleans
 them up by deleting the clusters).
+
+The controller also enforces project suspensions: all clusters in a
+suspended project are paused (and optionally hibernated first) and
+resumed once the suspension is lifted. Only clusters that were paused
+or hibernated by this controller are resumed.
 */
 package project
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/labels"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// suspensionPausedAnnotation is put on clusters that were paused because their project
+	// was suspended, so that only those clusters are unpaused once the project is resumed.
+	suspensionPausedAnnotation = "kubermatic.k8c.io/project-suspension-paused"
+
+	// suspensionHibernatedAnnotation is put on clusters that were hibernated because their
+	// project was suspended, so that only those clusters are woken up once the project is resumed.
+	suspensionHibernatedAnnotation = "kubermatic.k8c.io/project-suspension-hibernated"
+)
+
+// reconcileSuspension pauses (and optionally hibernates) all clusters in a suspended project
+// and reverts these changes once the project is resumed.
+func (r *Reconciler) reconcileSuspension(ctx context.Context, log *zap.SugaredLogger, project *kubermaticv1.Project) error {
+	clusters := &kubermaticv1.ClusterList{}
+	selector := labels.SelectorFromSet(map[string]string{kubermaticv1.ProjectIDLabelKey: project.Name})
+	if err := r.List(ctx, clusters, &ctrlruntimeclient.ListOptions{LabelSelector: selector}); err != nil {
+		return fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	for _, cluster := range clusters.Items {
+		if cluster.DeletionTimestamp != nil {
+			continue
+		}
+
+		var err error
+		if project.IsSuspended() {
+			err = r.suspendCluster(ctx, log, project, &cluster)
+		} else {
+			err = r.resumeCluster(ctx, log, &cluster)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to reconcile suspension of cluster %s: %w", cluster.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) suspendCluster(ctx context.Context, log *zap.SugaredLogger, project *kubermaticv1.Project, cluster *kubermaticv1.Cluster) error {
+	// clusters that are already paused are frozen already and cannot be hibernated,
+	// as the hibernation controller ignores paused clusters
+	if cluster.Spec.Pause {
+		return nil
+	}
+
+	oldCluster := cluster.DeepCopy()
+
+	if project.Spec.Suspension.HibernateClusters {
+		if cluster.Spec.Hibernation == nil || !cluster.Spec.Hibernation.Hibernated {
+			log.Infow("Hibernating cluster because project is suspended", "cluster", cluster.Name)
+
+			if cluster.Spec.Hibernation == nil {
+				cluster.Spec.Hibernation = &kubermaticv1.ClusterHibernationSettings{}
+			}
+			cluster.Spec.Hibernation.Hibernated = true
+			setAnnotation(cluster, suspensionHibernatedAnnotation)
+
+			return r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster))
+		}
+
+		// wait for the hibernation to complete before pausing the cluster; since we
+		// watch clusters, we get triggered again once its status changes
+		if !cluster.Status.IsHibernated() {
+			return nil
+		}
+	}
+
+	log.Infow("Pausing cluster because project is suspended", "cluster", cluster.Name)
+
+	cluster.Spec.Pause = true
+	cluster.Spec.PauseReason = fmt.Sprintf("Project %s is suspended: %s", project.Name, project.Spec.Suspension.Reason)
+	setAnnotation(cluster, suspensionPausedAnnotation)
+
+	return r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster))
+}
+
+func (r *Reconciler) resumeCluster(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
+	_, paused := cluster.Annotations[suspensionPausedAnnotation]
+	_, hibernated := cluster.Annotations[suspensionHibernatedAnnotation]
+
+	if !paused && !hibernated {
+		return nil
+	}
+
+	log.Infow("Resuming cluster because project is no longer suspended", "cluster", cluster.Name)
+
+	oldCluster := cluster.DeepCopy()
+
+	if paused {
+		cluster.Spec.Pause = false
+		cluster.Spec.PauseReason = ""
+		delete(cluster.Annotations, suspensionPausedAnnotation)
+	}
+
+	if hibernated {
+		if cluster.Spec.Hibernation != nil {
+			cluster.Spec.Hibernation.Hibernated = false
+		}
+		delete(cluster.Annotations, suspensionHibernatedAnnotation)
+	}
+
+	return r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster))
+}
+
+func setAnnotation(cluster *kubermaticv1.Cluster, key string) {
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[key] = "true"
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/kubermatic/v2/pkg/test/generator"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+)
+
+func TestReconcileSuspension(t *testing.T) {
+	suspended := func(hibernate bool) *kubermaticv1.ProjectSuspension {
+		return &kubermaticv1.ProjectSuspension{
+			Reason:            "budget exhausted",
+			HibernateClusters: hibernate,
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		suspension        *kubermaticv1.ProjectSuspension
+		modifyCluster     func(*kubermaticv1.Cluster)
+		expectPaused      bool
+		expectHibernated  bool
+		expectAnnotations []string
+	}{
+		{
+			name:              "suspended project pauses clusters",
+			suspension:        suspended(false),
+			expectPaused:      true,
+			expectAnnotations: []string{suspensionPausedAnnotation},
+		},
+		{
+			name:              "suspended project hibernates clusters before pausing them",
+			suspension:        suspended(true),
+			expectHibernated:  true,
+			expectAnnotations: []string{suspensionHibernatedAnnotation},
+		},
+		{
+			name:       "suspended project pauses hibernated clusters",
+			suspension: suspended(true),
+			modifyCluster: func(c *kubermaticv1.Cluster) {
+				c.Annotations = map[string]string{suspensionHibernatedAnnotation: "true"}
+				c.Spec.Hibernation = &kubermaticv1.ClusterHibernationSettings{Hibernated: true}
+				c.Status.Hibernation = &kubermaticv1.ClusterHibernationStatus{State: kubermaticv1.ClusterHibernationStateHibernated}
+			},
+			expectPaused:      true,
+			expectHibernated:  true,
+			expectAnnotations: []string{suspensionHibernatedAnnotation, suspensionPausedAnnotation},
+		},
+		{
+			name:       "suspended project leaves already paused clusters alone",
+			suspension: suspended(true),
+			modifyCluster: func(c *kubermaticv1.Cluster) {
+				c.Spec.Pause = true
+			},
+			expectPaused: true,
+		},
+		{
+			name: "resumed project unpauses and wakes up suspended clusters",
+			modifyCluster: func(c *kubermaticv1.Cluster) {
+				c.Annotations = map[string]string{suspensionHibernatedAnnotation: "true", suspensionPausedAnnotation: "true"}
+				c.Spec.Pause = true
+				c.Spec.Hibernation = &kubermaticv1.ClusterHibernationSettings{Hibernated: true}
+			},
+		},
+		{
+			name: "resumed project does not unpause clusters paused for other reasons",
+			modifyCluster: func(c *kubermaticv1.Cluster) {
+				c.Spec.Pause = true
+			},
+			expectPaused: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			project := generator.GenDefaultProject()
+			project.Spec.Suspension = tc.suspension
+
+			cluster := generator.GenCluster("abcd1234", "test", project.Name, generator.DefaultCreationTimestamp())
+			cluster.Labels[kubermaticv1.ProjectIDLabelKey] = project.Name
+			if tc.modifyCluster != nil {
+				tc.modifyCluster(cluster)
+			}
+
+			client := fake.NewClientBuilder().WithObjects(project, cluster).Build()
+
+			r := &Reconciler{
+				Client:   client,
+				log:      kubermaticlog.Logger,
+				recorder: events.NewFakeRecorder(10),
+			}
+
+			if err := r.reconcileSuspension(ctx, r.log, project); err != nil {
+				t.Fatalf("Failed to reconcile suspension: %v", err)
+			}
+
+			updated := &kubermaticv1.Cluster{}
+			if err := client.Get(ctx, types.NamespacedName{Name: cluster.Name}, updated); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			if updated.Spec.Pause != tc.expectPaused {
+				t.Errorf("Expected paused=%v, but got %v", tc.expectPaused, updated.Spec.Pause)
+			}
+
+			hibernated := updated.Spec.Hibernation != nil && updated.Spec.Hibernation.Hibernated
+			if hibernated != tc.expectHibernated {
+				t.Errorf("Expected hibernated=%v, but got %v", tc.expectHibernated, hibernated)
+			}
+
+			if len(updated.Annotations) != len(tc.expectAnnotations) {
+				t.Errorf("Expected annotations %v, but got %v", tc.expectAnnotations, updated.Annotations)
+			}
+			for _, annotation := range tc.expectAnnotations {
+				if _, ok := updated.Annotations[annotation]; !ok {
+					t.Errorf("Expected annotation %q, but got %v", annotation, updated.Annotations)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updatecontroller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
+	"k8c.io/kubermatic/v2/pkg/util/maintenancewindow"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// PreUpdateBackupLabel marks the one-off EtcdBackupConfigs created before updating the apiserver.
+	PreUpdateBackupLabel = "kubermatic.k8c.io/pre-update-backup"
+
+	// keptPreUpdateBackups is the number of pre-update backups that are kept per cluster;
+	// older backups are removed together with their EtcdBackupConfig.
+	keptPreUpdateBackups = 3
+
+	// preUpdateBackupMaxAge is the maximum age of a pre-update backup; older backups are
+	// taken again before updating the apiserver.
+	preUpdateBackupMaxAge = time.Hour
+)
+
+// preUpdateBackupConfigName returns the name of the EtcdBackupConfig for an update step.
+func preUpdateBackupConfigName(from, to *semver.Semver) string {
+	return strings.ReplaceAll(fmt.Sprintf("pre-update-%s-to-%s", from.String(), to.String()), ".", "-")
+}
+
+// preUpdateBackupFreshSince returns the time after which a pre-update backup must have been
+// completed to be used for an update at the given time. Backups from earlier maintenance
+// windows are never used, as the cluster might have changed considerably since then.
+func preUpdateBackupFreshSince(window *maintenancewindow.Window, now time.Time) time.Time {
+	freshSince := now.Add(-preUpdateBackupMaxAge)
+
+	if window != nil {
+		if windowStart, _ := window.Next(now); windowStart.After(freshSince) {
+			freshSince = windowStart
+		}
+	}
+
+	return freshSince
+}
+
+// preUpdateBackupDestination returns the destination used for pre-update backups,
+// preferring the seed's default destination.
+func preUpdateBackupDestination(seed *kubermaticv1.Seed) string {
+	if seed.IsDefaultEtcdAutomaticBackupEnabled() {
+		return seed.Spec.EtcdBackupRestore.DefaultDestination
+	}
+
+	return sets.List(sets.KeySet(seed.Spec.EtcdBackupRestore.Destinations))[0]
+}
+
+// ensurePreUpdateBackup makes sure that a one-off etcd backup was completed after freshSince
+// for the update step from the current to the new apiserver version. It returns false while
+// the update has to wait for the backup.
+func (r *Reconciler) ensurePreUpdateBackup(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, seed *kubermaticv1.Seed, from, to *semver.Semver, freshSince time.Time) (bool, error) {
+	name := preUpdateBackupConfigName(from, to)
+
+	if backup := cluster.Status.PreUpdateEtcdBackup; backup != nil && backup.BackupConfigName == name && !backup.FinishedTime.Time.Before(freshSince) {
+		return true, nil
+	}
+
+	backupConfig := &kubermaticv1.EtcdBackupConfig{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: name}, backupConfig)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to get EtcdBackupConfig: %w", err)
+		}
+
+		backupConfig = &kubermaticv1.EtcdBackupConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: cluster.Status.NamespaceName,
+				Labels: map[string]string{
+					kubermaticv1.ProjectIDLabelKey: cluster.Labels[kubermaticv1.ProjectIDLabelKey],
+					PreUpdateBackupLabel:           "true",
+				},
+			},
+			Spec: kubermaticv1.EtcdBackupConfigSpec{
+				Name: name,
+				Cluster: corev1.ObjectReference{
+					Kind:       kubermaticv1.ClusterKindName,
+					Name:       cluster.Name,
+					UID:        cluster.UID,
+					APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+				},
+				// no schedule means exactly one backup is taken immediately
+				Destination: preUpdateBackupDestination(seed),
+			},
+		}
+
+		if err := r.Create(ctx, backupConfig); err != nil {
+			return false, fmt.Errorf("failed to create EtcdBackupConfig: %w", err)
+		}
+
+		log.Infow("Creating etcd backup before updating the apiserver", "backupConfig", name, "destination", backupConfig.Spec.Destination)
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "PreUpdateBackupStarted", "Reconciling", "Creating etcd backup %s before updating the apiserver to version %s.", name, to.String())
+
+		return false, r.setClusterCondition(ctx, cluster, ClusterConditionWaitingForEtcdBackup, fmt.Sprintf("Update to v%s is waiting for the etcd backup %s to complete.", to.String(), name))
+	}
+
+	var backup *kubermaticv1.BackupStatus
+	if len(backupConfig.Status.CurrentBackups) > 0 {
+		backup = &backupConfig.Status.CurrentBackups[0]
+	}
+
+	if backupConfig.DeletionTimestamp != nil {
+		return false, r.setClusterCondition(ctx, cluster, ClusterConditionWaitingForEtcdBackup, fmt.Sprintf("Update to v%s is waiting for the outdated etcd backup %s to be removed.", to.String(), name))
+	}
+
+	// the backup was taken in an earlier maintenance window, so take it again
+	if backup != nil && backup.BackupPhase == kubermaticv1.BackupStatusPhaseCompleted && backup.BackupFinishedTime.Time.Before(freshSince) {
+		if err := r.Delete(ctx, backupConfig); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("failed to delete outdated EtcdBackupConfig: %w", err)
+		}
+
+		log.Infow("Etcd backup before updating the apiserver is outdated, taking it again", "backupConfig", name, "finished", backup.BackupFinishedTime)
+
+		return false, r.setClusterCondition(ctx, cluster, ClusterConditionWaitingForEtcdBackup, fmt.Sprintf("Update to v%s is waiting for the outdated etcd backup %s to be removed.", to.String(), name))
+	}
+
+	switch {
+	case backup == nil || (backup.BackupPhase != kubermaticv1.BackupStatusPhaseCompleted && backup.BackupPhase != kubermaticv1.BackupStatusPhaseFailed):
+		return false, r.setClusterCondition(ctx, cluster, ClusterConditionWaitingForEtcdBackup, fmt.Sprintf("Update to v%s is waiting for the etcd backup %s to complete.", to.String(), name))
+
+	case backup.BackupPhase == kubermaticv1.BackupStatusPhaseFailed:
+		return false, r.setClusterCondition(ctx, cluster, ClusterConditionEtcdBackupFailed, fmt.Sprintf("Update to v%s is blocked because the etcd backup %s failed (%s); delete the EtcdBackupConfig to retry.", to.String(), name, backup.BackupMessage))
+	}
+
+	if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.PreUpdateEtcdBackup = &kubermaticv1.PreUpdateEtcdBackupStatus{
+			BackupConfigName: name,
+			BackupName:       backup.BackupName,
+			Destination:      backupConfig.Spec.Destination,
+			From:             from.String(),
+			To:               to.String(),
+			FinishedTime:     backup.BackupFinishedTime,
+		}
+	}); err != nil {
+		return false, fmt.Errorf("failed to record etcd backup: %w", err)
+	}
+
+	log.Infow("Etcd backup before updating the apiserver has completed", "backup", backup.BackupName)
+	r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "PreUpdateBackupCompleted", "Reconciling", "Etcd backup %s was completed before updating the apiserver to version %s.", backup.BackupName, to.String())
+
+	if err := r.cleanupPreUpdateBackups(ctx, cluster); err != nil {
+		return false, fmt.Errorf("failed to clean up old etcd backups: %w", err)
+	}
+
+	return true, nil
+}
+
+// cleanupPreUpdateBackups removes all but the most recent pre-update EtcdBackupConfigs;
+// the etcdbackup controller deletes their backups before removing them.
+func (r *Reconciler) cleanupPreUpdateBackups(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	backupConfigs := &kubermaticv1.EtcdBackupConfigList{}
+	if err := r.List(ctx, backupConfigs, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName), ctrlruntimeclient.MatchingLabels{PreUpdateBackupLabel: "true"}); err != nil {
+		return err
+	}
+
+	items := backupConfigs.Items
+	sort.Slice(items, func(i, j int) bool {
+		return items[j].CreationTimestamp.Before(&items[i].CreationTimestamp)
+	})
+
+	for i := keptPreUpdateBackups; i < len(items); i++ {
+		if err := r.Delete(ctx, &items[i]); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updatecontroller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/kubermatic/v2/pkg/util/maintenancewindow"
+	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestEnsurePreUpdateBackup(t *testing.T) {
+	const (
+		namespace  = "cluster-testcluster"
+		configName = "pre-update-1-31-4-to-1-32-0"
+	)
+
+	from := semver.NewSemverOrDie("1.31.4")
+	to := semver.NewSemverOrDie("1.32.0")
+
+	seed := &kubermaticv1.Seed{
+		Spec: kubermaticv1.SeedSpec{
+			EtcdBackupRestore: &kubermaticv1.EtcdBackupRestore{
+				Destinations: map[string]*kubermaticv1.BackupDestination{
+					"s3":    {},
+					"minio": {},
+				},
+			},
+		},
+	}
+
+	backupConfig := func(name string, age time.Duration, phase kubermaticv1.BackupStatusPhase) *kubermaticv1.EtcdBackupConfig {
+		config := &kubermaticv1.EtcdBackupConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+				Labels: map[string]string{
+					PreUpdateBackupLabel: "true",
+				},
+			},
+			Spec: kubermaticv1.EtcdBackupConfigSpec{
+				Name:        name,
+				Destination: "minio",
+			},
+		}
+
+		if phase != "" {
+			config.Status.CurrentBackups = []kubermaticv1.BackupStatus{{
+				BackupName:         name + ".db.gz",
+				BackupPhase:        phase,
+				BackupFinishedTime: metav1.NewTime(time.Now().Add(-age)),
+			}}
+		}
+
+		return config
+	}
+
+	testcases := []struct {
+		name            string
+		recorded        *kubermaticv1.PreUpdateEtcdBackupStatus
+		existing        []ctrlruntimeclient.Object
+		expectedDone    bool
+		expectedReason  string
+		expectedConfigs []string
+	}{
+		{
+			name:            "backup is started",
+			expectedReason:  ClusterConditionWaitingForEtcdBackup,
+			expectedConfigs: []string{configName},
+		},
+		{
+			name:            "backup is still running",
+			existing:        []ctrlruntimeclient.Object{backupConfig(configName, time.Minute, kubermaticv1.BackupStatusPhaseRunning)},
+			expectedReason:  ClusterConditionWaitingForEtcdBackup,
+			expectedConfigs: []string{configName},
+		},
+		{
+			name:            "backup has failed",
+			existing:        []ctrlruntimeclient.Object{backupConfig(configName, time.Minute, kubermaticv1.BackupStatusPhaseFailed)},
+			expectedReason:  ClusterConditionEtcdBackupFailed,
+			expectedConfigs: []string{configName},
+		},
+		{
+			name: "backup has completed, old backups are cleaned up",
+			existing: []ctrlruntimeclient.Object{
+				backupConfig(configName, time.Minute, kubermaticv1.BackupStatusPhaseCompleted),
+				backupConfig("pre-update-1-30-0-to-1-31-4", time.Hour, kubermaticv1.BackupStatusPhaseCompleted),
+				backupConfig("pre-update-1-29-0-to-1-30-0", 2*time.Hour, kubermaticv1.BackupStatusPhaseCompleted),
+				backupConfig("pre-update-1-28-0-to-1-29-0", 3*time.Hour, kubermaticv1.BackupStatusPhaseCompleted),
+			},
+			expectedDone:    true,
+			expectedConfigs: []string{"pre-update-1-29-0-to-1-30-0", "pre-update-1-30-0-to-1-31-4", configName},
+		},
+		{
+			name:           "backup from an earlier maintenance window is taken again",
+			existing:       []ctrlruntimeclient.Object{backupConfig(configName, 2*time.Hour, kubermaticv1.BackupStatusPhaseCompleted)},
+			expectedReason: ClusterConditionWaitingForEtcdBackup,
+		},
+		{
+			name: "backup was already recorded",
+			recorded: &kubermaticv1.PreUpdateEtcdBackupStatus{
+				BackupConfigName: configName,
+				FinishedTime:     metav1.NewTime(time.Now().Add(-time.Minute)),
+			},
+			expectedDone: true,
+		},
+		{
+			name: "recorded backup is outdated",
+			recorded: &kubermaticv1.PreUpdateEtcdBackupStatus{
+				BackupConfigName: configName,
+				FinishedTime:     metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+			},
+			expectedReason:  ClusterConditionWaitingForEtcdBackup,
+			expectedConfigs: []string{configName},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "testcluster",
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName:       namespace,
+					PreUpdateEtcdBackup: tt.recorded,
+				},
+			}
+
+			rec := &Reconciler{
+				Client:   fake.NewClientBuilder().WithObjects(append(tt.existing, cluster)...).Build(),
+				log:      zap.NewNop().Sugar(),
+				versions: kubermatic.GetFakeVersions(),
+				recorder: events.NewFakeRecorder(10),
+			}
+
+			done, err := rec.ensurePreUpdateBackup(context.Background(), rec.log, cluster, seed, from, to, time.Now().Add(-time.Hour))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if done != tt.expectedDone {
+				t.Fatalf("Expected done to be %v, but got %v.", tt.expectedDone, done)
+			}
+
+			newCluster := &kubermaticv1.Cluster{}
+			if err := rec.Get(context.Background(), types.NamespacedName{Name: cluster.Name}, newCluster); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			if tt.expectedReason != "" {
+				if reason := newCluster.Status.Conditions[kubermaticv1.ClusterConditionUpdateProgress].Reason; reason != tt.expectedReason {
+					t.Errorf("Expected update progress reason to be %q, but is %q.", tt.expectedReason, reason)
+				}
+			}
+
+			if tt.expectedDone && tt.recorded == nil {
+				backup := newCluster.Status.PreUpdateEtcdBackup
+				if backup == nil || backup.BackupName != configName+".db.gz" || backup.Destination != "minio" || backup.To != to.String() {
+					t.Errorf("Expected backup %s.db.gz to be recorded, but got %+v.", configName, backup)
+				}
+			}
+
+			configs := &kubermaticv1.EtcdBackupConfigList{}
+			if err := rec.List(context.Background(), configs); err != nil {
+				t.Fatalf("Failed to list EtcdBackupConfigs: %v", err)
+			}
+
+			names := []string{}
+			for _, config := range configs.Items {
+				names = append(names, config.Name)
+
+				if config.Name == configName && config.Spec.Destination != "minio" {
+					t.Errorf("Expected backup to use the first destination, but got %q.", config.Spec.Destination)
+				}
+			}
+
+			if fmt.Sprint(names) != fmt.Sprint(tt.expectedConfigs) && len(names)+len(tt.expectedConfigs) > 0 {
+				t.Errorf("Expected EtcdBackupConfigs %v, but got %v.", tt.expectedConfigs, names)
+			}
+		})
+	}
+}
+
+func TestPreUpdateBackupFreshSince(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name     string
+		window   *kubermaticv1.MaintenanceWindow
+		expected time.Time
+	}{
+		{
+			name:     "no maintenance window",
+			expected: now.Add(-preUpdateBackupMaxAge),
+		},
+		{
+			name:     "window opened recently",
+			window:   &kubermaticv1.MaintenanceWindow{Start: "11:30", Length: "2h"},
+			expected: time.Date(2026, 1, 1, 11, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "window opened a long time ago",
+			window:   &kubermaticv1.MaintenanceWindow{Start: "06:00", Length: "8h"},
+			expected: now.Add(-preUpdateBackupMaxAge),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			var window *maintenancewindow.Window
+			if tt.window != nil {
+				var err error
+				if window, err = maintenancewindow.Parse(tt.window); err != nil {
+					t.Fatalf("Failed to parse maintenance window: %v", err)
+				}
+			}
+
+			if freshSince := preUpdateBackupFreshSince(window, now); !freshSince.Equal(tt.expected) {
+				t.Errorf("Expected backups to be fresh since %v, but got %v.", tt.expected, freshSince)
+			}
+		})
+	}
+}
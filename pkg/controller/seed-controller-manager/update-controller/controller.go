@@ -19,6 +19,7 @@ package updatecontroller
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -27,6 +28,7 @@ import (
 	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/util/maintenancewindow"
 	"k8c.io/kubermatic/v2/pkg/version"
 	clusterversion "k8c.io/kubermatic/v2/pkg/version/cluster"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
@@ -34,6 +36,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/events"
@@ -50,6 +53,12 @@ const (
 	ClusterConditionUpToDate    = "UpToDate"
 	ClusterConditionProgressing = "Progressing"
 	ClusterConditionOldNodes    = "OldNodes"
+
+	ClusterConditionWaitingForMaintenanceWindow = "WaitingForMaintenanceWindow"
+	ClusterConditionWaitingForUpgradePreflight  = "WaitingForUpgradePreflight"
+	ClusterConditionBlockedByRemovedAPIs        = "BlockedByRemovedAPIs"
+	ClusterConditionWaitingForEtcdBackup        = "WaitingForEtcdBackup"
+	ClusterConditionEtcdBackupFailed            = "EtcdBackupFailed"
 )
 
 type controlPlaneChecker func(context.Context, ctrlruntimeclient.Client, *zap.SugaredLogger, *kubermaticv1.Cluster) (*controlPlaneStatus, error)
@@ -59,6 +68,7 @@ type Reconciler struct {
 
 	workerName   string
 	configGetter provider.KubermaticConfigurationGetter
+	seedGetter   provider.SeedGetter
 	recorder     events.EventRecorder
 	log          *zap.SugaredLogger
 	versions     kubermatic.Versions
@@ -68,11 +78,12 @@ type Reconciler struct {
 }
 
 // Add creates a new update controller.
-func Add(mgr manager.Manager, numWorkers int, workerName string, configGetter provider.KubermaticConfigurationGetter, log *zap.SugaredLogger, versions kubermatic.Versions) error {
+func Add(mgr manager.Manager, numWorkers int, workerName string, configGetter provider.KubermaticConfigurationGetter, seedGetter provider.SeedGetter, log *zap.SugaredLogger, versions kubermatic.Versions) error {
 	reconciler := &Reconciler{
 		Client:       mgr.GetClient(),
 		workerName:   workerName,
 		configGetter: configGetter,
+		seedGetter:   seedGetter,
 		recorder:     mgr.GetEventRecorder(ControllerName),
 		log:          log,
 		versions:     versions,
@@ -88,6 +99,8 @@ func Add(mgr manager.Manager, numWorkers int, workerName string, configGetter pr
 		For(&kubermaticv1.Cluster{}).
 		// Watch Deployments in cluster namespaces to react to the control plane change over time
 		Watches(&appsv1.Deployment{}, controllerutil.EnqueueClusterForNamespacedObject(mgr.GetClient())).
+		// Watch EtcdBackupConfigs to continue once the backup before the update has completed
+		Watches(&kubermaticv1.EtcdBackupConfig{}, controllerutil.EnqueueClusterForNamespacedObject(mgr.GetClient())).
 		Build(reconciler)
 
 	return err
@@ -124,7 +137,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		r.versions,
 		kubermaticv1.ClusterConditionUpdateControllerReconcilingSuccess,
 		func() (*reconcile.Result, error) {
-			return nil, r.reconcile(ctx, log, cluster)
+			if err := r.reconcile(ctx, log, cluster); err != nil {
+				return nil, err
+			}
+
+			// Come back once the maintenance window opens to continue the update.
+			if pending, ok := cluster.Status.PendingUpdates[kubermaticv1.PendingUpdateKey(kubermaticv1.PendingUpdateTypeApiserver, "")]; ok {
+				return &reconcile.Result{RequeueAfter: time.Until(pending.NextWindowStart.Time)}, nil
+			}
+
+			return nil, nil
 		},
 	)
 
@@ -139,8 +161,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return *result, err
 }
 
-func (r *Reconciler) setClusterCondition(ctx context.Context, cluster *kubermaticv1.Cluster, reason, message string) error {
+// setClusterCondition sets the update progress condition and records the update step that is
+// waiting for the maintenance window, if any.
+func (r *Reconciler) setClusterCondition(ctx context.Context, cluster *kubermaticv1.Cluster, reason, message string, pendingUpdates ...kubermaticv1.PendingUpdate) error {
 	return controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		maintenancewindow.SetPendingUpdates(c, []kubermaticv1.PendingUpdateType{kubermaticv1.PendingUpdateTypeApiserver}, pendingUpdates)
 		controllerutil.SetClusterCondition(
 			c,
 			r.versions,
@@ -314,10 +339,60 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 		return fmt.Errorf("failed to determine update path: %w", err)
 	}
 
+	// Updating to a new minor release must not break workloads that still use APIs removed
+	// in the target version; wait for the upgrade-preflight-controller to check the cluster.
+	if newVersion.Semver().Minor() != versions.Apiserver.Semver().Minor() {
+		if reason, message := upgradePreflightBlocker(cluster); reason != "" {
+			log.Debugw("Cluster control plane update is held back by the upgrade preflight check.", "to", newVersion, "reason", reason)
+			return r.setClusterCondition(ctx, cluster, reason, message)
+		}
+	}
+
+	// Each step of the update restarts the control plane, so only take it within the maintenance window.
+	seed, err := r.seedGetter()
+	if err != nil {
+		return fmt.Errorf("failed to get seed: %w", err)
+	}
+
+	window, err := maintenancewindow.ForSeedCluster(cluster, seed)
+	if err != nil {
+		return fmt.Errorf("invalid maintenance window: %w", err)
+	}
+
+	now := time.Now()
+	if open, windowStart := window.IsOpen(now); !open {
+		log.Debugw("Cluster control plane update is waiting for the maintenance window.", "to", newVersion, "windowStart", windowStart)
+
+		return r.setClusterCondition(ctx, cluster, ClusterConditionWaitingForMaintenanceWindow,
+			fmt.Sprintf("Update to v%s is pending until the maintenance window opens at %s.", newVersion.String(), windowStart.UTC().Format(time.RFC3339)),
+			kubermaticv1.PendingUpdate{
+				Type:            kubermaticv1.PendingUpdateTypeApiserver,
+				From:            versions.Apiserver.String(),
+				To:              newVersion.String(),
+				NextWindowStart: metav1.NewTime(windowStart),
+			},
+		)
+	}
+
+	// Take a fresh etcd backup right before moving the apiserver, so that a failed update
+	// can be rolled back without losing the changes since the last scheduled backup.
+	if seed.IsEtcdAutomaticBackupEnabled() {
+		backedUp, err := r.ensurePreUpdateBackup(ctx, log, cluster, seed, &versions.Apiserver, newVersion, preUpdateBackupFreshSince(window, now))
+		if err != nil {
+			return fmt.Errorf("failed to ensure etcd backup: %w", err)
+		}
+
+		// changes to the EtcdBackupConfig will trigger another reconciliation
+		if !backedUp {
+			return nil
+		}
+	}
+
 	// Set this new target version as the next step on our upgrading journey. This will trigger a
 	// reconciliation for us and also make the KKP kubernetes controller roll out the new apiserver.
 	if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
 		c.Status.Versions.Apiserver = *newVersion
+		maintenancewindow.SetPendingUpdates(c, []kubermaticv1.PendingUpdateType{kubermaticv1.PendingUpdateTypeApiserver}, nil)
 	}); err != nil {
 		return fmt.Errorf("failed to update apiserver version: %w", err)
 	}
@@ -536,6 +611,29 @@ func getNextApiserverVersion(ctx context.Context, config *kubermaticv1.Kubermati
 	return normalize(newTarget), nil
 }
 
+// upgradePreflightBlocker returns the reason and message why the update to a new minor
+// release must not start yet, or empty strings if the update can proceed.
+func upgradePreflightBlocker(cluster *kubermaticv1.Cluster) (string, string) {
+	target := cluster.Spec.Version.String()
+	settings := cluster.Spec.UpgradePreflight
+
+	if settings.GetMode() == kubermaticv1.UpgradePreflightModeWarn || settings.Overrides(target) {
+		return "", ""
+	}
+
+	status := cluster.Status.UpgradePreflight
+	if status == nil || status.TargetVersion != target {
+		return ClusterConditionWaitingForUpgradePreflight, fmt.Sprintf("Update to v%s is waiting for the check for removed APIs.", target)
+	}
+
+	if len(status.RemovedAPIs) > 0 {
+		return ClusterConditionBlockedByRemovedAPIs, fmt.Sprintf("Update to v%s is blocked because APIs removed in this version are still in use (see the %s condition); set spec.upgradePreflight.overrideVersion to %q to update anyway.",
+			target, kubermaticv1.ClusterConditionUpgradePreflightPassed, target)
+	}
+
+	return "", ""
+}
+
 // normalize ensures that the string representation for semvers is always consistent.
 func normalize(s *semver.Semver) *semver.Semver {
 	if s == nil {
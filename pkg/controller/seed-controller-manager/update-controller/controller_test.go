@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -388,8 +389,14 @@ func TestReconcile(t *testing.T) {
 		clusterStatus  kubermaticv1.ClusterVersionsStatus
 		currentStatus  controlPlaneStatus
 		healthy        bool
+		window         *kubermaticv1.MaintenanceWindow
 		expectedStatus kubermaticv1.ClusterVersionsStatus
 		expectedErr    bool
+		expectPending  bool
+		// preflight defaults to a passed check for the spec'ed version
+		preflight         *kubermaticv1.UpgradePreflightStatus
+		preflightSettings *kubermaticv1.UpgradePreflightSettings
+		expectedReason    string
 	}{
 		// ///////////////////////////////////////////////////////
 		// all of the following tests ignore the existence of nodes;
@@ -474,6 +481,188 @@ func TestReconcile(t *testing.T) {
 				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
 			},
 		},
+		{
+			name:        "cluster was told to be updated, but the maintenance window is closed",
+			specVersion: *semver.NewSemverOrDie("1.21.0"),
+			healthy:     true,
+			window: &kubermaticv1.MaintenanceWindow{
+				Start:  time.Now().UTC().Add(2 * time.Hour).Format("15:04"),
+				Length: "1h",
+			},
+			clusterStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			currentStatus: controlPlaneStatus{
+				apiserver:         semver.NewSemverOrDie("1.20.1"),
+				controllerManager: semver.NewSemverOrDie("1.20.1"),
+				scheduler:         semver.NewSemverOrDie("1.20.1"),
+			},
+			expectedStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			expectPending: true,
+		},
+		{
+			name:        "cluster was told to be updated and the maintenance window is open",
+			specVersion: *semver.NewSemverOrDie("1.21.0"),
+			healthy:     true,
+			window: &kubermaticv1.MaintenanceWindow{
+				Start:  time.Now().UTC().Add(-30 * time.Minute).Format("15:04"),
+				Length: "2h",
+			},
+			clusterStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			currentStatus: controlPlaneStatus{
+				apiserver:         semver.NewSemverOrDie("1.20.1"),
+				controllerManager: semver.NewSemverOrDie("1.20.1"),
+				scheduler:         semver.NewSemverOrDie("1.20.1"),
+			},
+			expectedStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.21.0"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+		},
+		{
+			name:        "update to the next minor waits for the upgrade preflight check",
+			specVersion: *semver.NewSemverOrDie("1.21.0"),
+			healthy:     true,
+			clusterStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			currentStatus: controlPlaneStatus{
+				apiserver:         semver.NewSemverOrDie("1.20.1"),
+				controllerManager: semver.NewSemverOrDie("1.20.1"),
+				scheduler:         semver.NewSemverOrDie("1.20.1"),
+			},
+			expectedStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			preflight: &kubermaticv1.UpgradePreflightStatus{
+				TargetVersion: "1.20.1",
+			},
+			expectedReason: ClusterConditionWaitingForUpgradePreflight,
+		},
+		{
+			name:        "update to the next minor is blocked by removed APIs that are still in use",
+			specVersion: *semver.NewSemverOrDie("1.21.0"),
+			healthy:     true,
+			clusterStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			currentStatus: controlPlaneStatus{
+				apiserver:         semver.NewSemverOrDie("1.20.1"),
+				controllerManager: semver.NewSemverOrDie("1.20.1"),
+				scheduler:         semver.NewSemverOrDie("1.20.1"),
+			},
+			expectedStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			preflight: &kubermaticv1.UpgradePreflightStatus{
+				TargetVersion: "1.21.0",
+				RemovedAPIs: []kubermaticv1.RemovedAPIUsage{{
+					GroupVersion: "autoscaling/v2beta1",
+					Resource:     "horizontalpodautoscalers",
+					RemovedIn:    "1.21",
+					Source:       kubermaticv1.RemovedAPISourceStoredObjects,
+					Objects:      []string{"default/app"},
+				}},
+			},
+			expectedReason: ClusterConditionBlockedByRemovedAPIs,
+		},
+		{
+			name:        "removed APIs that are still in use only warn if configured",
+			specVersion: *semver.NewSemverOrDie("1.21.0"),
+			healthy:     true,
+			clusterStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			currentStatus: controlPlaneStatus{
+				apiserver:         semver.NewSemverOrDie("1.20.1"),
+				controllerManager: semver.NewSemverOrDie("1.20.1"),
+				scheduler:         semver.NewSemverOrDie("1.20.1"),
+			},
+			expectedStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.21.0"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			preflight: &kubermaticv1.UpgradePreflightStatus{
+				TargetVersion: "1.21.0",
+				RemovedAPIs: []kubermaticv1.RemovedAPIUsage{{
+					GroupVersion: "autoscaling/v2beta1",
+					Resource:     "horizontalpodautoscalers",
+					RemovedIn:    "1.21",
+					Source:       kubermaticv1.RemovedAPISourceStoredObjects,
+					Objects:      []string{"default/app"},
+				}},
+			},
+			preflightSettings: &kubermaticv1.UpgradePreflightSettings{
+				Mode: kubermaticv1.UpgradePreflightModeWarn,
+			},
+		},
+		{
+			name:        "removed APIs that are still in use were acknowledged for the target version",
+			specVersion: *semver.NewSemverOrDie("1.21.0"),
+			healthy:     true,
+			clusterStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			currentStatus: controlPlaneStatus{
+				apiserver:         semver.NewSemverOrDie("1.20.1"),
+				controllerManager: semver.NewSemverOrDie("1.20.1"),
+				scheduler:         semver.NewSemverOrDie("1.20.1"),
+			},
+			expectedStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.21.0"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			preflight: &kubermaticv1.UpgradePreflightStatus{
+				TargetVersion: "1.21.0",
+				RemovedAPIs: []kubermaticv1.RemovedAPIUsage{{
+					GroupVersion: "autoscaling/v2beta1",
+					Resource:     "horizontalpodautoscalers",
+					RemovedIn:    "1.21",
+					Source:       kubermaticv1.RemovedAPISourceStoredObjects,
+					Objects:      []string{"default/app"},
+				}},
+			},
+			preflightSettings: &kubermaticv1.UpgradePreflightSettings{
+				OverrideVersion: "1.21.0",
+			},
+		},
 		{
 			name:        "waiting for the new apiserver to become healthy before updating the controlplanne version, i.e. do nothing yet",
 			specVersion: *semver.NewSemverOrDie("1.21.0"),
@@ -768,12 +957,21 @@ func TestReconcile(t *testing.T) {
 					Cloud: kubermaticv1.CloudSpec{
 						ProviderName: string(kubermaticv1.AWSCloudProvider),
 					},
+					MaintenanceWindow: tt.window,
+					UpgradePreflight:  tt.preflightSettings,
 				},
 				Status: kubermaticv1.ClusterStatus{
-					Versions: tt.clusterStatus,
+					Versions:         tt.clusterStatus,
+					UpgradePreflight: tt.preflight,
 				},
 			}
 
+			if cluster.Status.UpgradePreflight == nil {
+				cluster.Status.UpgradePreflight = &kubermaticv1.UpgradePreflightStatus{
+					TargetVersion: tt.specVersion.String(),
+				}
+			}
+
 			if tt.healthy {
 				cluster.Status.ExtendedHealth = kubermaticv1.ExtendedClusterHealth{
 					Apiserver:                    kubermaticv1.HealthStatusUp,
@@ -802,9 +1000,12 @@ func TestReconcile(t *testing.T) {
 			rec := &Reconciler{
 				Client:       fake.NewClientBuilder().WithObjects(cluster).Build(),
 				configGetter: configGetter,
-				log:          zap.NewNop().Sugar(),
-				versions:     kubermatic.GetFakeVersions(),
-				recorder:     events.NewFakeRecorder(10),
+				seedGetter: func() (*kubermaticv1.Seed, error) {
+					return &kubermaticv1.Seed{}, nil
+				},
+				log:      zap.NewNop().Sugar(),
+				versions: kubermatic.GetFakeVersions(),
+				recorder: events.NewFakeRecorder(10),
 				cpChecker: func(_ context.Context, _ ctrlruntimeclient.Client, _ *zap.SugaredLogger, _ *kubermaticv1.Cluster) (*controlPlaneStatus, error) {
 					return &tt.currentStatus, nil
 				},
@@ -837,6 +1038,17 @@ func TestReconcile(t *testing.T) {
 				if !tt.expectedStatus.Scheduler.Equal(&newCluster.Status.Versions.Scheduler) {
 					t.Errorf("Expected scheduler to be %v, but is %v.", tt.expectedStatus.Scheduler, newCluster.Status.Versions.Scheduler)
 				}
+
+				_, pending := newCluster.Status.PendingUpdates[kubermaticv1.PendingUpdateKey(kubermaticv1.PendingUpdateTypeApiserver, "")]
+				if pending != tt.expectPending {
+					t.Errorf("Expected pending apiserver update to be %v, but is %v.", tt.expectPending, pending)
+				}
+
+				if tt.expectedReason != "" {
+					if reason := newCluster.Status.Conditions[kubermaticv1.ClusterConditionUpdateProgress].Reason; reason != tt.expectedReason {
+						t.Errorf("Expected update progress reason to be %q, but is %q.", tt.expectedReason, reason)
+					}
+				}
 			}
 		})
 	}
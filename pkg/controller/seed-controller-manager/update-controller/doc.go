@@ -21,5 +21,15 @@ initiate the controlled update of first the apiserver, then the rest
 of the control plane and finally nodes. It does so by manipulating
 the ClusterStatus, letting other controller take care of reconciling
 the cluster namespace or updating/watching the nodes in the user cluster.
+
+Updates to a new minor release are held back until the upgrade-preflight-controller
+has confirmed that no APIs removed in the target version are still in use, unless
+the cluster is configured to only warn about them or the findings were acknowledged
+by setting the override version.
+
+If etcd backup destinations are configured for the seed, a one-off EtcdBackupConfig
+is created before each apiserver update and the update waits for the backup to
+complete. The backup is recorded in the cluster status, so that an EtcdRestore can
+refer to it directly.
 */
 package updatecontroller
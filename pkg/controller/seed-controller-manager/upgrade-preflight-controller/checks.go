@@ -0,0 +1,319 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgradepreflightcontroller
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// requestedDeprecatedAPIsMetric is set to 1 by the apiserver for every deprecated
+	// API that was requested since the apiserver has started.
+	requestedDeprecatedAPIsMetric = "apiserver_requested_deprecated_apis"
+
+	helmReleaseSecretType = "helm.sh/release.v1"
+	helmStatusDeployed    = "deployed"
+
+	// maxObjects limits the number of objects listed per finding to keep the cluster status small.
+	maxObjects = 10
+)
+
+var (
+	metricLabelRegex  = regexp.MustCompile(`([a-zA-Z_][a-zA-Z0-9_]*)="((?:[^"\\]|\\.)*)"`)
+	manifestSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+)
+
+// findings collects the usages of removed APIs, grouped by API, resource and source.
+type findings struct {
+	usages map[string]*usage
+}
+
+type usage struct {
+	kubermaticv1.RemovedAPIUsage
+
+	objects sets.Set[string]
+	clients sets.Set[string]
+}
+
+func newFindings() *findings {
+	return &findings{usages: map[string]*usage{}}
+}
+
+func (f *findings) add(gv schema.GroupVersion, resource, removedIn string, source kubermaticv1.RemovedAPISource, object, client string) {
+	key := fmt.Sprintf("%s/%s/%s", gv.String(), resource, source)
+
+	u, ok := f.usages[key]
+	if !ok {
+		u = &usage{
+			RemovedAPIUsage: kubermaticv1.RemovedAPIUsage{
+				GroupVersion: gv.String(),
+				Resource:     resource,
+				RemovedIn:    removedIn,
+				Source:       source,
+			},
+			objects: sets.New[string](),
+			clients: sets.New[string](),
+		}
+		f.usages[key] = u
+	}
+
+	if object != "" {
+		u.objects.Insert(object)
+	}
+
+	if client != "" {
+		u.clients.Insert(client)
+	}
+}
+
+// List returns the findings in a stable order.
+func (f *findings) List() []kubermaticv1.RemovedAPIUsage {
+	keys := sets.List(sets.KeySet(f.usages))
+
+	result := []kubermaticv1.RemovedAPIUsage{}
+	for _, key := range keys {
+		u := f.usages[key]
+
+		item := u.RemovedAPIUsage
+		if u.objects.Len() > 0 {
+			item.Objects = sets.List(u.objects)
+			if len(item.Objects) > maxObjects {
+				item.Objects = item.Objects[:maxObjects]
+			}
+		}
+		if u.clients.Len() > 0 {
+			item.Clients = sets.List(u.clients)
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+// requestedAPI is a single sample of the apiserver_requested_deprecated_apis metric.
+type requestedAPI struct {
+	GroupVersion   schema.GroupVersion
+	Resource       string
+	RemovedRelease string
+}
+
+// parseRequestedDeprecatedAPIs extracts the deprecated APIs that are scheduled for removal
+// from the apiserver metrics in the Prometheus text format.
+func parseRequestedDeprecatedAPIs(metrics []byte) ([]requestedAPI, error) {
+	result := []requestedAPI{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(metrics))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, requestedDeprecatedAPIsMetric+"{") {
+			continue
+		}
+
+		end := strings.LastIndex(line, "}")
+		if end < 0 {
+			continue
+		}
+
+		// the gauge is reset to 0 when the apiserver is no longer requested to serve the API
+		value := strings.Fields(line[end+1:])
+		if len(value) == 0 || value[0] == "0" {
+			continue
+		}
+
+		labels := map[string]string{}
+		for _, match := range metricLabelRegex.FindAllStringSubmatch(line[len(requestedDeprecatedAPIsMetric)+1:end], -1) {
+			labels[match[1]] = match[2]
+		}
+
+		if labels["removed_release"] == "" || labels["resource"] == "" {
+			continue
+		}
+
+		result = append(result, requestedAPI{
+			GroupVersion:   schema.GroupVersion{Group: labels["group"], Version: labels["version"]},
+			Resource:       labels["resource"],
+			RemovedRelease: labels["removed_release"],
+		})
+	}
+
+	return result, scanner.Err()
+}
+
+// checkRequestedAPIs adds all APIs that clients requested and that are removed by the update.
+func checkRequestedAPIs(f *findings, metrics []byte, r versionRange) error {
+	requested, err := parseRequestedDeprecatedAPIs(metrics)
+	if err != nil {
+		return fmt.Errorf("failed to parse apiserver metrics: %w", err)
+	}
+
+	for _, api := range requested {
+		if r.Contains(api.RemovedRelease) {
+			f.add(api.GroupVersion, api.Resource, api.RemovedRelease, kubermaticv1.RemovedAPISourceRequests, "", "")
+		}
+	}
+
+	return nil
+}
+
+// checkStoredObjects adds all objects whose managed fields were written using a removed API.
+// The field managers are reported as the offending clients. APIs that are not served by the
+// user cluster anymore are skipped.
+func checkStoredObjects(ctx context.Context, client ctrlruntimeclient.Client, f *findings, apis []removedAPI) error {
+	for _, api := range apis {
+		gvk := api.GroupVersionKind()
+
+		list := &metav1.PartialObjectMetadataList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+		if err := client.List(ctx, list); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+
+			return fmt.Errorf("failed to list %s: %w", gvk.String(), err)
+		}
+
+		for _, item := range list.Items {
+			for _, entry := range item.ManagedFields {
+				if entry.APIVersion == api.GroupVersion.String() {
+					f.add(api.GroupVersion, api.Resource, api.RemovedIn, kubermaticv1.RemovedAPISourceStoredObjects, objectName(item.Namespace, item.Name), entry.Manager)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkHelmReleases adds all deployed Helm releases whose manifest contains objects of a removed API.
+func checkHelmReleases(ctx context.Context, client ctrlruntimeclient.Client, f *findings, apis []removedAPI) error {
+	secrets := &corev1.SecretList{}
+	if err := client.List(ctx, secrets, ctrlruntimeclient.MatchingLabels{"owner": "helm", "status": helmStatusDeployed}); err != nil {
+		return fmt.Errorf("failed to list Helm release secrets: %w", err)
+	}
+
+	for _, secret := range secrets.Items {
+		if secret.Type != helmReleaseSecretType {
+			continue
+		}
+
+		rel, err := decodeHelmRelease(secret.Data["release"])
+		if err != nil {
+			// a single broken release must not prevent the check of all others
+			continue
+		}
+
+		for _, kind := range manifestKinds(rel.Manifest) {
+			for _, api := range apis {
+				if kind.GroupVersionKind() == api.GroupVersionKind() {
+					f.add(api.GroupVersion, api.Resource, api.RemovedIn, kubermaticv1.RemovedAPISourceHelmReleases, objectName(rel.Namespace, rel.Name), "")
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// helmRelease contains the fields of a Helm release that are relevant for the check.
+type helmRelease struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Manifest  string `json:"manifest"`
+}
+
+// decodeHelmRelease decodes a release like the Helm secrets storage driver stores it,
+// i.e. base64 encoded and (usually) gzipped JSON.
+func decodeHelmRelease(data []byte) (*helmRelease, error) {
+	decoded, err := base64.StdEncoding.DecodeString(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(decoded, []byte{0x1f, 0x8b}) {
+		reader, err := gzip.NewReader(bytes.NewReader(decoded))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+
+		decoded, err = io.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rel := &helmRelease{}
+	if err := json.Unmarshal(decoded, rel); err != nil {
+		return nil, err
+	}
+
+	return rel, nil
+}
+
+// manifestKinds returns the distinct API versions and kinds used in a rendered Helm manifest.
+func manifestKinds(manifest string) []metav1.TypeMeta {
+	result := []metav1.TypeMeta{}
+	for _, doc := range manifestSeparator.Split(manifest, -1) {
+		typeMeta := metav1.TypeMeta{}
+		if err := yaml.Unmarshal([]byte(doc), &typeMeta); err != nil || typeMeta.Kind == "" {
+			continue
+		}
+
+		if !slices.Contains(result, typeMeta) {
+			result = append(result, typeMeta)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].APIVersion+result[i].Kind < result[j].APIVersion+result[j].Kind
+	})
+
+	return result
+}
+
+func objectName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+
+	return namespace + "/" + name
+}
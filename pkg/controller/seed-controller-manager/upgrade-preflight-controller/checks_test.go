@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgradepreflightcontroller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	semverlib "github.com/Masterminds/semver/v3"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const testMetrics = `# HELP apiserver_requested_deprecated_apis [STABLE] Gauge of deprecated APIs that have been requested, broken out by API group, version, resource, subresource, and removed_release.
+# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.32",resource="flowschemas",subresource="",version="v1beta3"} 1
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.29",resource="prioritylevelconfigurations",subresource="",version="v1beta2"} 1
+apiserver_requested_deprecated_apis{group="example.com",removed_release="",resource="widgets",subresource="",version="v1alpha1"} 1
+apiserver_requested_deprecated_apis{group="storage.k8s.io",removed_release="1.33",resource="volumeattachments",subresource="",version="v1beta1"} 0
+# HELP apiserver_request_total [STABLE] Counter of apiserver requests.
+apiserver_request_total{code="200",resource="flowschemas",version="v1beta3"} 42
+`
+
+func TestParseRequestedDeprecatedAPIs(t *testing.T) {
+	requested, err := parseRequestedDeprecatedAPIs([]byte(testMetrics))
+	if err != nil {
+		t.Fatalf("Failed to parse metrics: %v", err)
+	}
+
+	expected := []requestedAPI{
+		{
+			GroupVersion:   schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3"},
+			Resource:       "flowschemas",
+			RemovedRelease: "1.32",
+		},
+		{
+			GroupVersion:   schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta2"},
+			Resource:       "prioritylevelconfigurations",
+			RemovedRelease: "1.29",
+		},
+	}
+
+	if !equality.Semantic.DeepEqual(expected, requested) {
+		t.Fatalf("Expected %+v, but got %+v.", expected, requested)
+	}
+}
+
+func TestCheckRequestedAPIs(t *testing.T) {
+	testcases := []struct {
+		name     string
+		current  string
+		target   string
+		expected []kubermaticv1.RemovedAPIUsage
+	}{
+		{
+			name:     "API removed in the next minor",
+			current:  "1.31.4",
+			target:   "1.32.0",
+			expected: []kubermaticv1.RemovedAPIUsage{flowSchemaRequests},
+		},
+		{
+			name:    "update across multiple minors",
+			current: "1.28.1",
+			target:  "1.32.0",
+			expected: []kubermaticv1.RemovedAPIUsage{
+				{
+					GroupVersion: "flowcontrol.apiserver.k8s.io/v1beta2",
+					Resource:     "prioritylevelconfigurations",
+					RemovedIn:    "1.29",
+					Source:       kubermaticv1.RemovedAPISourceRequests,
+				},
+				flowSchemaRequests,
+			},
+		},
+		{
+			name:     "API is not removed by the update",
+			current:  "1.30.0",
+			target:   "1.31.0",
+			expected: []kubermaticv1.RemovedAPIUsage{},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFindings()
+			r := versionRange{
+				current: semverlib.MustParse(tt.current),
+				target:  semverlib.MustParse(tt.target),
+			}
+
+			if err := checkRequestedAPIs(f, []byte(testMetrics), r); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result := f.List(); !equality.Semantic.DeepEqual(tt.expected, result) {
+				t.Fatalf("Expected %+v, but got %+v.", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestDecodeHelmRelease(t *testing.T) {
+	rel := &helmRelease{
+		Name:      "legacy",
+		Namespace: "apps",
+		Manifest: `---
+# Source: legacy/templates/flowschema.yaml
+apiVersion: flowcontrol.apiserver.k8s.io/v1beta3
+kind: FlowSchema
+metadata:
+  name: legacy
+---
+# Source: legacy/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: legacy
+`,
+	}
+
+	decoded, err := decodeHelmRelease(encodeHelmRelease(t, rel))
+	if err != nil {
+		t.Fatalf("Failed to decode release: %v", err)
+	}
+
+	if decoded.Name != rel.Name || decoded.Namespace != rel.Namespace {
+		t.Fatalf("Expected release %s/%s, but got %s/%s.", rel.Namespace, rel.Name, decoded.Namespace, decoded.Name)
+	}
+
+	kinds := manifestKinds(decoded.Manifest)
+	if len(kinds) != 2 {
+		t.Fatalf("Expected 2 kinds, but got %v.", kinds)
+	}
+
+	if kinds[0].APIVersion != "flowcontrol.apiserver.k8s.io/v1beta3" || kinds[0].Kind != "FlowSchema" {
+		t.Errorf("Expected first kind to be the FlowSchema, but got %v.", kinds[0])
+	}
+}
+
+// encodeHelmRelease encodes a release like the Helm secrets storage driver.
+func encodeHelmRelease(t *testing.T, rel *helmRelease) []byte {
+	data, err := json.Marshal(rel)
+	if err != nil {
+		t.Fatalf("Failed to encode release: %v", err)
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		t.Fatalf("Failed to compress release: %v", err)
+	}
+	w.Close()
+
+	return []byte(base64.StdEncoding.EncodeToString(buf.Bytes()))
+}
+
+var flowSchemaRequests = kubermaticv1.RemovedAPIUsage{
+	GroupVersion: "flowcontrol.apiserver.k8s.io/v1beta3",
+	Resource:     "flowschemas",
+	RemovedIn:    "1.32",
+	Source:       kubermaticv1.RemovedAPISourceRequests,
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgradepreflightcontroller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	semverlib "github.com/Masterminds/semver/v3"
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
+	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	ControllerName = "kkp-upgrade-preflight-controller"
+
+	// recheckInterval is how often the check is repeated while an update is pending,
+	// so that migrated workloads are noticed.
+	recheckInterval = 5 * time.Minute
+)
+
+type UserClusterClientProvider interface {
+	GetClient(ctx context.Context, c *kubermaticv1.Cluster, options ...clusterclient.ConfigOption) (ctrlruntimeclient.Client, error)
+	GetK8sClient(ctx context.Context, c *kubermaticv1.Cluster, options ...clusterclient.ConfigOption) (kubernetes.Interface, error)
+}
+
+type metricsGetter func(context.Context, *kubermaticv1.Cluster) ([]byte, error)
+
+type Reconciler struct {
+	ctrlruntimeclient.Client
+
+	workerName                    string
+	recorder                      events.EventRecorder
+	userClusterConnectionProvider UserClusterClientProvider
+	log                           *zap.SugaredLogger
+	versions                      kubermatic.Versions
+
+	// getMetrics is here to make unit testing easier
+	getMetrics metricsGetter
+}
+
+// Add creates a new upgrade preflight controller.
+func Add(
+	mgr manager.Manager,
+	numWorkers int,
+	workerName string,
+	userClusterConnectionProvider UserClusterClientProvider,
+	log *zap.SugaredLogger,
+	versions kubermatic.Versions,
+) error {
+	reconciler := &Reconciler{
+		Client: mgr.GetClient(),
+
+		workerName:                    workerName,
+		recorder:                      mgr.GetEventRecorder(ControllerName),
+		userClusterConnectionProvider: userClusterConnectionProvider,
+		log:                           log.Named(ControllerName),
+		versions:                      versions,
+	}
+	reconciler.getMetrics = reconciler.getApiserverMetrics
+
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: numWorkers,
+		}).
+		For(&kubermaticv1.Cluster{}).
+		Build(reconciler)
+
+	return err
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("cluster", request.Name)
+	log.Debug("Reconciling")
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := r.Get(ctx, request.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	// Add a wrapping here so we can emit an event on error
+	result, err := controllerutil.ClusterReconcileWrapper(
+		ctx,
+		r,
+		r.workerName,
+		cluster,
+		r.versions,
+		kubermaticv1.ClusterConditionNone,
+		func() (*reconcile.Result, error) {
+			return r.reconcile(ctx, log, cluster)
+		},
+	)
+
+	if result == nil || err != nil {
+		result = &reconcile.Result{}
+	}
+
+	if err != nil {
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+	}
+
+	return *result, err
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	versions, pending := pendingMinorUpdate(cluster)
+	if !pending {
+		return nil, r.clearStatus(ctx, cluster)
+	}
+
+	target := cluster.Spec.Version.String()
+
+	// Do not repeat the check on every change to the cluster object.
+	if status := cluster.Status.UpgradePreflight; status != nil && status.TargetVersion == target {
+		if wait := recheckInterval - time.Since(status.LastCheckTime.Time); wait > 0 {
+			return &reconcile.Result{RequeueAfter: wait}, nil
+		}
+	}
+
+	// The user cluster cannot be inspected without a working apiserver; any change
+	// to the cluster health will trigger a new reconciliation.
+	if cluster.Status.ExtendedHealth.Apiserver != kubermaticv1.HealthStatusUp {
+		log.Debug("Apiserver is not healthy, skipping upgrade preflight check")
+		return nil, nil
+	}
+
+	removed, err := r.check(ctx, cluster, versions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for removed APIs: %w", err)
+	}
+
+	oldStatus := cluster.Status.UpgradePreflight
+	newStatus := &kubermaticv1.UpgradePreflightStatus{
+		TargetVersion: target,
+		LastCheckTime: metav1.Now(),
+		RemovedAPIs:   removed,
+	}
+
+	changed := oldStatus == nil || oldStatus.TargetVersion != target || !equality.Semantic.DeepEqual(oldStatus.RemovedAPIs, removed)
+
+	if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.UpgradePreflight = newStatus
+
+		if len(removed) == 0 {
+			controllerutil.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionUpgradePreflightPassed, corev1.ConditionTrue, kubermaticv1.ReasonClusterNoRemovedAPIsInUse,
+				fmt.Sprintf("No APIs removed in Kubernetes %s are in use.", target))
+		} else {
+			controllerutil.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionUpgradePreflightPassed, corev1.ConditionFalse, kubermaticv1.ReasonClusterRemovedAPIsInUse,
+				removedAPIsMessage(target, removed))
+		}
+	}); err != nil {
+		return nil, fmt.Errorf("failed to update cluster status: %w", err)
+	}
+
+	if changed && len(removed) > 0 {
+		log.Infow("Found APIs that are removed in the target version", "target", target, "findings", len(removed))
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, kubermaticv1.ReasonClusterRemovedAPIsInUse, "Reconciling", removedAPIsMessage(target, removed))
+	}
+
+	return &reconcile.Result{RequeueAfter: recheckInterval}, nil
+}
+
+// pendingMinorUpdate returns the range of minor releases the cluster still has to be
+// updated through, if the spec'ed version is a newer minor release than the apiserver.
+func pendingMinorUpdate(cluster *kubermaticv1.Cluster) (versionRange, bool) {
+	current := cluster.Status.Versions.Apiserver.Semver()
+	target := cluster.Spec.Version.Semver()
+
+	if current == nil || target == nil {
+		return versionRange{}, false
+	}
+
+	currentMinor := semverlib.New(current.Major(), current.Minor(), 0, "", "")
+	targetMinor := semverlib.New(target.Major(), target.Minor(), 0, "", "")
+
+	if !targetMinor.GreaterThan(currentMinor) {
+		return versionRange{}, false
+	}
+
+	return versionRange{current: current, target: target}, true
+}
+
+func (r *Reconciler) check(ctx context.Context, cluster *kubermaticv1.Cluster, versions versionRange) ([]kubermaticv1.RemovedAPIUsage, error) {
+	client, err := r.userClusterConnectionProvider.GetClient(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user cluster client: %w", err)
+	}
+
+	metrics, err := r.getMetrics(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get apiserver metrics: %w", err)
+	}
+
+	f := newFindings()
+	apis := removedAPIsIn(versions)
+
+	if err := checkRequestedAPIs(f, metrics, versions); err != nil {
+		return nil, err
+	}
+
+	if err := checkStoredObjects(ctx, client, f, apis); err != nil {
+		return nil, err
+	}
+
+	if err := checkHelmReleases(ctx, client, f, apis); err != nil {
+		return nil, err
+	}
+
+	return f.List(), nil
+}
+
+func (r *Reconciler) getApiserverMetrics(ctx context.Context, cluster *kubermaticv1.Cluster) ([]byte, error) {
+	client, err := r.userClusterConnectionProvider.GetK8sClient(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user cluster client: %w", err)
+	}
+
+	return client.Discovery().RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+}
+
+// clearStatus removes the results of a previous check once no minor update is pending anymore.
+func (r *Reconciler) clearStatus(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	if _, exists := cluster.Status.Conditions[kubermaticv1.ClusterConditionUpgradePreflightPassed]; !exists && cluster.Status.UpgradePreflight == nil {
+		return nil
+	}
+
+	return controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.UpgradePreflight = nil
+		delete(c.Status.Conditions, kubermaticv1.ClusterConditionUpgradePreflightPassed)
+	})
+}
+
+func removedAPIsMessage(target string, removed []kubermaticv1.RemovedAPIUsage) string {
+	parts := []string{}
+	for _, api := range removed {
+		part := fmt.Sprintf("%s %s (removed in %s, %s", api.GroupVersion, api.Resource, api.RemovedIn, api.Source)
+		if len(api.Objects) > 0 {
+			part += ": " + strings.Join(api.Objects, ", ")
+		}
+		if len(api.Clients) > 0 {
+			part += "; clients: " + strings.Join(api.Clients, ", ")
+		}
+
+		parts = append(parts, part+")")
+	}
+
+	return fmt.Sprintf("APIs removed in Kubernetes %s are still in use: %s.", target, strings.Join(parts, "; "))
+}
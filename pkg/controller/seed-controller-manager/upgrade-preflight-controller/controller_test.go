@@ -0,0 +1,270 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgradepreflightcontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+
+	corev1 "k8s.io/api/core/v1"
+	flowcontrolv1beta3 "k8s.io/api/flowcontrol/v1beta3"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/events"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+type fakeClientProvider struct {
+	client ctrlruntimeclient.Client
+}
+
+func (p *fakeClientProvider) GetClient(_ context.Context, _ *kubermaticv1.Cluster, _ ...clusterclient.ConfigOption) (ctrlruntimeclient.Client, error) {
+	return p.client, nil
+}
+
+func (p *fakeClientProvider) GetK8sClient(_ context.Context, _ *kubermaticv1.Cluster, _ ...clusterclient.ConfigOption) (kubernetes.Interface, error) {
+	return nil, nil
+}
+
+func TestReconcile(t *testing.T) {
+	flowSchema := &flowcontrolv1beta3.FlowSchema{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "legacy",
+		},
+	}
+
+	// the fake client does not keep managed fields, so they are added when listing
+	managedFields := []metav1.ManagedFieldsEntry{
+		{Manager: "kube-apiserver", Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "flowcontrol.apiserver.k8s.io/v1"},
+		{Manager: "legacy-operator", Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "flowcontrol.apiserver.k8s.io/v1beta3"},
+	}
+
+	withManagedFields := interceptor.Funcs{
+		List: func(ctx context.Context, client ctrlruntimeclient.WithWatch, list ctrlruntimeclient.ObjectList, opts ...ctrlruntimeclient.ListOption) error {
+			if err := client.List(ctx, list, opts...); err != nil {
+				return err
+			}
+
+			if metadataList, ok := list.(*metav1.PartialObjectMetadataList); ok && metadataList.GroupVersionKind().Kind == "FlowSchemaList" {
+				for i := range metadataList.Items {
+					metadataList.Items[i].ManagedFields = managedFields
+				}
+			}
+
+			return nil
+		},
+	}
+
+	helmRelease := &helmRelease{
+		Name:      "legacy",
+		Namespace: "apps",
+		Manifest: `apiVersion: flowcontrol.apiserver.k8s.io/v1beta3
+kind: PriorityLevelConfiguration
+metadata:
+  name: legacy
+`,
+	}
+
+	helmSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sh.helm.release.v1.legacy.v1",
+			Namespace: "apps",
+			Labels: map[string]string{
+				"owner":  "helm",
+				"status": "deployed",
+			},
+		},
+		Type: helmReleaseSecretType,
+		Data: map[string][]byte{
+			"release": encodeHelmRelease(t, helmRelease),
+		},
+	}
+
+	testcases := []struct {
+		name              string
+		specVersion       string
+		apiserverVersion  string
+		preflight         *kubermaticv1.UpgradePreflightStatus
+		userClusterObjs   []ctrlruntimeclient.Object
+		expectedStatus    *kubermaticv1.UpgradePreflightStatus
+		expectedCondition corev1.ConditionStatus
+	}{
+		{
+			name:             "no update pending, nothing to check",
+			specVersion:      "1.32.0",
+			apiserverVersion: "1.32.0",
+			userClusterObjs:  []ctrlruntimeclient.Object{flowSchema},
+		},
+		{
+			name:             "patch update does not need a check",
+			specVersion:      "1.31.5",
+			apiserverVersion: "1.31.4",
+			userClusterObjs:  []ctrlruntimeclient.Object{flowSchema},
+		},
+		{
+			name:             "update has finished, remove the previous findings",
+			specVersion:      "1.32.0",
+			apiserverVersion: "1.32.0",
+			preflight: &kubermaticv1.UpgradePreflightStatus{
+				TargetVersion: "1.32.0",
+				RemovedAPIs:   []kubermaticv1.RemovedAPIUsage{flowSchemaRequests},
+			},
+		},
+		{
+			name:              "no removed APIs in use",
+			specVersion:       "1.32.0",
+			apiserverVersion:  "1.31.4",
+			expectedStatus:    &kubermaticv1.UpgradePreflightStatus{TargetVersion: "1.32.0"},
+			expectedCondition: corev1.ConditionTrue,
+		},
+		{
+			name:             "removed APIs are requested, stored and deployed by Helm",
+			specVersion:      "1.32.0",
+			apiserverVersion: "1.31.4",
+			userClusterObjs:  []ctrlruntimeclient.Object{flowSchema, helmSecret},
+			expectedStatus: &kubermaticv1.UpgradePreflightStatus{
+				TargetVersion: "1.32.0",
+				RemovedAPIs: []kubermaticv1.RemovedAPIUsage{
+					{
+						GroupVersion: "flowcontrol.apiserver.k8s.io/v1beta3",
+						Resource:     "flowschemas",
+						RemovedIn:    "1.32",
+						Source:       kubermaticv1.RemovedAPISourceRequests,
+					},
+					{
+						GroupVersion: "flowcontrol.apiserver.k8s.io/v1beta3",
+						Resource:     "flowschemas",
+						RemovedIn:    "1.32",
+						Source:       kubermaticv1.RemovedAPISourceStoredObjects,
+						Objects:      []string{"legacy"},
+						Clients:      []string{"legacy-operator"},
+					},
+					{
+						GroupVersion: "flowcontrol.apiserver.k8s.io/v1beta3",
+						Resource:     "prioritylevelconfigurations",
+						RemovedIn:    "1.32",
+						Source:       kubermaticv1.RemovedAPISourceHelmReleases,
+						Objects:      []string{"apps/legacy"},
+					},
+				},
+			},
+			expectedCondition: corev1.ConditionFalse,
+		},
+		{
+			name:             "recent check is not repeated",
+			specVersion:      "1.32.0",
+			apiserverVersion: "1.31.4",
+			userClusterObjs:  []ctrlruntimeclient.Object{flowSchema},
+			preflight: &kubermaticv1.UpgradePreflightStatus{
+				TargetVersion: "1.32.0",
+				LastCheckTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+			},
+			expectedStatus: &kubermaticv1.UpgradePreflightStatus{TargetVersion: "1.32.0"},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "testcluster",
+				},
+				Spec: kubermaticv1.ClusterSpec{
+					Version: *semver.NewSemverOrDie(tt.specVersion),
+				},
+				Status: kubermaticv1.ClusterStatus{
+					Versions: kubermaticv1.ClusterVersionsStatus{
+						Apiserver: *semver.NewSemverOrDie(tt.apiserverVersion),
+					},
+					ExtendedHealth: kubermaticv1.ExtendedClusterHealth{
+						Apiserver: kubermaticv1.HealthStatusUp,
+					},
+					UpgradePreflight: tt.preflight,
+				},
+			}
+
+			if tt.preflight != nil {
+				cluster.Status.Conditions = map[kubermaticv1.ClusterConditionType]kubermaticv1.ClusterCondition{
+					kubermaticv1.ClusterConditionUpgradePreflightPassed: {Status: corev1.ConditionFalse},
+				}
+			}
+
+			rec := &Reconciler{
+				Client: fake.NewClientBuilder().WithObjects(cluster).Build(),
+				userClusterConnectionProvider: &fakeClientProvider{
+					client: fake.NewClientBuilder().WithObjects(tt.userClusterObjs...).WithInterceptorFuncs(withManagedFields).Build(),
+				},
+				log:      zap.NewNop().Sugar(),
+				versions: kubermatic.GetFakeVersions(),
+				recorder: events.NewFakeRecorder(10),
+				getMetrics: func(_ context.Context, _ *kubermaticv1.Cluster) ([]byte, error) {
+					if len(tt.userClusterObjs) == 0 {
+						return nil, nil
+					}
+					return []byte(testMetrics), nil
+				},
+			}
+
+			if _, err := rec.reconcile(context.Background(), rec.log, cluster); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			newCluster := &kubermaticv1.Cluster{}
+			if err := rec.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(cluster), newCluster); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			status := newCluster.Status.UpgradePreflight
+			if tt.expectedStatus == nil {
+				if status != nil {
+					t.Fatalf("Expected no preflight status, but got %+v.", status)
+				}
+			} else {
+				if status == nil {
+					t.Fatal("Expected preflight status, but got none.")
+				}
+
+				if status.TargetVersion != tt.expectedStatus.TargetVersion {
+					t.Errorf("Expected target version %q, but got %q.", tt.expectedStatus.TargetVersion, status.TargetVersion)
+				}
+
+				if !equality.Semantic.DeepEqual(tt.expectedStatus.RemovedAPIs, status.RemovedAPIs) {
+					t.Errorf("Expected removed APIs %+v, but got %+v.", tt.expectedStatus.RemovedAPIs, status.RemovedAPIs)
+				}
+			}
+
+			condition, exists := newCluster.Status.Conditions[kubermaticv1.ClusterConditionUpgradePreflightPassed]
+			switch {
+			case tt.expectedCondition == "" && tt.preflight == nil && exists:
+				t.Errorf("Expected no %s condition, but got %+v.", kubermaticv1.ClusterConditionUpgradePreflightPassed, condition)
+			case tt.expectedCondition != "" && condition.Status != tt.expectedCondition:
+				t.Errorf("Expected %s condition to be %q, but got %q.", kubermaticv1.ClusterConditionUpgradePreflightPassed, tt.expectedCondition, condition.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package upgradepreflightcontroller contains a controller that checks user clusters for
+APIs which are removed in the Kubernetes version the cluster is being updated to.
+
+Whenever the spec'ed version of a cluster is a newer minor release than its apiserver,
+the controller looks for usages of every API that is removed between the two releases:
+requests reported by the apiserver's `apiserver_requested_deprecated_apis` metric,
+objects last written using a removed API and manifests of deployed Helm releases.
+The findings are stored in the cluster status and summarized in the
+UpgradePreflightPassed condition; the update-controller uses them to hold back
+the control plane update unless the cluster is configured to only warn or the
+findings have been explicitly acknowledged for the target version.
+*/
+package upgradepreflightcontroller
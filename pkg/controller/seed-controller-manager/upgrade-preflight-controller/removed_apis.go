@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgradepreflightcontroller
+
+import (
+	semverlib "github.com/Masterminds/semver/v3"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// removedAPI is an API that is no longer served starting with the given Kubernetes release.
+type removedAPI struct {
+	GroupVersion schema.GroupVersion
+	Resource     string
+	Kind         string
+	// RemovedIn is the Kubernetes minor release, e.g. "1.25".
+	RemovedIn string
+}
+
+func (a removedAPI) GroupVersionKind() schema.GroupVersionKind {
+	return a.GroupVersion.WithKind(a.Kind)
+}
+
+// removedAPIs lists the APIs removed since the oldest Kubernetes release supported by KKP.
+// See https://kubernetes.io/docs/reference/using-api/deprecation-guide/.
+var removedAPIs = []removedAPI{
+	{GroupVersion: schema.GroupVersion{Group: "batch", Version: "v1beta1"}, Resource: "cronjobs", Kind: "CronJob", RemovedIn: "1.25"},
+	{GroupVersion: schema.GroupVersion{Group: "discovery.k8s.io", Version: "v1beta1"}, Resource: "endpointslices", Kind: "EndpointSlice", RemovedIn: "1.25"},
+	{GroupVersion: schema.GroupVersion{Group: "events.k8s.io", Version: "v1beta1"}, Resource: "events", Kind: "Event", RemovedIn: "1.25"},
+	{GroupVersion: schema.GroupVersion{Group: "autoscaling", Version: "v2beta1"}, Resource: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler", RemovedIn: "1.25"},
+	{GroupVersion: schema.GroupVersion{Group: "policy", Version: "v1beta1"}, Resource: "poddisruptionbudgets", Kind: "PodDisruptionBudget", RemovedIn: "1.25"},
+	{GroupVersion: schema.GroupVersion{Group: "policy", Version: "v1beta1"}, Resource: "podsecuritypolicies", Kind: "PodSecurityPolicy", RemovedIn: "1.25"},
+	{GroupVersion: schema.GroupVersion{Group: "node.k8s.io", Version: "v1beta1"}, Resource: "runtimeclasses", Kind: "RuntimeClass", RemovedIn: "1.25"},
+	{GroupVersion: schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta1"}, Resource: "flowschemas", Kind: "FlowSchema", RemovedIn: "1.26"},
+	{GroupVersion: schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta1"}, Resource: "prioritylevelconfigurations", Kind: "PriorityLevelConfiguration", RemovedIn: "1.26"},
+	{GroupVersion: schema.GroupVersion{Group: "autoscaling", Version: "v2beta2"}, Resource: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler", RemovedIn: "1.26"},
+	{GroupVersion: schema.GroupVersion{Group: "storage.k8s.io", Version: "v1beta1"}, Resource: "csistoragecapacities", Kind: "CSIStorageCapacity", RemovedIn: "1.27"},
+	{GroupVersion: schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta2"}, Resource: "flowschemas", Kind: "FlowSchema", RemovedIn: "1.29"},
+	{GroupVersion: schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta2"}, Resource: "prioritylevelconfigurations", Kind: "PriorityLevelConfiguration", RemovedIn: "1.29"},
+	{GroupVersion: schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3"}, Resource: "flowschemas", Kind: "FlowSchema", RemovedIn: "1.32"},
+	{GroupVersion: schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3"}, Resource: "prioritylevelconfigurations", Kind: "PriorityLevelConfiguration", RemovedIn: "1.32"},
+}
+
+// versionRange is the range of Kubernetes minor releases an update passes, excluding
+// the current and including the target release.
+type versionRange struct {
+	current *semverlib.Version
+	target  *semverlib.Version
+}
+
+// Contains returns true if the given release (e.g. "1.25") is part of the update.
+func (r versionRange) Contains(release string) bool {
+	v, err := semverlib.NewVersion(release)
+	if err != nil || v.Major() != r.target.Major() {
+		return false
+	}
+
+	return v.Minor() > r.current.Minor() && v.Minor() <= r.target.Minor()
+}
+
+// removedAPIsIn returns all known APIs that are removed by the update.
+func removedAPIsIn(r versionRange) []removedAPI {
+	result := []removedAPI{}
+	for _, api := range removedAPIs {
+		if r.Contains(api.RemovedIn) {
+			result = append(result, api)
+		}
+	}
+
+	return result
+}
@@ -118,6 +118,27 @@ func Add(ctx context.Context, log *zap.SugaredLogger, seedMgr, userMgr manager.M
 	return err
 }
 
+// ReconcileApplicationInstallation installs, updates or uninstalls a single ApplicationInstallation in a cluster
+// that is not managed by a user cluster controller manager, like an ExternalCluster. ApplicationDefinitions
+// and credentials are read using catalogClient, the application is installed using clusterClient.
+func ReconcileApplicationInstallation(ctx context.Context, log *zap.SugaredLogger, catalogClient, clusterClient ctrlruntimeclient.Client, recorder events.EventRecorder, appInstaller applications.ApplicationInstaller, overwriteRegistry string, appInstallation *appskubermaticv1.ApplicationInstallation) error {
+	r := &reconciler{
+		log:               log,
+		seedClient:        catalogClient,
+		userClient:        clusterClient,
+		userRecorder:      recorder,
+		appInstaller:      appInstaller,
+		overwriteRegistry: overwriteRegistry,
+	}
+
+	if err := r.reconcile(ctx, log, appInstallation); err != nil {
+		r.userRecorder.Eventf(appInstallation, nil, corev1.EventTypeWarning, applicationInstallationReconcileFailedEvent, "Reconciling", err.Error())
+		return err
+	}
+
+	return nil
+}
+
 // Reconcile ApplicationInstallation (i.e. install / update or uninstall application into the user-cluster).
 func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log := r.log.With("applicationinstallation", request)
@@ -224,9 +245,12 @@ func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, appI
 		}
 	}
 
-	// for addons migrated to ee default-application-catalog we need to purge resources before re-installing them via helm
-	if err := handleAddonCleanup(ctx, appInstallation.Name, r.seedClusterNamespace, r.seedClient, r.log); err != nil {
-		return err
+	// for addons migrated to ee default-application-catalog we need to purge resources before re-installing them via helm;
+	// clusters without a namespace on the seed (i.e. ExternalClusters) have no addons
+	if r.seedClusterNamespace != "" {
+		if err := handleAddonCleanup(ctx, appInstallation.Name, r.seedClusterNamespace, r.seedClient, r.log); err != nil {
+			return err
+		}
 	}
 
 	if r.overwriteRegistry != "" {
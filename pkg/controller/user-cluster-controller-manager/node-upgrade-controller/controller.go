@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeupgradecontroller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	userclustercontrollermanager "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager"
+	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
+	"k8c.io/kubermatic/v2/pkg/util/nodeupgrade"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+	nodetypes "k8c.io/machine-controller/sdk/node"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	controllerName = "kkp-node-upgrade-controller"
+)
+
+// reconciler applies the skip-drain selectors of the node upgrade policy to the nodes
+// and reports the progress of node upgrades in the cluster status.
+type reconciler struct {
+	log               *zap.SugaredLogger
+	seedClient        ctrlruntimeclient.Client
+	userClusterClient ctrlruntimeclient.Client
+	clusterName       string
+	clusterIsPaused   userclustercontrollermanager.IsPausedChecker
+	now               func() time.Time
+}
+
+func Add(ctx context.Context, log *zap.SugaredLogger, seedMgr, userMgr manager.Manager, clusterName string, clusterIsPaused userclustercontrollermanager.IsPausedChecker) error {
+	r := &reconciler{
+		log:               log.Named(controllerName),
+		seedClient:        seedMgr.GetClient(),
+		userClusterClient: userMgr.GetClient(),
+		clusterName:       clusterName,
+		clusterIsPaused:   clusterIsPaused,
+		now:               time.Now,
+	}
+
+	var clusterObj ctrlruntimeclient.Object = &kubermaticv1.Cluster{}
+
+	_, err := builder.ControllerManagedBy(userMgr).
+		Named(controllerName).
+		Watches(&corev1.Node{}, controllerutil.EnqueueConst("")).
+		Watches(&clusterv1alpha1.MachineDeployment{}, controllerutil.EnqueueConst("")).
+		WatchesRawSource(source.Kind(
+			seedMgr.GetCache(),
+			clusterObj,
+			controllerutil.EnqueueConst(""),
+			predicate.TypedGenerationChangedPredicate[ctrlruntimeclient.Object]{},
+		)).
+		Build(r)
+
+	return err
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	r.log.Debug("Reconciling")
+
+	paused, err := r.clusterIsPaused(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to check cluster pause status: %w", err)
+	}
+	if paused {
+		return reconcile.Result{}, nil
+	}
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := r.seedClient.Get(ctx, types.NamespacedName{Name: r.clusterName}, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, fmt.Errorf("failed to get cluster %q: %w", r.clusterName, err)
+	}
+
+	// the cluster deletion takes care of the nodes itself
+	if cluster.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.reconcileNodes(ctx, cluster); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, r.reconcileStatus(ctx, cluster)
+}
+
+func (r *reconciler) reconcileNodes(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	nodes := &corev1.NodeList{}
+	if err := r.userClusterClient.List(ctx, nodes); err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	for _, node := range nodes.Items {
+		skip, err := nodeupgrade.SkipDrain(cluster.Spec.NodeUpgradePolicy, &node)
+		if err != nil {
+			return err
+		}
+
+		_, byPolicy := node.Annotations[nodeupgrade.SkipEvictionByPolicyAnnotation]
+
+		oldNode := node.DeepCopy()
+
+		switch {
+		case skip && node.Annotations[nodetypes.SkipEvictionAnnotationKey] != "true":
+			if node.Annotations == nil {
+				node.Annotations = map[string]string{}
+			}
+
+			node.Annotations[nodetypes.SkipEvictionAnnotationKey] = "true"
+			node.Annotations[nodeupgrade.SkipEvictionByPolicyAnnotation] = ""
+
+		// only remove the annotation if it was set because of the policy
+		case !skip && byPolicy:
+			delete(node.Annotations, nodetypes.SkipEvictionAnnotationKey)
+			delete(node.Annotations, nodeupgrade.SkipEvictionByPolicyAnnotation)
+
+		default:
+			continue
+		}
+
+		r.log.Debugw("Updating skip-eviction annotation", "node", node.Name, "skip", skip)
+
+		if err := r.userClusterClient.Patch(ctx, &node, ctrlruntimeclient.MergeFrom(oldNode)); err != nil {
+			return fmt.Errorf("failed to update node %s: %w", node.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *reconciler) reconcileStatus(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	// Kubermatic only creates MachineDeployments in the kube-system namespace, everything else is essentially unsupported
+	if err := r.userClusterClient.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return fmt.Errorf("failed to list MachineDeployments: %w", err)
+	}
+
+	progress := nodeupgrade.Progress(machineDeployments.Items)
+
+	var status *kubermaticv1.NodeUpgradeStatus
+	if len(progress) > 0 {
+		status = &kubermaticv1.NodeUpgradeStatus{
+			MachineDeployments: progress,
+		}
+	}
+
+	current := cluster.Status.NodeUpgrade
+	if current == nil && status == nil {
+		return nil
+	}
+	if current != nil && status != nil && equality.Semantic.DeepEqual(current.MachineDeployments, status.MachineDeployments) {
+		return nil
+	}
+
+	if status != nil {
+		status.LastUpdateTime = metav1.NewTime(r.now())
+	}
+
+	return controllerutil.UpdateClusterStatus(ctx, r.seedClient, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.NodeUpgrade = status
+	})
+}
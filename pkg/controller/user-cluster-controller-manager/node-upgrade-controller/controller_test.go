@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeupgradecontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/kubermatic/v2/pkg/util/nodeupgrade"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+	nodetypes "k8c.io/machine-controller/sdk/node"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const clusterName = "testcluster"
+
+func init() {
+	utilruntime.Must(clusterv1alpha1.AddToScheme(scheme.Scheme))
+}
+
+func genNode(name string, labels, annotations map[string]string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+		Spec: kubermaticv1.ClusterSpec{
+			NodeUpgradePolicy: &kubermaticv1.NodeUpgradePolicy{
+				SkipDrainNodeSelectors: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"workload": "stateless"}},
+				},
+			},
+		},
+	}
+
+	byPolicy := map[string]string{
+		nodetypes.SkipEvictionAnnotationKey:        "true",
+		nodeupgrade.SkipEvictionByPolicyAnnotation: "",
+	}
+
+	machineDeployment := &clusterv1alpha1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "workers",
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: clusterv1alpha1.MachineDeploymentSpec{
+			Replicas: ptr.To[int32](3),
+		},
+		Status: clusterv1alpha1.MachineDeploymentStatus{
+			Replicas:          4,
+			UpdatedReplicas:   1,
+			AvailableReplicas: 3,
+		},
+	}
+	machineDeployment.Spec.Template.Spec.Versions.Kubelet = "1.32.1"
+
+	seedClient := fake.NewClientBuilder().WithObjects(cluster).Build()
+	userClusterClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(
+			genNode("stateless", map[string]string{"workload": "stateless"}, nil),
+			genNode("relabeled", map[string]string{"workload": "database"}, byPolicy),
+			// annotated by the cluster deletion or an admin, must be kept
+			genNode("manual", nil, map[string]string{nodetypes.SkipEvictionAnnotationKey: "true"}),
+			machineDeployment,
+		).
+		Build()
+
+	r := &reconciler{
+		log:               zap.NewNop().Sugar(),
+		seedClient:        seedClient,
+		userClusterClient: userClusterClient,
+		clusterName:       clusterName,
+		clusterIsPaused: func(_ context.Context) (bool, error) {
+			return false, nil
+		},
+		now: time.Now,
+	}
+
+	if _, err := r.Reconcile(ctx, reconcile.Request{}); err != nil {
+		t.Fatalf("Reconciling failed: %v", err)
+	}
+
+	expected := map[string]bool{
+		"stateless": true,
+		"relabeled": false,
+		"manual":    true,
+	}
+
+	for name, skip := range expected {
+		node := &corev1.Node{}
+		if err := userClusterClient.Get(ctx, types.NamespacedName{Name: name}, node); err != nil {
+			t.Fatalf("Failed to get node %s: %v", name, err)
+		}
+
+		if annotated := node.Annotations[nodetypes.SkipEvictionAnnotationKey] == "true"; annotated != skip {
+			t.Errorf("Expected skip-eviction annotation on node %s to be %v, got %v.", name, skip, annotated)
+		}
+	}
+
+	updated := &kubermaticv1.Cluster{}
+	if err := seedClient.Get(ctx, types.NamespacedName{Name: clusterName}, updated); err != nil {
+		t.Fatalf("Failed to get cluster: %v", err)
+	}
+
+	status := updated.Status.NodeUpgrade
+	if status == nil || len(status.MachineDeployments) != 1 {
+		t.Fatalf("Expected the progress of one MachineDeployment, got %+v.", status)
+	}
+
+	if progress := status.MachineDeployments[0]; progress.Name != "workers" || progress.KubeletVersion != "1.32.1" || progress.UpdatedReplicas != 1 {
+		t.Errorf("Unexpected progress %+v.", progress)
+	}
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package nodeupgradecontroller contains a controller that applies the node upgrade
+policy of the cluster inside the user cluster and reports the progress of node
+upgrades in the Cluster's status.
+
+Nodes that match one of the policy's skip-drain selectors are annotated, so that the
+machine-controller removes them without evicting their pods. The rollout progress of
+all MachineDeployments whose machines are being replaced is written into the
+status.nodeUpgrade field.
+*/
+package nodeupgradecontroller
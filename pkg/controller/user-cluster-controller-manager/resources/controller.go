@@ -202,6 +202,8 @@ func Add(
 	seedTypesToWatch := []ctrlruntimeclient.Object{
 		&corev1.Secret{},
 		&corev1.ConfigMap{},
+		// the object quota webhook is only installed if a quota is set
+		&kubermaticv1.ResourceQuota{},
 	}
 	for _, t := range seedTypesToWatch {
 		bldr.WatchesRawSource(source.Kind(
@@ -50,6 +50,7 @@ import (
 	mlaloggingagent "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/mla/logging-agent"
 	mlamonitoringagent "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/mla/monitoring-agent"
 	nodelocaldns "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/node-local-dns"
+	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/objectquota"
 	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/openvpn"
 	operatingsystemmanager "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/operating-system-manager"
 	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/prometheus"
@@ -120,6 +121,11 @@ func (r *reconciler) reconcile(ctx context.Context) error {
 		return fmt.Errorf("failed to setup cluster networking data: %w", err)
 	}
 
+	data.objectQuotaEnabled, err = objectQuotaEnabled(ctx, r.seedClient, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to check object quotas: %w", err)
+	}
+
 	if !r.isKonnectivityEnabled {
 		data.openVPNCACert, err = r.openVPNCA(ctx)
 		if err != nil {
@@ -221,6 +227,12 @@ func (r *reconciler) reconcile(ctx context.Context) error {
 		return err
 	}
 
+	if !data.objectQuotaEnabled {
+		if err := r.ensureObjectQuotaWebhookIsRemoved(ctx); err != nil {
+			return err
+		}
+	}
+
 	if err := r.reconcileMutatingWebhookConfigurations(ctx, data); err != nil {
 		return err
 	}
@@ -682,6 +694,10 @@ func (r *reconciler) reconcileValidatingWebhookConfigurations(ctx context.Contex
 		creators = append(creators, machine.ValidatingWebhookConfigurationReconciler(data.caCert.Cert, r.namespace))
 	}
 
+	if data.objectQuotaEnabled {
+		creators = append(creators, objectquota.ValidatingWebhookConfigurationReconciler(data.caCert.Cert, r.namespace))
+	}
+
 	if r.opaIntegration {
 		creators = append(creators, gatekeeper.ValidatingWebhookConfigurationReconciler(r.opaWebhookTimeout))
 	}
@@ -1255,6 +1271,9 @@ type reconcileData struct {
 	k8sServiceEndpointPort      int32
 	reconcileK8sSvcEndpoints    bool
 	kubernetesDashboardEnabled  bool
+	// objectQuotaEnabled is true if the project of the cluster has a quota for
+	// LoadBalancers or PersistentVolumeClaims that needs to be enforced by a webhook
+	objectQuotaEnabled bool
 }
 
 func (r *reconciler) ensureOPAIntegrationIsRemoved(ctx context.Context) error {
@@ -1286,6 +1305,16 @@ func (r *reconciler) ensureOPAExperimentalMutationWebhookIsRemoved(ctx context.C
 	return nil
 }
 
+func (r *reconciler) ensureObjectQuotaWebhookIsRemoved(ctx context.Context) error {
+	if err := r.Delete(ctx, &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: objectquota.ValidatingWebhookConfigurationName,
+		}}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to remove object quota webhook: %w", err)
+	}
+	return nil
+}
+
 func (r *reconciler) getCluster(ctx context.Context) (*kubermaticv1.Cluster, error) {
 	cluster, err := kubernetes.ClusterFromNamespace(ctx, r.seedClient, r.namespace)
 	if err != nil {
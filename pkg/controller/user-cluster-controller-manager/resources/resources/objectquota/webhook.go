@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectquota
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	ValidatingWebhookConfigurationName = "kubermatic-object-quota-validation"
+)
+
+// exemptNamespaces are not subject to the object count quotas, so that system
+// workloads and KKP's own components cannot be blocked by them.
+var exemptNamespaces = []string{
+	metav1.NamespaceSystem,
+	metav1.NamespacePublic,
+	corev1.NamespaceNodeLease,
+	resources.KubermaticNamespace,
+	resources.CloudInitSettingsNamespace,
+	resources.GatekeeperNamespace,
+	resources.UserClusterMLANamespace,
+	resources.ClusterBackupNamespaceName,
+}
+
+// ValidatingWebhookConfigurationReconciler returns the ValidatingWebhookConfiguration enforcing the
+// LoadBalancer and PersistentVolumeClaim count quotas of the clusters project.
+func ValidatingWebhookConfigurationReconciler(caCert *x509.Certificate, namespace string) reconciling.NamedValidatingWebhookConfigurationReconcilerFactory {
+	return func() (string, reconciling.ValidatingWebhookConfigurationReconciler) {
+		return ValidatingWebhookConfigurationName, func(hook *admissionregistrationv1.ValidatingWebhookConfiguration) (*admissionregistrationv1.ValidatingWebhookConfiguration, error) {
+			hook.Webhooks = []admissionregistrationv1.ValidatingWebhook{
+				webhook(caCert, namespace, "services.quota.k8c.io", "services", resources.ServiceQuotaValidatingWebhookPath,
+					admissionregistrationv1.Create, admissionregistrationv1.Update),
+				webhook(caCert, namespace, "persistentvolumeclaims.quota.k8c.io", "persistentvolumeclaims", resources.PersistentVolumeClaimQuotaValidatingWebhookPath,
+					admissionregistrationv1.Create),
+			}
+
+			return hook, nil
+		}
+	}
+}
+
+func webhook(caCert *x509.Certificate, namespace, name, resource, path string, operations ...admissionregistrationv1.OperationType) admissionregistrationv1.ValidatingWebhook {
+	matchPolicy := admissionregistrationv1.Exact
+	// quotas are enforced on a best-effort basis, an unavailable webhook must not
+	// block Services and PersistentVolumeClaims in the user cluster
+	failurePolicy := admissionregistrationv1.Ignore
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	scope := admissionregistrationv1.NamespacedScope
+
+	url := fmt.Sprintf("https://%s.%s.svc.cluster.local.:%d%s",
+		resources.UserClusterWebhookServiceName,
+		namespace,
+		resources.UserClusterWebhookUserListenPort,
+		path,
+	)
+
+	return admissionregistrationv1.ValidatingWebhook{
+		Name:                    name, // this should be a FQDN
+		AdmissionReviewVersions: []string{admissionregistrationv1.SchemeGroupVersion.Version, admissionregistrationv1beta1.SchemeGroupVersion.Version},
+		MatchPolicy:             &matchPolicy,
+		FailurePolicy:           &failurePolicy,
+		SideEffects:             &sideEffects,
+		TimeoutSeconds:          ptr.To[int32](3),
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			CABundle: triple.EncodeCertPEM(caCert),
+			URL:      &url,
+		},
+		ObjectSelector: &metav1.LabelSelector{},
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{
+					Key:      corev1.LabelMetadataName,
+					Operator: metav1.LabelSelectorOpNotIn,
+					Values:   exemptNamespaces,
+				},
+			},
+		},
+		Rules: []admissionregistrationv1.RuleWithOperations{{
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{corev1.GroupName},
+				APIVersions: []string{corev1.SchemeGroupVersion.Version},
+				Resources:   []string{resource},
+				Scope:       &scope,
+			},
+			Operations: operations,
+		}},
+	}
+}
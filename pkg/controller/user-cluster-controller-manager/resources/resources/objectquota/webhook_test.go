@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectquota
+
+import (
+	"crypto/x509"
+	"slices"
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidatingWebhookConfiguration(t *testing.T) {
+	_, reconciler := ValidatingWebhookConfigurationReconciler(&x509.Certificate{Raw: []byte("test-ca")}, "cluster-abcd")()
+	configuration, err := reconciler(&admissionregistrationv1.ValidatingWebhookConfiguration{})
+	if err != nil {
+		t.Fatalf("reconcile validating webhook: %v", err)
+	}
+
+	expected := []struct {
+		url        string
+		resource   string
+		operations []admissionregistrationv1.OperationType
+	}{
+		{
+			url:        "https://usercluster-webhook.cluster-abcd.svc.cluster.local.:6443/validate-service-quota",
+			resource:   "services",
+			operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+		},
+		{
+			url:        "https://usercluster-webhook.cluster-abcd.svc.cluster.local.:6443/validate-persistentvolumeclaim-quota",
+			resource:   "persistentvolumeclaims",
+			operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+		},
+	}
+
+	if len(configuration.Webhooks) != len(expected) {
+		t.Fatalf("webhook count = %d, want %d", len(configuration.Webhooks), len(expected))
+	}
+	for i, want := range expected {
+		hook := configuration.Webhooks[i]
+		if hook.ClientConfig.URL == nil || *hook.ClientConfig.URL != want.url {
+			t.Fatalf("URL = %v, want %q", hook.ClientConfig.URL, want.url)
+		}
+		if len(hook.Rules) != 1 || !slices.Equal(hook.Rules[0].Resources, []string{want.resource}) {
+			t.Fatalf("rules = %#v, want %s", hook.Rules, want.resource)
+		}
+		if got := hook.Rules[0].Operations; !slices.Equal(got, want.operations) {
+			t.Fatalf("operations = %v, want %v", got, want.operations)
+		}
+		if hook.FailurePolicy == nil || *hook.FailurePolicy != admissionregistrationv1.Ignore {
+			t.Fatalf("failure policy = %v, want %s", hook.FailurePolicy, admissionregistrationv1.Ignore)
+		}
+		if selector := hook.NamespaceSelector; selector == nil || len(selector.MatchExpressions) != 1 || !slices.Contains(selector.MatchExpressions[0].Values, metav1.NamespaceSystem) {
+			t.Fatalf("namespace selector = %v, want %s to be exempt", selector, metav1.NamespaceSystem)
+		}
+	}
+}
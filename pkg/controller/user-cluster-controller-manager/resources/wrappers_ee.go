@@ -0,0 +1,53 @@
+//go:build ee
+
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// objectQuotaEnabled returns true if the resource quota of the clusters project
+// limits the number of LoadBalancers or PersistentVolumeClaims.
+func objectQuotaEnabled(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+	projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+	if projectID == "" {
+		return false, nil
+	}
+
+	quotaList := &kubermaticv1.ResourceQuotaList{}
+	if err := seedClient.List(ctx, quotaList, ctrlruntimeclient.MatchingLabels{
+		kubermaticv1.ResourceQuotaSubjectNameLabelKey: projectID,
+		kubermaticv1.ResourceQuotaSubjectKindLabelKey: kubermaticv1.ProjectSubjectKind,
+	}); err != nil {
+		return false, fmt.Errorf("failed to list resource quotas: %w", err)
+	}
+
+	for _, quota := range quotaList.Items {
+		if quota.Spec.Quota.LoadBalancers != nil || quota.Spec.Quota.PersistentVolumeClaims != nil {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
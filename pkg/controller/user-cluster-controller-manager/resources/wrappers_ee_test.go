@@ -0,0 +1,107 @@
+//go:build ee
+
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestObjectQuotaEnabled(t *testing.T) {
+	genQuota := func(project string, quota kubermaticv1.ResourceDetails) *kubermaticv1.ResourceQuota {
+		return &kubermaticv1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "project-" + project,
+				Labels: map[string]string{
+					kubermaticv1.ResourceQuotaSubjectNameLabelKey: project,
+					kubermaticv1.ResourceQuotaSubjectKindLabelKey: kubermaticv1.ProjectSubjectKind,
+				},
+			},
+			Spec: kubermaticv1.ResourceQuotaSpec{
+				Quota: quota,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		quotas   []ctrlruntimeclient.Object
+		expected bool
+	}{
+		{
+			name: "no quota",
+		},
+		{
+			name: "quota without object counts",
+			quotas: []ctrlruntimeclient.Object{
+				genQuota("abc", kubermaticv1.ResourceDetails{CPU: ptr.To(resource.MustParse("10"))}),
+			},
+		},
+		{
+			name: "LoadBalancer quota of another project",
+			quotas: []ctrlruntimeclient.Object{
+				genQuota("xyz", kubermaticv1.ResourceDetails{LoadBalancers: ptr.To(resource.MustParse("2"))}),
+			},
+		},
+		{
+			name: "LoadBalancer quota",
+			quotas: []ctrlruntimeclient.Object{
+				genQuota("abc", kubermaticv1.ResourceDetails{LoadBalancers: ptr.To(resource.MustParse("2"))}),
+			},
+			expected: true,
+		},
+		{
+			name: "PersistentVolumeClaim quota",
+			quotas: []ctrlruntimeclient.Object{
+				genQuota("abc", kubermaticv1.ResourceDetails{PersistentVolumeClaims: ptr.To(resource.MustParse("0"))}),
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "test",
+					Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: "abc"},
+				},
+			}
+
+			seedClient := fake.NewClientBuilder().WithObjects(tc.quotas...).Build()
+
+			enabled, err := objectQuotaEnabled(context.Background(), seedClient, cluster)
+			if err != nil {
+				t.Fatalf("Failed to check object quotas: %v", err)
+			}
+
+			if enabled != tc.expected {
+				t.Fatalf("Expected %v, but got %v.", tc.expected, enabled)
+			}
+		})
+	}
+}
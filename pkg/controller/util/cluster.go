@@ -34,6 +34,7 @@ import (
 // ClusterReconcileWrapper is a wrapper that should be used around
 // any cluster reconciliaton. It:
 //   - Checks if the cluster is paused
+//   - Checks if the cluster is hibernated
 //   - Checks if the worker-name matches
 //   - Sets the ReconcileSuccess condition for the controller by fetching
 //     the current Cluster object and patching its status.
@@ -52,6 +53,9 @@ func ClusterReconcileWrapper(
 	if cluster.Spec.Pause {
 		return nil, nil
 	}
+	if cluster.Status.IsHibernated() {
+		return nil, nil
+	}
 
 	reconcilingStatus := corev1.ConditionFalse
 	result, err := reconcile()
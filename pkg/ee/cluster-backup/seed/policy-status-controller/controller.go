@@ -0,0 +1,192 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package policystatuscontroller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"k8c.io/kubermatic/sdk/v2/apis/equality"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	ControllerName = "cluster-backup-policy-status-controller"
+)
+
+type reconciler struct {
+	seedClient ctrlruntimeclient.Client
+	log        *zap.SugaredLogger
+}
+
+func Add(
+	seedMgr manager.Manager,
+	log *zap.SugaredLogger,
+	numWorkers int,
+) error {
+	r := &reconciler{
+		seedClient: seedMgr.GetClient(),
+		log:        log.Named(ControllerName),
+	}
+
+	_, err := builder.ControllerManagedBy(seedMgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: numWorkers,
+		}).
+		For(&kubermaticv1.ClusterBackupPolicy{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(
+			&kubermaticv1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(enqueueAllPolicies(r.seedClient, r.log)),
+			builder.WithPredicates(backupSchedulesChangedPredicate()),
+		).
+		Build(r)
+
+	return err
+}
+
+// backupSchedulesChangedPredicate filters for clusters whose reported backup schedules changed.
+func backupSchedulesChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return len(e.Object.(*kubermaticv1.Cluster).Status.BackupSchedules) > 0
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldCluster := e.ObjectOld.(*kubermaticv1.Cluster)
+			newCluster := e.ObjectNew.(*kubermaticv1.Cluster)
+			return !equality.Semantic.DeepEqual(oldCluster.Status.BackupSchedules, newCluster.Status.BackupSchedules)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return len(e.Object.(*kubermaticv1.Cluster).Status.BackupSchedules) > 0
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// enqueueAllPolicies enqueues all ClusterBackupPolicies, as a cluster can stop matching a policy,
+// after which its status no longer references the policy.
+func enqueueAllPolicies(client ctrlruntimeclient.Client, log *zap.SugaredLogger) handler.MapFunc {
+	return func(ctx context.Context, _ ctrlruntimeclient.Object) []reconcile.Request {
+		policies := &kubermaticv1.ClusterBackupPolicyList{}
+		if err := client.List(ctx, policies, ctrlruntimeclient.InNamespace(resources.KubermaticNamespace)); err != nil {
+			log.Errorw("Failed to list ClusterBackupPolicies", zap.Error(err))
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(policies.Items))
+		for _, policy := range policies.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: policy.Namespace, Name: policy.Name},
+			})
+		}
+
+		return requests
+	}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("policy", request.Name)
+	log.Debug("Reconciling")
+
+	policy := &kubermaticv1.ClusterBackupPolicy{}
+	if err := r.seedClient.Get(ctx, request.NamespacedName, policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed to get ClusterBackupPolicy: %w", err)
+	}
+
+	if policy.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	clusters := &kubermaticv1.ClusterList{}
+	if err := r.seedClient.List(ctx, clusters); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	status := policyStatus(policy.Name, clusters.Items)
+	if equality.Semantic.DeepEqual(policy.Status, status) {
+		return reconcile.Result{}, nil
+	}
+
+	oldPolicy := policy.DeepCopy()
+	policy.Status = status
+	if err := r.seedClient.Status().Patch(ctx, policy, ctrlruntimeclient.MergeFrom(oldPolicy)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to update ClusterBackupPolicy status: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// policyStatus aggregates the backup schedules reported by the clusters for the given policy.
+func policyStatus(policyName string, clusters []kubermaticv1.Cluster) kubermaticv1.ClusterBackupPolicyStatus {
+	status := kubermaticv1.ClusterBackupPolicyStatus{}
+
+	for _, cluster := range clusters {
+		for _, schedule := range cluster.Status.BackupSchedules {
+			if schedule.Policy != policyName {
+				continue
+			}
+
+			status.MatchingClusters++
+			if schedule.Failed() {
+				status.FailedClusters++
+			}
+
+			if last := schedule.LastBackupTime; last != nil && (status.LastBackupTime == nil || status.LastBackupTime.Before(last)) {
+				status.LastBackupTime = last.DeepCopy()
+			}
+
+			status.Clusters = append(status.Clusters, kubermaticv1.ClusterBackupPolicyClusterStatus{
+				Name:                        cluster.Name,
+				ClusterBackupScheduleStatus: *schedule.ClusterBackupScheduleStatus.DeepCopy(),
+			})
+		}
+	}
+
+	sort.Slice(status.Clusters, func(i, j int) bool {
+		return status.Clusters[i].Name < status.Clusters[j].Name
+	})
+
+	return status
+}
@@ -0,0 +1,98 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package policystatuscontroller
+
+import (
+	"testing"
+	"time"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPolicyStatus(t *testing.T) {
+	older := metav1.NewTime(time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC))
+	newer := metav1.NewTime(older.Add(time.Hour))
+
+	completed := kubermaticv1.ClusterBackupScheduleStatus{
+		Phase:           velerov1.SchedulePhaseEnabled,
+		LastBackupTime:  &older,
+		LastBackupName:  "kkp-daily-1",
+		LastBackupPhase: velerov1.BackupPhaseCompleted,
+	}
+	failed := kubermaticv1.ClusterBackupScheduleStatus{
+		Phase:           velerov1.SchedulePhaseEnabled,
+		LastBackupTime:  &newer,
+		LastBackupName:  "kkp-daily-2",
+		LastBackupPhase: velerov1.BackupPhasePartiallyFailed,
+	}
+	invalid := kubermaticv1.ClusterBackupScheduleStatus{
+		Phase:            velerov1.SchedulePhaseFailedValidation,
+		ValidationErrors: []string{"invalid schedule"},
+	}
+
+	clusters := []kubermaticv1.Cluster{
+		genCluster("c", kubermaticv1.ClusterBackupPolicySchedule{Policy: "daily", ClusterBackupScheduleStatus: invalid}),
+		genCluster("a", kubermaticv1.ClusterBackupPolicySchedule{Policy: "daily", ClusterBackupScheduleStatus: completed}),
+		genCluster("b",
+			kubermaticv1.ClusterBackupPolicySchedule{Policy: "weekly", ClusterBackupScheduleStatus: completed},
+			kubermaticv1.ClusterBackupPolicySchedule{Policy: "daily", ClusterBackupScheduleStatus: failed},
+		),
+		genCluster("d"),
+	}
+
+	expected := kubermaticv1.ClusterBackupPolicyStatus{
+		MatchingClusters: 3,
+		FailedClusters:   2,
+		LastBackupTime:   &newer,
+		Clusters: []kubermaticv1.ClusterBackupPolicyClusterStatus{
+			{Name: "a", ClusterBackupScheduleStatus: completed},
+			{Name: "b", ClusterBackupScheduleStatus: failed},
+			{Name: "c", ClusterBackupScheduleStatus: invalid},
+		},
+	}
+
+	status := policyStatus("daily", clusters)
+	if !diff.SemanticallyEqual(expected, status) {
+		t.Fatalf("status differs:\n%v", diff.ObjectDiff(expected, status))
+	}
+
+	if status := policyStatus("unused", clusters); !diff.SemanticallyEqual(kubermaticv1.ClusterBackupPolicyStatus{}, status) {
+		t.Fatalf("expected empty status for unused policy, got %v", status)
+	}
+}
+
+func genCluster(name string, schedules ...kubermaticv1.ClusterBackupPolicySchedule) kubermaticv1.Cluster {
+	return kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: kubermaticv1.ClusterStatus{
+			BackupSchedules: schedules,
+		},
+	}
+}
@@ -0,0 +1,401 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package restorecontroller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	userclustercontrollermanager "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager"
+	"k8c.io/kubermatic/v2/pkg/controller/util/predicate"
+	userclusterresources "k8c.io/kubermatic/v2/pkg/ee/cluster-backup/user-cluster/velero-controller/resources"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	kkpreconciling "k8c.io/kubermatic/v2/pkg/resources/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlruntimepredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	ControllerName   = "cluster-backup-restore-controller"
+	cleanupFinalizer = kubermaticv1.ClusterRestoreCleanupFinalizer
+
+	// restoreNamePrefix is prepended to the name of the ClusterRestore to form the
+	// name of the Velero Restore in the user cluster.
+	restoreNamePrefix = "kkp-"
+
+	// backupSyncTimeout is the time Velero is given to sync the requested backup from
+	// the object storage before the ClusterRestore is marked as failed.
+	backupSyncTimeout = 15 * time.Minute
+
+	// progressRefreshInterval is the interval in which pending and running restores are
+	// checked. The Velero resources are not watched, as their CRDs are only installed
+	// in the user cluster while cluster backups are enabled.
+	progressRefreshInterval = 30 * time.Second
+)
+
+type reconciler struct {
+	seedClient ctrlruntimeclient.Client
+	userClient ctrlruntimeclient.Client
+
+	recorder        events.EventRecorder
+	log             *zap.SugaredLogger
+	namespace       string
+	clusterName     string
+	clusterIsPaused userclustercontrollermanager.IsPausedChecker
+}
+
+func Add(
+	seedMgr, userMgr manager.Manager,
+	log *zap.SugaredLogger,
+	namespace, clusterName string,
+	clusterIsPaused userclustercontrollermanager.IsPausedChecker,
+) error {
+	r := &reconciler{
+		seedClient:      seedMgr.GetClient(),
+		userClient:      userMgr.GetClient(),
+		recorder:        seedMgr.GetEventRecorder(ControllerName),
+		log:             log.Named(ControllerName),
+		namespace:       namespace,
+		clusterName:     clusterName,
+		clusterIsPaused: clusterIsPaused,
+	}
+
+	_, err := builder.ControllerManagedBy(userMgr).
+		Named(ControllerName).
+		WatchesRawSource(source.Kind(
+			seedMgr.GetCache(),
+			&kubermaticv1.ClusterRestore{},
+			&handler.TypedEnqueueRequestForObject[*kubermaticv1.ClusterRestore]{},
+			predicate.TypedFactory(func(o *kubermaticv1.ClusterRestore) bool {
+				return o.Namespace == namespace
+			}),
+		)).
+		WatchesRawSource(source.Kind(
+			seedMgr.GetCache(),
+			&kubermaticv1.Cluster{},
+			handler.TypedEnqueueRequestsFromMapFunc(r.enqueueAllRestores),
+			predicate.TypedByName[*kubermaticv1.Cluster](clusterName),
+			ctrlruntimepredicate.TypedGenerationChangedPredicate[*kubermaticv1.Cluster]{},
+		)).
+		Build(r)
+
+	return err
+}
+
+// enqueueAllRestores requeues all ClusterRestores, so that restores waiting for cluster
+// backups to be enabled continue.
+func (r *reconciler) enqueueAllRestores(ctx context.Context, _ *kubermaticv1.Cluster) []reconcile.Request {
+	restoreList := &kubermaticv1.ClusterRestoreList{}
+	if err := r.seedClient.List(ctx, restoreList, ctrlruntimeclient.InNamespace(r.namespace)); err != nil {
+		r.log.Errorw("Failed to list ClusterRestores", zap.Error(err))
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(restoreList.Items))
+	for _, restore := range restoreList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(&restore)})
+	}
+
+	return requests
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("restore", request.Name)
+	log.Debug("Reconciling")
+
+	paused, err := r.clusterIsPaused(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to check cluster pause status: %w", err)
+	}
+	if paused {
+		return reconcile.Result{}, nil
+	}
+
+	restore := &kubermaticv1.ClusterRestore{}
+	if err := r.seedClient.Get(ctx, request.NamespacedName, restore); err != nil {
+		return reconcile.Result{}, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	if restore.DeletionTimestamp != nil {
+		if err := r.cleanup(ctx, restore); err != nil {
+			r.recorder.Eventf(restore, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{}, nil
+	}
+
+	// Finished restores are never run again; their Velero resources are kept until
+	// the ClusterRestore is deleted, so that the Velero logs remain available.
+	if restore.Status.Phase.Finished() {
+		return reconcile.Result{}, nil
+	}
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := r.seedClient.Get(ctx, types.NamespacedName{Name: r.clusterName}, cluster); err != nil {
+		return reconcile.Result{}, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	oldRestore := restore.DeepCopy()
+
+	result, err := r.reconcile(ctx, cluster, restore)
+	if err != nil {
+		r.recorder.Eventf(restore, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+		return result, err
+	}
+
+	if err := r.updateStatus(ctx, oldRestore, restore); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return result, nil
+}
+
+func (r *reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluster, restore *kubermaticv1.ClusterRestore) (reconcile.Result, error) {
+	if !cluster.Spec.IsClusterBackupEnabled() {
+		setPhase(restore, kubermaticv1.ClusterRestorePending, "cluster backups are not enabled for this cluster")
+		return reconcile.Result{}, nil
+	}
+
+	if err := kuberneteshelper.TryAddFinalizer(ctx, r.seedClient, restore, cleanupFinalizer); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+	}
+
+	cbsl := &kubermaticv1.ClusterBackupStorageLocation{}
+	key := types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: cluster.Spec.BackupConfig.BackupStorageLocation.Name}
+	if err := r.seedClient.Get(ctx, key, cbsl); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to get ClusterBackupStorageLocation %v: %w", key, err)
+	}
+
+	bslFactories := []kkpreconciling.NamedBackupStorageLocationReconcilerFactory{
+		userclusterresources.RestoreBSLReconciler(cluster, cbsl, restore.Spec.SourceCluster),
+	}
+	if err := kkpreconciling.ReconcileBackupStorageLocations(ctx, bslFactories, resources.ClusterBackupNamespaceName, r.userClient); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to reconcile BackupStorageLocation: %w", err)
+	}
+
+	veleroRestore := &velerov1.Restore{}
+	key = types.NamespacedName{Namespace: resources.ClusterBackupNamespaceName, Name: restoreName(restore)}
+	if err := r.userClient.Get(ctx, key, veleroRestore); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("failed to get Velero Restore %s: %w", key.Name, err)
+		}
+
+		return r.createRestore(ctx, restore)
+	}
+
+	applyRestoreStatus(restore, veleroRestore)
+
+	switch restore.Status.Phase {
+	case kubermaticv1.ClusterRestoreCompleted:
+		r.recorder.Eventf(restore, nil, corev1.EventTypeNormal, "RestoreCompleted", "Reconciling", "Backup %s of cluster %s has been restored", restore.Spec.BackupName, restore.Spec.SourceCluster)
+	case kubermaticv1.ClusterRestorePartiallyFailed, kubermaticv1.ClusterRestoreFailed:
+		r.recorder.Eventf(restore, nil, corev1.EventTypeWarning, "RestoreFailed", "Reconciling", "Restore of backup %s of cluster %s %s: %s", restore.Spec.BackupName, restore.Spec.SourceCluster, strings.ToLower(string(restore.Status.Phase)), restore.Status.Message)
+	default:
+		return reconcile.Result{RequeueAfter: progressRefreshInterval}, nil
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// createRestore creates the Velero Restore once the backup of the source cluster has been
+// synced from the object storage into the user cluster.
+func (r *reconciler) createRestore(ctx context.Context, restore *kubermaticv1.ClusterRestore) (reconcile.Result, error) {
+	bslName := userclusterresources.RestoreBSLName(restore.Spec.SourceCluster)
+
+	backup := &velerov1.Backup{}
+	key := types.NamespacedName{Namespace: resources.ClusterBackupNamespaceName, Name: restore.Spec.BackupName}
+	if err := r.userClient.Get(ctx, key, backup); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to get Velero Backup %s: %w", key.Name, err)
+	}
+
+	// A backup with the same name from a different storage location (e.g. a backup of
+	// this cluster) is never restored by accident.
+	if backup.Name == "" || backup.Spec.StorageLocation != bslName {
+		if time.Since(restore.CreationTimestamp.Time) > backupSyncTimeout {
+			setPhase(restore, kubermaticv1.ClusterRestoreFailed, fmt.Sprintf("backup %s of cluster %s was not found in the backup storage location", restore.Spec.BackupName, restore.Spec.SourceCluster))
+			return reconcile.Result{}, nil
+		}
+
+		setPhase(restore, kubermaticv1.ClusterRestorePending, fmt.Sprintf("waiting for backup %s of cluster %s to be synced", restore.Spec.BackupName, restore.Spec.SourceCluster))
+		return reconcile.Result{RequeueAfter: progressRefreshInterval}, nil
+	}
+
+	if phase := backup.Status.Phase; phase != velerov1.BackupPhaseCompleted && phase != velerov1.BackupPhasePartiallyFailed {
+		setPhase(restore, kubermaticv1.ClusterRestoreFailed, fmt.Sprintf("backup %s is in phase %q and cannot be restored", backup.Name, phase))
+		return reconcile.Result{}, nil
+	}
+
+	veleroRestore := &velerov1.Restore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      restoreName(restore),
+			Namespace: resources.ClusterBackupNamespaceName,
+			Labels: map[string]string{
+				appskubermaticv1.ApplicationManagedByLabel: ControllerName,
+			},
+		},
+		Spec: velerov1.RestoreSpec{
+			BackupName:         backup.Name,
+			IncludedNamespaces: restore.Spec.IncludedNamespaces,
+			ExcludedNamespaces: restore.Spec.ExcludedNamespaces,
+			NamespaceMapping:   restore.Spec.NamespaceMapping,
+		},
+	}
+
+	if err := r.userClient.Create(ctx, veleroRestore); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to create Velero Restore %s: %w", veleroRestore.Name, err)
+	}
+
+	restore.Status.RestoreName = veleroRestore.Name
+	setPhase(restore, kubermaticv1.ClusterRestoreInProgress, "")
+
+	return reconcile.Result{RequeueAfter: progressRefreshInterval}, nil
+}
+
+// cleanup removes the Velero Restore and, if no other ClusterRestore of the same source
+// cluster is left, the read-only BackupStorageLocation from the user cluster.
+func (r *reconciler) cleanup(ctx context.Context, restore *kubermaticv1.ClusterRestore) error {
+	if !kuberneteshelper.HasFinalizer(restore, cleanupFinalizer) {
+		return nil
+	}
+
+	veleroRestore := &velerov1.Restore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      restoreName(restore),
+			Namespace: resources.ClusterBackupNamespaceName,
+		},
+	}
+	if err := r.deleteVeleroObject(ctx, veleroRestore); err != nil {
+		return err
+	}
+
+	restoreList := &kubermaticv1.ClusterRestoreList{}
+	if err := r.seedClient.List(ctx, restoreList, ctrlruntimeclient.InNamespace(restore.Namespace)); err != nil {
+		return fmt.Errorf("failed to list ClusterRestores: %w", err)
+	}
+
+	bslInUse := false
+	for _, other := range restoreList.Items {
+		if other.Name != restore.Name && other.DeletionTimestamp == nil && other.Spec.SourceCluster == restore.Spec.SourceCluster {
+			bslInUse = true
+			break
+		}
+	}
+
+	if !bslInUse {
+		bsl := &velerov1.BackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      userclusterresources.RestoreBSLName(restore.Spec.SourceCluster),
+				Namespace: resources.ClusterBackupNamespaceName,
+			},
+		}
+		if err := r.deleteVeleroObject(ctx, bsl); err != nil {
+			return err
+		}
+	}
+
+	return kuberneteshelper.TryRemoveFinalizer(ctx, r.seedClient, restore, cleanupFinalizer)
+}
+
+func (r *reconciler) deleteVeleroObject(ctx context.Context, obj ctrlruntimeclient.Object) error {
+	err := r.userClient.Delete(ctx, obj)
+	// The Velero CRDs are removed from the user cluster when cluster backups are disabled.
+	if err == nil || apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return nil
+	}
+
+	return fmt.Errorf("failed to delete %T %s: %w", obj, obj.GetName(), err)
+}
+
+func (r *reconciler) updateStatus(ctx context.Context, oldRestore, restore *kubermaticv1.ClusterRestore) error {
+	if reflect.DeepEqual(oldRestore.Status, restore.Status) {
+		return nil
+	}
+
+	if err := r.seedClient.Status().Patch(ctx, restore, ctrlruntimeclient.MergeFrom(oldRestore)); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to update ClusterRestore status: %w", err)
+	}
+
+	return nil
+}
+
+func restoreName(restore *kubermaticv1.ClusterRestore) string {
+	return restoreNamePrefix + restore.Name
+}
+
+func setPhase(restore *kubermaticv1.ClusterRestore, phase kubermaticv1.ClusterRestorePhase, message string) {
+	restore.Status.Phase = phase
+	restore.Status.Message = message
+}
+
+// applyRestoreStatus copies the state of the Velero Restore into the ClusterRestore status.
+func applyRestoreStatus(restore *kubermaticv1.ClusterRestore, veleroRestore *velerov1.Restore) {
+	status := veleroRestore.Status
+
+	restore.Status.RestoreName = veleroRestore.Name
+	restore.Status.StartTime = status.StartTimestamp
+	restore.Status.CompletionTime = status.CompletionTimestamp
+	restore.Status.Progress = status.Progress
+	restore.Status.Warnings = status.Warnings
+	restore.Status.Errors = status.Errors
+
+	switch status.Phase {
+	case velerov1.RestorePhaseCompleted:
+		setPhase(restore, kubermaticv1.ClusterRestoreCompleted, "")
+	case velerov1.RestorePhasePartiallyFailed:
+		setPhase(restore, kubermaticv1.ClusterRestorePartiallyFailed, fmt.Sprintf("%d errors occurred while restoring the backup", status.Errors))
+	case velerov1.RestorePhaseFailed:
+		setPhase(restore, kubermaticv1.ClusterRestoreFailed, status.FailureReason)
+	case velerov1.RestorePhaseFailedValidation:
+		setPhase(restore, kubermaticv1.ClusterRestoreFailed, fmt.Sprintf("validation failed: %s", strings.Join(status.ValidationErrors, ", ")))
+	default:
+		setPhase(restore, kubermaticv1.ClusterRestoreInProgress, "")
+	}
+}
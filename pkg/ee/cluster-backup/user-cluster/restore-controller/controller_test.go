@@ -0,0 +1,418 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package restorecontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	userclusterresources "k8c.io/kubermatic/v2/pkg/ee/cluster-backup/user-cluster/velero-controller/resources"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	clusterName      = "target"
+	clusterNamespace = "cluster-target"
+	sourceCluster    = "source"
+	projectID        = "testproject"
+	backupName       = "nightly"
+)
+
+func TestReconcile(t *testing.T) {
+	sourceBSL := userclusterresources.RestoreBSLName(sourceCluster)
+
+	testCases := []struct {
+		name            string
+		backupEnabled   bool
+		age             time.Duration
+		userObjects     []ctrlruntimeclient.Object
+		expectedPhase   kubermaticv1.ClusterRestorePhase
+		expectedMessage string
+		expectedErrors  int
+		expectRestore   bool
+	}{
+		{
+			name:            "waits for cluster backups to be enabled",
+			expectedPhase:   kubermaticv1.ClusterRestorePending,
+			expectedMessage: "cluster backups are not enabled for this cluster",
+		},
+		{
+			name:            "waits for the backup to be synced",
+			backupEnabled:   true,
+			expectedPhase:   kubermaticv1.ClusterRestorePending,
+			expectedMessage: "waiting for backup nightly of cluster source to be synced",
+		},
+		{
+			name:          "ignores backups from other storage locations",
+			backupEnabled: true,
+			userObjects: []ctrlruntimeclient.Object{
+				genBackup(userclusterresources.DefaultBSLName, velerov1.BackupPhaseCompleted),
+			},
+			expectedPhase:   kubermaticv1.ClusterRestorePending,
+			expectedMessage: "waiting for backup nightly of cluster source to be synced",
+		},
+		{
+			name:            "fails if the backup is not synced in time",
+			backupEnabled:   true,
+			age:             2 * backupSyncTimeout,
+			expectedPhase:   kubermaticv1.ClusterRestoreFailed,
+			expectedMessage: "backup nightly of cluster source was not found in the backup storage location",
+		},
+		{
+			name:          "fails if the backup is not usable",
+			backupEnabled: true,
+			userObjects: []ctrlruntimeclient.Object{
+				genBackup(sourceBSL, velerov1.BackupPhaseFailed),
+			},
+			expectedPhase:   kubermaticv1.ClusterRestoreFailed,
+			expectedMessage: `backup nightly is in phase "Failed" and cannot be restored`,
+		},
+		{
+			name:          "creates the Velero Restore",
+			backupEnabled: true,
+			userObjects: []ctrlruntimeclient.Object{
+				genBackup(sourceBSL, velerov1.BackupPhaseCompleted),
+			},
+			expectedPhase: kubermaticv1.ClusterRestoreInProgress,
+			expectRestore: true,
+		},
+		{
+			name:          "reports the result of the Velero Restore",
+			backupEnabled: true,
+			userObjects: []ctrlruntimeclient.Object{
+				genBackup(sourceBSL, velerov1.BackupPhaseCompleted),
+				&velerov1.Restore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "kkp-migrate",
+						Namespace: resources.ClusterBackupNamespaceName,
+					},
+					Status: velerov1.RestoreStatus{
+						Phase:  velerov1.RestorePhasePartiallyFailed,
+						Errors: 2,
+					},
+				},
+			},
+			expectedPhase:   kubermaticv1.ClusterRestorePartiallyFailed,
+			expectedMessage: "2 errors occurred while restoring the backup",
+			expectedErrors:  2,
+			expectRestore:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := newScheme(t)
+
+			restore := genClusterRestore("migrate", time.Now().Add(-tc.age))
+			seedClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(genCluster(tc.backupEnabled), genCBSL(), restore).
+				WithStatusSubresource(restore).
+				Build()
+			userClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.userObjects...).
+				Build()
+
+			r := newTestReconciler(seedClient, userClient)
+
+			request := reconcile.Request{NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(restore)}
+			if _, err := r.Reconcile(ctx, request); err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+
+			if err := seedClient.Get(ctx, request.NamespacedName, restore); err != nil {
+				t.Fatalf("failed to get ClusterRestore: %v", err)
+			}
+			if restore.Status.Phase != tc.expectedPhase {
+				t.Errorf("expected phase %q, got %q", tc.expectedPhase, restore.Status.Phase)
+			}
+			if restore.Status.Message != tc.expectedMessage {
+				t.Errorf("expected message %q, got %q", tc.expectedMessage, restore.Status.Message)
+			}
+			if restore.Status.Errors != tc.expectedErrors {
+				t.Errorf("expected %d errors, got %d", tc.expectedErrors, restore.Status.Errors)
+			}
+
+			bsl := &velerov1.BackupStorageLocation{}
+			err := userClient.Get(ctx, types.NamespacedName{Namespace: resources.ClusterBackupNamespaceName, Name: sourceBSL}, bsl)
+			if tc.backupEnabled {
+				if err != nil {
+					t.Fatalf("failed to get BackupStorageLocation: %v", err)
+				}
+				if bsl.Spec.AccessMode != velerov1.BackupStorageLocationAccessModeReadOnly {
+					t.Errorf("expected BackupStorageLocation to be read-only, got %q", bsl.Spec.AccessMode)
+				}
+				if expected := projectID + "/" + sourceCluster; bsl.Spec.ObjectStorage.Prefix != expected {
+					t.Errorf("expected BackupStorageLocation prefix %q, got %q", expected, bsl.Spec.ObjectStorage.Prefix)
+				}
+			} else if !apierrors.IsNotFound(err) {
+				t.Errorf("expected no BackupStorageLocation, got %v", err)
+			}
+
+			veleroRestore := &velerov1.Restore{}
+			err = userClient.Get(ctx, types.NamespacedName{Namespace: resources.ClusterBackupNamespaceName, Name: "kkp-migrate"}, veleroRestore)
+			if !tc.expectRestore {
+				if !apierrors.IsNotFound(err) {
+					t.Errorf("expected no Velero Restore, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to get Velero Restore: %v", err)
+			}
+			if restore.Status.RestoreName != veleroRestore.Name {
+				t.Errorf("expected restore name %q, got %q", veleroRestore.Name, restore.Status.RestoreName)
+			}
+		})
+	}
+}
+
+func TestCreatedRestoreSpec(t *testing.T) {
+	ctx := context.Background()
+	scheme := newScheme(t)
+
+	restore := genClusterRestore("migrate", time.Now())
+	seedClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(genCluster(true), genCBSL(), restore).
+		WithStatusSubresource(restore).
+		Build()
+	userClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(genBackup(userclusterresources.RestoreBSLName(sourceCluster), velerov1.BackupPhaseCompleted)).
+		Build()
+
+	r := newTestReconciler(seedClient, userClient)
+	if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(restore)}); err != nil {
+		t.Fatalf("reconciling failed: %v", err)
+	}
+
+	veleroRestore := &velerov1.Restore{}
+	if err := userClient.Get(ctx, types.NamespacedName{Namespace: resources.ClusterBackupNamespaceName, Name: "kkp-migrate"}, veleroRestore); err != nil {
+		t.Fatalf("failed to get Velero Restore: %v", err)
+	}
+
+	expected := velerov1.RestoreSpec{
+		BackupName:         backupName,
+		IncludedNamespaces: []string{"app"},
+		NamespaceMapping:   map[string]string{"app": "app-migrated"},
+	}
+	if !diff.SemanticallyEqual(expected, veleroRestore.Spec) {
+		t.Errorf("restore spec differs:\n%v", diff.ObjectDiff(expected, veleroRestore.Spec))
+	}
+}
+
+func TestCleanup(t *testing.T) {
+	testCases := []struct {
+		name        string
+		otherSource string
+		expectBSL   bool
+	}{
+		{
+			name:        "removes the BackupStorageLocation of the last restore",
+			otherSource: "another-source",
+		},
+		{
+			name:        "keeps the BackupStorageLocation while other restores use it",
+			otherSource: sourceCluster,
+			expectBSL:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := newScheme(t)
+
+			restore := genClusterRestore("migrate", time.Now())
+			restore.Finalizers = []string{cleanupFinalizer}
+			restore.Status.Phase = kubermaticv1.ClusterRestoreCompleted
+
+			other := genClusterRestore("other", time.Now())
+			other.Spec.SourceCluster = tc.otherSource
+
+			seedClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(genCluster(true), restore, other).
+				Build()
+			userClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					&velerov1.BackupStorageLocation{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      userclusterresources.RestoreBSLName(sourceCluster),
+							Namespace: resources.ClusterBackupNamespaceName,
+						},
+					},
+					&velerov1.Restore{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "kkp-migrate",
+							Namespace: resources.ClusterBackupNamespaceName,
+						},
+					},
+				).
+				Build()
+
+			if err := seedClient.Delete(ctx, restore); err != nil {
+				t.Fatalf("failed to delete ClusterRestore: %v", err)
+			}
+
+			r := newTestReconciler(seedClient, userClient)
+			request := reconcile.Request{NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(restore)}
+			if _, err := r.Reconcile(ctx, request); err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+
+			if err := seedClient.Get(ctx, request.NamespacedName, restore); !apierrors.IsNotFound(err) {
+				t.Errorf("expected ClusterRestore to be gone, got %v", err)
+			}
+
+			key := types.NamespacedName{Namespace: resources.ClusterBackupNamespaceName, Name: "kkp-migrate"}
+			if err := userClient.Get(ctx, key, &velerov1.Restore{}); !apierrors.IsNotFound(err) {
+				t.Errorf("expected Velero Restore to be deleted, got %v", err)
+			}
+
+			key.Name = userclusterresources.RestoreBSLName(sourceCluster)
+			err := userClient.Get(ctx, key, &velerov1.BackupStorageLocation{})
+			if tc.expectBSL && err != nil {
+				t.Errorf("expected BackupStorageLocation to be kept, got %v", err)
+			}
+			if !tc.expectBSL && !apierrors.IsNotFound(err) {
+				t.Errorf("expected BackupStorageLocation to be deleted, got %v", err)
+			}
+		})
+	}
+}
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	scheme := fake.NewScheme()
+	if err := velerov1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add velero to scheme: %v", err)
+	}
+
+	return scheme
+}
+
+func newTestReconciler(seedClient, userClient ctrlruntimeclient.Client) *reconciler {
+	return &reconciler{
+		seedClient:  seedClient,
+		userClient:  userClient,
+		recorder:    &events.FakeRecorder{},
+		log:         zap.NewNop().Sugar(),
+		namespace:   clusterNamespace,
+		clusterName: clusterName,
+		clusterIsPaused: func(ctx context.Context) (bool, error) {
+			return false, nil
+		},
+	}
+}
+
+func genCluster(backupEnabled bool) *kubermaticv1.Cluster {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+			Labels: map[string]string{
+				kubermaticv1.ProjectIDLabelKey: projectID,
+			},
+		},
+	}
+
+	if backupEnabled {
+		cluster.Spec.BackupConfig = &kubermaticv1.BackupConfig{
+			BackupStorageLocation: &corev1.LocalObjectReference{Name: "cbsl"},
+		}
+	}
+
+	return cluster
+}
+
+func genCBSL() *kubermaticv1.ClusterBackupStorageLocation {
+	return &kubermaticv1.ClusterBackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cbsl",
+			Namespace: resources.KubermaticNamespace,
+			Labels: map[string]string{
+				kubermaticv1.ProjectIDLabelKey: projectID,
+			},
+		},
+		Spec: velerov1.BackupStorageLocationSpec{
+			Provider: "aws",
+			StorageType: velerov1.StorageType{
+				ObjectStorage: &velerov1.ObjectStorageLocation{
+					Bucket: "backups",
+				},
+			},
+		},
+	}
+}
+
+func genClusterRestore(name string, created time.Time) *kubermaticv1.ClusterRestore {
+	return &kubermaticv1.ClusterRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         clusterNamespace,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: kubermaticv1.ClusterRestoreSpec{
+			SourceCluster:      sourceCluster,
+			BackupName:         backupName,
+			IncludedNamespaces: []string{"app"},
+			NamespaceMapping:   map[string]string{"app": "app-migrated"},
+		},
+	}
+}
+
+func genBackup(storageLocation string, phase velerov1.BackupPhase) *velerov1.Backup {
+	return &velerov1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      backupName,
+			Namespace: resources.ClusterBackupNamespaceName,
+		},
+		Spec: velerov1.BackupSpec{
+			StorageLocation: storageLocation,
+		},
+		Status: velerov1.BackupStatus{
+			Phase: phase,
+		},
+	}
+}
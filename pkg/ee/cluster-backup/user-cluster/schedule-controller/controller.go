@@ -0,0 +1,370 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package schedulecontroller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/apis/equality"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	userclustercontrollermanager "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager"
+	"k8c.io/kubermatic/v2/pkg/controller/util"
+	"k8c.io/kubermatic/v2/pkg/controller/util/predicate"
+	userclusterresources "k8c.io/kubermatic/v2/pkg/ee/cluster-backup/user-cluster/velero-controller/resources"
+	"k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	kkpreconciling "k8c.io/kubermatic/v2/pkg/resources/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlruntimepredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	ControllerName = "cluster-backup-schedule-controller"
+
+	// scheduleNamePrefix is prepended to the name of the ClusterBackupPolicy to
+	// form the name of the Velero Schedule in the user cluster.
+	scheduleNamePrefix = "kkp-"
+
+	// statusRefreshInterval is the interval in which the status of the Velero Schedules is
+	// refreshed. The Velero resources are not watched, as their CRDs are only installed
+	// in the user cluster while cluster backups are enabled.
+	statusRefreshInterval = 5 * time.Minute
+)
+
+type reconciler struct {
+	seedClient ctrlruntimeclient.Client
+	userClient ctrlruntimeclient.Client
+
+	recorder        events.EventRecorder
+	log             *zap.SugaredLogger
+	clusterName     string
+	clusterIsPaused userclustercontrollermanager.IsPausedChecker
+}
+
+func Add(
+	seedMgr, userMgr manager.Manager,
+	log *zap.SugaredLogger,
+	clusterName string,
+	clusterIsPaused userclustercontrollermanager.IsPausedChecker,
+) error {
+	r := &reconciler{
+		seedClient:      seedMgr.GetClient(),
+		userClient:      userMgr.GetClient(),
+		recorder:        seedMgr.GetEventRecorder(ControllerName),
+		log:             log.Named(ControllerName),
+		clusterName:     clusterName,
+		clusterIsPaused: clusterIsPaused,
+	}
+
+	request := []reconcile.Request{{NamespacedName: types.NamespacedName{Name: clusterName}}}
+
+	_, err := builder.ControllerManagedBy(userMgr).
+		Named(ControllerName).
+		WatchesRawSource(source.Kind(
+			seedMgr.GetCache(),
+			&kubermaticv1.Cluster{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, _ *kubermaticv1.Cluster) []reconcile.Request {
+				return request
+			}),
+			predicate.TypedByName[*kubermaticv1.Cluster](clusterName),
+			ctrlruntimepredicate.Or(
+				ctrlruntimepredicate.TypedGenerationChangedPredicate[*kubermaticv1.Cluster]{},
+				ctrlruntimepredicate.TypedLabelChangedPredicate[*kubermaticv1.Cluster]{},
+			),
+		)).
+		WatchesRawSource(source.Kind(
+			seedMgr.GetCache(),
+			&kubermaticv1.ClusterBackupPolicy{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, _ *kubermaticv1.ClusterBackupPolicy) []reconcile.Request {
+				return request
+			}),
+			ctrlruntimepredicate.Or(
+				ctrlruntimepredicate.TypedGenerationChangedPredicate[*kubermaticv1.ClusterBackupPolicy]{},
+				ctrlruntimepredicate.TypedLabelChangedPredicate[*kubermaticv1.ClusterBackupPolicy]{},
+			),
+		)).
+		Build(r)
+
+	return err
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("cluster", request.Name)
+	log.Debug("Reconciling")
+
+	paused, err := r.clusterIsPaused(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to check cluster pause status: %w", err)
+	}
+	if paused {
+		return reconcile.Result{}, nil
+	}
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := r.seedClient.Get(ctx, request.NamespacedName, cluster); err != nil {
+		return reconcile.Result{}, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	result, err := r.reconcile(ctx, log, cluster)
+	if err != nil {
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+	}
+
+	return result, err
+}
+
+func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (reconcile.Result, error) {
+	policies, err := r.matchingPolicies(ctx, log, cluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if len(policies) > 0 {
+		factories := make([]kkpreconciling.NamedScheduleReconcilerFactory, 0, len(policies))
+		for i := range policies {
+			factories = append(factories, scheduleReconcilerFactory(&policies[i]))
+		}
+
+		if err := kkpreconciling.ReconcileSchedules(ctx, factories, resources.ClusterBackupNamespaceName, r.userClient); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to reconcile Velero Schedules: %w", err)
+		}
+	}
+
+	if err := r.deleteStaleSchedules(ctx, policies); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	schedules := make([]kubermaticv1.ClusterBackupPolicySchedule, 0, len(policies))
+	for _, policy := range policies {
+		status, err := r.scheduleStatus(ctx, policy.Name)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		schedules = append(schedules, status)
+	}
+	if len(schedules) == 0 {
+		schedules = nil
+	}
+
+	if !equality.Semantic.DeepEqual(cluster.Status.BackupSchedules, schedules) {
+		if err := util.UpdateClusterStatus(ctx, r.seedClient, cluster, func(c *kubermaticv1.Cluster) {
+			c.Status.BackupSchedules = schedules
+		}); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to update cluster status: %w", err)
+		}
+	}
+
+	if len(policies) == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: statusRefreshInterval}, nil
+}
+
+// matchingPolicies returns the ClusterBackupPolicies that apply to the cluster, sorted by name.
+func (r *reconciler) matchingPolicies(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) ([]kubermaticv1.ClusterBackupPolicy, error) {
+	if !cluster.Spec.IsClusterBackupEnabled() {
+		return nil, nil
+	}
+
+	policyList := &kubermaticv1.ClusterBackupPolicyList{}
+	if err := r.seedClient.List(ctx, policyList, ctrlruntimeclient.InNamespace(resources.KubermaticNamespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ClusterBackupPolicies: %w", err)
+	}
+
+	var policies []kubermaticv1.ClusterBackupPolicy
+	for _, policy := range policyList.Items {
+		matches, err := policyMatches(cluster, &policy)
+		if err != nil {
+			log.Warnw("Ignoring invalid ClusterBackupPolicy", "policy", policy.Name, zap.Error(err))
+			continue
+		}
+		if matches {
+			policies = append(policies, policy)
+		}
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+
+	return policies, nil
+}
+
+// policyMatches returns true if the policy applies to the cluster. Policies labelled with a
+// project ID only apply to the clusters of that project.
+func policyMatches(cluster *kubermaticv1.Cluster, policy *kubermaticv1.ClusterBackupPolicy) (bool, error) {
+	if policy.DeletionTimestamp != nil {
+		return false, nil
+	}
+
+	if project := policy.Labels[kubermaticv1.ProjectIDLabelKey]; project != "" && project != cluster.Labels[kubermaticv1.ProjectIDLabelKey] {
+		return false, nil
+	}
+
+	if loc := policy.Spec.StorageLocation; loc != nil && loc.Name != cluster.Spec.BackupConfig.BackupStorageLocation.Name {
+		return false, nil
+	}
+
+	if policy.Spec.ClusterSelector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(policy.Spec.ClusterSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid cluster selector: %w", err)
+	}
+
+	return selector.Matches(labels.Set(cluster.Labels)), nil
+}
+
+func scheduleName(policyName string) string {
+	return scheduleNamePrefix + policyName
+}
+
+func scheduleReconcilerFactory(policy *kubermaticv1.ClusterBackupPolicy) kkpreconciling.NamedScheduleReconcilerFactory {
+	return func() (string, kkpreconciling.ScheduleReconciler) {
+		return scheduleName(policy.Name), func(s *velerov1.Schedule) (*velerov1.Schedule, error) {
+			kubernetes.EnsureLabels(s, map[string]string{
+				appskubermaticv1.ApplicationManagedByLabel: ControllerName,
+			})
+
+			s.Spec.Schedule = policy.Spec.Schedule
+			s.Spec.Template = velerov1.BackupSpec{
+				IncludedNamespaces: policy.Spec.IncludedNamespaces,
+				ExcludedNamespaces: policy.Spec.ExcludedNamespaces,
+				StorageLocation:    userclusterresources.DefaultBSLName,
+			}
+			if policy.Spec.TTL != nil {
+				s.Spec.Template.TTL = *policy.Spec.TTL
+			}
+
+			return s, nil
+		}
+	}
+}
+
+// deleteStaleSchedules removes all Velero Schedules created by this controller that do not
+// belong to any of the given policies anymore.
+func (r *reconciler) deleteStaleSchedules(ctx context.Context, policies []kubermaticv1.ClusterBackupPolicy) error {
+	wanted := sets.New[string]()
+	for _, policy := range policies {
+		wanted.Insert(scheduleName(policy.Name))
+	}
+
+	scheduleList := &velerov1.ScheduleList{}
+	if err := r.userClient.List(ctx, scheduleList,
+		ctrlruntimeclient.InNamespace(resources.ClusterBackupNamespaceName),
+		ctrlruntimeclient.MatchingLabels{appskubermaticv1.ApplicationManagedByLabel: ControllerName},
+	); err != nil {
+		// The Velero CRDs are removed from the user cluster when cluster backups are disabled.
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to list Velero Schedules: %w", err)
+	}
+
+	for _, schedule := range scheduleList.Items {
+		if wanted.Has(schedule.Name) {
+			continue
+		}
+
+		if err := r.userClient.Delete(ctx, &schedule); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete Velero Schedule %s: %w", schedule.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// scheduleStatus returns the status of the Velero Schedule of the given policy and its last Backup.
+func (r *reconciler) scheduleStatus(ctx context.Context, policyName string) (kubermaticv1.ClusterBackupPolicySchedule, error) {
+	status := kubermaticv1.ClusterBackupPolicySchedule{Policy: policyName}
+
+	schedule := &velerov1.Schedule{}
+	key := types.NamespacedName{Namespace: resources.ClusterBackupNamespaceName, Name: scheduleName(policyName)}
+	if err := r.userClient.Get(ctx, key, schedule); err != nil {
+		// A freshly created Schedule might not be in the cache yet.
+		if apierrors.IsNotFound(err) {
+			return status, nil
+		}
+		return status, fmt.Errorf("failed to get Velero Schedule %s: %w", key.Name, err)
+	}
+
+	status.Phase = schedule.Status.Phase
+	status.ValidationErrors = schedule.Status.ValidationErrors
+	status.LastBackupTime = schedule.Status.LastBackup
+
+	backupList := &velerov1.BackupList{}
+	if err := r.userClient.List(ctx, backupList,
+		ctrlruntimeclient.InNamespace(resources.ClusterBackupNamespaceName),
+		ctrlruntimeclient.MatchingLabels{velerov1.ScheduleNameLabel: schedule.Name},
+	); err != nil {
+		return status, fmt.Errorf("failed to list Velero Backups of Schedule %s: %w", schedule.Name, err)
+	}
+
+	if backup := latestBackup(backupList.Items); backup != nil {
+		status.LastBackupName = backup.Name
+		status.LastBackupPhase = backup.Status.Phase
+	}
+
+	return status, nil
+}
+
+func latestBackup(backups []velerov1.Backup) *velerov1.Backup {
+	var latest *velerov1.Backup
+	for i, backup := range backups {
+		if latest == nil || latest.CreationTimestamp.Before(&backup.CreationTimestamp) {
+			latest = &backups[i]
+		}
+	}
+
+	return latest
+}
@@ -0,0 +1,313 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package schedulecontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	userclusterresources "k8c.io/kubermatic/v2/pkg/ee/cluster-backup/user-cluster/velero-controller/resources"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	clusterName = "testcluster"
+	projectID   = "testproject"
+)
+
+func TestPolicyMatches(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   *kubermaticv1.ClusterBackupPolicy
+		expected bool
+	}{
+		{
+			name:     "policy without selector matches",
+			policy:   genPolicy("all", nil),
+			expected: true,
+		},
+		{
+			name: "policy of the same project matches",
+			policy: func() *kubermaticv1.ClusterBackupPolicy {
+				p := genPolicy("project", nil)
+				p.Labels = map[string]string{kubermaticv1.ProjectIDLabelKey: projectID}
+				return p
+			}(),
+			expected: true,
+		},
+		{
+			name: "policy of another project does not match",
+			policy: func() *kubermaticv1.ClusterBackupPolicy {
+				p := genPolicy("other-project", nil)
+				p.Labels = map[string]string{kubermaticv1.ProjectIDLabelKey: "other"}
+				return p
+			}(),
+		},
+		{
+			name:     "matching cluster selector",
+			policy:   genPolicy("selected", map[string]string{"env": "prod"}),
+			expected: true,
+		},
+		{
+			name:   "non-matching cluster selector",
+			policy: genPolicy("not-selected", map[string]string{"env": "dev"}),
+		},
+		{
+			name: "policy for another storage location does not match",
+			policy: func() *kubermaticv1.ClusterBackupPolicy {
+				p := genPolicy("other-location", nil)
+				p.Spec.StorageLocation = &corev1.LocalObjectReference{Name: "other"}
+				return p
+			}(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches, err := policyMatches(genCluster(true), tc.policy)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if matches != tc.expected {
+				t.Fatalf("expected match to be %v, got %v", tc.expected, matches)
+			}
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	lastBackup := metav1.NewTime(time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC))
+
+	testCases := []struct {
+		name              string
+		backupEnabled     bool
+		policies          []ctrlruntimeclient.Object
+		userObjects       []ctrlruntimeclient.Object
+		expectedSchedules []string
+		expectedStatus    []kubermaticv1.ClusterBackupPolicySchedule
+	}{
+		{
+			name:          "creates schedules for matching policies",
+			backupEnabled: true,
+			policies: []ctrlruntimeclient.Object{
+				genPolicy("daily", nil),
+				genPolicy("dev-only", map[string]string{"env": "dev"}),
+			},
+			expectedSchedules: []string{"kkp-daily"},
+			expectedStatus: []kubermaticv1.ClusterBackupPolicySchedule{
+				{Policy: "daily"},
+			},
+		},
+		{
+			name:          "reports the last backup and removes stale schedules",
+			backupEnabled: true,
+			policies: []ctrlruntimeclient.Object{
+				genPolicy("daily", nil),
+			},
+			userObjects: []ctrlruntimeclient.Object{
+				func() *velerov1.Schedule {
+					s := genSchedule("kkp-daily")
+					s.Status.Phase = velerov1.SchedulePhaseEnabled
+					s.Status.LastBackup = &lastBackup
+					return s
+				}(),
+				genSchedule("kkp-removed"),
+				genBackup("kkp-daily-1", "kkp-daily", lastBackup.Add(-24*time.Hour), velerov1.BackupPhaseCompleted),
+				genBackup("kkp-daily-2", "kkp-daily", lastBackup.Time, velerov1.BackupPhaseFailed),
+			},
+			expectedSchedules: []string{"kkp-daily"},
+			expectedStatus: []kubermaticv1.ClusterBackupPolicySchedule{
+				{
+					Policy: "daily",
+					ClusterBackupScheduleStatus: kubermaticv1.ClusterBackupScheduleStatus{
+						Phase:           velerov1.SchedulePhaseEnabled,
+						LastBackupTime:  &lastBackup,
+						LastBackupName:  "kkp-daily-2",
+						LastBackupPhase: velerov1.BackupPhaseFailed,
+					},
+				},
+			},
+		},
+		{
+			name: "removes schedules when cluster backups are disabled",
+			policies: []ctrlruntimeclient.Object{
+				genPolicy("daily", nil),
+			},
+			userObjects: []ctrlruntimeclient.Object{
+				genSchedule("kkp-daily"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			scheme := fake.NewScheme()
+			if err := velerov1.AddToScheme(scheme); err != nil {
+				t.Fatalf("failed to add velero to scheme: %v", err)
+			}
+
+			cluster := genCluster(tc.backupEnabled)
+			seedClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tc.policies, cluster)...).
+				Build()
+			userClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.userObjects...).
+				Build()
+
+			r := &reconciler{
+				seedClient:  seedClient,
+				userClient:  userClient,
+				recorder:    &events.FakeRecorder{},
+				log:         zap.NewNop().Sugar(),
+				clusterName: clusterName,
+				clusterIsPaused: func(ctx context.Context) (bool, error) {
+					return false, nil
+				},
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: clusterName}}
+			if _, err := r.Reconcile(ctx, request); err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+
+			schedules := &velerov1.ScheduleList{}
+			if err := userClient.List(ctx, schedules); err != nil {
+				t.Fatalf("failed to list schedules: %v", err)
+			}
+
+			var names []string
+			for _, schedule := range schedules.Items {
+				names = append(names, schedule.Name)
+
+				if schedule.Spec.Template.StorageLocation != userclusterresources.DefaultBSLName {
+					t.Errorf("expected schedule %s to use the storage location %q, got %q", schedule.Name, userclusterresources.DefaultBSLName, schedule.Spec.Template.StorageLocation)
+				}
+			}
+			if !diff.SemanticallyEqual(tc.expectedSchedules, names) {
+				t.Errorf("schedules differ:\n%v", diff.ObjectDiff(tc.expectedSchedules, names))
+			}
+
+			if err := seedClient.Get(ctx, request.NamespacedName, cluster); err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			if !diff.SemanticallyEqual(tc.expectedStatus, cluster.Status.BackupSchedules) {
+				t.Errorf("backup schedules differ:\n%v", diff.ObjectDiff(tc.expectedStatus, cluster.Status.BackupSchedules))
+			}
+		})
+	}
+}
+
+func genCluster(backupEnabled bool) *kubermaticv1.Cluster {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+			Labels: map[string]string{
+				kubermaticv1.ProjectIDLabelKey: projectID,
+				"env":                          "prod",
+			},
+		},
+	}
+
+	if backupEnabled {
+		cluster.Spec.BackupConfig = &kubermaticv1.BackupConfig{
+			BackupStorageLocation: &corev1.LocalObjectReference{Name: "cbsl"},
+		}
+	}
+
+	return cluster
+}
+
+func genPolicy(name string, selector map[string]string) *kubermaticv1.ClusterBackupPolicy {
+	policy := &kubermaticv1.ClusterBackupPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: resources.KubermaticNamespace,
+		},
+		Spec: kubermaticv1.ClusterBackupPolicySpec{
+			Schedule:           "0 2 * * *",
+			ExcludedNamespaces: []string{"kube-system"},
+			TTL:                &metav1.Duration{Duration: 72 * time.Hour},
+		},
+	}
+
+	if selector != nil {
+		policy.Spec.ClusterSelector = &metav1.LabelSelector{MatchLabels: selector}
+	}
+
+	return policy
+}
+
+func genSchedule(name string) *velerov1.Schedule {
+	return &velerov1.Schedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: resources.ClusterBackupNamespaceName,
+			Labels: map[string]string{
+				appskubermaticv1.ApplicationManagedByLabel: ControllerName,
+			},
+		},
+		Spec: velerov1.ScheduleSpec{
+			Schedule: "0 2 * * *",
+			Template: velerov1.BackupSpec{
+				StorageLocation: userclusterresources.DefaultBSLName,
+			},
+		},
+	}
+}
+
+func genBackup(name, schedule string, created time.Time, phase velerov1.BackupPhase) *velerov1.Backup {
+	return &velerov1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         resources.ClusterBackupNamespaceName,
+			CreationTimestamp: metav1.NewTime(created),
+			Labels: map[string]string{
+				velerov1.ScheduleNameLabel: schedule,
+			},
+		},
+		Status: velerov1.BackupStatus{
+			Phase: phase,
+		},
+	}
+}
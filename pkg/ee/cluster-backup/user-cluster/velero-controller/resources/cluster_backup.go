@@ -139,6 +139,36 @@ func BSLReconciler(cluster *kubermaticv1.Cluster, cbsl *kubermaticv1.ClusterBack
 	}
 }
 
+// RestoreBSLName returns the name of the read-only BackupStorageLocation that provides
+// the backups of the given source cluster.
+func RestoreBSLName(sourceCluster string) string {
+	return fmt.Sprintf("kkp-restore-%s", sourceCluster)
+}
+
+// RestoreBSLReconciler creates a read-only BackupStorageLocation for the backups of another
+// cluster in the same project, so that they can be restored into this cluster.
+func RestoreBSLReconciler(cluster *kubermaticv1.Cluster, cbsl *kubermaticv1.ClusterBackupStorageLocation, sourceCluster string) kkpreconciling.NamedBackupStorageLocationReconcilerFactory {
+	return func() (string, kkpreconciling.BackupStorageLocationReconciler) {
+		return RestoreBSLName(sourceCluster), func(bsl *velerov1.BackupStorageLocation) (*velerov1.BackupStorageLocation, error) {
+			kubernetes.EnsureLabels(bsl, resources.BaseAppLabels(clusterBackupAppName, nil))
+
+			projectID, ok := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+			if !ok {
+				return nil, fmt.Errorf("cluster ProjectID label is not set")
+			}
+			bsl.Spec = *cbsl.Spec.DeepCopy()
+			bsl.Spec.Default = false
+			bsl.Spec.Credential = nil
+			// never let Velero write into the backups of the source cluster.
+			bsl.Spec.AccessMode = velerov1.BackupStorageLocationAccessModeReadOnly
+			// the source cluster is always looked up in the project of this cluster.
+			bsl.Spec.ObjectStorage.Prefix = fmt.Sprintf("%s/%s", projectID, sourceCluster)
+
+			return bsl, nil
+		}
+	}
+}
+
 // CustomizationConfigMapReconciler reconciles a ConfigMap to configure the velero restore helper,
 // see https://velero.io/docs/v1.17/file-system-backup/#customize-restore-helper-container.
 func CustomizationConfigMapReconciler(rewriter registry.ImageRewriter) reconciling.NamedConfigMapReconcilerFactory {
@@ -170,7 +170,7 @@ func (r *Reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluste
 		}
 
 		if policyTemplate.Spec.Enforced || (policyTemplate.Spec.Default && !ignoreDefaultPolicies) {
-			reconcilers = append(reconcilers, policyBindingReconcilerFactory(policyTemplate))
+			reconcilers = append(reconcilers, PolicyBindingReconcilerFactory(policyTemplate))
 		}
 	}
 
@@ -196,8 +196,8 @@ func (r *Reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluste
 	return nil, nil
 }
 
-// policyBindingReconcilerFactory creates a named factory for reconciling policy bindings.
-func policyBindingReconcilerFactory(template kubermaticv1.PolicyTemplate) reconciling.NamedPolicyBindingReconcilerFactory {
+// PolicyBindingReconcilerFactory creates a named factory for reconciling the policy binding of a default or enforced template.
+func PolicyBindingReconcilerFactory(template kubermaticv1.PolicyTemplate) reconciling.NamedPolicyBindingReconcilerFactory {
 	return func() (string, reconciling.PolicyBindingReconciler) {
 		return template.Name, func(binding *kubermaticv1.PolicyBinding) (*kubermaticv1.PolicyBinding, error) {
 			annotations := make(map[string]string)
@@ -217,6 +217,9 @@ func policyBindingReconcilerFactory(template kubermaticv1.PolicyTemplate) reconc
 				PolicyTemplateRef: corev1.ObjectReference{
 					Name: template.Name,
 				},
+				// Keep a preview requested for the binding, so that enforced
+				// templates can be observed in Audit mode before being promoted.
+				Preview: binding.Spec.Preview,
 			}
 
 			return binding, nil
@@ -224,9 +227,16 @@ func policyBindingReconcilerFactory(template kubermaticv1.PolicyTemplate) reconc
 	}
 }
 
+// IsClusterTargeted checks if the PolicyTemplate targets the given cluster, which can
+// either be a KKP user cluster or an ExternalCluster.
+func IsClusterTargeted(ctx context.Context, client ctrlruntimeclient.Client, cluster metav1.Object, template *kubermaticv1.PolicyTemplate) bool {
+	r := &Reconciler{Client: client}
+	return r.isClusterTargeted(ctx, cluster, template)
+}
+
 // isClusterTargeted checks if the PolicyTemplate targets the given cluster.
-func (r *Reconciler) isClusterTargeted(ctx context.Context, cluster *kubermaticv1.Cluster, template *kubermaticv1.PolicyTemplate) bool {
-	clusterProjectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+func (r *Reconciler) isClusterTargeted(ctx context.Context, cluster metav1.Object, template *kubermaticv1.PolicyTemplate) bool {
+	clusterProjectID := cluster.GetLabels()[kubermaticv1.ProjectIDLabelKey]
 
 	// If no target is specified, we check the visibility
 	if template.Spec.Target == nil {
@@ -247,7 +257,7 @@ func (r *Reconciler) isClusterTargeted(ctx context.Context, cluster *kubermaticv
 }
 
 // handleVisibilityOnly handles when no target is specified - uses visibility rules only.
-func handleVisibilityOnly(cluster *kubermaticv1.Cluster, template *kubermaticv1.PolicyTemplate, clusterProjectID string) bool {
+func handleVisibilityOnly(cluster metav1.Object, template *kubermaticv1.PolicyTemplate, clusterProjectID string) bool {
 	switch template.Spec.Visibility {
 	case kubermaticv1.PolicyTemplateVisibilityGlobal:
 		return true
@@ -259,7 +269,7 @@ func handleVisibilityOnly(cluster *kubermaticv1.Cluster, template *kubermaticv1.
 }
 
 // handleGlobalWithTarget handles Global visibility with Target specified, using the provided client.
-func (r *Reconciler) handleGlobalWithTarget(ctx context.Context, cluster *kubermaticv1.Cluster, template *kubermaticv1.PolicyTemplate, clusterProjectID string) bool {
+func (r *Reconciler) handleGlobalWithTarget(ctx context.Context, cluster metav1.Object, template *kubermaticv1.PolicyTemplate, clusterProjectID string) bool {
 	target := template.Spec.Target
 	hasProjectSelector := target.ProjectSelector != nil
 	hasClusterSelector := target.ClusterSelector != nil
@@ -280,7 +290,7 @@ func (r *Reconciler) handleGlobalWithTarget(ctx context.Context, cluster *kuberm
 }
 
 // handleProjectWithTarget handles Project visibility with Target specified.
-func handleProjectWithTarget(cluster *kubermaticv1.Cluster, template *kubermaticv1.PolicyTemplate, clusterProjectID string) bool {
+func handleProjectWithTarget(cluster metav1.Object, template *kubermaticv1.PolicyTemplate, clusterProjectID string) bool {
 	if template.Spec.ProjectID != "" && clusterProjectID != template.Spec.ProjectID {
 		return false
 	}
@@ -293,7 +303,7 @@ func handleProjectWithTarget(cluster *kubermaticv1.Cluster, template *kubermatic
 }
 
 // handleGlobalProjectAndClusterSelectors handles Global + Project + Cluster selectors (AND filtering).
-func (r *Reconciler) handleGlobalProjectAndClusterSelectors(ctx context.Context, cluster *kubermaticv1.Cluster, template *kubermaticv1.PolicyTemplate, clusterProjectID string) bool {
+func (r *Reconciler) handleGlobalProjectAndClusterSelectors(ctx context.Context, cluster metav1.Object, template *kubermaticv1.PolicyTemplate, clusterProjectID string) bool {
 	if !matchesClusterSelector(cluster, template.Spec.Target.ClusterSelector) {
 		return false
 	}
@@ -306,17 +316,17 @@ func (r *Reconciler) handleGlobalProjectAndClusterSelectors(ctx context.Context,
 }
 
 // handleGlobalProjectSelectorOnly handles Global + Project selector only.
-func (r *Reconciler) handleGlobalProjectSelectorOnly(ctx context.Context, cluster *kubermaticv1.Cluster, template *kubermaticv1.PolicyTemplate, clusterProjectID string) bool {
+func (r *Reconciler) handleGlobalProjectSelectorOnly(ctx context.Context, cluster metav1.Object, template *kubermaticv1.PolicyTemplate, clusterProjectID string) bool {
 	return r.matchesProjectSelector(ctx, clusterProjectID, template.Spec.Target.ProjectSelector)
 }
 
 // handleGlobalClusterSelectorOnly handles Global + Cluster selector only.
-func handleGlobalClusterSelectorOnly(cluster *kubermaticv1.Cluster, template *kubermaticv1.PolicyTemplate) bool {
+func handleGlobalClusterSelectorOnly(cluster metav1.Object, template *kubermaticv1.PolicyTemplate) bool {
 	return matchesClusterSelector(cluster, template.Spec.Target.ClusterSelector)
 }
 
 // matchesClusterSelector checks if a cluster matches the given cluster selector.
-func matchesClusterSelector(cluster *kubermaticv1.Cluster, clusterSelector *metav1.LabelSelector) bool {
+func matchesClusterSelector(cluster metav1.Object, clusterSelector *metav1.LabelSelector) bool {
 	if isLabelSelectorEmpty(clusterSelector) {
 		return true
 	}
@@ -326,7 +336,7 @@ func matchesClusterSelector(cluster *kubermaticv1.Cluster, clusterSelector *meta
 		return false
 	}
 
-	return selector.Matches(labels.Set(cluster.Labels))
+	return selector.Matches(labels.Set(cluster.GetLabels()))
 }
 
 // matchesProjectSelector checks if a project (by ID) matches the given project selector.
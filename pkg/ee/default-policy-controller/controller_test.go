@@ -29,6 +29,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -1193,3 +1194,30 @@ func genPolicyTemplateWithNilSelectors(name string, defaultPolicy, enforced bool
 
 	return template
 }
+
+func TestPolicyBindingReconcilerFactoryKeepsPreview(t *testing.T) {
+	template := genPolicyTemplate(policyName, false, true, kubermaticv1.PolicyTemplateVisibilityGlobal, "", nil, nil)
+	preview := &kubermaticv1.PolicyBindingPreview{
+		ObservationWindow: &metav1.Duration{Duration: time.Hour},
+	}
+
+	binding := &kubermaticv1.PolicyBinding{
+		Spec: kubermaticv1.PolicyBindingSpec{
+			PolicyTemplateRef: corev1.ObjectReference{Name: "outdated"},
+			Preview:           preview,
+		},
+	}
+
+	_, reconciler := PolicyBindingReconcilerFactory(*template)()
+	reconciled, err := reconciler(binding)
+	if err != nil {
+		t.Fatalf("reconciling failed: %v", err)
+	}
+
+	if reconciled.Spec.PolicyTemplateRef.Name != policyName {
+		t.Errorf("expected template reference %q, got %q", policyName, reconciled.Spec.PolicyTemplateRef.Name)
+	}
+	if reconciled.Spec.Preview != preview {
+		t.Errorf("expected preview to be kept, got %v", reconciled.Spec.Preview)
+	}
+}
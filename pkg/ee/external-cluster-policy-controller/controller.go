@@ -0,0 +1,327 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package externalclusterpolicycontroller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	defaultpolicycontroller "k8c.io/kubermatic/v2/pkg/ee/default-policy-controller"
+	policybindingcontroller "k8c.io/kubermatic/v2/pkg/ee/policy-binding-controller"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	kkpreconciling "k8c.io/kubermatic/v2/pkg/resources/reconciling"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	ControllerName = "kkp-external-cluster-policy-controller"
+
+	// resyncInterval is the interval in which external clusters are reconciled, as the
+	// Kyverno resources inside of them are not watched.
+	resyncInterval = 5 * time.Minute
+)
+
+var clusterScheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(clusterScheme))
+	utilruntime.Must(kyvernov1.AddToScheme(clusterScheme))
+}
+
+type Reconciler struct {
+	ctrlruntimeclient.Client
+
+	log      *zap.SugaredLogger
+	recorder events.EventRecorder
+}
+
+// Add creates the external cluster policy controller.
+func Add(mgr manager.Manager, numWorkers int, log *zap.SugaredLogger) error {
+	reconciler := &Reconciler{
+		Client:   mgr.GetClient(),
+		log:      log.Named(ControllerName),
+		recorder: mgr.GetEventRecorder(ControllerName),
+	}
+
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{MaxConcurrentReconciles: numWorkers}).
+		// labels are used to select the clusters targeted by PolicyTemplates
+		For(&kubermaticv1.ExternalCluster{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}))).
+		Watches(&kubermaticv1.PolicyBinding{}, handler.EnqueueRequestsFromMapFunc(enqueueExternalClusterForPolicyBinding)).
+		Watches(&kubermaticv1.PolicyTemplate{}, enqueueExternalClusters(reconciler, reconciler.log)).
+		Build(reconciler)
+
+	return err
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("externalcluster", request.Name)
+	log.Debug("Processing")
+
+	cluster := &kubermaticv1.ExternalCluster{}
+	if err := r.Get(ctx, request.NamespacedName, cluster); err != nil {
+		return reconcile.Result{}, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		if err := r.handleDeletion(ctx, log, cluster); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to clean up policies: %w", err)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	// Nothing to do if policies have never been enabled; if they have been disabled, the
+	// existing PolicyBindings are still reconciled to remove their Kyverno resources.
+	if !cluster.Spec.IsPoliciesEnabled() && !kuberneteshelper.HasFinalizer(cluster, kubermaticv1.ExternalClusterPoliciesCleanupFinalizer) {
+		return reconcile.Result{}, nil
+	}
+
+	if cluster.Spec.Pause {
+		return reconcile.Result{}, nil
+	}
+
+	if !kuberneteshelper.ExternalClusterReachable(cluster) {
+		log.Debug("External cluster is not reachable yet")
+		return reconcile.Result{RequeueAfter: resyncInterval}, nil
+	}
+
+	requeueAfter, err := r.reconcile(ctx, log, cluster)
+	if err != nil {
+		r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "PolicyReconcilingError", "Reconciling", err.Error())
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.ExternalCluster) (time.Duration, error) {
+	if cluster.Spec.IsPoliciesEnabled() {
+		if err := kuberneteshelper.TryAddFinalizer(ctx, r, cluster, kubermaticv1.ExternalClusterPoliciesCleanupFinalizer); err != nil {
+			return 0, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+
+		if err := reconciling.ReconcileNamespaces(ctx, []reconciling.NamedNamespaceReconcilerFactory{namespaceReconciler(cluster)}, "", r); err != nil {
+			return 0, fmt.Errorf("failed to reconcile namespace: %w", err)
+		}
+
+		if err := r.reconcileDefaultPolicyBindings(ctx, cluster); err != nil {
+			return 0, err
+		}
+	}
+
+	clusterClient, err := newClusterClient(ctx, cluster, r)
+	if err != nil {
+		return 0, err
+	}
+
+	bindings := &kubermaticv1.PolicyBindingList{}
+	if err := r.List(ctx, bindings, ctrlruntimeclient.InNamespace(cluster.GetNamespaceName())); err != nil {
+		return 0, fmt.Errorf("failed to list PolicyBindings: %w", err)
+	}
+
+	requeueAfter := resyncInterval
+
+	var errs []error
+	for _, binding := range bindings.Items {
+		bindingLog := log.With("binding", binding.Name)
+
+		bindingRequeueAfter, err := policybindingcontroller.ReconcileExternalClusterBinding(ctx, bindingLog, r, clusterClient, r.recorder, &binding, cluster)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile PolicyBinding %s: %w", binding.Name, err))
+			continue
+		}
+
+		if bindingRequeueAfter > 0 {
+			requeueAfter = min(requeueAfter, bindingRequeueAfter)
+		}
+	}
+
+	return requeueAfter, kerrors.NewAggregate(errs)
+}
+
+func namespaceReconciler(cluster *kubermaticv1.ExternalCluster) reconciling.NamedNamespaceReconcilerFactory {
+	return func() (string, reconciling.NamespaceReconciler) {
+		return cluster.GetNamespaceName(), func(ns *corev1.Namespace) (*corev1.Namespace, error) {
+			kuberneteshelper.EnsureLabels(ns, map[string]string{
+				kubermaticv1.ProjectIDLabelKey: cluster.Labels[kubermaticv1.ProjectIDLabelKey],
+			})
+
+			return ns, nil
+		}
+	}
+}
+
+// reconcileDefaultPolicyBindings creates the PolicyBindings for enforced PolicyTemplates and, once, for default
+// PolicyTemplates targeting the cluster, so that default policies can be removed by the cluster owner.
+func (r *Reconciler) reconcileDefaultPolicyBindings(ctx context.Context, cluster *kubermaticv1.ExternalCluster) error {
+	policyTemplates := &kubermaticv1.PolicyTemplateList{}
+	if err := r.List(ctx, policyTemplates); err != nil {
+		return fmt.Errorf("failed to list PolicyTemplates: %w", err)
+	}
+
+	var factories []kkpreconciling.NamedPolicyBindingReconcilerFactory
+	for _, policyTemplate := range policyTemplates.Items {
+		if policyTemplate.DeletionTimestamp != nil {
+			continue
+		}
+
+		if !defaultpolicycontroller.IsClusterTargeted(ctx, r, cluster, &policyTemplate) {
+			continue
+		}
+
+		if policyTemplate.Spec.Enforced || (policyTemplate.Spec.Default && !cluster.Status.DefaultPolicyBindingsCreated) {
+			factories = append(factories, defaultpolicycontroller.PolicyBindingReconcilerFactory(policyTemplate))
+		}
+	}
+
+	if err := kkpreconciling.ReconcilePolicyBindings(ctx, factories, cluster.GetNamespaceName(), r); err != nil {
+		return fmt.Errorf("failed to reconcile PolicyBindings: %w", err)
+	}
+
+	if !cluster.Status.DefaultPolicyBindingsCreated {
+		oldCluster := cluster.DeepCopy()
+		cluster.Status.DefaultPolicyBindingsCreated = true
+		if err := r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+			return fmt.Errorf("failed to update external cluster status: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// handleDeletion removes the PolicyBindings of a deleted external cluster. The Kyverno resources are removed
+// from the cluster if it is still reachable; otherwise the cluster is no longer managed by KKP and they are kept.
+func (r *Reconciler) handleDeletion(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.ExternalCluster) error {
+	if !kuberneteshelper.HasFinalizer(cluster, kubermaticv1.ExternalClusterPoliciesCleanupFinalizer) {
+		return nil
+	}
+
+	bindings := &kubermaticv1.PolicyBindingList{}
+	if err := r.List(ctx, bindings, ctrlruntimeclient.InNamespace(cluster.GetNamespaceName())); err != nil {
+		return fmt.Errorf("failed to list PolicyBindings: %w", err)
+	}
+
+	var clusterClient ctrlruntimeclient.Client
+	if len(bindings.Items) > 0 && kuberneteshelper.ExternalClusterReachable(cluster) {
+		client, err := newClusterClient(ctx, cluster, r)
+		if err != nil {
+			log.Infow("Cannot connect to cluster, keeping its Kyverno resources", zap.Error(err))
+		}
+		clusterClient = client
+	}
+
+	for _, binding := range bindings.Items {
+		if clusterClient != nil {
+			if _, err := policybindingcontroller.ReconcileExternalClusterBinding(ctx, log.With("binding", binding.Name), r, clusterClient, r.recorder, &binding, cluster); err != nil {
+				log.Infow("Failed to remove Kyverno resources", "binding", binding.Name, zap.Error(err))
+			}
+		}
+
+		if err := kuberneteshelper.TryRemoveFinalizer(ctx, r, &binding, kubermaticv1.PolicyBindingCleanupFinalizer); err != nil {
+			return fmt.Errorf("failed to remove finalizer from PolicyBinding %s: %w", binding.Name, err)
+		}
+	}
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: cluster.GetNamespaceName(),
+		},
+	}
+	if err := r.Delete(ctx, namespace); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete namespace: %w", err)
+	}
+
+	return kuberneteshelper.TryRemoveFinalizer(ctx, r, cluster, kubermaticv1.ExternalClusterPoliciesCleanupFinalizer)
+}
+
+func newClusterClient(ctx context.Context, cluster *kubermaticv1.ExternalCluster, masterClient ctrlruntimeclient.Client) (ctrlruntimeclient.Client, error) {
+	cfg, err := kuberneteshelper.GetClusterRESTConfig(ctx, cluster, masterClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kubeconfig: %w", err)
+	}
+
+	clusterClient, err := ctrlruntimeclient.New(cfg, ctrlruntimeclient.Options{Scheme: clusterScheme})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cluster client: %w", err)
+	}
+
+	return clusterClient, nil
+}
+
+func enqueueExternalClusterForPolicyBinding(_ context.Context, obj ctrlruntimeclient.Object) []reconcile.Request {
+	prefix := kubermaticv1.ExternalClusterNamespacePrefix + "-"
+	if !strings.HasPrefix(obj.GetNamespace(), prefix) {
+		return nil
+	}
+
+	return []reconcile.Request{{
+		NamespacedName: ctrlruntimeclient.ObjectKey{Name: strings.TrimPrefix(obj.GetNamespace(), prefix)},
+	}}
+}
+
+func enqueueExternalClusters(client ctrlruntimeclient.Client, log *zap.SugaredLogger) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj ctrlruntimeclient.Object) []reconcile.Request {
+		var requests []reconcile.Request
+
+		clusters := &kubermaticv1.ExternalClusterList{}
+		if err := client.List(ctx, clusters); err != nil {
+			log.Errorw("Failed to list external clusters", zap.Error(err))
+			utilruntime.HandleError(fmt.Errorf("failed to list external clusters: %w", err))
+			return requests
+		}
+
+		for _, cluster := range clusters.Items {
+			if cluster.Spec.IsPoliciesEnabled() {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: ctrlruntimeclient.ObjectKeyFromObject(&cluster),
+				})
+			}
+		}
+
+		return requests
+	})
+}
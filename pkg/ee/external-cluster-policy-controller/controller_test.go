@@ -0,0 +1,205 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package externalclusterpolicycontroller
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	clusterName = "external"
+	projectID   = "testproject"
+)
+
+func genExternalCluster(labels map[string]string) *kubermaticv1.ExternalCluster {
+	return &kubermaticv1.ExternalCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   clusterName,
+			Labels: labels,
+		},
+		Spec: kubermaticv1.ExternalClusterSpec{
+			HumanReadableName: "imported",
+			Policies: &kubermaticv1.ExternalClusterPolicySettings{
+				Enabled: true,
+			},
+		},
+	}
+}
+
+func genPolicyTemplate(name string, defaultPolicy, enforced bool, visibility, projectID string) *kubermaticv1.PolicyTemplate {
+	return &kubermaticv1.PolicyTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: kubermaticv1.PolicyTemplateSpec{
+			Default:    defaultPolicy,
+			Enforced:   enforced,
+			Visibility: visibility,
+			ProjectID:  projectID,
+		},
+	}
+}
+
+func TestReconcileDefaultPolicyBindings(t *testing.T) {
+	cluster := genExternalCluster(map[string]string{kubermaticv1.ProjectIDLabelKey: projectID})
+
+	client := fake.NewClientBuilder().WithObjects(
+		cluster,
+		genPolicyTemplate("regular", false, false, kubermaticv1.PolicyTemplateVisibilityGlobal, ""),
+		genPolicyTemplate("default", true, false, kubermaticv1.PolicyTemplateVisibilityGlobal, ""),
+		genPolicyTemplate("enforced", false, true, kubermaticv1.PolicyTemplateVisibilityProject, projectID),
+		genPolicyTemplate("other-project", true, true, kubermaticv1.PolicyTemplateVisibilityProject, "other"),
+	).Build()
+
+	r := &Reconciler{
+		Client:   client,
+		log:      zap.NewNop().Sugar(),
+		recorder: events.NewFakeRecorder(10),
+	}
+
+	ctx := context.Background()
+	if err := r.reconcileDefaultPolicyBindings(ctx, cluster); err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+
+	assertBindings(t, client, "default", "enforced")
+
+	updated := &kubermaticv1.ExternalCluster{}
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), updated); err != nil {
+		t.Fatalf("Failed to get cluster: %v", err)
+	}
+	if !updated.Status.DefaultPolicyBindingsCreated {
+		t.Fatal("Expected default PolicyBindings to be marked as created")
+	}
+
+	// default bindings removed by the user must not be recreated, enforced ones must
+	for _, name := range []string{"default", "enforced"} {
+		binding := &kubermaticv1.PolicyBinding{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: cluster.GetNamespaceName()}}
+		if err := client.Delete(ctx, binding); err != nil {
+			t.Fatalf("Failed to delete PolicyBinding: %v", err)
+		}
+	}
+
+	if err := r.reconcileDefaultPolicyBindings(ctx, updated); err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+
+	assertBindings(t, client, "enforced")
+}
+
+func assertBindings(t *testing.T, client ctrlruntimeclient.Client, expected ...string) {
+	t.Helper()
+
+	bindings := &kubermaticv1.PolicyBindingList{}
+	if err := client.List(context.Background(), bindings, ctrlruntimeclient.InNamespace(kubermaticv1.ExternalClusterNamespacePrefix+"-"+clusterName)); err != nil {
+		t.Fatalf("Failed to list PolicyBindings: %v", err)
+	}
+
+	if len(bindings.Items) != len(expected) {
+		t.Fatalf("Expected %d PolicyBindings, got %d", len(expected), len(bindings.Items))
+	}
+
+	for i, name := range expected {
+		if bindings.Items[i].Name != name {
+			t.Errorf("Expected PolicyBinding %q, got %q", name, bindings.Items[i].Name)
+		}
+		if bindings.Items[i].Spec.PolicyTemplateRef.Name != name {
+			t.Errorf("Expected PolicyBinding %q to reference PolicyTemplate %q, got %q", name, name, bindings.Items[i].Spec.PolicyTemplateRef.Name)
+		}
+	}
+}
+
+func TestHandleDeletion(t *testing.T) {
+	cluster := genExternalCluster(nil)
+	cluster.Finalizers = []string{kubermaticv1.ExternalClusterPoliciesCleanupFinalizer}
+	cluster.DeletionTimestamp = ptr.To(metav1.Now())
+
+	binding := &kubermaticv1.PolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "policy",
+			Namespace:  cluster.GetNamespaceName(),
+			Finalizers: []string{kubermaticv1.PolicyBindingCleanupFinalizer},
+		},
+		Spec: kubermaticv1.PolicyBindingSpec{
+			PolicyTemplateRef: corev1.ObjectReference{Name: "policy"},
+		},
+	}
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: cluster.GetNamespaceName(),
+		},
+	}
+
+	client := fake.NewClientBuilder().WithObjects(cluster, binding, namespace).Build()
+
+	r := &Reconciler{
+		Client:   client,
+		log:      zap.NewNop().Sugar(),
+		recorder: events.NewFakeRecorder(10),
+	}
+
+	// the cluster has no kubeconfig, so its Kyverno resources cannot be removed
+	// and the cleanup must not be blocked by it
+	ctx := context.Background()
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), cluster); err != nil {
+		t.Fatalf("Failed to get cluster: %v", err)
+	}
+
+	if err := r.handleDeletion(ctx, r.log, cluster); err != nil {
+		t.Fatalf("Failed to handle deletion: %v", err)
+	}
+
+	updatedBinding := &kubermaticv1.PolicyBinding{}
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(binding), updatedBinding); err == nil {
+		if kuberneteshelper.HasFinalizer(updatedBinding, kubermaticv1.PolicyBindingCleanupFinalizer) {
+			t.Error("Expected the finalizer to be removed from the PolicyBinding")
+		}
+	} else if !apierrors.IsNotFound(err) {
+		t.Fatalf("Failed to get PolicyBinding: %v", err)
+	}
+
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(namespace), &corev1.Namespace{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Expected the namespace to be deleted, got %v", err)
+	}
+
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), &kubermaticv1.ExternalCluster{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Expected the external cluster to be gone after removing the last finalizer, got %v", err)
+	}
+}
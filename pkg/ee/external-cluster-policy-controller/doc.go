@@ -0,0 +1,40 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+/*
+Package externalclusterpolicycontroller applies KKP policies to ExternalClusters
+(KubeOne, bring-your-own and imported EKS/AKS/GKE clusters).
+
+The PolicyBindings of an external cluster are stored on the master cluster in the
+namespace "external-cluster-<cluster name>". For every ExternalCluster with
+spec.policies.enabled the controller creates this namespace and the PolicyBindings
+for default and enforced PolicyTemplates targeting the cluster, and then applies
+all PolicyBindings as Kyverno resources using the cluster's KubeconfigReference,
+reporting the same status as for KKP user clusters.
+
+KKP does not deploy Kyverno into external clusters, it has to be installed
+beforehand, for example as an Application. As the Kyverno resources in the
+external cluster are not watched, the clusters are reconciled periodically.
+*/
+package externalclusterpolicycontroller
@@ -28,6 +28,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -40,8 +41,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -50,18 +53,21 @@ const (
 	ControllerName = "group-project-binding-controller"
 )
 
-// Add creates a new group-project-binding controller and sets up Watches.
+// Add creates a new group-project-binding controller and sets up Watches. Expired GroupProjectBindings
+// are deleted after expiredRetention, if it is greater than 0.
 func Add(
 	mgr manager.Manager,
 	log *zap.SugaredLogger,
 	numWorkers int,
 	setOwnerRef bool,
+	expiredRetention time.Duration,
 ) error {
 	reconciler := &Reconciler{
-		Client:      mgr.GetClient(),
-		recorder:    mgr.GetEventRecorder(ControllerName),
-		log:         log.Named(ControllerName),
-		setOwnerRef: setOwnerRef,
+		Client:           mgr.GetClient(),
+		recorder:         mgr.GetEventRecorder(ControllerName),
+		log:              log.Named(ControllerName),
+		setOwnerRef:      setOwnerRef,
+		expiredRetention: expiredRetention,
 	}
 
 	_, err := builder.ControllerManagedBy(mgr).
@@ -75,6 +81,8 @@ func Add(
 		Watches(&rbacv1.ClusterRole{}, enqueueGroupProjectBindingsForRole(mgr.GetClient()), builder.WithPredicates(predicateutil.ByLabelExists(kubermaticv1.AuthZRoleLabel))).
 		// watch Roles with the authz.k8c.io/role label as we might need to create new ClusterRoleBindings/RoleBindings
 		Watches(&rbacv1.Role{}, enqueueGroupProjectBindingsForRole(mgr.GetClient()), builder.WithPredicates(predicateutil.ByLabelExists(kubermaticv1.AuthZRoleLabel))).
+		// watch Projects to revoke and restore the bindings when they are suspended or resumed
+		Watches(&kubermaticv1.Project{}, enqueueGroupProjectBindingsForProject(mgr.GetClient()), builder.WithPredicates(projectSuspensionChangedPredicate())).
 		Build(reconciler)
 
 	return err
@@ -111,3 +119,53 @@ func enqueueGroupProjectBindingsForRole(client ctrlruntimeclient.Client) handler
 		return requests
 	})
 }
+
+// enqueueGroupProjectBindingsForProject returns a handler.EventHandler that enqueues all GroupProjectBindings
+// of an observed Project.
+func enqueueGroupProjectBindingsForProject(client ctrlruntimeclient.Client) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a ctrlruntimeclient.Object) []reconcile.Request {
+		var (
+			requests []reconcile.Request
+		)
+
+		bindingList := &kubermaticv1.GroupProjectBindingList{}
+
+		if err := client.List(ctx, bindingList); err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to list GroupProjectBindings: %w", err))
+			return []reconcile.Request{}
+		}
+
+		for _, binding := range bindingList.Items {
+			if binding.Spec.ProjectID == a.GetName() {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Name: binding.Name,
+					},
+				})
+			}
+		}
+
+		return requests
+	})
+}
+
+// projectSuspensionChangedPredicate only lets through Projects that were suspended or resumed.
+func projectSuspensionChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldProject, ok := e.ObjectOld.(*kubermaticv1.Project)
+			if !ok {
+				return false
+			}
+			newProject, ok := e.ObjectNew.(*kubermaticv1.Project)
+			if !ok {
+				return false
+			}
+
+			return oldProject.IsSuspended() != newProject.IsSuspended()
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
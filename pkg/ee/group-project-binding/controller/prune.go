@@ -108,3 +108,36 @@ func pruneRoleBindings(ctx context.Context, client ctrlruntimeclient.Client, log
 
 	return nil
 }
+
+// revokeBindings deletes all ClusterRoleBindings and RoleBindings created for an expired GroupProjectBinding.
+func revokeBindings(ctx context.Context, client ctrlruntimeclient.Client, log *zap.SugaredLogger, binding *kubermaticv1.GroupProjectBinding) error {
+	selector := ctrlruntimeclient.MatchingLabels{
+		kubermaticv1.AuthZGroupProjectBindingLabel: binding.Name,
+	}
+
+	clusterRoleBindingList := &rbacv1.ClusterRoleBindingList{}
+	if err := client.List(ctx, clusterRoleBindingList, selector); err != nil {
+		return err
+	}
+
+	for _, clusterRoleBinding := range clusterRoleBindingList.Items {
+		log.Debugw("revoking ClusterRoleBinding of expired binding", "GroupProjectBinding", binding.Name, "ClusterRoleBinding", clusterRoleBinding.Name)
+		if err := client.Delete(ctx, &clusterRoleBinding); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	roleBindingList := &rbacv1.RoleBindingList{}
+	if err := client.List(ctx, roleBindingList, selector); err != nil {
+		return err
+	}
+
+	for _, roleBinding := range roleBindingList.Items {
+		log.Debugw("revoking RoleBinding of expired binding", "GroupProjectBinding", binding.Name, "RoleBinding", roleBinding.Name)
+		if err := client.Delete(ctx, &roleBinding); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
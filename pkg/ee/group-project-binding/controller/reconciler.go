@@ -27,6 +27,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -50,6 +51,10 @@ type Reconciler struct {
 	recorder events.EventRecorder
 
 	setOwnerRef bool
+
+	// expiredRetention is how long expired GroupProjectBindings are kept before they
+	// are deleted; 0 keeps them forever.
+	expiredRetention time.Duration
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -94,14 +99,71 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 	}
 
+	now := time.Now()
+
+	if binding.Spec.IsExpired(now) {
+		if err := revokeBindings(ctx, r.Client, log, binding); err != nil {
+			r.recorder.Eventf(binding, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+			return reconcile.Result{}, fmt.Errorf("failed to revoke expired binding: %w", err)
+		}
+
+		return r.reconcileExpired(ctx, log, binding, now)
+	}
+
+	suspended, err := r.projectSuspended(ctx, binding)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to check project suspension: %w", err)
+	}
+
+	// members of a suspended project lose their access until the project is resumed
+	if suspended {
+		if err := revokeBindings(ctx, r.Client, log, binding); err != nil {
+			r.recorder.Eventf(binding, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+			return reconcile.Result{}, fmt.Errorf("failed to revoke binding of suspended project: %w", err)
+		}
+
+		return reconcile.Result{}, nil
+	}
+
 	if err := r.reconcile(ctx, r.Client, log, binding); err != nil {
 		r.recorder.Eventf(binding, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
 		return reconcile.Result{}, err
 	}
 
+	if binding.Spec.ExpiresAt != nil {
+		return reconcile.Result{RequeueAfter: binding.Spec.ExpiresAt.Sub(now)}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
+// projectSuspended returns true if the project of the binding is suspended. Projects that
+// do not exist (yet) in this cluster are not considered suspended.
+func (r *Reconciler) projectSuspended(ctx context.Context, binding *kubermaticv1.GroupProjectBinding) (bool, error) {
+	project := &kubermaticv1.Project{}
+	if err := r.Get(ctx, ctrlruntimeclient.ObjectKey{Name: binding.Spec.ProjectID}, project); err != nil {
+		return false, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	return project.IsSuspended(), nil
+}
+
+// reconcileExpired deletes an expired GroupProjectBinding once its retention period has passed.
+func (r *Reconciler) reconcileExpired(ctx context.Context, log *zap.SugaredLogger, binding *kubermaticv1.GroupProjectBinding, now time.Time) (reconcile.Result, error) {
+	if r.expiredRetention <= 0 {
+		return reconcile.Result{}, nil
+	}
+
+	deleteAt := binding.Spec.ExpiresAt.Add(r.expiredRetention)
+	if now.Before(deleteAt) {
+		return reconcile.Result{RequeueAfter: deleteAt.Sub(now)}, nil
+	}
+
+	log.Infow("Deleting expired GroupProjectBinding", "expiresAt", binding.Spec.ExpiresAt)
+
+	return reconcile.Result{}, ctrlruntimeclient.IgnoreNotFound(r.Delete(ctx, binding))
+}
+
 func (r *Reconciler) reconcile(ctx context.Context, client ctrlruntimeclient.Client, log *zap.SugaredLogger, binding *kubermaticv1.GroupProjectBinding) error {
 	clusterRoles, err := getTargetClusterRoles(ctx, client, binding)
 	if err != nil {
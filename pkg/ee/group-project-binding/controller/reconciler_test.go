@@ -28,6 +28,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
@@ -35,6 +36,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/test/fake"
 
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/events"
@@ -323,6 +325,148 @@ func TestReconcile(t *testing.T) {
 	}
 }
 
+func TestReconcileExpiredBinding(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		expiredFor           time.Duration
+		expectBindingDeleted bool
+	}{
+		{
+			name:       "expired binding is revoked, but kept during retention",
+			expiredFor: time.Hour,
+		},
+		{
+			name:                 "expired binding is deleted after retention",
+			expiredFor:           48 * time.Hour,
+			expectBindingDeleted: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			binding := genGroupProjectBinding("group-project-binding", "external-group", "owners", "test")
+			binding.Spec.ExpiresAt = &metav1.Time{Time: time.Now().Add(-tc.expiredFor)}
+
+			clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "kubermatic:usersshkeys:owners:group-project-binding",
+					Labels: map[string]string{
+						kubermaticv1.AuthZGroupProjectBindingLabel: binding.Name,
+						kubermaticv1.AuthZRoleLabel:                "owners",
+					},
+				},
+			}
+
+			roleBinding := &rbacv1.RoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "kubermatic:addons:owners:group-project-binding",
+					Namespace: "cluster-fake",
+					Labels: map[string]string{
+						kubermaticv1.AuthZGroupProjectBindingLabel: binding.Name,
+						kubermaticv1.AuthZRoleLabel:                "owners",
+					},
+				},
+			}
+
+			client := fake.NewClientBuilder().
+				WithObjects(generateProject("test"), binding, clusterRoleBinding, roleBinding).
+				Build()
+
+			r := &Reconciler{
+				log:              kubermaticlog.Logger,
+				recorder:         &events.FakeRecorder{},
+				Client:           client,
+				expiredRetention: 24 * time.Hour,
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: binding.Name}}
+			if _, err := r.Reconcile(ctx, request); err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+
+			if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(clusterRoleBinding), &rbacv1.ClusterRoleBinding{}); !apierrors.IsNotFound(err) {
+				t.Errorf("expected ClusterRoleBinding to be revoked, but got: %v", err)
+			}
+
+			if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(roleBinding), &rbacv1.RoleBinding{}); !apierrors.IsNotFound(err) {
+				t.Errorf("expected RoleBinding to be revoked, but got: %v", err)
+			}
+
+			err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(binding), &kubermaticv1.GroupProjectBinding{})
+			if deleted := apierrors.IsNotFound(err); deleted != tc.expectBindingDeleted {
+				t.Errorf("expected GroupProjectBinding deleted = %v, but got: %v", tc.expectBindingDeleted, err)
+			}
+		})
+	}
+}
+
+func TestReconcileSuspendedProject(t *testing.T) {
+	ctx := context.Background()
+
+	project := generateProject("test")
+	project.Spec.Suspension = &kubermaticv1.ProjectSuspension{Reason: "budget exhausted"}
+
+	binding := genGroupProjectBinding("group-project-binding", "external-group", "editors", project.Name)
+
+	clusterRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "kubermatic:usersshkeys:editors",
+			Labels: map[string]string{
+				kubermaticv1.AuthZRoleLabel: "editors",
+			},
+		},
+	}
+
+	// binding created before the project was suspended
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "kubermatic:usersshkeys:editors:group-project-binding",
+			Labels: map[string]string{
+				kubermaticv1.AuthZGroupProjectBindingLabel: binding.Name,
+				kubermaticv1.AuthZRoleLabel:                "editors",
+			},
+		},
+	}
+
+	client := fake.NewClientBuilder().
+		WithObjects(project, binding, clusterRole, clusterRoleBinding).
+		Build()
+
+	r := &Reconciler{
+		log:      kubermaticlog.Logger,
+		recorder: &events.FakeRecorder{},
+		Client:   client,
+	}
+
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: binding.Name}}
+	if _, err := r.Reconcile(ctx, request); err != nil {
+		t.Fatalf("reconciling failed: %v", err)
+	}
+
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(clusterRoleBinding), &rbacv1.ClusterRoleBinding{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected ClusterRoleBinding to be revoked while the project is suspended, but got: %v", err)
+	}
+
+	// resume the project
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(project), project); err != nil {
+		t.Fatalf("failed to get project: %v", err)
+	}
+	project.Spec.Suspension = nil
+	if err := client.Update(ctx, project); err != nil {
+		t.Fatalf("failed to resume project: %v", err)
+	}
+
+	if _, err := r.Reconcile(ctx, request); err != nil {
+		t.Fatalf("reconciling failed: %v", err)
+	}
+
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(clusterRoleBinding), &rbacv1.ClusterRoleBinding{}); err != nil {
+		t.Fatalf("expected ClusterRoleBinding to be restored after the project was resumed, but got: %v", err)
+	}
+}
+
 func generateProject(name string) *kubermaticv1.Project {
 	project := &kubermaticv1.Project{
 		ObjectMeta: metav1.ObjectMeta{
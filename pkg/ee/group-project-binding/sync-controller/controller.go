@@ -28,6 +28,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -111,6 +112,16 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
 	}
 
+	// expired bindings are kept on the master for auditing, but must not grant access on the seeds anymore
+	now := time.Now()
+	if groupProjectBinding.Spec.IsExpired(now) {
+		if err := r.syncAllSeeds(log, groupProjectBinding, deleteExpiredFromSeed(ctx)); err != nil {
+			r.recorder.Eventf(groupProjectBinding, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+			return reconcile.Result{}, fmt.Errorf("failed to remove expired groupprojectbinding '%s' from seeds: %w", groupProjectBinding.Name, err)
+		}
+		return reconcile.Result{}, nil
+	}
+
 	groupProjectBindingReconcilerFactories := []reconciling.NamedGroupProjectBindingReconcilerFactory{
 		groupProjectBindingReconcilerFactory(groupProjectBinding),
 	}
@@ -124,25 +135,46 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile groupprojectbinding '%s': %w", groupProjectBinding.Name, err)
 	}
 
+	if groupProjectBinding.Spec.ExpiresAt != nil {
+		return reconcile.Result{RequeueAfter: groupProjectBinding.Spec.ExpiresAt.Sub(now)}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
 func (r *reconciler) handleDeletion(ctx context.Context, log *zap.SugaredLogger, groupProjectBinding *kubermaticv1.GroupProjectBinding) error {
-	err := r.syncAllSeeds(log, groupProjectBinding,
-		func(seedClusterClient ctrlruntimeclient.Client, groupProjectBinding *kubermaticv1.GroupProjectBinding) error {
-			if err := seedClusterClient.Delete(ctx, groupProjectBinding); err != nil {
-				return ctrlruntimeclient.IgnoreNotFound(err)
-			}
+	if err := r.syncAllSeeds(log, groupProjectBinding, deleteFromSeed(ctx)); err != nil {
+		return err
+	}
+
+	return kuberneteshelper.TryRemoveFinalizer(ctx, r.masterClient, groupProjectBinding, cleanupFinalizer)
+}
 
+// deleteExpiredFromSeed removes the copy of an expired binding from a seed, unless the seed
+// is also the master cluster.
+func deleteExpiredFromSeed(ctx context.Context) actionFunc {
+	return func(seedClusterClient ctrlruntimeclient.Client, groupProjectBinding *kubermaticv1.GroupProjectBinding) error {
+		seedBinding := &kubermaticv1.GroupProjectBinding{}
+		if err := seedClusterClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(groupProjectBinding), seedBinding); err != nil {
+			return ctrlruntimeclient.IgnoreNotFound(err)
+		}
+
+		if seedBinding.UID == groupProjectBinding.UID {
 			return nil
-		},
-	)
+		}
 
-	if err != nil {
-		return err
+		return ctrlruntimeclient.IgnoreNotFound(seedClusterClient.Delete(ctx, seedBinding))
 	}
+}
 
-	return kuberneteshelper.TryRemoveFinalizer(ctx, r.masterClient, groupProjectBinding, cleanupFinalizer)
+func deleteFromSeed(ctx context.Context) actionFunc {
+	return func(seedClusterClient ctrlruntimeclient.Client, groupProjectBinding *kubermaticv1.GroupProjectBinding) error {
+		if err := seedClusterClient.Delete(ctx, groupProjectBinding); err != nil {
+			return ctrlruntimeclient.IgnoreNotFound(err)
+		}
+
+		return nil
+	}
 }
 
 type actionFunc func(seedClusterClient ctrlruntimeclient.Client, groupProjectBinding *kubermaticv1.GroupProjectBinding) error
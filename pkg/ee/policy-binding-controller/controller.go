@@ -29,6 +29,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 
 	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
 	"go.uber.org/zap"
@@ -190,10 +191,37 @@ func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, err
 	}
 
-	return reconcile.Result{}, nil
+	// Come back once the observation window of a preview has elapsed to report the would-be violations.
+	return reconcile.Result{RequeueAfter: previewRequeueAfter(binding, time.Now())}, nil
+}
+
+// ReconcileExternalClusterBinding applies a PolicyBinding of an ExternalCluster. The binding is stored on the
+// master in the namespace of the external cluster and applied to the cluster using clusterClient, as there is no
+// user cluster controller manager for external clusters. It returns the duration after which the binding should
+// be reconciled again to complete a preview, or zero.
+func ReconcileExternalClusterBinding(ctx context.Context, log *zap.SugaredLogger, masterClient, clusterClient ctrlruntimeclient.Client, recorder events.EventRecorder, binding *kubermaticv1.PolicyBinding, cluster *kubermaticv1.ExternalCluster) (time.Duration, error) {
+	r := &reconciler{
+		seedClient:  masterClient,
+		userClient:  clusterClient,
+		log:         log,
+		recorder:    recorder,
+		namespace:   cluster.GetNamespaceName(),
+		clusterName: cluster.Name,
+	}
+
+	if err := r.reconcileBinding(ctx, log, binding, cluster.Spec.IsPoliciesEnabled(), cluster.GetDeletionTimestamp() != nil); err != nil {
+		r.recorder.Eventf(binding, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+		return 0, err
+	}
+
+	return previewRequeueAfter(binding, time.Now()), nil
 }
 
 func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, binding *kubermaticv1.PolicyBinding, cluster *kubermaticv1.Cluster) error {
+	return r.reconcileBinding(ctx, log, binding, cluster.Spec.IsKyvernoEnabled(), cluster.GetDeletionTimestamp() != nil)
+}
+
+func (r *reconciler) reconcileBinding(ctx context.Context, log *zap.SugaredLogger, binding *kubermaticv1.PolicyBinding, kyvernoEnabled, clusterDeleting bool) error {
 	// Keep a copy of the original binding for status patching.
 	oldBinding := binding.DeepCopy()
 	defer func() {
@@ -203,7 +231,7 @@ func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, bind
 	}()
 
 	// Handle cleanup when Kyverno is disabled or cluster is being deleted.
-	if !cluster.Spec.IsKyvernoEnabled() {
+	if !kyvernoEnabled {
 		return r.markBindingInactiveAndCleanup(ctx, binding,
 			kubermaticv1.PolicyBindingReasonKyvernoDisabled,
 			"Kyverno resources have been deleted because Kyverno is disabled",
@@ -211,7 +239,7 @@ func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, bind
 		)
 	}
 
-	if cluster.GetDeletionTimestamp() != nil {
+	if clusterDeleting {
 		return r.markBindingInactiveAndCleanup(ctx, binding,
 			kubermaticv1.PolicyBindingReasonDeleting,
 			"Kyverno resources have been deleted because the cluster is being deleted",
@@ -275,10 +303,18 @@ func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, bind
 		return reconcileErr
 	}
 
-	binding.SetCondition(kubermaticv1.PolicyBindingConditionKyvernoPolicyApplied, metav1.ConditionTrue, kubermaticv1.PolicyBindingReasonPolicyApplied, "Kyverno Policy successfully created/updated")
+	if binding.InPreview() {
+		binding.SetCondition(kubermaticv1.PolicyBindingConditionKyvernoPolicyApplied, metav1.ConditionTrue, kubermaticv1.PolicyBindingReasonPreview, "Kyverno Policy successfully created/updated in Audit mode for preview")
+	} else {
+		binding.SetCondition(kubermaticv1.PolicyBindingConditionKyvernoPolicyApplied, metav1.ConditionTrue, kubermaticv1.PolicyBindingReasonPolicyApplied, "Kyverno Policy successfully created/updated")
+	}
 	binding.SetCondition(kubermaticv1.PolicyBindingConditionReady, metav1.ConditionTrue, kubermaticv1.PolicyBindingReasonReady, "PolicyBinding is ready")
 	binding.SetStatusFields(template, true)
 
+	if updatePreviewStatus(binding, time.Now()) {
+		r.reportPreviewCompletion(binding)
+	}
+
 	return nil
 }
 
@@ -381,6 +417,9 @@ func (r *reconciler) kyvernoClusterPolicyFactory(template *kubermaticv1.PolicyTe
 			if err := json.Unmarshal(template.Spec.PolicySpec.Raw, &spec); err != nil {
 				return nil, fmt.Errorf("failed to unmarshal policySpec for ClusterPolicy %s: %w", template.Name, err)
 			}
+			if binding.InPreview() {
+				auditSpec(&spec)
+			}
 			cp.Spec = spec
 			return cp, nil
 		}
@@ -416,6 +455,9 @@ func (r *reconciler) kyvernoPolicyFactory(template *kubermaticv1.PolicyTemplate,
 			if err := json.Unmarshal(template.Spec.PolicySpec.Raw, &spec); err != nil {
 				return nil, fmt.Errorf("failed to unmarshal policySpec for Policy %s: %w", template.Name, err)
 			}
+			if binding.InPreview() {
+				auditSpec(&spec)
+			}
 			p.Spec = spec
 			return p, nil
 		}
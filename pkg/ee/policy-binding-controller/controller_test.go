@@ -118,6 +118,46 @@ func TestReconcile(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name: "preview deploys ClusterPolicy in Audit mode",
+			binding: func() *kubermaticv1.PolicyBinding {
+				binding := genPolicyBinding(testPolicyName, testClusterNamespace, testPolicyName)
+				binding.Spec.Preview = &kubermaticv1.PolicyBindingPreview{}
+				return binding
+			}(),
+			template: func() *kubermaticv1.PolicyTemplate {
+				template := genPolicyTemplate(testPolicyName, false)
+				template.Spec.PolicySpec.Raw = []byte(`{"validationFailureAction":"Enforce","rules":[{"name":"check","validate":{"failureAction":"Enforce","message":"denied"}}]}`)
+				return template
+			}(),
+			cluster: genCluster(testClusterName, true),
+			validate: func(t *testing.T, seedClient, userClient ctrlruntimeclient.Client, binding *kubermaticv1.PolicyBinding) error {
+				ctx := context.Background()
+
+				clusterPolicy := &kyvernov1.ClusterPolicy{}
+				if err := userClient.Get(ctx, ctrlruntimeclient.ObjectKey{Name: testPolicyName}, clusterPolicy); err != nil {
+					return fmt.Errorf("ClusterPolicy should be created: %w", err)
+				}
+
+				if clusterPolicy.Spec.ValidationFailureAction != kyvernov1.Audit {
+					return fmt.Errorf("ClusterPolicy should be in Audit mode, got %q", clusterPolicy.Spec.ValidationFailureAction)
+				}
+				if action := clusterPolicy.Spec.Rules[0].Validation.FailureAction; action == nil || *action != kyvernov1.Audit {
+					return fmt.Errorf("ClusterPolicy rule should be in Audit mode, got %v", action)
+				}
+
+				appliedCondition := getCondition(binding, kubermaticv1.PolicyBindingConditionKyvernoPolicyApplied)
+				if appliedCondition == nil || appliedCondition.Reason != kubermaticv1.PolicyBindingReasonPreview {
+					return fmt.Errorf("KyvernoPolicyApplied reason should be %s, got %v", kubermaticv1.PolicyBindingReasonPreview, appliedCondition)
+				}
+
+				if binding.Status.Preview == nil || binding.Status.Preview.Phase != kubermaticv1.PolicyBindingPreviewObserving {
+					return fmt.Errorf("preview should be observing, got %v", binding.Status.Preview)
+				}
+
+				return nil
+			},
+		},
 		{
 			name:     "namespaced policy without namespace remains inactive",
 			binding:  genPolicyBinding(testPolicyName, testClusterNamespace, testPolicyName),
@@ -29,5 +29,8 @@ The controller watches PolicyBinding resources within the cluster namespace in t
 It manages Kyverno ClusterPolicies for cluster-wide policies and Kyverno Policies for namespace-scoped policies.
 It also manages the cleanup of stale Kyverno resources for PolicyBindings that are deleted or
 have had their PolicyTemplate changed.
+PolicyBindings in preview have their Kyverno policies deployed in Audit mode; once the
+observation window has elapsed, the would-be violations are recorded in the status until
+the preview is promoted and the policy is deployed as configured in the PolicyTemplate.
 */
 package policybindingcontroller
@@ -0,0 +1,125 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package policybindingcontroller
+
+import (
+	"time"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// auditSpec switches all validation and image verification rules of the given Kyverno
+// policy spec to Audit mode, so that violations are reported but never block requests.
+func auditSpec(spec *kyvernov1.Spec) {
+	spec.ValidationFailureAction = kyvernov1.Audit
+	spec.ValidationFailureActionOverrides = nil
+
+	for i := range spec.Rules {
+		rule := &spec.Rules[i]
+
+		if rule.Validation != nil {
+			rule.Validation.FailureAction = ptr.To(kyvernov1.Audit)
+			rule.Validation.FailureActionOverrides = nil
+		}
+
+		for j := range rule.VerifyImages {
+			rule.VerifyImages[j].FailureAction = ptr.To(kyvernov1.Audit)
+			// Kyverno rejects digest mutation for image verification rules in Audit mode.
+			rule.VerifyImages[j].MutateDigest = false
+		}
+	}
+}
+
+// updatePreviewStatus advances the preview status of the binding. It returns true if
+// the observation window elapsed during this reconciliation.
+func updatePreviewStatus(binding *kubermaticv1.PolicyBinding, now time.Time) bool {
+	preview := binding.Spec.Preview
+	status := binding.Status.Preview
+
+	switch {
+	case preview == nil:
+		binding.Status.Preview = nil
+
+	case preview.Promote:
+		// A binding that was promoted without ever being previewed has nothing to report.
+		if status != nil {
+			status.Phase = kubermaticv1.PolicyBindingPreviewPromoted
+		}
+
+	case status == nil || status.Phase == kubermaticv1.PolicyBindingPreviewPromoted:
+		binding.Status.Preview = &kubermaticv1.PolicyBindingPreviewStatus{
+			Phase:     kubermaticv1.PolicyBindingPreviewObserving,
+			StartTime: metav1.NewTime(now),
+		}
+
+	case status.Phase == kubermaticv1.PolicyBindingPreviewObserving:
+		if now.Before(status.StartTime.Add(preview.GetObservationWindow())) {
+			return false
+		}
+
+		var violations int32
+		if binding.Status.PolicyReport != nil {
+			violations = binding.Status.PolicyReport.Fail
+		}
+
+		status.Phase = kubermaticv1.PolicyBindingPreviewCompleted
+		status.CompletionTime = ptr.To(metav1.NewTime(now))
+		status.Violations = ptr.To(violations)
+
+		return true
+	}
+
+	return false
+}
+
+// previewRequeueAfter returns the time until the observation window of the binding
+// elapses, or zero if the binding is not observing.
+func previewRequeueAfter(binding *kubermaticv1.PolicyBinding, now time.Time) time.Duration {
+	status := binding.Status.Preview
+	if !binding.InPreview() || status == nil || status.Phase != kubermaticv1.PolicyBindingPreviewObserving {
+		return 0
+	}
+
+	return max(status.StartTime.Add(binding.Spec.Preview.GetObservationWindow()).Sub(now), time.Second)
+}
+
+// reportPreviewCompletion emits an event with the would-be violations of a completed preview.
+func (r *reconciler) reportPreviewCompletion(binding *kubermaticv1.PolicyBinding) {
+	violations := ptr.Deref(binding.Status.Preview.Violations, 0)
+	if violations == 0 {
+		r.recorder.Eventf(binding, nil, corev1.EventTypeNormal, "PolicyPreviewCompleted", "Reconciling",
+			"Observation window elapsed without violations, the policy can be promoted")
+		return
+	}
+
+	r.recorder.Eventf(binding, nil, corev1.EventTypeWarning, "PolicyPreviewCompleted", "Reconciling",
+		"Observation window elapsed, %d resource(s) would have been blocked if the policy had been enforced", violations)
+}
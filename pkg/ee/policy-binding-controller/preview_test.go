@@ -0,0 +1,202 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package policybindingcontroller
+
+import (
+	"testing"
+	"time"
+
+	kyvernov1 "github.com/kyverno/kyverno/api/kyverno/v1"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestAuditSpec(t *testing.T) {
+	spec := kyvernov1.Spec{
+		ValidationFailureAction: kyvernov1.Enforce,
+		ValidationFailureActionOverrides: []kyvernov1.ValidationFailureActionOverride{
+			{Action: kyvernov1.Enforce, Namespaces: []string{"default"}},
+		},
+		Rules: []kyvernov1.Rule{
+			{
+				Name: "validate",
+				Validation: &kyvernov1.Validation{
+					FailureAction: ptr.To(kyvernov1.Enforce),
+					FailureActionOverrides: []kyvernov1.ValidationFailureActionOverride{
+						{Action: kyvernov1.Enforce, Namespaces: []string{"default"}},
+					},
+					Message: "denied",
+				},
+			},
+			{
+				Name: "verify-images",
+				VerifyImages: []kyvernov1.ImageVerification{
+					{FailureAction: ptr.To(kyvernov1.Enforce), MutateDigest: true},
+				},
+			},
+			{
+				Name: "mutate",
+			},
+		},
+	}
+
+	expected := kyvernov1.Spec{
+		ValidationFailureAction: kyvernov1.Audit,
+		Rules: []kyvernov1.Rule{
+			{
+				Name: "validate",
+				Validation: &kyvernov1.Validation{
+					FailureAction: ptr.To(kyvernov1.Audit),
+					Message:       "denied",
+				},
+			},
+			{
+				Name: "verify-images",
+				VerifyImages: []kyvernov1.ImageVerification{
+					{FailureAction: ptr.To(kyvernov1.Audit)},
+				},
+			},
+			{
+				Name: "mutate",
+			},
+		},
+	}
+
+	auditSpec(&spec)
+
+	if !diff.SemanticallyEqual(expected, spec) {
+		t.Fatalf("spec differs:\n%v", diff.ObjectDiff(expected, spec))
+	}
+}
+
+func TestUpdatePreviewStatus(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	started := metav1.NewTime(now.Add(-2 * time.Hour))
+
+	testCases := []struct {
+		name              string
+		preview           *kubermaticv1.PolicyBindingPreview
+		status            *kubermaticv1.PolicyBindingPreviewStatus
+		report            *kubermaticv1.PolicyReportSummary
+		expectedStatus    *kubermaticv1.PolicyBindingPreviewStatus
+		expectedCompleted bool
+		expectedRequeue   time.Duration
+	}{
+		{
+			name: "no preview",
+		},
+		{
+			name: "preview removed",
+			status: &kubermaticv1.PolicyBindingPreviewStatus{
+				Phase:     kubermaticv1.PolicyBindingPreviewObserving,
+				StartTime: started,
+			},
+		},
+		{
+			name:    "preview starts observing",
+			preview: &kubermaticv1.PolicyBindingPreview{},
+			expectedStatus: &kubermaticv1.PolicyBindingPreviewStatus{
+				Phase:     kubermaticv1.PolicyBindingPreviewObserving,
+				StartTime: metav1.NewTime(now),
+			},
+			expectedRequeue: kubermaticv1.DefaultPolicyBindingPreviewObservationWindow,
+		},
+		{
+			name:    "preview keeps observing within the window",
+			preview: &kubermaticv1.PolicyBindingPreview{ObservationWindow: &metav1.Duration{Duration: 3 * time.Hour}},
+			status: &kubermaticv1.PolicyBindingPreviewStatus{
+				Phase:     kubermaticv1.PolicyBindingPreviewObserving,
+				StartTime: started,
+			},
+			expectedStatus: &kubermaticv1.PolicyBindingPreviewStatus{
+				Phase:     kubermaticv1.PolicyBindingPreviewObserving,
+				StartTime: started,
+			},
+			expectedRequeue: time.Hour,
+		},
+		{
+			name:    "preview reports violations after the window",
+			preview: &kubermaticv1.PolicyBindingPreview{ObservationWindow: &metav1.Duration{Duration: time.Hour}},
+			status: &kubermaticv1.PolicyBindingPreviewStatus{
+				Phase:     kubermaticv1.PolicyBindingPreviewObserving,
+				StartTime: started,
+			},
+			report: &kubermaticv1.PolicyReportSummary{Pass: 10, Fail: 3},
+			expectedStatus: &kubermaticv1.PolicyBindingPreviewStatus{
+				Phase:          kubermaticv1.PolicyBindingPreviewCompleted,
+				StartTime:      started,
+				CompletionTime: ptr.To(metav1.NewTime(now)),
+				Violations:     ptr.To[int32](3),
+			},
+			expectedCompleted: true,
+		},
+		{
+			name:    "preview is promoted",
+			preview: &kubermaticv1.PolicyBindingPreview{Promote: true},
+			status: &kubermaticv1.PolicyBindingPreviewStatus{
+				Phase:          kubermaticv1.PolicyBindingPreviewCompleted,
+				StartTime:      started,
+				CompletionTime: ptr.To(started),
+				Violations:     ptr.To[int32](0),
+			},
+			expectedStatus: &kubermaticv1.PolicyBindingPreviewStatus{
+				Phase:          kubermaticv1.PolicyBindingPreviewPromoted,
+				StartTime:      started,
+				CompletionTime: ptr.To(started),
+				Violations:     ptr.To[int32](0),
+			},
+		},
+		{
+			name:    "promoted without preview",
+			preview: &kubermaticv1.PolicyBindingPreview{Promote: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			binding := genPolicyBinding(testPolicyName, testClusterNamespace, testPolicyName)
+			binding.Spec.Preview = tc.preview
+			binding.Status.Preview = tc.status
+			binding.Status.PolicyReport = tc.report
+
+			completed := updatePreviewStatus(binding, now)
+			if completed != tc.expectedCompleted {
+				t.Errorf("expected completed to be %v, got %v", tc.expectedCompleted, completed)
+			}
+
+			if !diff.SemanticallyEqual(tc.expectedStatus, binding.Status.Preview) {
+				t.Errorf("preview status differs:\n%v", diff.ObjectDiff(tc.expectedStatus, binding.Status.Preview))
+			}
+
+			if requeue := previewRequeueAfter(binding, now); requeue != tc.expectedRequeue {
+				t.Errorf("expected requeue after %v, got %v", tc.expectedRequeue, requeue)
+			}
+		})
+	}
+}
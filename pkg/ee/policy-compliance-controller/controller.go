@@ -0,0 +1,289 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package policycompliancecontroller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	k8cequality "k8c.io/kubermatic/sdk/v2/apis/equality"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const ControllerName = "kkp-policy-compliance-controller"
+
+type reconciler struct {
+	log          *zap.SugaredLogger
+	recorder     events.EventRecorder
+	masterClient ctrlruntimeclient.Client
+	seedClients  kuberneteshelper.SeedClientMap
+	now          func() time.Time
+}
+
+func Add(
+	masterManager manager.Manager,
+	seedManagers map[string]manager.Manager,
+	log *zap.SugaredLogger,
+	numWorkers int,
+) error {
+	mustRegisterMetrics()
+
+	r := &reconciler{
+		log:          log.Named(ControllerName),
+		recorder:     masterManager.GetEventRecorder(ControllerName),
+		masterClient: masterManager.GetClient(),
+		seedClients:  kuberneteshelper.SeedClientMap{},
+		now:          time.Now,
+	}
+
+	bldr := builder.ControllerManagedBy(masterManager).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: numWorkers,
+		}).
+		For(&kubermaticv1.Project{}, builder.WithPredicates(predicate.GenerationChangedPredicate{}))
+
+	for seedName, seedManager := range seedManagers {
+		seedClient := seedManager.GetClient()
+		r.seedClients[seedName] = seedClient
+
+		bldr.WatchesRawSource(source.Kind(
+			seedManager.GetCache(),
+			&kubermaticv1.PolicyBinding{},
+			handler.TypedEnqueueRequestsFromMapFunc(mapPolicyBindingToProject(seedClient, r.log)),
+			policyReportChangedPredicate(),
+		))
+	}
+
+	_, err := bldr.Build(r)
+
+	return err
+}
+
+// policyReportChangedPredicate filters PolicyBinding updates that do not change the
+// policy report summary, as the binding status is updated frequently.
+func policyReportChangedPredicate() predicate.TypedPredicate[*kubermaticv1.PolicyBinding] {
+	return predicate.TypedFuncs[*kubermaticv1.PolicyBinding]{
+		UpdateFunc: func(e event.TypedUpdateEvent[*kubermaticv1.PolicyBinding]) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return false
+			}
+
+			return !k8cequality.Semantic.DeepEqual(e.ObjectOld.Status.PolicyReport, e.ObjectNew.Status.PolicyReport) ||
+				e.ObjectOld.Spec.PolicyTemplateRef.Name != e.ObjectNew.Spec.PolicyTemplateRef.Name
+		},
+	}
+}
+
+func mapPolicyBindingToProject(seedClient ctrlruntimeclient.Client, log *zap.SugaredLogger) func(context.Context, *kubermaticv1.PolicyBinding) []reconcile.Request {
+	return func(ctx context.Context, binding *kubermaticv1.PolicyBinding) []reconcile.Request {
+		clusterName, found := strings.CutPrefix(binding.Namespace, kubernetes.NamespacePrefix)
+		if !found {
+			return nil
+		}
+
+		cluster := &kubermaticv1.Cluster{}
+		if err := seedClient.Get(ctx, types.NamespacedName{Name: clusterName}, cluster); err != nil {
+			if !apierrors.IsNotFound(err) {
+				log.Errorw("Failed to get cluster to map PolicyBinding", "binding", ctrlruntimeclient.ObjectKeyFromObject(binding), zap.Error(err))
+			}
+			return nil
+		}
+
+		projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+		if projectID == "" {
+			return nil
+		}
+
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: projectID}}}
+	}
+}
+
+// Reconcile computes the policy compliance summary of a project.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("project", request.Name)
+	log.Debug("Reconciling")
+
+	project := &kubermaticv1.Project{}
+	if err := r.masterClient.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			deleteMetrics(request.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	if !project.DeletionTimestamp.IsZero() {
+		deleteMetrics(project.Name)
+		return reconcile.Result{}, nil
+	}
+
+	err := r.reconcile(ctx, log, project)
+	if err != nil {
+		r.recorder.Eventf(project, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+	}
+
+	return reconcile.Result{}, err
+}
+
+func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, project *kubermaticv1.Project) error {
+	summary := newComplianceSummary()
+
+	// If a Seed cannot be read, the last complete summary is kept instead of
+	// reporting a partial one.
+	err := r.seedClients.Each(ctx, log, func(_ string, seedClient ctrlruntimeclient.Client, _ *zap.SugaredLogger) error {
+		clusters := &kubermaticv1.ClusterList{}
+		if err := seedClient.List(ctx, clusters, ctrlruntimeclient.MatchingLabels{kubermaticv1.ProjectIDLabelKey: project.Name}); err != nil {
+			return fmt.Errorf("failed to list clusters: %w", err)
+		}
+
+		for _, cluster := range clusters.Items {
+			if cluster.Status.NamespaceName == "" {
+				continue
+			}
+
+			bindings := &kubermaticv1.PolicyBindingList{}
+			if err := seedClient.List(ctx, bindings, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
+				return fmt.Errorf("failed to list PolicyBindings of cluster %s: %w", cluster.Name, err)
+			}
+			summary.addCluster(bindings.Items)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	status := summary.status()
+	setMetrics(project.Name, status)
+
+	current := project.Status.PolicyCompliance.DeepCopy()
+	if current != nil && status != nil {
+		current.LastUpdateTime = status.LastUpdateTime
+	}
+	if k8cequality.Semantic.DeepEqual(current, status) {
+		return nil
+	}
+
+	if status != nil {
+		status.LastUpdateTime = metav1.NewTime(r.now())
+	}
+
+	oldProject := project.DeepCopy()
+	project.Status.PolicyCompliance = status
+	return r.masterClient.Status().Patch(ctx, project, ctrlruntimeclient.MergeFrom(oldProject))
+}
+
+type complianceSummary struct {
+	clusters             int32
+	nonCompliantClusters int32
+	results              kubermaticv1.PolicyReportSummary
+	templates            map[string]*kubermaticv1.PolicyTemplateComplianceStatus
+}
+
+func newComplianceSummary() *complianceSummary {
+	return &complianceSummary{
+		templates: map[string]*kubermaticv1.PolicyTemplateComplianceStatus{},
+	}
+}
+
+// addCluster adds the policy report summaries of the PolicyBindings of a single cluster.
+// Clusters without any reported results are not counted.
+func (s *complianceSummary) addCluster(bindings []kubermaticv1.PolicyBinding) {
+	reporting := false
+	compliant := true
+
+	for _, binding := range bindings {
+		report := binding.Status.PolicyReport
+		if report == nil {
+			continue
+		}
+		reporting = true
+
+		name := binding.Spec.PolicyTemplateRef.Name
+		template, ok := s.templates[name]
+		if !ok {
+			template = &kubermaticv1.PolicyTemplateComplianceStatus{Name: name}
+			s.templates[name] = template
+		}
+
+		template.Results.Add(*report)
+		s.results.Add(*report)
+		if !report.Compliant() {
+			template.NonCompliantClusters++
+			compliant = false
+		}
+	}
+
+	if reporting {
+		s.clusters++
+		if !compliant {
+			s.nonCompliantClusters++
+		}
+	}
+}
+
+func (s *complianceSummary) status() *kubermaticv1.ProjectPolicyComplianceStatus {
+	if s.clusters == 0 {
+		return nil
+	}
+
+	status := &kubermaticv1.ProjectPolicyComplianceStatus{
+		Clusters:             s.clusters,
+		NonCompliantClusters: s.nonCompliantClusters,
+		Results:              s.results,
+	}
+	for _, template := range s.templates {
+		status.Templates = append(status.Templates, *template)
+	}
+	sort.Slice(status.Templates, func(i, j int) bool {
+		return status.Templates[i].Name < status.Templates[j].Name
+	})
+
+	return status
+}
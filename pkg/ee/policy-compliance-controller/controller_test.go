@@ -0,0 +1,196 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package policycompliancecontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const projectID = "my-project"
+
+func TestReconcile(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		seedObjects   map[string][]ctrlruntimeclient.Object
+		currentStatus *kubermaticv1.ProjectPolicyComplianceStatus
+		expected      *kubermaticv1.ProjectPolicyComplianceStatus
+	}{
+		{
+			name: "no policy reports",
+			seedObjects: map[string][]ctrlruntimeclient.Object{
+				"europe": {
+					genCluster("cluster-a", projectID),
+					genPolicyBinding("cluster-a", "disallow-latest-tag", nil),
+				},
+			},
+		},
+		{
+			name: "aggregates clusters across seeds",
+			seedObjects: map[string][]ctrlruntimeclient.Object{
+				"europe": {
+					genCluster("cluster-a", projectID),
+					genPolicyBinding("cluster-a", "disallow-latest-tag", &kubermaticv1.PolicyReportSummary{Pass: 5, Fail: 1}),
+					genPolicyBinding("cluster-a", "require-labels", &kubermaticv1.PolicyReportSummary{Pass: 3, Warn: 2}),
+					genCluster("cluster-b", projectID),
+					genPolicyBinding("cluster-b", "disallow-latest-tag", &kubermaticv1.PolicyReportSummary{Pass: 2}),
+					genCluster("cluster-other", "other-project"),
+					genPolicyBinding("cluster-other", "disallow-latest-tag", &kubermaticv1.PolicyReportSummary{Fail: 10}),
+				},
+				"asia": {
+					genCluster("cluster-c", projectID),
+					genPolicyBinding("cluster-c", "require-labels", &kubermaticv1.PolicyReportSummary{Fail: 2, Error: 1}),
+				},
+			},
+			expected: &kubermaticv1.ProjectPolicyComplianceStatus{
+				Clusters:             3,
+				NonCompliantClusters: 2,
+				Results:              kubermaticv1.PolicyReportSummary{Pass: 10, Fail: 3, Warn: 2, Error: 1},
+				Templates: []kubermaticv1.PolicyTemplateComplianceStatus{
+					{
+						Name:                 "disallow-latest-tag",
+						NonCompliantClusters: 1,
+						Results:              kubermaticv1.PolicyReportSummary{Pass: 7, Fail: 1},
+					},
+					{
+						Name:                 "require-labels",
+						NonCompliantClusters: 1,
+						Results:              kubermaticv1.PolicyReportSummary{Pass: 3, Fail: 2, Warn: 2, Error: 1},
+					},
+				},
+				LastUpdateTime: metav1.NewTime(now),
+			},
+		},
+		{
+			name: "unchanged summary keeps update time",
+			seedObjects: map[string][]ctrlruntimeclient.Object{
+				"europe": {
+					genCluster("cluster-a", projectID),
+					genPolicyBinding("cluster-a", "disallow-latest-tag", &kubermaticv1.PolicyReportSummary{Pass: 1}),
+				},
+			},
+			currentStatus: &kubermaticv1.ProjectPolicyComplianceStatus{
+				Clusters: 1,
+				Results:  kubermaticv1.PolicyReportSummary{Pass: 1},
+				Templates: []kubermaticv1.PolicyTemplateComplianceStatus{
+					{Name: "disallow-latest-tag", Results: kubermaticv1.PolicyReportSummary{Pass: 1}},
+				},
+				LastUpdateTime: metav1.NewTime(now.Add(-time.Hour)),
+			},
+			expected: &kubermaticv1.ProjectPolicyComplianceStatus{
+				Clusters: 1,
+				Results:  kubermaticv1.PolicyReportSummary{Pass: 1},
+				Templates: []kubermaticv1.PolicyTemplateComplianceStatus{
+					{Name: "disallow-latest-tag", Results: kubermaticv1.PolicyReportSummary{Pass: 1}},
+				},
+				LastUpdateTime: metav1.NewTime(now.Add(-time.Hour)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			project := &kubermaticv1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: projectID},
+				Status: kubermaticv1.ProjectStatus{
+					Phase:            kubermaticv1.ProjectActive,
+					PolicyCompliance: tc.currentStatus,
+				},
+			}
+			masterClient := fake.NewClientBuilder().WithObjects(project).Build()
+
+			seedClients := kuberneteshelper.SeedClientMap{}
+			for seed, objects := range tc.seedObjects {
+				seedClients[seed] = fake.NewClientBuilder().WithObjects(objects...).Build()
+			}
+
+			r := &reconciler{
+				log:          kubermaticlog.Logger,
+				recorder:     &events.FakeRecorder{},
+				masterClient: masterClient,
+				seedClients:  seedClients,
+				now:          func() time.Time { return now },
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: projectID}}
+			if _, err := r.Reconcile(ctx, request); err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+
+			got := &kubermaticv1.Project{}
+			if err := masterClient.Get(ctx, request.NamespacedName, got); err != nil {
+				t.Fatalf("failed to get project: %v", err)
+			}
+			if !diff.SemanticallyEqual(tc.expected, got.Status.PolicyCompliance) {
+				t.Fatalf("policy compliance differs:\n%v", diff.ObjectDiff(tc.expected, got.Status.PolicyCompliance))
+			}
+		})
+	}
+}
+
+func genCluster(name, project string) *kubermaticv1.Cluster {
+	return &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: project},
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: "cluster-" + name,
+		},
+	}
+}
+
+func genPolicyBinding(cluster, template string, summary *kubermaticv1.PolicyReportSummary) *kubermaticv1.PolicyBinding {
+	return &kubermaticv1.PolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      template,
+			Namespace: "cluster-" + cluster,
+		},
+		Spec: kubermaticv1.PolicyBindingSpec{
+			PolicyTemplateRef: corev1.ObjectReference{Name: template},
+		},
+		Status: kubermaticv1.PolicyBindingStatus{
+			PolicyReport: summary,
+		},
+	}
+}
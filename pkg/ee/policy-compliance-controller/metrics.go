@@ -0,0 +1,99 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package policycompliancecontroller
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+var (
+	registerMetrics sync.Once
+
+	clusters = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "project_policy",
+		Name:      "clusters",
+		Help:      "The number of clusters in the project that report policy results",
+	}, []string{"project"})
+
+	nonCompliantClusters = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "project_policy",
+		Name:      "non_compliant_clusters",
+		Help:      "The number of clusters in the project with at least one failed policy result",
+	}, []string{"project"})
+
+	templateResults = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "project_policy",
+		Name:      "template_results",
+		Help:      "The number of policy report results of a PolicyTemplate in all clusters of the project",
+	}, []string{"project", "policy_template", "result"})
+
+	templateNonCompliantClusters = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "project_policy",
+		Name:      "template_non_compliant_clusters",
+		Help:      "The number of clusters in the project with at least one failed result of a PolicyTemplate",
+	}, []string{"project", "policy_template"})
+)
+
+func mustRegisterMetrics() {
+	registerMetrics.Do(func() {
+		prometheus.MustRegister(clusters, nonCompliantClusters, templateResults, templateNonCompliantClusters)
+	})
+}
+
+func setMetrics(project string, status *kubermaticv1.ProjectPolicyComplianceStatus) {
+	// drop metrics of templates that are no longer bound
+	deleteMetrics(project)
+	if status == nil {
+		return
+	}
+
+	clusters.WithLabelValues(project).Set(float64(status.Clusters))
+	nonCompliantClusters.WithLabelValues(project).Set(float64(status.NonCompliantClusters))
+	for _, template := range status.Templates {
+		templateResults.WithLabelValues(project, template.Name, "pass").Set(float64(template.Results.Pass))
+		templateResults.WithLabelValues(project, template.Name, "fail").Set(float64(template.Results.Fail))
+		templateResults.WithLabelValues(project, template.Name, "warn").Set(float64(template.Results.Warn))
+		templateResults.WithLabelValues(project, template.Name, "error").Set(float64(template.Results.Error))
+		templateResults.WithLabelValues(project, template.Name, "skip").Set(float64(template.Results.Skip))
+		templateNonCompliantClusters.WithLabelValues(project, template.Name).Set(float64(template.NonCompliantClusters))
+	}
+}
+
+func deleteMetrics(project string) {
+	labels := prometheus.Labels{"project": project}
+
+	clusters.DeletePartialMatch(labels)
+	nonCompliantClusters.DeletePartialMatch(labels)
+	templateResults.DeletePartialMatch(labels)
+	templateNonCompliantClusters.DeletePartialMatch(labels)
+}
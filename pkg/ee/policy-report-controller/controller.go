@@ -0,0 +1,215 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package policyreportcontroller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	policyreportv1alpha2 "github.com/kyverno/kyverno/api/policyreport/v1alpha2"
+	"go.uber.org/zap"
+
+	k8cequality "k8c.io/kubermatic/sdk/v2/apis/equality"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	userclustercontrollermanager "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	ControllerName = "kkp-policy-report-controller"
+
+	// kyvernoSource is the source Kyverno sets on the policy report results it owns.
+	kyvernoSource = "kyverno"
+)
+
+type reconciler struct {
+	seedClient ctrlruntimeclient.Client
+	userClient ctrlruntimeclient.Client
+
+	log             *zap.SugaredLogger
+	recorder        events.EventRecorder
+	namespace       string
+	clusterIsPaused userclustercontrollermanager.IsPausedChecker
+}
+
+// Add creates the controller and registers watches. All PolicyBindings of the cluster are
+// updated in a single reconciliation, as every policy report can contain results of many policies.
+func Add(seedMgr, userMgr manager.Manager, log *zap.SugaredLogger, namespace string, clusterIsPaused userclustercontrollermanager.IsPausedChecker) error {
+	r := &reconciler{
+		seedClient:      seedMgr.GetClient(),
+		userClient:      userMgr.GetClient(),
+		log:             log.Named(ControllerName),
+		recorder:        userMgr.GetEventRecorder(ControllerName),
+		namespace:       namespace,
+		clusterIsPaused: clusterIsPaused,
+	}
+
+	request := []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: namespace, Name: ControllerName}}}
+
+	_, err := builder.ControllerManagedBy(userMgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
+		WatchesRawSource(source.Kind(seedMgr.GetCache(), &kubermaticv1.PolicyBinding{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, _ *kubermaticv1.PolicyBinding) []reconcile.Request { return request }),
+			predicate.TypedGenerationChangedPredicate[*kubermaticv1.PolicyBinding]{},
+		)).
+		WatchesRawSource(source.Kind(userMgr.GetCache(), &policyreportv1alpha2.PolicyReport{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, _ *policyreportv1alpha2.PolicyReport) []reconcile.Request { return request }),
+		)).
+		WatchesRawSource(source.Kind(userMgr.GetCache(), &policyreportv1alpha2.ClusterPolicyReport{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, _ *policyreportv1alpha2.ClusterPolicyReport) []reconcile.Request {
+				return request
+			}),
+		)).
+		Build(r)
+
+	return err
+}
+
+// Reconcile aggregates all policy reports of the user cluster into the PolicyBindings.
+func (r *reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("namespace", r.namespace)
+	log.Debug("Reconciling")
+
+	paused, err := r.clusterIsPaused(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to check cluster pause status: %w", err)
+	}
+	if paused {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{}, r.reconcile(ctx, log)
+}
+
+func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger) error {
+	bindings := &kubermaticv1.PolicyBindingList{}
+	if err := r.seedClient.List(ctx, bindings, ctrlruntimeclient.InNamespace(r.namespace)); err != nil {
+		return fmt.Errorf("failed to list PolicyBindings: %w", err)
+	}
+	if len(bindings.Items) == 0 {
+		return nil
+	}
+
+	summaries, err := r.collectResults(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, binding := range bindings.Items {
+		if binding.DeletionTimestamp != nil {
+			continue
+		}
+
+		var summary *kubermaticv1.PolicyReportSummary
+		if s, ok := summaries[binding.Spec.PolicyTemplateRef.Name]; ok {
+			summary = &s
+		}
+		if k8cequality.Semantic.DeepEqual(binding.Status.PolicyReport, summary) {
+			continue
+		}
+
+		oldBinding := binding.DeepCopy()
+		binding.Status.PolicyReport = summary
+		if err := r.seedClient.Status().Patch(ctx, &binding, ctrlruntimeclient.MergeFrom(oldBinding)); err != nil {
+			r.recorder.Eventf(&binding, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+			return fmt.Errorf("failed to update status of PolicyBinding %s: %w", binding.Name, err)
+		}
+		log.Debugw("Updated policy report summary", "binding", binding.Name)
+	}
+
+	return nil
+}
+
+// collectResults counts the Kyverno results of all PolicyReports and ClusterPolicyReports
+// in the user cluster by policy name.
+func (r *reconciler) collectResults(ctx context.Context) (map[string]kubermaticv1.PolicyReportSummary, error) {
+	summaries := map[string]kubermaticv1.PolicyReportSummary{}
+
+	policyReports := &policyreportv1alpha2.PolicyReportList{}
+	if err := r.userClient.List(ctx, policyReports); err != nil {
+		return nil, fmt.Errorf("failed to list PolicyReports: %w", err)
+	}
+	for _, report := range policyReports.Items {
+		addResults(summaries, report.Results)
+	}
+
+	clusterPolicyReports := &policyreportv1alpha2.ClusterPolicyReportList{}
+	if err := r.userClient.List(ctx, clusterPolicyReports); err != nil {
+		return nil, fmt.Errorf("failed to list ClusterPolicyReports: %w", err)
+	}
+	for _, report := range clusterPolicyReports.Items {
+		addResults(summaries, report.Results)
+	}
+
+	return summaries, nil
+}
+
+func addResults(summaries map[string]kubermaticv1.PolicyReportSummary, results []policyreportv1alpha2.PolicyReportResult) {
+	for _, result := range results {
+		if result.Source != "" && result.Source != kyvernoSource {
+			continue
+		}
+
+		name := policyName(result.Policy)
+		summary := summaries[name]
+		switch result.Result {
+		case policyreportv1alpha2.StatusPass:
+			summary.Pass++
+		case policyreportv1alpha2.StatusFail:
+			summary.Fail++
+		case policyreportv1alpha2.StatusWarn:
+			summary.Warn++
+		case policyreportv1alpha2.StatusError:
+			summary.Error++
+		case policyreportv1alpha2.StatusSkip:
+			summary.Skip++
+		default:
+			continue
+		}
+		summaries[name] = summary
+	}
+}
+
+// policyName strips the namespace Kyverno prefixes the names of namespaced policies with.
+// Generated Kyverno policies are named after their PolicyTemplate.
+func policyName(policy string) string {
+	if _, name, found := strings.Cut(policy, "/"); found {
+		return name
+	}
+	return policy
+}
@@ -0,0 +1,189 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package policyreportcontroller
+
+import (
+	"context"
+	"testing"
+
+	policyreportv1alpha2 "github.com/kyverno/kyverno/api/policyreport/v1alpha2"
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const testClusterNamespace = "cluster-test-cluster"
+
+func TestReconcile(t *testing.T) {
+	testCases := []struct {
+		name        string
+		bindings    []*kubermaticv1.PolicyBinding
+		userObjects []ctrlruntimeclient.Object
+		expected    map[string]*kubermaticv1.PolicyReportSummary
+	}{
+		{
+			name:     "no policy reports",
+			bindings: []*kubermaticv1.PolicyBinding{genPolicyBinding("disallow-latest-tag", nil)},
+			expected: map[string]*kubermaticv1.PolicyReportSummary{"disallow-latest-tag": nil},
+		},
+		{
+			name: "aggregates results of namespaced and cluster-wide reports",
+			bindings: []*kubermaticv1.PolicyBinding{
+				genPolicyBinding("disallow-latest-tag", nil),
+				genPolicyBinding("require-labels", nil),
+			},
+			userObjects: []ctrlruntimeclient.Object{
+				genPolicyReport("default",
+					genResult("disallow-latest-tag", policyreportv1alpha2.StatusPass),
+					genResult("disallow-latest-tag", policyreportv1alpha2.StatusFail),
+					genResult("default/require-labels", policyreportv1alpha2.StatusWarn),
+				),
+				genPolicyReport("kube-system",
+					genResult("disallow-latest-tag", policyreportv1alpha2.StatusPass),
+					genResult("disallow-latest-tag", policyreportv1alpha2.StatusSkip),
+				),
+				&policyreportv1alpha2.ClusterPolicyReport{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster-report"},
+					Results: []policyreportv1alpha2.PolicyReportResult{
+						genResult("require-labels", policyreportv1alpha2.StatusError),
+					},
+				},
+			},
+			expected: map[string]*kubermaticv1.PolicyReportSummary{
+				"disallow-latest-tag": {Pass: 2, Fail: 1, Skip: 1},
+				"require-labels":      {Warn: 1, Error: 1},
+			},
+		},
+		{
+			name:     "ignores results of other policy engines",
+			bindings: []*kubermaticv1.PolicyBinding{genPolicyBinding("disallow-latest-tag", nil)},
+			userObjects: []ctrlruntimeclient.Object{
+				genPolicyReport("default",
+					genResult("disallow-latest-tag", policyreportv1alpha2.StatusPass),
+					policyreportv1alpha2.PolicyReportResult{Source: "trivy", Policy: "disallow-latest-tag", Result: policyreportv1alpha2.StatusFail},
+				),
+			},
+			expected: map[string]*kubermaticv1.PolicyReportSummary{
+				"disallow-latest-tag": {Pass: 1},
+			},
+		},
+		{
+			name:     "removes stale summary",
+			bindings: []*kubermaticv1.PolicyBinding{genPolicyBinding("disallow-latest-tag", &kubermaticv1.PolicyReportSummary{Fail: 3})},
+			expected: map[string]*kubermaticv1.PolicyReportSummary{"disallow-latest-tag": nil},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			scheme := fake.NewScheme()
+			if err := policyreportv1alpha2.Install(scheme); err != nil {
+				t.Fatalf("failed to add policy reports to scheme: %v", err)
+			}
+
+			seedObjects := []ctrlruntimeclient.Object{}
+			for _, binding := range tc.bindings {
+				seedObjects = append(seedObjects, binding)
+			}
+
+			seedClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(seedObjects...).
+				WithStatusSubresource(&kubermaticv1.PolicyBinding{}).
+				Build()
+
+			userClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.userObjects...).
+				Build()
+
+			r := &reconciler{
+				seedClient:      seedClient,
+				userClient:      userClient,
+				log:             zap.NewNop().Sugar(),
+				recorder:        &events.FakeRecorder{},
+				namespace:       testClusterNamespace,
+				clusterIsPaused: func(context.Context) (bool, error) { return false, nil },
+			}
+
+			if _, err := r.Reconcile(ctx, reconcile.Request{}); err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+
+			for name, expected := range tc.expected {
+				binding := &kubermaticv1.PolicyBinding{}
+				if err := seedClient.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: testClusterNamespace, Name: name}, binding); err != nil {
+					t.Fatalf("failed to get PolicyBinding: %v", err)
+				}
+				if !diff.SemanticallyEqual(expected, binding.Status.PolicyReport) {
+					t.Fatalf("policy report summary of %s differs:\n%v", name, diff.ObjectDiff(expected, binding.Status.PolicyReport))
+				}
+			}
+		})
+	}
+}
+
+func genPolicyBinding(template string, summary *kubermaticv1.PolicyReportSummary) *kubermaticv1.PolicyBinding {
+	return &kubermaticv1.PolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      template,
+			Namespace: testClusterNamespace,
+		},
+		Spec: kubermaticv1.PolicyBindingSpec{
+			PolicyTemplateRef: corev1.ObjectReference{Name: template},
+		},
+		Status: kubermaticv1.PolicyBindingStatus{
+			PolicyReport: summary,
+		},
+	}
+}
+
+func genPolicyReport(namespace string, results ...policyreportv1alpha2.PolicyReportResult) *policyreportv1alpha2.PolicyReport {
+	return &policyreportv1alpha2.PolicyReport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "report",
+			Namespace: namespace,
+		},
+		Results: results,
+	}
+}
+
+func genResult(policy string, result policyreportv1alpha2.PolicyResult) policyreportv1alpha2.PolicyReportResult {
+	return policyreportv1alpha2.PolicyReportResult{
+		Source: kyvernoSource,
+		Policy: policy,
+		Result: result,
+	}
+}
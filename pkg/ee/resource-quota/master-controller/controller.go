@@ -72,6 +72,8 @@ func Add(mgr manager.Manager,
 	log *zap.SugaredLogger,
 	numWorkers int,
 ) error {
+	mustRegisterMetrics()
+
 	reconciler := &reconciler{
 		log:          log.Named(ControllerName),
 		recorder:     mgr.GetEventRecorder(ControllerName),
@@ -134,6 +136,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	if err := r.masterClient.Get(ctx, request.NamespacedName, resourceQuota); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.Debug("resource quota not found, might be deleted: %w", err)
+			deleteMetrics(request.Name)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, fmt.Errorf("failed to get resource quota: %w", err)
@@ -151,6 +154,7 @@ func (r *reconciler) reconcile(ctx context.Context, resourceQuota *kubermaticv1.
 	// skip reconcile if resourceQuota is in delete state
 	if !resourceQuota.DeletionTimestamp.IsZero() {
 		log.Debug("resource quota is in deletion, skipping")
+		deleteMetrics(resourceQuota.Name)
 		return 0, nil
 	}
 
@@ -178,6 +182,7 @@ func (r *reconciler) reconcile(ctx context.Context, resourceQuota *kubermaticv1.
 		if localUsage.Storage != nil {
 			globalUsage.Storage.Add(*localUsage.Storage)
 		}
+		kubermaticv1.AddObjectCountUsage(globalUsage, localUsage)
 		if acceleratorAccountingActive {
 			kubermaticv1.AddAcceleratorUsage(globalUsage, localUsage.Accelerators)
 		}
@@ -190,13 +195,22 @@ func (r *reconciler) reconcile(ctx context.Context, resourceQuota *kubermaticv1.
 		globalUsage = resourceQuota.Status.GlobalUsage.DeepCopy()
 	}
 	globalAccounting, requeueAfter := r.globalAcceleratorAccounting(resourceQuota, seedResourceQuotas, seedErrors)
-	if err := r.ensureGlobalStatus(ctx, log, resourceQuota, globalUsage, globalAccounting); err != nil {
+	now := r.currentTime()
+	burst := burstStatus(resourceQuota, globalUsage, now)
+	previousUsage := *resourceQuota.Status.GlobalUsage.DeepCopy()
+	previousBurst := resourceQuota.Status.Burst.DeepCopy()
+	if err := r.ensureGlobalStatus(ctx, log, resourceQuota, globalUsage, globalAccounting, burst); err != nil {
 		return 0, err
 	}
+	r.reportLimits(resourceQuota, previousUsage, previousBurst, now)
 	if seedReadError != nil {
 		return 0, seedReadError
 	}
 
+	if burstRequeue := burstRequeueAfter(burst, now); burstRequeue > 0 && (requeueAfter == 0 || burstRequeue < requeueAfter) {
+		requeueAfter = burstRequeue
+	}
+
 	return requeueAfter, nil
 }
 
@@ -220,9 +234,11 @@ func aggregateSeedReadErrors(seedErrors map[string]error) error {
 }
 
 func (r *reconciler) ensureGlobalStatus(ctx context.Context, log *zap.SugaredLogger, resourceQuota *kubermaticv1.ResourceQuota,
-	globalUsage *kubermaticv1.ResourceDetails, globalAccounting *kubermaticv1.ResourceQuotaGlobalAcceleratorAccountingStatus) error {
+	globalUsage *kubermaticv1.ResourceDetails, globalAccounting *kubermaticv1.ResourceQuotaGlobalAcceleratorAccountingStatus,
+	burst *kubermaticv1.ResourceQuotaBurstStatus) error {
 	if k8cequality.Semantic.DeepEqual(*globalUsage, resourceQuota.Status.GlobalUsage) &&
-		k8cequality.Semantic.DeepEqual(globalAccounting, resourceQuota.Status.GlobalAcceleratorAccounting) {
+		k8cequality.Semantic.DeepEqual(globalAccounting, resourceQuota.Status.GlobalAcceleratorAccounting) &&
+		k8cequality.Semantic.DeepEqual(burst, resourceQuota.Status.Burst) {
 		log.Debugw("global usage for resource quota is the same, not updating",
 			"cpu", globalUsage.CPU.String(),
 			"memory", globalUsage.Memory.String(),
@@ -237,6 +253,7 @@ func (r *reconciler) ensureGlobalStatus(ctx context.Context, log *zap.SugaredLog
 	return util.UpdateResourceQuotaStatus(ctx, r.masterClient, resourceQuota, func(rq *kubermaticv1.ResourceQuota) {
 		rq.Status.GlobalUsage = *globalUsage
 		rq.Status.GlobalAcceleratorAccounting = globalAccounting
+		rq.Status.Burst = burst
 	})
 }
 
@@ -190,6 +190,44 @@ func TestReconcile(t *testing.T) {
 					Build(),
 			},
 		},
+		{
+			name:        "scenario 2: calculate rq global object counts",
+			requestName: rqName,
+			expectedUsage: func() kubermaticv1.ResourceDetails {
+				usage := genResourceDetails("3", "3G", "3G")
+				usage.Clusters = quantityPointer("5")
+				usage.Nodes = quantityPointer("12")
+				usage.LoadBalancers = quantityPointer("4")
+				usage.PersistentVolumeClaims = quantityPointer("7")
+				return *usage
+			}(),
+			masterClient: fake.
+				NewClientBuilder().
+				WithObjects(genResourceQuota(rqName, kubermaticv1.ResourceDetails{}), generator.GenTestSeed()).
+				Build(),
+			seedClients: map[string]ctrlruntimeclient.Client{
+				"first": fake.
+					NewClientBuilder().
+					WithObjects(func() *kubermaticv1.ResourceQuota {
+						usage := genResourceDetails("1", "1G", "1G")
+						usage.Clusters = quantityPointer("2")
+						usage.Nodes = quantityPointer("10")
+						usage.LoadBalancers = quantityPointer("4")
+						return genResourceQuota(rqName, *usage)
+					}()).
+					Build(),
+				"second": fake.
+					NewClientBuilder().
+					WithObjects(func() *kubermaticv1.ResourceQuota {
+						usage := genResourceDetails("2", "2G", "2G")
+						usage.Clusters = quantityPointer("3")
+						usage.Nodes = quantityPointer("2")
+						usage.PersistentVolumeClaims = quantityPointer("7")
+						return genResourceQuota(rqName, *usage)
+					}()).
+					Build(),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
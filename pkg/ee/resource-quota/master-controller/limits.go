@@ -0,0 +1,132 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package mastercontroller
+
+import (
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	eeresourcequotavalidation "k8c.io/kubermatic/v2/pkg/ee/validation/resourcequota"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// burstStatus returns the burst allowance window for the given global usage. A burst starts
+// as soon as the usage exceeds the quota and is kept, even after it expired, until the usage
+// went back below the quota. This prevents a single overrun from restarting the burst.
+func burstStatus(resourceQuota *kubermaticv1.ResourceQuota, globalUsage *kubermaticv1.ResourceDetails, now time.Time) *kubermaticv1.ResourceQuotaBurstStatus {
+	if resourceQuota.Spec.Burst == nil || !quotaExceeded(resourceQuota.Spec.Quota, *globalUsage) {
+		return nil
+	}
+
+	if resourceQuota.Status.Burst != nil {
+		return resourceQuota.Status.Burst.DeepCopy()
+	}
+
+	return &kubermaticv1.ResourceQuotaBurstStatus{
+		StartTime:      metav1.NewTime(now),
+		ExpirationTime: metav1.NewTime(now.Add(resourceQuota.Spec.Burst.Duration.Duration)),
+	}
+}
+
+func quotaExceeded(quota, usage kubermaticv1.ResourceDetails) bool {
+	for _, dimension := range eeresourcequotavalidation.Dimensions(quota, usage) {
+		if dimension.Quota != nil && dimension.Used != nil && dimension.Quota.Cmp(*dimension.Used) < 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// softLimitExceededDimensions returns the names of all dimensions whose usage exceeds the
+// soft limit of the resource quota.
+func softLimitExceededDimensions(resourceQuota *kubermaticv1.ResourceQuota, usage kubermaticv1.ResourceDetails) map[string]bool {
+	exceeded := map[string]bool{}
+	for _, dimension := range eeresourcequotavalidation.Dimensions(resourceQuota.Spec.Quota, usage) {
+		if dimension.Quota == nil || dimension.Used == nil {
+			continue
+		}
+		softLimit, ok := eeresourcequotavalidation.SoftLimit(resourceQuota, *dimension.Quota)
+		if ok && softLimit.Cmp(*dimension.Used) < 0 {
+			exceeded[dimension.Name] = true
+		}
+	}
+
+	return exceeded
+}
+
+// reportLimits emits events for dimensions that newly exceeded their soft limit and for a
+// newly started burst allowance, and updates the resource quota metrics.
+func (r *reconciler) reportLimits(resourceQuota *kubermaticv1.ResourceQuota, previousUsage kubermaticv1.ResourceDetails,
+	previousBurst *kubermaticv1.ResourceQuotaBurstStatus, now time.Time) {
+	usage := resourceQuota.Status.GlobalUsage
+	previouslyExceeded := softLimitExceededDimensions(resourceQuota, previousUsage)
+	exceeded := softLimitExceededDimensions(resourceQuota, usage)
+
+	// drop metrics of dimensions that are no longer limited
+	deleteMetrics(resourceQuota.Name)
+	for _, dimension := range eeresourcequotavalidation.Dimensions(resourceQuota.Spec.Quota, usage) {
+		if exceeded[dimension.Name] && !previouslyExceeded[dimension.Name] {
+			r.recorder.Eventf(resourceQuota, nil, corev1.EventTypeWarning, "SoftLimitExceeded", "Reconciling",
+				"%s usage %s exceeds the soft limit of %d%% of the quota %s",
+				dimension.Name, dimension.Used.String(), resourceQuota.Spec.SoftLimit.Percentage, dimension.Quota.String())
+		}
+
+		if dimension.Quota == nil {
+			continue
+		}
+		labels := []string{resourceQuota.Name, resourceQuota.Spec.Subject.Name, dimension.Name}
+		if dimension.Quota.Sign() > 0 && dimension.Used != nil {
+			usageRatio.WithLabelValues(labels...).Set(dimension.Used.AsApproximateFloat64() / dimension.Quota.AsApproximateFloat64())
+		}
+		softLimitExceeded.WithLabelValues(labels...).Set(boolToFloat(exceeded[dimension.Name]))
+	}
+
+	burst := resourceQuota.Status.Burst
+	if burst != nil && previousBurst == nil {
+		r.recorder.Eventf(resourceQuota, nil, corev1.EventTypeWarning, "QuotaBurstStarted", "Reconciling",
+			"usage exceeds the quota, the burst allowance of %d%% is active until %s",
+			resourceQuota.Spec.Burst.Percentage, burst.ExpirationTime.UTC().Format(time.RFC3339))
+	}
+	burstActive.WithLabelValues(resourceQuota.Name, resourceQuota.Spec.Subject.Name).Set(boolToFloat(burst != nil && !burst.Expired(now)))
+}
+
+// burstRequeueAfter returns the time until an active burst allowance expires.
+func burstRequeueAfter(burst *kubermaticv1.ResourceQuotaBurstStatus, now time.Time) time.Duration {
+	if burst == nil || burst.Expired(now) {
+		return 0
+	}
+
+	return burst.ExpirationTime.Sub(now)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,97 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package mastercontroller
+
+import (
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBurstStatus(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	started := &kubermaticv1.ResourceQuotaBurstStatus{
+		StartTime:      metav1.NewTime(now.Add(-2 * time.Hour)),
+		ExpirationTime: metav1.NewTime(now.Add(-time.Hour)),
+	}
+
+	testCases := []struct {
+		name     string
+		burst    *kubermaticv1.ResourceQuotaBurst
+		previous *kubermaticv1.ResourceQuotaBurstStatus
+		usage    *kubermaticv1.ResourceDetails
+		expected *kubermaticv1.ResourceQuotaBurstStatus
+	}{
+		{
+			name:  "no burst configured",
+			usage: genResourceDetails("10", "2G", "3G"),
+		},
+		{
+			name:  "usage within quota",
+			burst: &kubermaticv1.ResourceQuotaBurst{Percentage: 20, Duration: metav1.Duration{Duration: time.Hour}},
+			usage: genResourceDetails("5", "2G", "3G"),
+		},
+		{
+			name:  "usage exceeds quota starts burst",
+			burst: &kubermaticv1.ResourceQuotaBurst{Percentage: 20, Duration: metav1.Duration{Duration: time.Hour}},
+			usage: genResourceDetails("6", "2G", "3G"),
+			expected: &kubermaticv1.ResourceQuotaBurstStatus{
+				StartTime:      metav1.NewTime(now),
+				ExpirationTime: metav1.NewTime(now.Add(time.Hour)),
+			},
+		},
+		{
+			name:     "expired burst is kept while usage exceeds quota",
+			burst:    &kubermaticv1.ResourceQuotaBurst{Percentage: 20, Duration: metav1.Duration{Duration: time.Hour}},
+			previous: started,
+			usage:    genResourceDetails("6", "2G", "3G"),
+			expected: started,
+		},
+		{
+			name:     "burst is reset once usage is back within quota",
+			burst:    &kubermaticv1.ResourceQuotaBurst{Percentage: 20, Duration: metav1.Duration{Duration: time.Hour}},
+			previous: started,
+			usage:    genResourceDetails("5", "2G", "3G"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceQuota := genResourceQuota(rqName, kubermaticv1.ResourceDetails{})
+			resourceQuota.Spec.Quota = *genResourceDetails("5", "5G", "5G")
+			resourceQuota.Spec.Burst = tc.burst
+			resourceQuota.Status.Burst = tc.previous
+
+			got := burstStatus(resourceQuota, tc.usage, now)
+			if !diff.SemanticallyEqual(tc.expected, got) {
+				t.Fatalf("burst status differs:\n%v", diff.ObjectDiff(tc.expected, got))
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package mastercontroller
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	registerMetrics sync.Once
+
+	usageRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "resource_quota",
+		Name:      "usage_ratio",
+		Help:      "The global usage of a resource quota dimension relative to its quota",
+	}, []string{"resource_quota", "subject", "resource"})
+
+	softLimitExceeded = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "resource_quota",
+		Name:      "soft_limit_exceeded",
+		Help:      "Whether the global usage of a resource quota dimension exceeds its soft limit (1) or not (0)",
+	}, []string{"resource_quota", "subject", "resource"})
+
+	burstActive = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "resource_quota",
+		Name:      "burst_active",
+		Help:      "Whether the burst allowance of a resource quota is currently active (1) or not (0)",
+	}, []string{"resource_quota", "subject"})
+)
+
+func mustRegisterMetrics() {
+	registerMetrics.Do(func() {
+		prometheus.MustRegister(usageRatio, softLimitExceeded, burstActive)
+	})
+}
+
+func deleteMetrics(resourceQuota string) {
+	labels := prometheus.Labels{"resource_quota": resourceQuota}
+
+	usageRatio.DeletePartialMatch(labels)
+	softLimitExceeded.DeletePartialMatch(labels)
+	burstActive.DeletePartialMatch(labels)
+}
@@ -239,6 +239,10 @@ func TestReconcileSynchronizesOnlyMasterOwnedAcceleratorStatus(t *testing.T) {
 		ObservedAt:                 observedAt,
 		Ready:                      true,
 	}
+	masterResourceQuota.Status.Burst = &kubermaticv1.ResourceQuotaBurstStatus{
+		StartTime:      observedAt,
+		ExpirationTime: metav1.NewTime(observedAt.Add(time.Hour)),
+	}
 	seedResourceQuota := genResourceQuota(rqName, false)
 	seedResourceQuota.Status.GlobalUsage.Accelerators = acceleratorUsage("nvidia.com/stale", "9")
 	seedResourceQuota.Status.GlobalAcceleratorAccounting = &kubermaticv1.ResourceQuotaGlobalAcceleratorAccountingStatus{
@@ -280,6 +284,9 @@ func TestReconcileSynchronizesOnlyMasterOwnedAcceleratorStatus(t *testing.T) {
 	if !diff.SemanticallyEqual(masterResourceQuota.Status.GlobalAcceleratorAccounting, got.Status.GlobalAcceleratorAccounting) {
 		t.Fatalf("global accounting differs:\n%v", diff.ObjectDiff(masterResourceQuota.Status.GlobalAcceleratorAccounting, got.Status.GlobalAcceleratorAccounting))
 	}
+	if !diff.SemanticallyEqual(masterResourceQuota.Status.Burst, got.Status.Burst) {
+		t.Fatalf("burst status differs:\n%v", diff.ObjectDiff(masterResourceQuota.Status.Burst, got.Status.Burst))
+	}
 	if !diff.SemanticallyEqual(expectedLocal, got.Status.LocalAcceleratorAccounting) {
 		t.Fatalf("Seed-local accounting was overwritten:\n%v", diff.ObjectDiff(expectedLocal, got.Status.LocalAcceleratorAccounting))
 	}
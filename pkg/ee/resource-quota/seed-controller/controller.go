@@ -147,6 +147,7 @@ func (r *reconciler) reconcile(ctx context.Context, resourceQuota *kubermaticv1.
 	}
 
 	localUsage := kubermaticv1.NewResourceDetails(resource.Quantity{}, resource.Quantity{}, resource.Quantity{})
+	localUsage.Clusters = resource.NewQuantity(int64(len(clusterList.Items)), resource.DecimalSI)
 	acceleratorAccountingActive := resourceQuota.Annotations[resources.AcceleratorAccountingEnabledAnnotation] == resources.AcceleratorAccountingEnabledAnnotationValue
 	for _, cluster := range clusterList.Items {
 		if cluster.Status.ResourceUsage != nil {
@@ -160,6 +161,11 @@ func (r *reconciler) reconcile(ctx context.Context, resourceQuota *kubermaticv1.
 			if clusterUsage.Storage != nil {
 				localUsage.Storage.Add(*clusterUsage.Storage)
 			}
+			kubermaticv1.AddObjectCountUsage(localUsage, kubermaticv1.ResourceDetails{
+				Nodes:                  clusterUsage.Nodes,
+				LoadBalancers:          clusterUsage.LoadBalancers,
+				PersistentVolumeClaims: clusterUsage.PersistentVolumeClaims,
+			})
 			if acceleratorAccountingActive && cluster.Spec.Cloud.Kubevirt != nil {
 				kubermaticv1.AddAcceleratorUsage(localUsage, clusterUsage.Accelerators)
 			}
@@ -182,13 +188,15 @@ func (r *reconciler) ensureLocalStatus(ctx context.Context, log *zap.SugaredLogg
 		log.Debugw("local usage for resource quota is the same, not updating",
 			"cpu", localUsage.CPU.String(),
 			"memory", localUsage.Memory.String(),
-			"storage", localUsage.Storage.String())
+			"storage", localUsage.Storage.String(),
+			"clusters", localUsage.Clusters.String())
 		return nil
 	}
 	log.Debugw("local usage for resource quota needs update",
 		"cpu", localUsage.CPU.String(),
 		"memory", localUsage.Memory.String(),
-		"storage", localUsage.Storage.String())
+		"storage", localUsage.Storage.String(),
+		"clusters", localUsage.Clusters.String())
 
 	return util.UpdateResourceQuotaStatus(ctx, r.seedClient, resourceQuota, func(rq *kubermaticv1.ResourceQuota) {
 		rq.Status.LocalUsage = *localUsage
@@ -373,8 +381,9 @@ func accountingRequeueAfter(now, expiresAt time.Time) time.Duration {
 func withClusterEventFilter() predicate.Predicate {
 	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
-			cluster, ok := e.Object.(*kubermaticv1.Cluster)
-			return ok && cluster.Spec.Cloud.Kubevirt != nil
+			// every new cluster counts towards the cluster quota
+			_, ok := e.Object.(*kubermaticv1.Cluster)
+			return ok
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			oldCluster, ok := e.ObjectOld.(*kubermaticv1.Cluster)
@@ -67,7 +67,21 @@ func TestReconcile(t *testing.T) {
 					genCluster("c2", projectID, "5", "2G", "8G"),
 					genCluster("notSameProjectCluster", "impostor", "3", "3G", "3G")).
 				Build(),
-			expectedUsage: *genResourceDetails("7", "7G", "18G"),
+			expectedUsage: withObjectCounts(genResourceDetails("7", "7G", "18G"), "2", "", "", ""),
+		},
+		{
+			name:          "scenario 2: calculate rq local object counts",
+			requestName:   rqName,
+			resourceQuota: genResourceQuota(rqName),
+			seedClient: fake.
+				NewClientBuilder().
+				WithObjects(genResourceQuota(rqName),
+					genClusterWithObjectCounts("c1", projectID, "3", "2", "5"),
+					genClusterWithObjectCounts("c2", projectID, "1", "0", "4"),
+					genCluster("c3", projectID, "0", "0", "0"),
+					genClusterWithObjectCounts("notSameProjectCluster", "impostor", "10", "10", "10")).
+				Build(),
+			expectedUsage: withObjectCounts(genResourceDetails("2", "2G", "2G"), "3", "4", "2", "9"),
 		},
 	}
 
@@ -148,6 +162,7 @@ func TestReconcileAcceleratorAccounting(t *testing.T) {
 		t.Fatalf("getting ResourceQuota: %v", err)
 	}
 	expectedUsage := genResourceDetails("8", "8G", "19G")
+	expectedUsage.Clusters = resource.NewQuantity(3, resource.DecimalSI)
 	expectedUsage.Accelerators = acceleratorUsage("3")
 	if !diff.SemanticallyEqual(*expectedUsage, got.Status.LocalUsage) {
 		t.Fatalf("local usage differs:\n%v", diff.ObjectDiff(*expectedUsage, got.Status.LocalUsage))
@@ -249,6 +264,31 @@ func genCluster(name, projectID, cpu, mem, storage string) *kubermaticv1.Cluster
 	return cluster
 }
 
+func genClusterWithObjectCounts(name, projectID, nodes, loadBalancers, pvcs string) *kubermaticv1.Cluster {
+	cluster := genCluster(name, projectID, "1", "1G", "1G")
+	withObjectCounts(cluster.Status.ResourceUsage, "", nodes, loadBalancers, pvcs)
+
+	return cluster
+}
+
+// withObjectCounts sets the given object counts on details; empty counts are left unset.
+func withObjectCounts(details *kubermaticv1.ResourceDetails, clusters, nodes, loadBalancers, pvcs string) kubermaticv1.ResourceDetails {
+	quantity := func(value string) *resource.Quantity {
+		if value == "" {
+			return nil
+		}
+		q := resource.MustParse(value)
+		return &q
+	}
+
+	details.Clusters = quantity(clusters)
+	details.Nodes = quantity(nodes)
+	details.LoadBalancers = quantity(loadBalancers)
+	details.PersistentVolumeClaims = quantity(pvcs)
+
+	return *details
+}
+
 func acceleratorUsage(count string) []kubermaticv1.AcceleratorQuota {
 	return []kubermaticv1.AcceleratorQuota{{
 		Provider: accelerator.ProviderKubeVirt,
@@ -95,7 +95,9 @@ func Add(log *zap.SugaredLogger, seedMgr, userMgr manager.Manager, clusterName,
 
 	bldr := builder.ControllerManagedBy(userMgr).
 		Named(controllerName).
-		For(&clusterv1alpha1.Machine{}, builder.WithPredicates(controllerpredicate.ByNamespace(metav1.NamespaceSystem)))
+		For(&clusterv1alpha1.Machine{}, builder.WithPredicates(controllerpredicate.ByNamespace(metav1.NamespaceSystem))).
+		Watches(&corev1.Service{}, &handler.EnqueueRequestForObject{}, builder.WithPredicates(loadBalancerServiceChangedPredicate())).
+		Watches(&corev1.PersistentVolumeClaim{}, &handler.EnqueueRequestForObject{}, builder.WithPredicates(objectCountChangedPredicate()))
 	if acceleratorAccounting {
 		bldr.WatchesRawSource(source.Kind(
 			seedMgr.GetCache(),
@@ -164,15 +166,18 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	var (
-		scalarUsage *kubermaticv1.ResourceDetails
-		scalarErr   error
+		scalarUsage    *kubermaticv1.ResourceDetails
+		scalarErr      error
+		objectCounts   *kubermaticv1.ResourceDetails
+		objectCountErr error
 	)
 	if !heartbeatRequest {
 		scalarUsage, scalarErr = r.calculateScalarResourceUsage(ctx, machines)
+		objectCounts, objectCountErr = r.calculateObjectCounts(ctx, machines)
 	}
 
 	var updateErr error
-	if scalarUsage != nil || updateAcceleratorAccounting {
+	if scalarUsage != nil || objectCounts != nil || updateAcceleratorAccounting {
 		updateErr = util.UpdateClusterStatus(ctx, r.seedClient, cluster, func(c *kubermaticv1.Cluster) {
 			if c.Status.ResourceUsage == nil {
 				c.Status.ResourceUsage = &kubermaticv1.ResourceDetails{}
@@ -182,6 +187,11 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 				c.Status.ResourceUsage.Memory = scalarUsage.Memory
 				c.Status.ResourceUsage.Storage = scalarUsage.Storage
 			}
+			if objectCounts != nil {
+				c.Status.ResourceUsage.Nodes = objectCounts.Nodes
+				c.Status.ResourceUsage.LoadBalancers = objectCounts.LoadBalancers
+				c.Status.ResourceUsage.PersistentVolumeClaims = objectCounts.PersistentVolumeClaims
+			}
 			if updateAcceleratorAccounting {
 				c.Status.ResourceUsage.Accelerators = acceleratorUsage
 				c.Status.AcceleratorAccounting = acceleratorAccountingStatus
@@ -189,7 +199,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		})
 	}
 
-	err = errors.Join(acceleratorAccountingErr, scalarErr, updateErr)
+	err = errors.Join(acceleratorAccountingErr, scalarErr, objectCountErr, updateErr)
 	if err != nil {
 		r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "ClusterResourceUsageReconcileFailed", "Reconciling", err.Error())
 	}
@@ -224,6 +234,33 @@ func (r *reconciler) calculateScalarResourceUsage(ctx context.Context, machines
 	return resourceUsage, nil
 }
 
+// calculateObjectCounts counts the user cluster objects that are limited by the object count
+// dimensions of the project quota.
+func (r *reconciler) calculateObjectCounts(ctx context.Context, machines *clusterv1alpha1.MachineList) (*kubermaticv1.ResourceDetails, error) {
+	services := &corev1.ServiceList{}
+	if err := r.userClient.List(ctx, services); err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+
+	var loadBalancers int64
+	for _, service := range services.Items {
+		if service.Spec.Type == corev1.ServiceTypeLoadBalancer {
+			loadBalancers++
+		}
+	}
+
+	pvcs := &corev1.PersistentVolumeClaimList{}
+	if err := r.userClient.List(ctx, pvcs); err != nil {
+		return nil, fmt.Errorf("failed to list persistent volume claims: %w", err)
+	}
+
+	return &kubermaticv1.ResourceDetails{
+		Nodes:                  resource.NewQuantity(int64(len(machines.Items)), resource.DecimalSI),
+		LoadBalancers:          resource.NewQuantity(loadBalancers, resource.DecimalSI),
+		PersistentVolumeClaims: resource.NewQuantity(int64(len(pvcs.Items)), resource.DecimalSI),
+	}, nil
+}
+
 func (r *reconciler) acceleratorUsageAndStatus(clusterName string, resourceQuota *kubermaticv1.ResourceQuota, machines *clusterv1alpha1.MachineList) ([]kubermaticv1.AcceleratorQuota, *kubermaticv1.ClusterAcceleratorAccountingStatus) {
 	usage := &kubermaticv1.ResourceDetails{}
 	var machinesWithoutFootprint int32
@@ -430,6 +467,38 @@ func resourceQuotaAccountingChangedPredicate() ctrlruntimepredicate.TypedPredica
 	}
 }
 
+// loadBalancerServiceChangedPredicate only lets events pass that change the number of
+// Services of type LoadBalancer.
+func loadBalancerServiceChangedPredicate() ctrlruntimepredicate.Predicate {
+	isLoadBalancer := func(obj ctrlruntimeclient.Object) bool {
+		service, ok := obj.(*corev1.Service)
+		return ok && service.Spec.Type == corev1.ServiceTypeLoadBalancer
+	}
+
+	return ctrlruntimepredicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isLoadBalancer(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return isLoadBalancer(e.ObjectOld) != isLoadBalancer(e.ObjectNew)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return isLoadBalancer(e.Object)
+		},
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// objectCountChangedPredicate only lets events pass that change the number of objects.
+func objectCountChangedPredicate() ctrlruntimepredicate.Predicate {
+	return ctrlruntimepredicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return true },
+		UpdateFunc:  func(event.UpdateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
 func acceleratorAccountingPair(status *kubermaticv1.ResourceQuotaGlobalAcceleratorAccountingStatus) (kubermaticv1.AcceleratorAccountingRevision, kubermaticv1.AcceleratorQuotaDigest) {
 	if status == nil {
 		return "", ""
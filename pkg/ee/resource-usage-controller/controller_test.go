@@ -59,6 +59,7 @@ func TestReconcile(t *testing.T) {
 		name                  string
 		cluster               *kubermaticv1.Cluster
 		machines              []*clusterv1alpha1.Machine
+		userObjects           []ctrlruntimeclient.Object
 		expectedResourceUsage *kubermaticv1.ResourceDetails
 	}{
 		{
@@ -66,9 +67,12 @@ func TestReconcile(t *testing.T) {
 			cluster:  generator.GenDefaultCluster(),
 			machines: []*clusterv1alpha1.Machine{genFakeMachine("m1", "5", "5G", "10G")},
 			expectedResourceUsage: &kubermaticv1.ResourceDetails{
-				CPU:     getQuantity("5"),
-				Memory:  getQuantity("5G"),
-				Storage: getQuantity("10G"),
+				CPU:                    getQuantity("5"),
+				Memory:                 getQuantity("5G"),
+				Storage:                getQuantity("10G"),
+				Nodes:                  getQuantity("1"),
+				LoadBalancers:          getQuantity("0"),
+				PersistentVolumeClaims: getQuantity("0"),
 			},
 		},
 		{
@@ -80,9 +84,12 @@ func TestReconcile(t *testing.T) {
 			}(),
 			machines: []*clusterv1alpha1.Machine{genFakeMachine("m1", "5", "5G", "10G")},
 			expectedResourceUsage: &kubermaticv1.ResourceDetails{
-				CPU:     getQuantity("5"),
-				Memory:  getQuantity("5G"),
-				Storage: getQuantity("10G"),
+				CPU:                    getQuantity("5"),
+				Memory:                 getQuantity("5G"),
+				Storage:                getQuantity("10G"),
+				Nodes:                  getQuantity("1"),
+				LoadBalancers:          getQuantity("0"),
+				PersistentVolumeClaims: getQuantity("0"),
 			},
 		},
 		{
@@ -92,9 +99,12 @@ func TestReconcile(t *testing.T) {
 				genFakeMachine("m1", "5", "5G", "10G"),
 				genFakeMachine("m2", "2", "3G", "5G")},
 			expectedResourceUsage: &kubermaticv1.ResourceDetails{
-				CPU:     getQuantity("7"),
-				Memory:  getQuantity("8G"),
-				Storage: getQuantity("15G"),
+				CPU:                    getQuantity("7"),
+				Memory:                 getQuantity("8G"),
+				Storage:                getQuantity("15G"),
+				Nodes:                  getQuantity("2"),
+				LoadBalancers:          getQuantity("0"),
+				PersistentVolumeClaims: getQuantity("0"),
 			},
 		},
 		{
@@ -105,9 +115,34 @@ func TestReconcile(t *testing.T) {
 				return c
 			}(),
 			expectedResourceUsage: &kubermaticv1.ResourceDetails{
-				CPU:     getQuantity("0"),
-				Memory:  getQuantity("0"),
-				Storage: getQuantity("0"),
+				CPU:                    getQuantity("0"),
+				Memory:                 getQuantity("0"),
+				Storage:                getQuantity("0"),
+				Nodes:                  getQuantity("0"),
+				LoadBalancers:          getQuantity("0"),
+				PersistentVolumeClaims: getQuantity("0"),
+			},
+		},
+		{
+			name:    "scenario 5: count LoadBalancer services and persistent volume claims",
+			cluster: generator.GenDefaultCluster(),
+			machines: []*clusterv1alpha1.Machine{
+				genFakeMachine("m1", "1", "1G", "1G")},
+			userObjects: []ctrlruntimeclient.Object{
+				genService("default", "lb-1", corev1.ServiceTypeLoadBalancer),
+				genService("kube-system", "lb-2", corev1.ServiceTypeLoadBalancer),
+				genService("default", "cluster-ip", corev1.ServiceTypeClusterIP),
+				genPersistentVolumeClaim("default", "data-1"),
+				genPersistentVolumeClaim("monitoring", "data-2"),
+				genPersistentVolumeClaim("monitoring", "data-3"),
+			},
+			expectedResourceUsage: &kubermaticv1.ResourceDetails{
+				CPU:                    getQuantity("1"),
+				Memory:                 getQuantity("1G"),
+				Storage:                getQuantity("1G"),
+				Nodes:                  getQuantity("1"),
+				LoadBalancers:          getQuantity("2"),
+				PersistentVolumeClaims: getQuantity("3"),
 			},
 		},
 	}
@@ -126,6 +161,7 @@ func TestReconcile(t *testing.T) {
 			for _, m := range tc.machines {
 				userClientBuilder.WithObjects(m)
 			}
+			userClientBuilder.WithObjects(tc.userObjects...)
 
 			seedClient := seedClientBuilder.Build()
 			userClient := userClientBuilder.Build()
@@ -499,6 +535,19 @@ func genFakeMachine(name, cpu, memory, storage string) *clusterv1alpha1.Machine
 		nil, nil)
 }
 
+func genService(namespace, name string, serviceType corev1.ServiceType) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       corev1.ServiceSpec{Type: serviceType},
+	}
+}
+
+func genPersistentVolumeClaim(namespace, name string) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+}
+
 func providerMachine(t *testing.T, name string, provider providerconfig.CloudProvider) *clusterv1alpha1.Machine {
 	t.Helper()
 
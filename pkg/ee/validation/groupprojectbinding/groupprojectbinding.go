@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 
@@ -35,6 +36,10 @@ import (
 )
 
 func ValidateCreate(ctx context.Context, binding *kubermaticv1.GroupProjectBinding, client ctrlruntimeclient.Client) error {
+	if binding.Spec.IsExpired(time.Now()) {
+		return errors.New("attribute \"expiresAt\" must be in the future")
+	}
+
 	duplicate, err := hasDuplicateGroupProjectBinding(ctx, client, binding, binding.Name)
 	if err != nil {
 		return err
@@ -68,8 +73,11 @@ func hasDuplicateGroupProjectBinding(ctx context.Context, client ctrlruntimeclie
 		return false, fmt.Errorf("failed to list GroupProjectBindings: %w", err)
 	}
 
+	now := time.Now()
+
 	for _, existing := range existingBindings.Items {
-		if existing.Name == excludeName {
+		// expired bindings are only kept for auditing and do not grant access anymore
+		if existing.Name == excludeName || existing.Spec.IsExpired(now) {
 			continue
 		}
 		if existing.Spec.Group == binding.Spec.Group && existing.Spec.ProjectID == binding.Spec.ProjectID {
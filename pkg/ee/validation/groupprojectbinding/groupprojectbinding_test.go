@@ -29,6 +29,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -91,6 +92,36 @@ func TestValidateCreate(t *testing.T) {
 			},
 			errExpected: false,
 		},
+		{
+			name: "same group and project bound by an expired binding, create succeeds",
+			existingBinding: &kubermaticv1.GroupProjectBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "existing-binding"},
+				Spec: kubermaticv1.GroupProjectBindingSpec{
+					Group:     "group1",
+					ProjectID: "project1",
+					Role:      "owners",
+					ExpiresAt: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+				},
+			},
+			newBinding: &kubermaticv1.GroupProjectBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "new-binding"},
+				Spec:       kubermaticv1.GroupProjectBindingSpec{Group: "group1", ProjectID: "project1", Role: "owners"},
+			},
+			errExpected: false,
+		},
+		{
+			name: "expiry in the past, create fails",
+			newBinding: &kubermaticv1.GroupProjectBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "new-binding"},
+				Spec: kubermaticv1.GroupProjectBindingSpec{
+					Group:     "group1",
+					ProjectID: "project1",
+					Role:      "owners",
+					ExpiresAt: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+				},
+			},
+			errExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
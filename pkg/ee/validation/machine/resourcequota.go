@@ -34,6 +34,7 @@ import (
 	"go.uber.org/zap"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	eeresourcequotavalidation "k8c.io/kubermatic/v2/pkg/ee/validation/resourcequota"
 	"k8c.io/kubermatic/v2/pkg/machine/accelerator"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources"
@@ -47,6 +48,7 @@ import (
 )
 
 // ValidateQuota validates if the requested Machine resource consumption fits in the quota of the clusters project.
+// Requests that exceed the soft limit or are only admitted by the burst allowance of the quota result in warnings.
 func ValidateQuota(ctx context.Context,
 	log *zap.SugaredLogger,
 	userClient ctrlruntimeclient.Client,
@@ -54,56 +56,63 @@ func ValidateQuota(ctx context.Context,
 	machine *clusterv1alpha1.Machine,
 	caBundle *certificates.CABundle,
 	resourceQuota *kubermaticv1.ResourceQuota,
-) error {
+) ([]string, error) {
 	machineResourceUsage, err := GetMachineResourceUsage(ctx, userClient, kubeVirtInfraNamespace, machine, caBundle)
 	if err != nil {
-		return fmt.Errorf("error getting machine resource request: %w", err)
+		return nil, fmt.Errorf("error getting machine resource request: %w", err)
 	}
 
 	var currentCPU = resource.Quantity{}
 	if resourceQuota.Status.GlobalUsage.CPU != nil {
 		currentCPU = *resourceQuota.Status.GlobalUsage.CPU
 	}
-
 	var currentMem = resource.Quantity{}
 	if resourceQuota.Status.GlobalUsage.Memory != nil {
 		currentMem = *resourceQuota.Status.GlobalUsage.Memory
 	}
-
 	var currentStorage = resource.Quantity{}
 	if resourceQuota.Status.GlobalUsage.Storage != nil {
 		currentStorage = *resourceQuota.Status.GlobalUsage.Storage
 	}
 
-	// add requested resources to current usage and compare
-	combinedUsage := NewResourceDetails(currentCPU, currentMem, currentStorage)
-	combinedUsage.CPU().Add(*machineResourceUsage.CPU())
-	combinedUsage.Memory().Add(*machineResourceUsage.Memory())
-	combinedUsage.Storage().Add(*machineResourceUsage.Storage())
-
+	now := time.Now()
 	quota := resourceQuota.Spec.Quota
-	if quota.CPU != nil && quota.CPU.Cmp(*combinedUsage.CPU()) < 0 {
-		log.Debugw("requested CPU would exceed current quota", "request",
-			machineResourceUsage.CPU(), "quota", quota.CPU, "used", currentCPU.String())
-		return fmt.Errorf("requested CPU %q would exceed current quota (quota/used %q/%q)",
-			machineResourceUsage.CPU(), quota.CPU, currentCPU.String())
+	var warnings []string
+	for _, dimension := range []struct {
+		name      string
+		quota     *resource.Quantity
+		requested *resource.Quantity
+		used      resource.Quantity
+	}{
+		{name: "CPU", quota: quota.CPU, requested: machineResourceUsage.CPU(), used: currentCPU},
+		{name: "Memory", quota: quota.Memory, requested: machineResourceUsage.Memory(), used: currentMem},
+		{name: "disk size", quota: quota.Storage, requested: machineResourceUsage.Storage(), used: currentStorage},
+	} {
+		// add requested resources to current usage and compare
+		combined := dimension.used.DeepCopy()
+		combined.Add(*dimension.requested)
+
+		warning, exceeded := eeresourcequotavalidation.CheckLimit(resourceQuota, dimension.name, dimension.quota, combined, now)
+		if exceeded {
+			log.Debugw(fmt.Sprintf("requested %s would exceed current quota", dimension.name), "request",
+				dimension.requested, "quota", dimension.quota, "used", dimension.used.String())
+			return nil, fmt.Errorf("requested %s %q would exceed current quota (quota/used %q/%q)",
+				dimension.name, dimension.requested, dimension.quota, dimension.used.String())
+		}
+		if warning != "" {
+			warnings = append(warnings, warning)
+		}
 	}
 
-	if quota.Memory != nil && quota.Memory.Cmp(*combinedUsage.Memory()) < 0 {
-		log.Debugw("requested Memory would exceed current quota", "request",
-			machineResourceUsage.Memory(), "quota", quota.Memory, "used", currentMem.String())
-		return fmt.Errorf("requested Memory %q would exceed current quota (quota/used %q/%q)",
-			machineResourceUsage.Memory(), quota.Memory, currentMem.String())
+	warning, err := eeresourcequotavalidation.ValidateObjectCount(resourceQuota, "nodes", quota.Nodes, resourceQuota.Status.GlobalUsage.Nodes, 1, now)
+	if err != nil {
+		return nil, err
 	}
-
-	if quota.Storage != nil && quota.Storage.Cmp(*combinedUsage.Storage()) < 0 {
-		log.Debugw("requested disk size would exceed current quota", "request",
-			machineResourceUsage.Storage(), "quota", quota.Storage, "used", currentStorage.String())
-		return fmt.Errorf("requested disk size %q would exceed current quota (quota/used %q/%q)",
-			machineResourceUsage.Storage(), quota.Storage, currentStorage.String())
+	if warning != "" {
+		warnings = append(warnings, warning)
 	}
 
-	return validateAcceleratorQuota(log, machine, resourceQuota, time.Now())
+	return warnings, validateAcceleratorQuota(log, machine, resourceQuota, now)
 }
 
 func validateAcceleratorQuota(log *zap.SugaredLogger, machine *clusterv1alpha1.Machine, resourceQuota *kubermaticv1.ResourceQuota, now time.Time) error {
@@ -52,15 +52,31 @@ func TestResourceQuotaValidation(t *testing.T) {
 	l := kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar()
 
 	testCases := []struct {
-		name        string
-		machine     *clusterv1alpha1.Machine
-		expectedErr bool
+		name             string
+		machine          *clusterv1alpha1.Machine
+		nodesQuota       string
+		softLimit        int32
+		burst            int32
+		expectedErr      bool
+		expectedWarnings int
 	}{
 		{
 			name:        "quota that fits should succeed",
 			machine:     genFakeMachine("2", "2G", "10G"),
 			expectedErr: false,
 		},
+		{
+			name:        "node quota that fits should succeed",
+			machine:     genFakeMachine("2", "2G", "10G"),
+			nodesQuota:  "6",
+			expectedErr: false,
+		},
+		{
+			name:        "should fail with node quota exceeded",
+			machine:     genFakeMachine("2", "2G", "10G"),
+			nodesQuota:  "5",
+			expectedErr: true,
+		},
 		{
 			name:        "should fail with CPU quota exceeded",
 			machine:     genFakeMachine("50", "2G", "10G"),
@@ -76,11 +92,44 @@ func TestResourceQuotaValidation(t *testing.T) {
 			machine:     genFakeMachine("2", "2G", "5000G"),
 			expectedErr: true,
 		},
+		{
+			name:             "should warn when exceeding the soft limit",
+			machine:          genFakeMachine("30", "2G", "10G"),
+			softLimit:        50,
+			expectedWarnings: 1,
+		},
+		{
+			name:             "should warn when admitted by the burst allowance",
+			machine:          genFakeMachine("50", "2G", "10G"),
+			burst:            20,
+			expectedWarnings: 1,
+		},
+		{
+			name:        "should fail with burst allowance exceeded",
+			machine:     genFakeMachine("60", "2G", "10G"),
+			burst:       10,
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			err := machine.ValidateQuota(context.Background(), l, nil, "", tc.machine, nil, genResourceQuota())
+			quota := genResourceQuota()
+			if tc.nodesQuota != "" {
+				nodes := resource.MustParse(tc.nodesQuota)
+				quota.Spec.Quota.Nodes = &nodes
+			}
+			if tc.softLimit > 0 {
+				quota.Spec.SoftLimit = &kubermaticv1.ResourceQuotaSoftLimit{Percentage: tc.softLimit}
+			}
+			if tc.burst > 0 {
+				quota.Spec.Burst = &kubermaticv1.ResourceQuotaBurst{Percentage: tc.burst, Duration: metav1.Duration{Duration: time.Hour}}
+			}
+
+			warnings, err := machine.ValidateQuota(context.Background(), l, nil, "", tc.machine, nil, quota)
+			if len(warnings) != tc.expectedWarnings {
+				t.Fatalf("expected %d warnings, got: %v", tc.expectedWarnings, warnings)
+			}
 			if err != nil {
 				if !tc.expectedErr {
 					t.Fatalf("unexpected error: %v", err)
@@ -217,7 +266,7 @@ func TestAcceleratorResourceQuotaValidation(t *testing.T) {
 			if tc.mutate != nil {
 				tc.mutate(tc.quota)
 			}
-			err := machine.ValidateQuota(context.Background(), l, nil, "", tc.machine, nil, tc.quota)
+			_, err := machine.ValidateQuota(context.Background(), l, nil, "", tc.machine, nil, tc.quota)
 			if tc.errorContains == "" {
 				if err != nil {
 					t.Fatalf("unexpected error: %v", err)
@@ -312,6 +361,7 @@ func genResourceQuota() *kubermaticv1.ResourceQuota {
 	rq := &kubermaticv1.ResourceQuota{}
 	rq.Spec.Quota = *kubermaticv1.NewResourceDetails(resource.MustParse("50"), resource.MustParse("50G"), resource.MustParse("1000G"))
 	rq.Status.GlobalUsage = *kubermaticv1.NewResourceDetails(resource.MustParse("3"), resource.MustParse("3G"), resource.MustParse("60G"))
+	rq.Status.GlobalUsage.Nodes = resource.NewQuantity(5, resource.DecimalSI)
 
 	return rq
 }
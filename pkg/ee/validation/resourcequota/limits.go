@@ -0,0 +1,139 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package resourcequota
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/inf.v0"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Dimension is a single scalar dimension of a resource quota, together with its usage.
+type Dimension struct {
+	Name  string
+	Quota *resource.Quantity
+	Used  *resource.Quantity
+}
+
+// Dimensions returns all scalar quota dimensions that are subject to soft limits and burst
+// allowances. Accelerator quotas are strictly enforced and therefore not included.
+func Dimensions(quota, usage kubermaticv1.ResourceDetails) []Dimension {
+	return []Dimension{
+		{Name: "cpu", Quota: quota.CPU, Used: usage.CPU},
+		{Name: "memory", Quota: quota.Memory, Used: usage.Memory},
+		{Name: "storage", Quota: quota.Storage, Used: usage.Storage},
+		{Name: "clusters", Quota: quota.Clusters, Used: usage.Clusters},
+		{Name: "nodes", Quota: quota.Nodes, Used: usage.Nodes},
+		{Name: "loadBalancers", Quota: quota.LoadBalancers, Used: usage.LoadBalancers},
+		{Name: "persistentVolumeClaims", Quota: quota.PersistentVolumeClaims, Used: usage.PersistentVolumeClaims},
+	}
+}
+
+// SoftLimit returns the soft limit for the given quota, if the ResourceQuota configures one.
+func SoftLimit(resourceQuota *kubermaticv1.ResourceQuota, quota resource.Quantity) (resource.Quantity, bool) {
+	if resourceQuota.Spec.SoftLimit == nil {
+		return resource.Quantity{}, false
+	}
+
+	return scaleQuantity(quota, int64(resourceQuota.Spec.SoftLimit.Percentage)), true
+}
+
+// BurstLimit returns the hard limit plus the burst allowance for the given quota, if the
+// ResourceQuota configures a burst.
+func BurstLimit(resourceQuota *kubermaticv1.ResourceQuota, quota resource.Quantity) (resource.Quantity, bool) {
+	if resourceQuota.Spec.Burst == nil {
+		return resource.Quantity{}, false
+	}
+
+	return scaleQuantity(quota, 100+int64(resourceQuota.Spec.Burst.Percentage)), true
+}
+
+// BurstAvailable returns true if requests above the quota may currently be admitted by the
+// burst allowance. A burst that has not been started yet is available, as it is started by
+// the master controller as soon as the global usage exceeds the quota.
+func BurstAvailable(resourceQuota *kubermaticv1.ResourceQuota, now time.Time) bool {
+	if resourceQuota.Spec.Burst == nil {
+		return false
+	}
+
+	return resourceQuota.Status.Burst == nil || !resourceQuota.Status.Burst.Expired(now)
+}
+
+// CheckLimit compares the combined usage of a single quota dimension, including the
+// requested amount, against the limits of the ResourceQuota. It returns a warning if the
+// usage exceeds the soft limit or is only admitted by the burst allowance, and reports
+// whether the request has to be denied. A nil quota does not limit the dimension.
+func CheckLimit(resourceQuota *kubermaticv1.ResourceQuota, dimension string, quota *resource.Quantity, combined resource.Quantity, now time.Time) (string, bool) {
+	if quota == nil {
+		return "", false
+	}
+
+	if quota.Cmp(combined) >= 0 {
+		softLimit, ok := SoftLimit(resourceQuota, *quota)
+		if ok && softLimit.Cmp(combined) < 0 {
+			return fmt.Sprintf("%s usage %q exceeds the soft limit %q of the project quota %q",
+				dimension, combined.String(), softLimit.String(), quota.String()), false
+		}
+		return "", false
+	}
+
+	burstLimit, ok := BurstLimit(resourceQuota, *quota)
+	if !ok || !BurstAvailable(resourceQuota, now) || burstLimit.Cmp(combined) < 0 {
+		return "", true
+	}
+
+	return fmt.Sprintf("%s usage %q exceeds the project quota %q and is only admitted by the burst allowance (limit %q) until %s",
+		dimension, combined.String(), quota.String(), burstLimit.String(), burstExpiration(resourceQuota, now).Format(time.RFC3339)), false
+}
+
+func burstExpiration(resourceQuota *kubermaticv1.ResourceQuota, now time.Time) time.Time {
+	if resourceQuota.Status.Burst != nil {
+		return resourceQuota.Status.Burst.ExpirationTime.Time
+	}
+
+	return now.Add(resourceQuota.Spec.Burst.Duration.Duration)
+}
+
+// scaleQuantity returns the given percentage of a quantity. Whole quantities like memory or
+// object counts are rounded down to whole units, fractional ones to milli units. The
+// calculation is done on decimals, so that large quotas cannot overflow.
+func scaleQuantity(quantity resource.Quantity, percentage int64) resource.Quantity {
+	value := quantity.AsDec()
+
+	scale := inf.Scale(0)
+	if new(inf.Dec).Round(value, 0, inf.RoundDown).Cmp(value) != 0 {
+		scale = 3
+	}
+
+	scaled := new(inf.Dec).Mul(value, inf.NewDec(percentage, 2))
+	scaled.Round(scaled, scale, inf.RoundDown)
+
+	return *resource.NewDecimalQuantity(*scaled, quantity.Format)
+}
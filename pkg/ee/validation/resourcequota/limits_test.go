@@ -0,0 +1,156 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package resourcequota_test
+
+import (
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/ee/validation/resourcequota"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLimits(t *testing.T) {
+	testCases := []struct {
+		name              string
+		quota             string
+		softLimit         int32
+		burst             int32
+		expectedSoftLimit string
+		expectedBurst     string
+	}{
+		{
+			name:              "CPU cores",
+			quota:             "10",
+			softLimit:         80,
+			burst:             50,
+			expectedSoftLimit: "8",
+			expectedBurst:     "15",
+		},
+		{
+			name:              "fractional CPU",
+			quota:             "1500m",
+			softLimit:         50,
+			burst:             10,
+			expectedSoftLimit: "750m",
+			expectedBurst:     "1650m",
+		},
+		{
+			name:              "memory",
+			quota:             "10Gi",
+			softLimit:         75,
+			burst:             100,
+			expectedSoftLimit: "7680Mi",
+			expectedBurst:     "20Gi",
+		},
+		{
+			name:              "object count rounds down",
+			quota:             "3",
+			softLimit:         50,
+			burst:             50,
+			expectedSoftLimit: "1",
+			expectedBurst:     "4",
+		},
+		{
+			name:              "large quota does not overflow",
+			quota:             "8E",
+			softLimit:         80,
+			burst:             100,
+			expectedSoftLimit: "6400P",
+			expectedBurst:     "16E",
+		},
+		{
+			name:              "large fractional quota does not overflow",
+			quota:             "9223372036854775.5",
+			softLimit:         50,
+			burst:             100,
+			expectedSoftLimit: "4611686018427387750m",
+			expectedBurst:     "18446744073709551",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceQuota := genLimitedResourceQuota(tc.softLimit, tc.burst, nil)
+			quota := resource.MustParse(tc.quota)
+
+			softLimit, ok := resourcequota.SoftLimit(resourceQuota, quota)
+			if !ok {
+				t.Fatal("Expected a soft limit")
+			}
+			if softLimit.Cmp(resource.MustParse(tc.expectedSoftLimit)) != 0 {
+				t.Fatalf("Expected soft limit %s, but got %s", tc.expectedSoftLimit, softLimit.String())
+			}
+
+			burstLimit, ok := resourcequota.BurstLimit(resourceQuota, quota)
+			if !ok {
+				t.Fatal("Expected a burst limit")
+			}
+			if burstLimit.Cmp(resource.MustParse(tc.expectedBurst)) != 0 {
+				t.Fatalf("Expected burst limit %s, but got %s", tc.expectedBurst, burstLimit.String())
+			}
+		})
+	}
+}
+
+func TestBurstAvailable(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		resourceQuota *kubermaticv1.ResourceQuota
+		expected      bool
+	}{
+		{
+			name:          "no burst configured",
+			resourceQuota: genLimitedResourceQuota(80, 0, nil),
+		},
+		{
+			name:          "burst not started yet",
+			resourceQuota: genLimitedResourceQuota(0, 20, nil),
+			expected:      true,
+		},
+		{
+			name:          "burst active",
+			resourceQuota: genLimitedResourceQuota(0, 20, &kubermaticv1.ResourceQuotaBurstStatus{ExpirationTime: metav1.NewTime(now.Add(time.Second))}),
+			expected:      true,
+		},
+		{
+			name:          "burst expired",
+			resourceQuota: genLimitedResourceQuota(0, 20, &kubermaticv1.ResourceQuotaBurstStatus{ExpirationTime: metav1.NewTime(now.Add(-time.Second))}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if available := resourcequota.BurstAvailable(tc.resourceQuota, now); available != tc.expected {
+				t.Fatalf("Expected burst available %t, but got %t", tc.expected, available)
+			}
+		})
+	}
+}
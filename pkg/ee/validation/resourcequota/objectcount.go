@@ -0,0 +1,58 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package resourcequota
+
+import (
+	"fmt"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ValidateObjectCount validates if creating count objects of the given kind fits in the object
+// count quota, given the currently used amount. A nil quota does not limit the kind. If the
+// request is admitted above the soft limit or by the burst allowance of the ResourceQuota,
+// a warning is returned.
+func ValidateObjectCount(resourceQuota *kubermaticv1.ResourceQuota, kind string, quota, used *resource.Quantity, count int64, now time.Time) (string, error) {
+	if quota == nil {
+		return "", nil
+	}
+
+	current := resource.Quantity{}
+	if used != nil {
+		current = used.DeepCopy()
+	}
+
+	combined := current.DeepCopy()
+	combined.Add(*resource.NewQuantity(count, resource.DecimalSI))
+	warning, exceeded := CheckLimit(resourceQuota, kind, quota, combined, now)
+	if exceeded {
+		return "", fmt.Errorf("requested %d additional %s would exceed current quota (quota/used %q/%q)", count, kind, quota.String(), current.String())
+	}
+
+	return warning, nil
+}
@@ -52,6 +52,12 @@ func ValidateCreate(ctx context.Context,
 	if err := validation.ValidateAcceleratorQuota(incomingQuota.Spec.Quota); err != nil {
 		return fmt.Errorf("invalid accelerator quota: %w", err)
 	}
+	if err := validation.ValidateObjectCountQuota(incomingQuota.Spec.Quota); err != nil {
+		return fmt.Errorf("invalid object count quota: %w", err)
+	}
+	if err := validation.ValidateQuotaLimits(incomingQuota.Spec); err != nil {
+		return fmt.Errorf("invalid quota limits: %w", err)
+	}
 
 	currentQuotaList := &kubermaticv1.ResourceQuotaList{}
 	if err := client.List(ctx, currentQuotaList, &ctrlruntimeclient.ListOptions{}); err != nil {
@@ -89,6 +95,12 @@ func ValidateUpdate(ctx context.Context,
 	if err := validation.ValidateAcceleratorQuota(newQuota.Spec.Quota); err != nil {
 		return fmt.Errorf("invalid accelerator quota: %w", err)
 	}
+	if err := validation.ValidateObjectCountQuota(newQuota.Spec.Quota); err != nil {
+		return fmt.Errorf("invalid object count quota: %w", err)
+	}
+	if err := validation.ValidateQuotaLimits(newQuota.Spec); err != nil {
+		return fmt.Errorf("invalid quota limits: %w", err)
+	}
 
 	oldSubject := oldQuota.Spec.Subject
 	newSubject := newQuota.Spec.Subject
@@ -28,6 +28,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/ee/validation/resourcequota"
@@ -188,6 +189,31 @@ func TestValidateCreate(t *testing.T) {
 			errExpected:   true,
 			errorContains: "invalid accelerator quota:",
 		},
+		{
+			name: "Create ResourceQuota with object count quota",
+			resourceQuotaToValidate: &kubermaticv1.ResourceQuota{
+				Spec: kubermaticv1.ResourceQuotaSpec{
+					Subject: kubermaticv1.Subject{Name: "project-with-object-counts", Kind: kubermaticv1.ProjectSubjectKind},
+					Quota: kubermaticv1.ResourceDetails{
+						Clusters:      quantityPtr("40"),
+						LoadBalancers: quantityPtr("100"),
+					},
+				},
+			},
+		},
+		{
+			name: "Create ResourceQuota with negative object count quota failure",
+			resourceQuotaToValidate: &kubermaticv1.ResourceQuota{
+				Spec: kubermaticv1.ResourceQuotaSpec{
+					Subject: kubermaticv1.Subject{Name: "project-with-negative-object-counts", Kind: kubermaticv1.ProjectSubjectKind},
+					Quota: kubermaticv1.ResourceDetails{
+						Clusters: quantityPtr("-1"),
+					},
+				},
+			},
+			errExpected:   true,
+			errorContains: `invalid object count quota: clusters: Invalid value: "-1"`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -348,6 +374,24 @@ func TestValidateUpdate(t *testing.T) {
 			errExpected:   true,
 			errorContains: `invalid accelerator quota: accelerators[1].provider: Duplicate value: "kubevirt"`,
 		},
+		{
+			name: "fractional object count quota is rejected",
+			oldResourceQuota: &kubermaticv1.ResourceQuota{
+				Spec: kubermaticv1.ResourceQuotaSpec{
+					Subject: kubermaticv1.Subject{Name: "project-with-object-counts", Kind: kubermaticv1.ProjectSubjectKind},
+				},
+			},
+			newResourceQuota: &kubermaticv1.ResourceQuota{
+				Spec: kubermaticv1.ResourceQuotaSpec{
+					Subject: kubermaticv1.Subject{Name: "project-with-object-counts", Kind: kubermaticv1.ProjectSubjectKind},
+					Quota: kubermaticv1.ResourceDetails{
+						PersistentVolumeClaims: quantityPtr("500m"),
+					},
+				},
+			},
+			errExpected:   true,
+			errorContains: `invalid object count quota: persistentVolumeClaims: Invalid value: "500m": object count must be a whole number`,
+		},
 		{
 			name: "valid accelerator quota is accepted",
 			oldResourceQuota: &kubermaticv1.ResourceQuota{
@@ -387,3 +431,119 @@ func acceleratorQuota(provider, resourceName, quantity string) []kubermaticv1.Ac
 		},
 	}}
 }
+
+func quantityPtr(value string) *resource.Quantity {
+	quantity := resource.MustParse(value)
+	return &quantity
+}
+
+func TestValidateObjectCount(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		resourceQuota   *kubermaticv1.ResourceQuota
+		quota           *resource.Quantity
+		used            *resource.Quantity
+		errExpected     bool
+		warningExpected bool
+	}{
+		{
+			name: "no quota",
+			used: quantityPtr("100"),
+		},
+		{
+			name:  "no usage reported yet",
+			quota: quantityPtr("1"),
+		},
+		{
+			name:  "fits in quota",
+			quota: quantityPtr("3"),
+			used:  quantityPtr("2"),
+		},
+		{
+			name:        "exceeds quota",
+			quota:       quantityPtr("2"),
+			used:        quantityPtr("2"),
+			errExpected: true,
+		},
+		{
+			name:        "zero quota",
+			quota:       quantityPtr("0"),
+			errExpected: true,
+		},
+		{
+			name:            "exceeds soft limit",
+			resourceQuota:   genLimitedResourceQuota(80, 0, nil),
+			quota:           quantityPtr("10"),
+			used:            quantityPtr("8"),
+			warningExpected: true,
+		},
+		{
+			name:          "fits in soft limit",
+			resourceQuota: genLimitedResourceQuota(80, 0, nil),
+			quota:         quantityPtr("10"),
+			used:          quantityPtr("7"),
+		},
+		{
+			name:            "burst not started yet",
+			resourceQuota:   genLimitedResourceQuota(0, 20, nil),
+			quota:           quantityPtr("10"),
+			used:            quantityPtr("10"),
+			warningExpected: true,
+		},
+		{
+			name:            "active burst",
+			resourceQuota:   genLimitedResourceQuota(0, 20, &kubermaticv1.ResourceQuotaBurstStatus{ExpirationTime: metav1.NewTime(now.Add(time.Minute))}),
+			quota:           quantityPtr("10"),
+			used:            quantityPtr("11"),
+			warningExpected: true,
+		},
+		{
+			name:          "exceeds burst limit",
+			resourceQuota: genLimitedResourceQuota(0, 20, nil),
+			quota:         quantityPtr("10"),
+			used:          quantityPtr("12"),
+			errExpected:   true,
+		},
+		{
+			name:          "expired burst",
+			resourceQuota: genLimitedResourceQuota(0, 20, &kubermaticv1.ResourceQuotaBurstStatus{ExpirationTime: metav1.NewTime(now)}),
+			quota:         quantityPtr("10"),
+			used:          quantityPtr("10"),
+			errExpected:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceQuota := tc.resourceQuota
+			if resourceQuota == nil {
+				resourceQuota = &kubermaticv1.ResourceQuota{}
+			}
+
+			warning, err := resourcequota.ValidateObjectCount(resourceQuota, "clusters", tc.quota, tc.used, 1, now)
+			if (err != nil) != tc.errExpected {
+				t.Fatalf("Expected err: %t, but got err: %v", tc.errExpected, err)
+			}
+			if (warning != "") != tc.warningExpected {
+				t.Fatalf("Expected warning: %t, but got warning: %q", tc.warningExpected, warning)
+			}
+		})
+	}
+}
+
+func genLimitedResourceQuota(softLimit, burst int32, burstStatus *kubermaticv1.ResourceQuotaBurstStatus) *kubermaticv1.ResourceQuota {
+	resourceQuota := &kubermaticv1.ResourceQuota{}
+	if softLimit > 0 {
+		resourceQuota.Spec.SoftLimit = &kubermaticv1.ResourceQuotaSoftLimit{Percentage: softLimit}
+	}
+	if burst > 0 {
+		resourceQuota.Spec.Burst = &kubermaticv1.ResourceQuotaBurst{
+			Percentage: burst,
+			Duration:   metav1.Duration{Duration: time.Hour},
+		}
+	}
+	resourceQuota.Status.Burst = burstStatus
+	return resourceQuota
+}
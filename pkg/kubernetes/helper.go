@@ -483,25 +483,41 @@ func GetVersion(client *kubernetes.Clientset) (*ksemver.Semver, error) {
 }
 
 func GetClusterClient(ctx context.Context, cluster *kubermaticv1.ExternalCluster, masterClient ctrlruntimeclient.Client) (*kubernetes.Clientset, error) {
-	secretKeyGetter := provider.SecretKeySelectorValueFuncFactory(ctx, masterClient)
-	rawKubeconfig, err := secretKeyGetter(cluster.Spec.KubeconfigReference, "kubeconfig")
+	clientConfig, err := GetClusterRESTConfig(ctx, cluster, masterClient)
 	if err != nil {
 		return nil, err
 	}
-	cfg, err := clientcmd.Load([]byte(rawKubeconfig))
+	client, err := kubernetes.NewForConfig(clientConfig)
 	if err != nil {
 		return nil, err
 	}
-	clientConfig, err := getRestConfig(cfg)
+
+	return client, nil
+}
+
+// GetClusterKubeconfig returns the raw kubeconfig referenced by the external cluster.
+func GetClusterKubeconfig(ctx context.Context, cluster *kubermaticv1.ExternalCluster, masterClient ctrlruntimeclient.Client) ([]byte, error) {
+	secretKeyGetter := provider.SecretKeySelectorValueFuncFactory(ctx, masterClient)
+	rawKubeconfig, err := secretKeyGetter(cluster.Spec.KubeconfigReference, "kubeconfig")
 	if err != nil {
 		return nil, err
 	}
-	client, err := kubernetes.NewForConfig(clientConfig)
+
+	return []byte(rawKubeconfig), nil
+}
+
+// GetClusterRESTConfig returns the REST config for the external cluster.
+func GetClusterRESTConfig(ctx context.Context, cluster *kubermaticv1.ExternalCluster, masterClient ctrlruntimeclient.Client) (*rest.Config, error) {
+	rawKubeconfig, err := GetClusterKubeconfig(ctx, cluster, masterClient)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := clientcmd.Load(rawKubeconfig)
 	if err != nil {
 		return nil, err
 	}
 
-	return client, nil
+	return getRestConfig(cfg)
 }
 
 func getRestConfig(cfg *clientcmdapi.Config) (*rest.Config, error) {
@@ -543,6 +559,22 @@ func GetContainerRuntime(ctx context.Context,
 	return "", fmt.Errorf("failed to fetch container runtime: no control plane nodes found with label %s", NodeControlPlaneLabel)
 }
 
+// ExternalClusterReachable returns whether KKP can connect to the external cluster, i.e. it references a
+// kubeconfig and its provider reports it as usable. Clusters without a phase (like bring-your-own clusters)
+// are considered reachable.
+func ExternalClusterReachable(cluster *kubermaticv1.ExternalCluster) bool {
+	if cluster.Spec.KubeconfigReference == nil {
+		return false
+	}
+
+	switch cluster.Status.Condition.Phase {
+	case "", kubermaticv1.ExternalClusterPhaseRunning, kubermaticv1.ExternalClusterPhaseReconciling, kubermaticv1.ExternalClusterPhaseWarning:
+		return true
+	default:
+		return false
+	}
+}
+
 func ExternalClusterPausedChecker(ctx context.Context, externalClusterName string, masterClient ctrlruntimeclient.Client) (bool, error) {
 	externalCluster := &kubermaticv1.ExternalCluster{}
 	if err := masterClient.Get(ctx, types.NamespacedName{Name: externalClusterName}, externalCluster); err != nil {
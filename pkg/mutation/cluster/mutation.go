@@ -17,12 +17,17 @@ limitations under the License.
 package cluster
 
 import (
+	"encoding/json"
+
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/cni"
 	"k8c.io/kubermatic/v2/pkg/defaulting"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/validation"
 	"k8c.io/kubermatic/v2/pkg/version"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
@@ -107,6 +112,15 @@ func MutateUpdate(oldCluster, newCluster *kubermaticv1.Cluster, config *kubermat
 		newCluster.Spec.Features[kubermaticv1.ClusterFeatureCCMClusterName] = true
 	}
 
+	// If the CNI plugin is changed from Canal to Cilium, remember the previous CNI plugin, as it
+	// has to keep running until the CNI migration has moved all nodes to Cilium. The unsafe CNI
+	// migration label opts out of the in-place migration.
+	if cni.IsInPlaceMigration(oldCluster.Spec.CNIPlugin, newCluster.Spec.CNIPlugin) && !metav1.HasLabel(newCluster.ObjectMeta, validation.UnsafeCNIMigrationLabel) {
+		if err := addCNIMigrationSourceAnnotation(oldCluster, newCluster); err != nil {
+			return field.InternalError(field.NewPath("spec", "cniPlugin"), err)
+		}
+	}
+
 	// just because spec.Version might say 1.23 doesn't say that the cluster is already on 1.23,
 	// so for all feature toggles and migrations we should base this on the actual, current apiserver
 	curVersion := newCluster.Status.Versions.ControlPlane
@@ -125,3 +139,22 @@ func addCCMCSIMigrationAnnotations(cluster *kubermaticv1.Cluster) {
 	cluster.Annotations[kubermaticv1.CCMMigrationNeededAnnotation] = ""
 	cluster.Annotations[kubermaticv1.CSIMigrationNeededAnnotation] = ""
 }
+
+func addCNIMigrationSourceAnnotation(oldCluster, newCluster *kubermaticv1.Cluster) error {
+	source, err := json.Marshal(kubermaticv1.CNIMigrationSource{
+		Type:           oldCluster.Spec.CNIPlugin.Type,
+		Version:        oldCluster.Spec.CNIPlugin.Version,
+		PodsCIDRBlocks: oldCluster.Spec.ClusterNetwork.Pods.CIDRBlocks,
+	})
+	if err != nil {
+		return err
+	}
+
+	if newCluster.Annotations == nil {
+		newCluster.Annotations = map[string]string{}
+	}
+
+	newCluster.Annotations[kubermaticv1.CNIMigrationSourceAnnotation] = string(source)
+
+	return nil
+}
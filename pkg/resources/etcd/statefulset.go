@@ -338,7 +338,8 @@ func StatefulSetReconciler(data etcdStatefulSetReconcilerData, enableDataCorrupt
 
 			set.Spec.Template.Spec.Volumes = getVolumes()
 
-			// Make sure we don't change volume claim template of existing sts
+			// Make sure we don't change volume claim template of existing sts; the
+			// etcd storage migration recreates the sts to change it.
 			if len(set.Spec.VolumeClaimTemplates) == 0 {
 				storageClass := data.Cluster().Spec.ComponentsOverride.Etcd.StorageClass
 				if storageClass == "" {
@@ -349,6 +350,10 @@ func StatefulSetReconciler(data etcdStatefulSetReconcilerData, enableDataCorrupt
 					d := data.EtcdDiskSize()
 					diskSize = &d
 				}
+				if migration := data.Cluster().Status.EtcdStorageMigration; migration.InProgress() {
+					storageClass = migration.StorageClass
+					diskSize = &migration.DiskSize
+				}
 				set.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{
 					{
 						ObjectMeta: metav1.ObjectMeta{
@@ -446,6 +451,13 @@ func computeReplicas(data etcdStatefulSetReconcilerData, set *appsv1.StatefulSet
 		return kubermaticv1.DefaultEtcdClusterSize
 	}
 	etcdClusterSize := getClusterSize(data.Cluster().Spec.ComponentsOverride.Etcd)
+	// a storage migration temporarily adds one member and defers other scaling
+	if migration := data.Cluster().Status.EtcdStorageMigration; migration.InProgress() {
+		etcdClusterSize = migration.ClusterSize
+		if migration.HasAdditionalMember() {
+			etcdClusterSize++
+		}
+	}
 	if set.Spec.Replicas == nil { // new replicaset
 		return etcdClusterSize
 	}
@@ -23,9 +23,12 @@ import (
 	"testing"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	testhelper "k8c.io/kubermatic/v2/pkg/test"
 
+	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 var update = flag.Bool("update", false, "update .golden files")
@@ -136,3 +139,87 @@ func TestGetEtcdCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeReplicas(t *testing.T) {
+	tests := []struct {
+		name      string
+		migration *kubermaticv1.EtcdStorageMigrationStatus
+		health    kubermaticv1.HealthStatus
+		replicas  int32
+		expected  int32
+	}{
+		{
+			name:     "at desired size",
+			health:   kubermaticv1.HealthStatusUp,
+			replicas: 3,
+			expected: 3,
+		},
+		{
+			name:     "scale up one member at a time",
+			health:   kubermaticv1.HealthStatusUp,
+			replicas: 1,
+			expected: 2,
+		},
+		{
+			name: "migration adds a member",
+			migration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:       kubermaticv1.EtcdStorageMigrationPhaseAddingMember,
+				ClusterSize: 3,
+			},
+			health:   kubermaticv1.HealthStatusUp,
+			replicas: 3,
+			expected: 4,
+		},
+		{
+			name: "migration does not add a member to an unhealthy cluster",
+			migration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:       kubermaticv1.EtcdStorageMigrationPhaseAddingMember,
+				ClusterSize: 3,
+			},
+			health:   kubermaticv1.HealthStatusDown,
+			replicas: 3,
+			expected: 3,
+		},
+		{
+			name: "migration removes the additional member",
+			migration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:       kubermaticv1.EtcdStorageMigrationPhaseRemovingMember,
+				ClusterSize: 3,
+			},
+			health:   kubermaticv1.HealthStatusUp,
+			replicas: 4,
+			expected: 3,
+		},
+		{
+			name: "completed migration does not affect the size",
+			migration: &kubermaticv1.EtcdStorageMigrationStatus{
+				Phase:       kubermaticv1.EtcdStorageMigrationPhaseCompleted,
+				ClusterSize: 5,
+			},
+			health:   kubermaticv1.HealthStatusUp,
+			replicas: 3,
+			expected: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					Features: map[string]bool{kubermaticv1.ClusterFeatureEtcdLauncher: true},
+				},
+				Status: kubermaticv1.ClusterStatus{
+					ExtendedHealth:       kubermaticv1.ExtendedClusterHealth{Etcd: test.health},
+					EtcdStorageMigration: test.migration,
+				},
+			}
+
+			data := resources.NewTemplateDataBuilder().WithCluster(cluster).Build()
+			set := &appsv1.StatefulSet{Spec: appsv1.StatefulSetSpec{Replicas: ptr.To(test.replicas)}}
+
+			if replicas := computeReplicas(data, set); replicas != test.expected {
+				t.Errorf("Expected %d replicas, got %d.", test.expected, replicas)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/apiserver"
 	"k8c.io/kubermatic/v2/pkg/resources/registry"
+	"k8c.io/kubermatic/v2/pkg/util/nodeupgrade"
 	"k8c.io/machine-controller/sdk/providerconfig"
 	"k8c.io/reconciler/pkg/reconciling"
 
@@ -155,7 +156,7 @@ func DeploymentReconcilerWithoutInitWrapper(data machinecontrollerData) reconcil
 					Name:    resources.MachineControllerContainerName,
 					Image:   repository + ":" + tag,
 					Command: []string{"/usr/local/bin/machine-controller"},
-					Args:    getFlags(data.Cluster().Spec.Features),
+					Args:    getFlags(&data.Cluster().Spec),
 					Env: append(envVars, corev1.EnvVar{
 						Name:  "PROBER_KUBECONFIG",
 						Value: "/etc/kubernetes/kubeconfig/kubeconfig",
@@ -234,7 +235,7 @@ func DeploymentReconcilerWithoutInitWrapper(data machinecontrollerData) reconcil
 	}
 }
 
-func getFlags(features map[string]bool) []string {
+func getFlags(spec *kubermaticv1.ClusterSpec) []string {
 	flags := []string{
 		"-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
 		"-health-probe-address", "0.0.0.0:8085",
@@ -243,10 +244,14 @@ func getFlags(features map[string]bool) []string {
 		"-node-csr-approver",
 	}
 
-	externalCloudProvider := features[kubermaticv1.ClusterFeatureExternalCloudProvider]
+	externalCloudProvider := spec.Features[kubermaticv1.ClusterFeatureExternalCloudProvider]
 	if externalCloudProvider {
 		flags = append(flags, "-node-external-cloud-provider")
 	}
 
+	if skipEvictionAfter, ok := nodeupgrade.SkipEvictionAfter(spec.NodeUpgradePolicy); ok {
+		flags = append(flags, "-skip-eviction-after", skipEvictionAfter.String())
+	}
+
 	return flags
 }
@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/reconciler/pkg/reconciling"
 
@@ -41,17 +42,16 @@ func ServerClientConfigsConfigMapReconciler(data serverClientConfigsData) reconc
 
 			var iroutes []string
 
-			// iroute for pod network
-			if len(data.Cluster().Spec.ClusterNetwork.Pods.CIDRBlocks) < 1 {
-				return nil, fmt.Errorf("cluster.Spec.ClusterNetwork.Pods.CIDRBlocks must contain at least one entry")
-			}
-			_, podNet, err := net.ParseCIDR(data.Cluster().Spec.ClusterNetwork.Pods.CIDRBlocks[0])
+			// iroutes for pod networks
+			podNets, err := podNetworks(data.Cluster())
 			if err != nil {
 				return nil, err
 			}
-			iroutes = append(iroutes, fmt.Sprintf("iroute %s %s",
-				podNet.IP.String(),
-				net.IP(podNet.Mask).String()))
+			for _, podNet := range podNets {
+				iroutes = append(iroutes, fmt.Sprintf("iroute %s %s",
+					podNet.IP.String(),
+					net.IP(podNet.Mask).String()))
+			}
 
 			// iroute for service network
 			if len(data.Cluster().Spec.ClusterNetwork.Services.CIDRBlocks) < 1 {
@@ -85,3 +85,27 @@ func ServerClientConfigsConfigMapReconciler(data serverClientConfigsData) reconc
 		}
 	}
 }
+
+// podNetworks returns the pod network of the cluster. During an in-place CNI migration, the
+// pod network of the previous CNI plugin is returned as well, as long as nodes still use it.
+func podNetworks(cluster *kubermaticv1.Cluster) ([]*net.IPNet, error) {
+	if len(cluster.Spec.ClusterNetwork.Pods.CIDRBlocks) < 1 {
+		return nil, fmt.Errorf("cluster.Spec.ClusterNetwork.Pods.CIDRBlocks must contain at least one entry")
+	}
+
+	cidrs := []string{cluster.Spec.ClusterNetwork.Pods.CIDRBlocks[0]}
+	if source := kubermaticv1helper.CNIMigrationSource(cluster); source != nil && len(source.PodsCIDRBlocks) > 0 && source.PodsCIDRBlocks[0] != cidrs[0] {
+		cidrs = append(cidrs, source.PodsCIDRBlocks[0])
+	}
+
+	podNets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, podNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		podNets = append(podNets, podNet)
+	}
+
+	return podNets, nil
+}
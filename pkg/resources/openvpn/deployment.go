@@ -110,7 +110,7 @@ func DeploymentReconciler(data openVPNDeploymentReconcilerData) reconciling.Name
 				resources.ClusterAutoscalerSafeToEvictVolumesAnnotation: "openvpn-status",
 			})
 
-			_, podNet, err := net.ParseCIDR(data.Cluster().Spec.ClusterNetwork.Pods.CIDRBlocks[0])
+			podNets, err := podNetworks(data.Cluster())
 			if err != nil {
 				return nil, err
 			}
@@ -120,13 +120,20 @@ func DeploymentReconciler(data openVPNDeploymentReconcilerData) reconciling.Name
 				return nil, err
 			}
 
-			pushRoutes := []string{
-				// pod and service routes
-				"--push", fmt.Sprintf("route %s %s", podNet.IP.String(), net.IP(podNet.Mask).String()),
-				"--route", podNet.IP.String(), net.IP(podNet.Mask).String(),
+			// pod and service routes
+			var pushRoutes []string
+			var podForwardRules string
+			for _, podNet := range podNets {
+				pushRoutes = append(pushRoutes,
+					"--push", fmt.Sprintf("route %s %s", podNet.IP.String(), net.IP(podNet.Mask).String()),
+					"--route", podNet.IP.String(), net.IP(podNet.Mask).String(),
+				)
+				podForwardRules += "iptables -A FORWARD -i tun0 -o tun0 -s 10.20.0.0/24 -d " + podNet.String() + " -j ACCEPT\n"
+			}
+			pushRoutes = append(pushRoutes,
 				"--push", fmt.Sprintf("route %s %s", serviceNet.IP.String(), net.IP(serviceNet.Mask).String()),
 				"--route", serviceNet.IP.String(), net.IP(serviceNet.Mask).String(),
-			}
+			)
 
 			// node access network route
 			_, nodeAccessNetwork, err := net.ParseCIDR(data.NodeAccessNetwork())
@@ -153,8 +160,7 @@ iptables -t nat -A POSTROUTING -o tun0 -s 10.20.0.0/24 -j MASQUERADE
 # Only allow outbound traffic to services, pods, nodes
 iptables -P FORWARD DROP
 iptables -A FORWARD -m state --state ESTABLISHED,RELATED -j ACCEPT
-iptables -A FORWARD -i tun0 -o tun0 -s 10.20.0.0/24 -d ` + podNet.String() + ` -j ACCEPT
-iptables -A FORWARD -i tun0 -o tun0 -s 10.20.0.0/24 -d ` + serviceNet.String() + ` -j ACCEPT
+` + podForwardRules + `iptables -A FORWARD -i tun0 -o tun0 -s 10.20.0.0/24 -d ` + serviceNet.String() + ` -j ACCEPT
 iptables -A FORWARD -i tun0 -o tun0 -s 10.20.0.0/24 -d ` + nodeAccessNetwork.String() + ` -j ACCEPT
 
 iptables -A INPUT -m state --state ESTABLISHED,RELATED -j ACCEPT
@@ -1259,6 +1259,43 @@ func ReconcileBackupStorageLocations(ctx context.Context, namedFactories []Named
 	return nil
 }
 
+// ScheduleReconciler defines an interface to create/update Schedules.
+type ScheduleReconciler = func(existing *velerov1.Schedule) (*velerov1.Schedule, error)
+
+// NamedScheduleReconcilerFactory returns the name of the resource and the corresponding Reconciler function.
+type NamedScheduleReconcilerFactory = func() (name string, reconciler ScheduleReconciler)
+
+// ScheduleObjectWrapper adds a wrapper so the ScheduleReconciler matches ObjectReconciler.
+// This is needed as Go does not support function interface matching.
+func ScheduleObjectWrapper(reconciler ScheduleReconciler) reconciling.ObjectReconciler {
+	return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		if existing != nil {
+			return reconciler(existing.(*velerov1.Schedule))
+		}
+		return reconciler(&velerov1.Schedule{})
+	}
+}
+
+// ReconcileSchedules will create and update the Schedules coming from the passed ScheduleReconciler slice.
+func ReconcileSchedules(ctx context.Context, namedFactories []NamedScheduleReconcilerFactory, namespace string, client ctrlruntimeclient.Client, objectModifiers ...reconciling.ObjectModifier) error {
+	for _, factory := range namedFactories {
+		name, reconciler := factory()
+		reconcileObject := ScheduleObjectWrapper(reconciler)
+		reconcileObject = reconciling.CreateWithNamespace(reconcileObject, namespace)
+		reconcileObject = reconciling.CreateWithName(reconcileObject, name)
+
+		for _, objectModifier := range objectModifiers {
+			reconcileObject = objectModifier(reconcileObject)
+		}
+
+		if err := reconciling.EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, reconcileObject, client, &velerov1.Schedule{}, false); err != nil {
+			return fmt.Errorf("failed to ensure Schedule %s/%s: %w", namespace, name, err)
+		}
+	}
+
+	return nil
+}
+
 // KyvernoClusterPolicyReconciler defines an interface to create/update ClusterPolicies.
 type KyvernoClusterPolicyReconciler = func(existing *kyvernov1.ClusterPolicy) (*kyvernov1.ClusterPolicy, error)
 
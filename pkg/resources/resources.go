@@ -1103,6 +1103,14 @@ const (
 	AcceleratorAccountingHeartbeatTimeout = 5 * time.Minute
 )
 
+const (
+	// ServiceQuotaValidatingWebhookPath is the user cluster webhook endpoint enforcing the LoadBalancer quota.
+	ServiceQuotaValidatingWebhookPath = "/validate-service-quota"
+	// PersistentVolumeClaimQuotaValidatingWebhookPath is the user cluster webhook endpoint enforcing the
+	// PersistentVolumeClaim quota.
+	PersistentVolumeClaimQuotaValidatingWebhookPath = "/validate-persistentvolumeclaim-quota"
+)
+
 var DefaultApplicationCacheSize = resource.MustParse("300Mi")
 
 // GetApplicationCacheSize return the application cache size if defined, otherwise fallback to the default size.
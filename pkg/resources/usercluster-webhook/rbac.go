@@ -55,7 +55,7 @@ func ClusterRole() reconciling.NamedClusterRoleReconcilerFactory {
 				},
 				{
 					APIGroups: []string{kubermaticv1.GroupName},
-					Resources: []string{"resourcequotas", "resourcequotas/status"},
+					Resources: []string{"resourcequotas", "resourcequotas/status", "projects"},
 					Verbs: []string{
 						"get",
 						"list",
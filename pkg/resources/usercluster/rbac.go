@@ -91,6 +91,23 @@ func RoleReconciler() (string, reconciling.RoleReconciler) {
 					"delete",
 				},
 			},
+			{
+				APIGroups: []string{"kubermatic.k8c.io"},
+				Resources: []string{"clusterrestores"},
+				Verbs: []string{
+					"get",
+					"list",
+					"watch",
+					"update",
+				},
+			},
+			{
+				APIGroups: []string{"kubermatic.k8c.io"},
+				Resources: []string{"clusterrestores/status"},
+				Verbs: []string{
+					"patch",
+				},
+			},
 		}
 		return r, nil
 	}
@@ -164,6 +181,11 @@ func ClusterRole() reconciling.NamedClusterRoleReconcilerFactory {
 					Resources: []string{"policybindings", "policybindings/status"},
 					Verbs:     []string{"*"},
 				},
+				{
+					APIGroups: []string{"kubermatic.k8c.io"},
+					Resources: []string{"clusterbackuppolicies"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
 			}
 			return r, nil
 		}
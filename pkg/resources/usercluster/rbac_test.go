@@ -52,6 +52,42 @@ func TestClusterRoleAllowsReadingResourceQuotas(t *testing.T) {
 	}
 }
 
+func TestClusterRoleAllowsReadingClusterBackupPolicies(t *testing.T) {
+	t.Parallel()
+
+	_, reconciler := ClusterRole()()
+	role, err := reconciler(&rbacv1.ClusterRole{})
+	if err != nil {
+		t.Fatalf("failed to reconcile ClusterRole: %v", err)
+	}
+
+	for _, verb := range []string{"get", "list", "watch"} {
+		if !hasRule(role.Rules, "kubermatic.k8c.io", "clusterbackuppolicies", verb) {
+			t.Errorf("ClusterRole does not allow %s on ClusterBackupPolicies", verb)
+		}
+	}
+}
+
+func TestRoleAllowsManagingClusterRestores(t *testing.T) {
+	t.Parallel()
+
+	_, reconciler := RoleReconciler()
+	role, err := reconciler(&rbacv1.Role{})
+	if err != nil {
+		t.Fatalf("failed to reconcile Role: %v", err)
+	}
+
+	for _, verb := range []string{"get", "list", "watch", "update"} {
+		if !hasRule(role.Rules, "kubermatic.k8c.io", "clusterrestores", verb) {
+			t.Errorf("Role does not allow %s on ClusterRestores", verb)
+		}
+	}
+
+	if !hasRule(role.Rules, "kubermatic.k8c.io", "clusterrestores/status", "patch") {
+		t.Error("Role does not allow patching the ClusterRestore status")
+	}
+}
+
 func hasRule(rules []rbacv1.PolicyRule, apiGroup, resource, verb string) bool {
 	for _, rule := range rules {
 		if contains(rule.APIGroups, apiGroup) && contains(rule.Resources, resource) && (contains(rule.Verbs, verb) || contains(rule.Verbs, "*")) {
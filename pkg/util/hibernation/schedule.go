@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package hibernation evaluates the hibernation schedules of clusters.
+package hibernation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	// embed the time zone database, as the container images do not ship one.
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+// lookback limits how far into the past the last scheduled transition is searched.
+const lookback = 8 * 24 * time.Hour
+
+var parser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Schedule is a parsed hibernation schedule.
+type Schedule struct {
+	hibernate cron.Schedule
+	wakeUp    cron.Schedule
+	location  *time.Location
+}
+
+// Transition is a scheduled hibernation or wake up of a cluster.
+type Transition struct {
+	// Hibernate is true if the cluster is hibernated, false if it is woken up.
+	Hibernate bool
+	Time      time.Time
+}
+
+// ParseSchedule parses and validates a hibernation schedule.
+func ParseSchedule(schedule *kubermaticv1.ClusterHibernationSchedule) (*Schedule, error) {
+	if schedule.Hibernate == "" || schedule.WakeUp == "" {
+		return nil, errors.New("both hibernate and wakeUp must be specified")
+	}
+
+	hibernate, err := parser.Parse(schedule.Hibernate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hibernate schedule: %w", err)
+	}
+
+	wakeUp, err := parser.Parse(schedule.WakeUp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wakeUp schedule: %w", err)
+	}
+
+	location := time.UTC
+	if schedule.Timezone != "" {
+		location, err = time.LoadLocation(schedule.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone: %w", err)
+		}
+	}
+
+	return &Schedule{
+		hibernate: hibernate,
+		wakeUp:    wakeUp,
+		location:  location,
+	}, nil
+}
+
+// Last returns the most recent transition at or before now. If there was no transition
+// within the last 8 days, nil is returned.
+func (s *Schedule) Last(now time.Time) *Transition {
+	hibernate := last(s.hibernate, now.In(s.location))
+	wakeUp := last(s.wakeUp, now.In(s.location))
+
+	switch {
+	case hibernate.IsZero() && wakeUp.IsZero():
+		return nil
+	case hibernate.After(wakeUp):
+		return &Transition{Hibernate: true, Time: hibernate}
+	default:
+		return &Transition{Hibernate: false, Time: wakeUp}
+	}
+}
+
+// Next returns the next transition after now.
+func (s *Schedule) Next(now time.Time) Transition {
+	hibernate := s.hibernate.Next(now.In(s.location))
+	wakeUp := s.wakeUp.Next(now.In(s.location))
+
+	if !hibernate.IsZero() && (wakeUp.IsZero() || hibernate.Before(wakeUp)) {
+		return Transition{Hibernate: true, Time: hibernate}
+	}
+
+	return Transition{Hibernate: false, Time: wakeUp}
+}
+
+func last(schedule cron.Schedule, now time.Time) time.Time {
+	var result time.Time
+
+	for t := schedule.Next(now.Add(-lookback)); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		result = t
+	}
+
+	return result
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hibernation
+
+import (
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+func TestParseSchedule(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule kubermaticv1.ClusterHibernationSchedule
+		wantErr  bool
+	}{
+		{
+			name:     "valid schedule",
+			schedule: kubermaticv1.ClusterHibernationSchedule{Hibernate: "0 20 * * 1-5", WakeUp: "0 7 * * 1-5", Timezone: "Europe/Berlin"},
+		},
+		{
+			name:     "missing wake up",
+			schedule: kubermaticv1.ClusterHibernationSchedule{Hibernate: "0 20 * * 1-5"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid cron expression",
+			schedule: kubermaticv1.ClusterHibernationSchedule{Hibernate: "0 25 * * *", WakeUp: "0 7 * * *"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid timezone",
+			schedule: kubermaticv1.ClusterHibernationSchedule{Hibernate: "0 20 * * *", WakeUp: "0 7 * * *", Timezone: "Berlin"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseSchedule(&tc.schedule)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error = %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestScheduleTransitions(t *testing.T) {
+	// hibernate on weekday evenings, wake up on weekday mornings, i.e. stay hibernated over the weekend
+	schedule, err := ParseSchedule(&kubermaticv1.ClusterHibernationSchedule{
+		Hibernate: "0 20 * * 1-5",
+		WakeUp:    "0 7 * * 1-5",
+		Timezone:  "Europe/Berlin",
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse schedule: %v", err)
+	}
+
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	testCases := []struct {
+		name          string
+		now           time.Time
+		lastHibernate bool
+		lastTime      time.Time
+		nextHibernate bool
+		nextTime      time.Time
+	}{
+		{
+			name:          "weekday noon",
+			now:           time.Date(2026, 10, 14, 12, 0, 0, 0, berlin), // Wednesday
+			lastHibernate: false,
+			lastTime:      time.Date(2026, 10, 14, 7, 0, 0, 0, berlin),
+			nextHibernate: true,
+			nextTime:      time.Date(2026, 10, 14, 20, 0, 0, 0, berlin),
+		},
+		{
+			name:          "weekend",
+			now:           time.Date(2026, 10, 17, 12, 0, 0, 0, berlin), // Saturday
+			lastHibernate: true,
+			lastTime:      time.Date(2026, 10, 16, 20, 0, 0, 0, berlin),
+			nextHibernate: false,
+			nextTime:      time.Date(2026, 10, 19, 7, 0, 0, 0, berlin),
+		},
+		{
+			name:          "exactly at a transition",
+			now:           time.Date(2026, 10, 14, 20, 0, 0, 0, berlin).UTC(),
+			lastHibernate: true,
+			lastTime:      time.Date(2026, 10, 14, 20, 0, 0, 0, berlin),
+			nextHibernate: false,
+			nextTime:      time.Date(2026, 10, 15, 7, 0, 0, 0, berlin),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			last := schedule.Last(tc.now)
+			if last == nil {
+				t.Fatal("Expected a last transition.")
+			}
+			if last.Hibernate != tc.lastHibernate || !last.Time.Equal(tc.lastTime) {
+				t.Errorf("Expected last transition (%v, %v), got (%v, %v).", tc.lastHibernate, tc.lastTime, last.Hibernate, last.Time)
+			}
+
+			next := schedule.Next(tc.now)
+			if next.Hibernate != tc.nextHibernate || !next.Time.Equal(tc.nextTime) {
+				t.Errorf("Expected next transition (%v, %v), got (%v, %v).", tc.nextHibernate, tc.nextTime, next.Hibernate, next.Time)
+			}
+		})
+	}
+}
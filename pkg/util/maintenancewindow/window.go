@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindow
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	// embed the time zone database, as the container images do not ship one.
+	_ "time/tzdata"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	day  = 24 * time.Hour
+	week = 7 * day
+)
+
+var weekdays = map[string]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// ForCluster returns the maintenance window that applies to the cluster, i.e. the cluster's
+// own window or the default window of its datacenter. nil means updates are always allowed.
+func ForCluster(cluster *kubermaticv1.Cluster, datacenter *kubermaticv1.Datacenter) *kubermaticv1.MaintenanceWindow {
+	if cluster.Spec.MaintenanceWindow != nil {
+		return cluster.Spec.MaintenanceWindow
+	}
+
+	if datacenter != nil {
+		return datacenter.Spec.DefaultMaintenanceWindow
+	}
+
+	return nil
+}
+
+// ForSeedCluster returns the parsed maintenance window of a cluster running in the given seed.
+// nil means updates are always allowed.
+func ForSeedCluster(cluster *kubermaticv1.Cluster, seed *kubermaticv1.Seed) (*Window, error) {
+	var datacenter *kubermaticv1.Datacenter
+	if dc, ok := seed.Spec.Datacenters[cluster.Spec.Cloud.DatacenterName]; ok {
+		datacenter = &dc
+	}
+
+	window := ForCluster(cluster, datacenter)
+	if window == nil {
+		return nil, nil
+	}
+
+	return Parse(window)
+}
+
+// Window is a parsed maintenance window.
+type Window struct {
+	weekday  *time.Weekday
+	hour     int
+	minute   int
+	length   time.Duration
+	location *time.Location
+}
+
+// Parse parses and validates the given maintenance window.
+func Parse(w *kubermaticv1.MaintenanceWindow) (*Window, error) {
+	length, err := time.ParseDuration(w.Length)
+	if err != nil {
+		return nil, fmt.Errorf("invalid length: %w", err)
+	}
+
+	location, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	window := &Window{
+		length:   length,
+		location: location,
+	}
+
+	timeOfDay := w.Start
+	maxLength := day
+	if weekdayName, rest, found := strings.Cut(w.Start, " "); found {
+		weekday, ok := weekdays[weekdayName]
+		if !ok {
+			return nil, fmt.Errorf("invalid start: unknown day of week %q", weekdayName)
+		}
+
+		window.weekday = &weekday
+		timeOfDay = rest
+		maxLength = week
+	}
+
+	start, err := time.Parse("15:04", timeOfDay)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start: %w", err)
+	}
+
+	window.hour = start.Hour()
+	window.minute = start.Minute()
+
+	if length <= 0 || length > maxLength {
+		return nil, fmt.Errorf("length must be greater than 0 and at most %v", maxLength)
+	}
+
+	return window, nil
+}
+
+// Next returns the start and end of the window that is currently open or, if the window is
+// closed, of the next window.
+func (w *Window) Next(now time.Time) (time.Time, time.Time) {
+	local := now.In(w.location)
+
+	days := 1
+	offset := 0
+	if w.weekday != nil {
+		days = 7
+		offset = int(*w.weekday - local.Weekday())
+	}
+
+	// The window might have opened in the previous period and still be open, so check
+	// the previous, current and next period. Constructing the dates via time.Date keeps
+	// the start time correct across daylight saving time changes.
+	for i := -1; i <= 1; i++ {
+		start := time.Date(local.Year(), local.Month(), local.Day()+offset+i*days, w.hour, w.minute, 0, 0, w.location)
+		end := start.Add(w.length)
+
+		if now.Before(end) {
+			return start, end
+		}
+	}
+
+	// unreachable, as the window is never longer than a period
+	start := time.Date(local.Year(), local.Month(), local.Day()+offset+2*days, w.hour, w.minute, 0, 0, w.location)
+	return start, start.Add(w.length)
+}
+
+// IsOpen returns whether the window is open at the given time and the start of the current
+// or next window. A nil window is always open.
+func (w *Window) IsOpen(now time.Time) (bool, time.Time) {
+	if w == nil {
+		return true, now
+	}
+
+	start, _ := w.Next(now)
+	return !now.Before(start), start
+}
+
+// SetPendingUpdates replaces all pending updates of the given types in the cluster status with
+// the given updates. Unchanged entries are kept as they are, so that the status is not updated
+// needlessly.
+func SetPendingUpdates(cluster *kubermaticv1.Cluster, types []kubermaticv1.PendingUpdateType, updates []kubermaticv1.PendingUpdate) {
+	owned := sets.New(types...)
+
+	desired := map[string]kubermaticv1.PendingUpdate{}
+	for _, update := range updates {
+		desired[kubermaticv1.PendingUpdateKey(update.Type, update.Name)] = update
+	}
+
+	for key, update := range cluster.Status.PendingUpdates {
+		if _, ok := desired[key]; !ok && owned.Has(update.Type) {
+			delete(cluster.Status.PendingUpdates, key)
+		}
+	}
+
+	for key, update := range desired {
+		existing, ok := cluster.Status.PendingUpdates[key]
+		if ok && existing.From == update.From && existing.To == update.To && existing.NextWindowStart.Equal(&update.NextWindowStart) {
+			continue
+		}
+
+		if cluster.Status.PendingUpdates == nil {
+			cluster.Status.PendingUpdates = map[string]kubermaticv1.PendingUpdate{}
+		}
+		cluster.Status.PendingUpdates[key] = update
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindow
+
+import (
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name    string
+		window  kubermaticv1.MaintenanceWindow
+		wantErr bool
+	}{
+		{
+			name:   "daily window",
+			window: kubermaticv1.MaintenanceWindow{Start: "22:30", Length: "4h"},
+		},
+		{
+			name:   "weekly window with timezone",
+			window: kubermaticv1.MaintenanceWindow{Start: "Sat 21:00", Length: "48h", Timezone: "Europe/Berlin"},
+		},
+		{
+			name:    "invalid start",
+			window:  kubermaticv1.MaintenanceWindow{Start: "Saturday 21:00", Length: "4h"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid timezone",
+			window:  kubermaticv1.MaintenanceWindow{Start: "21:00", Length: "4h", Timezone: "Mars/Olympus"},
+			wantErr: true,
+		},
+		{
+			name:    "daily window longer than a day",
+			window:  kubermaticv1.MaintenanceWindow{Start: "21:00", Length: "25h"},
+			wantErr: true,
+		},
+		{
+			name:    "empty window",
+			window:  kubermaticv1.MaintenanceWindow{Start: "21:00", Length: "0s"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(&tc.window)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error = %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestIsOpen(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	testCases := []struct {
+		name          string
+		window        kubermaticv1.MaintenanceWindow
+		now           time.Time
+		expectedOpen  bool
+		expectedStart time.Time
+	}{
+		{
+			name:          "daily window later today",
+			window:        kubermaticv1.MaintenanceWindow{Start: "22:00", Length: "4h"},
+			now:           time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC),
+			expectedStart: time.Date(2026, 3, 4, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "daily window opened yesterday is still open",
+			window:        kubermaticv1.MaintenanceWindow{Start: "22:00", Length: "4h"},
+			now:           time.Date(2026, 3, 5, 1, 0, 0, 0, time.UTC),
+			expectedOpen:  true,
+			expectedStart: time.Date(2026, 3, 4, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "daily window is closed at its end",
+			window:        kubermaticv1.MaintenanceWindow{Start: "22:00", Length: "4h"},
+			now:           time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC),
+			expectedStart: time.Date(2026, 3, 5, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "window in another timezone",
+			window:        kubermaticv1.MaintenanceWindow{Start: "02:00", Length: "2h", Timezone: "Europe/Berlin"},
+			now:           time.Date(2026, 1, 10, 1, 30, 0, 0, time.UTC),
+			expectedOpen:  true,
+			expectedStart: time.Date(2026, 1, 10, 2, 0, 0, 0, berlin),
+		},
+		{
+			name:          "window across daylight saving time change",
+			window:        kubermaticv1.MaintenanceWindow{Start: "04:00", Length: "1h", Timezone: "Europe/Berlin"},
+			now:           time.Date(2026, 3, 28, 12, 0, 0, 0, time.UTC),
+			expectedStart: time.Date(2026, 3, 29, 4, 0, 0, 0, berlin),
+		},
+		{
+			name:          "weekly window next week",
+			window:        kubermaticv1.MaintenanceWindow{Start: "Mon 06:00", Length: "2h"},
+			now:           time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC), // Wednesday
+			expectedStart: time.Date(2026, 3, 9, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "weekly window spanning the weekend",
+			window:        kubermaticv1.MaintenanceWindow{Start: "Sat 00:00", Length: "48h"},
+			now:           time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC), // Sunday
+			expectedOpen:  true,
+			expectedStart: time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			window, err := Parse(&tc.window)
+			if err != nil {
+				t.Fatalf("failed to parse window: %v", err)
+			}
+
+			open, start := window.IsOpen(tc.now)
+			if open != tc.expectedOpen {
+				t.Errorf("expected open = %v, got %v", tc.expectedOpen, open)
+			}
+			if !start.Equal(tc.expectedStart) {
+				t.Errorf("expected window start %v, got %v", tc.expectedStart, start)
+			}
+		})
+	}
+}
+
+func TestForCluster(t *testing.T) {
+	clusterWindow := &kubermaticv1.MaintenanceWindow{Start: "22:00", Length: "4h"}
+	dcWindow := &kubermaticv1.MaintenanceWindow{Start: "Sat 22:00", Length: "4h"}
+
+	cluster := &kubermaticv1.Cluster{}
+	datacenter := &kubermaticv1.Datacenter{Spec: kubermaticv1.DatacenterSpec{DefaultMaintenanceWindow: dcWindow}}
+
+	if w := ForCluster(cluster, nil); w != nil {
+		t.Errorf("expected no window, got %v", w)
+	}
+	if w := ForCluster(cluster, datacenter); w != dcWindow {
+		t.Errorf("expected datacenter window, got %v", w)
+	}
+
+	cluster.Spec.MaintenanceWindow = clusterWindow
+	if w := ForCluster(cluster, datacenter); w != clusterWindow {
+		t.Errorf("expected cluster window, got %v", w)
+	}
+}
+
+func TestSetPendingUpdates(t *testing.T) {
+	windowStart := metav1.NewTime(time.Date(2026, 3, 7, 22, 0, 0, 0, time.UTC))
+
+	cluster := &kubermaticv1.Cluster{
+		Status: kubermaticv1.ClusterStatus{
+			PendingUpdates: map[string]kubermaticv1.PendingUpdate{
+				"Apiserver":             {Type: kubermaticv1.PendingUpdateTypeApiserver, To: "1.34.1", NextWindowStart: windowStart},
+				"MachineDeployment/old": {Type: kubermaticv1.PendingUpdateTypeMachineDeployment, Name: "old", To: "1.34.1", NextWindowStart: windowStart},
+			},
+		},
+	}
+
+	SetPendingUpdates(cluster, []kubermaticv1.PendingUpdateType{kubermaticv1.PendingUpdateTypeControlPlane, kubermaticv1.PendingUpdateTypeMachineDeployment}, []kubermaticv1.PendingUpdate{
+		{Type: kubermaticv1.PendingUpdateTypeMachineDeployment, Name: "workers", To: "1.34.1", NextWindowStart: windowStart},
+	})
+
+	expected := map[string]kubermaticv1.PendingUpdate{
+		"Apiserver":                 {Type: kubermaticv1.PendingUpdateTypeApiserver, To: "1.34.1", NextWindowStart: windowStart},
+		"MachineDeployment/workers": {Type: kubermaticv1.PendingUpdateTypeMachineDeployment, Name: "workers", To: "1.34.1", NextWindowStart: windowStart},
+	}
+	if !diff.SemanticallyEqual(expected, cluster.Status.PendingUpdates) {
+		t.Errorf("pending updates differ:\n%v", diff.ObjectDiff(expected, cluster.Status.PendingUpdates))
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nodeupgrade applies the node upgrade policy of a cluster to its MachineDeployments
+// and nodes and reports the progress of node upgrades.
+package nodeupgrade
+
+import (
+	"fmt"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/machine-controller/sdk/apis/cluster/common"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// SkipEvictionByPolicyAnnotation marks nodes on which the machine-controller's skip-eviction
+	// annotation was set because they match a skip-drain selector of the node upgrade policy,
+	// so that it can be removed again once the node does not match anymore.
+	SkipEvictionByPolicyAnnotation = "kubermatic.k8c.io/skip-eviction-by-policy"
+
+	// respectingSkipEvictionAfter is passed to the machine-controller if PodDisruptionBudgets must
+	// be respected. The machine-controller always gives up on evictions eventually, so this is
+	// effectively never.
+	respectingSkipEvictionAfter = 365 * 24 * time.Hour
+)
+
+// ApplyToMachineDeployment sets the rolling update strategy of the MachineDeployment according
+// to the policy and returns whether the MachineDeployment was changed.
+func ApplyToMachineDeployment(md *clusterv1alpha1.MachineDeployment, policy *kubermaticv1.NodeUpgradePolicy) bool {
+	if policy == nil || (policy.MaxSurge == nil && policy.MaxUnavailable == nil) {
+		return false
+	}
+
+	oldStrategy := md.Spec.Strategy.DeepCopy()
+
+	if md.Spec.Strategy == nil {
+		md.Spec.Strategy = &clusterv1alpha1.MachineDeploymentStrategy{}
+	}
+	md.Spec.Strategy.Type = common.RollingUpdateMachineDeploymentStrategyType
+
+	if md.Spec.Strategy.RollingUpdate == nil {
+		md.Spec.Strategy.RollingUpdate = &clusterv1alpha1.MachineRollingUpdateDeployment{}
+	}
+	if policy.MaxSurge != nil {
+		md.Spec.Strategy.RollingUpdate.MaxSurge = ptr.To(*policy.MaxSurge)
+	}
+	if policy.MaxUnavailable != nil {
+		md.Spec.Strategy.RollingUpdate.MaxUnavailable = ptr.To(*policy.MaxUnavailable)
+	}
+
+	return !equality.Semantic.DeepEqual(oldStrategy, md.Spec.Strategy)
+}
+
+// SkipEvictionAfter returns the duration after which the machine-controller stops evicting the
+// pods of a node that is being removed. If the policy does not change the machine-controller's
+// default, false is returned.
+func SkipEvictionAfter(policy *kubermaticv1.NodeUpgradePolicy) (time.Duration, bool) {
+	if policy == nil {
+		return 0, false
+	}
+
+	if policy.GetPodDisruptionBudgetPolicy() == kubermaticv1.PodDisruptionBudgetPolicyRespect {
+		return respectingSkipEvictionAfter, true
+	}
+
+	if policy.DrainTimeout != nil {
+		return policy.DrainTimeout.Duration, true
+	}
+
+	return 0, false
+}
+
+// SkipDrain returns whether the pods of the node should not be evicted before the node is removed.
+func SkipDrain(policy *kubermaticv1.NodeUpgradePolicy, node *corev1.Node) (bool, error) {
+	if policy == nil {
+		return false, nil
+	}
+
+	for i, selector := range policy.SkipDrainNodeSelectors {
+		s, err := metav1.LabelSelectorAsSelector(&selector)
+		if err != nil {
+			return false, fmt.Errorf("invalid skip-drain node selector %d: %w", i, err)
+		}
+
+		if s.Matches(labels.Set(node.Labels)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Progress returns the upgrade progress of all MachineDeployments whose machines are
+// currently being replaced.
+func Progress(machineDeployments []clusterv1alpha1.MachineDeployment) []kubermaticv1.MachineDeploymentUpgradeStatus {
+	var result []kubermaticv1.MachineDeploymentUpgradeStatus
+
+	for _, md := range machineDeployments {
+		if !rollingOut(&md) {
+			continue
+		}
+
+		result = append(result, kubermaticv1.MachineDeploymentUpgradeStatus{
+			Name:              md.Name,
+			KubeletVersion:    md.Spec.Template.Spec.Versions.Kubelet,
+			Replicas:          ptr.Deref(md.Spec.Replicas, 1),
+			UpdatedReplicas:   md.Status.UpdatedReplicas,
+			AvailableReplicas: md.Status.AvailableReplicas,
+		})
+	}
+
+	return result
+}
+
+func rollingOut(md *clusterv1alpha1.MachineDeployment) bool {
+	if md.DeletionTimestamp != nil {
+		return false
+	}
+
+	desired := ptr.Deref(md.Spec.Replicas, 1)
+
+	return md.Status.ObservedGeneration < md.Generation ||
+		md.Status.UpdatedReplicas < desired ||
+		md.Status.AvailableReplicas < desired ||
+		// old machines are still being removed
+		md.Status.Replicas > desired
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeupgrade
+
+import (
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+func TestApplyToMachineDeployment(t *testing.T) {
+	policy := &kubermaticv1.NodeUpgradePolicy{
+		MaxSurge: ptr.To(intstr.FromInt32(3)),
+	}
+
+	md := &clusterv1alpha1.MachineDeployment{}
+	if !ApplyToMachineDeployment(md, policy) {
+		t.Fatal("Expected the MachineDeployment to be changed.")
+	}
+
+	rollingUpdate := md.Spec.Strategy.RollingUpdate
+	if rollingUpdate.MaxSurge.IntValue() != 3 || rollingUpdate.MaxUnavailable != nil {
+		t.Fatalf("Expected maxSurge=3 and no maxUnavailable, got %+v.", rollingUpdate)
+	}
+
+	if ApplyToMachineDeployment(md, policy) {
+		t.Fatal("Expected applying the policy again to not change the MachineDeployment.")
+	}
+
+	if ApplyToMachineDeployment(md, nil) {
+		t.Fatal("Expected no policy to not change the MachineDeployment.")
+	}
+}
+
+func TestSkipEvictionAfter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   *kubermaticv1.NodeUpgradePolicy
+		expected time.Duration
+		set      bool
+	}{
+		{
+			name:   "no policy",
+			policy: nil,
+		},
+		{
+			name:   "no drain timeout",
+			policy: &kubermaticv1.NodeUpgradePolicy{},
+		},
+		{
+			name:     "drain timeout",
+			policy:   &kubermaticv1.NodeUpgradePolicy{DrainTimeout: &metav1.Duration{Duration: 20 * time.Minute}},
+			expected: 20 * time.Minute,
+			set:      true,
+		},
+		{
+			name:     "respect PodDisruptionBudgets",
+			policy:   &kubermaticv1.NodeUpgradePolicy{PodDisruptionBudgetPolicy: kubermaticv1.PodDisruptionBudgetPolicyRespect},
+			expected: respectingSkipEvictionAfter,
+			set:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			duration, set := SkipEvictionAfter(tc.policy)
+			if set != tc.set || duration != tc.expected {
+				t.Fatalf("Expected (%v, %v), got (%v, %v).", tc.expected, tc.set, duration, set)
+			}
+		})
+	}
+}
+
+func TestSkipDrain(t *testing.T) {
+	policy := &kubermaticv1.NodeUpgradePolicy{
+		SkipDrainNodeSelectors: []metav1.LabelSelector{
+			{MatchLabels: map[string]string{"workload": "stateless"}},
+			{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "spot", Operator: metav1.LabelSelectorOpExists}}},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		labels   map[string]string
+		expected bool
+	}{
+		{
+			name:     "matches first selector",
+			labels:   map[string]string{"workload": "stateless"},
+			expected: true,
+		},
+		{
+			name:     "matches second selector",
+			labels:   map[string]string{"spot": ""},
+			expected: true,
+		},
+		{
+			name:     "matches no selector",
+			labels:   map[string]string{"workload": "database"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: tc.labels}}
+
+			skip, err := SkipDrain(policy, node)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if skip != tc.expected {
+				t.Fatalf("Expected %v, got %v.", tc.expected, skip)
+			}
+		})
+	}
+}
+
+func TestProgress(t *testing.T) {
+	md := func(name string, replicas, updated, available, current int32) clusterv1alpha1.MachineDeployment {
+		return clusterv1alpha1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: clusterv1alpha1.MachineDeploymentSpec{
+				Replicas: ptr.To(replicas),
+			},
+			Status: clusterv1alpha1.MachineDeploymentStatus{
+				Replicas:          current,
+				UpdatedReplicas:   updated,
+				AvailableReplicas: available,
+			},
+		}
+	}
+
+	progress := Progress([]clusterv1alpha1.MachineDeployment{
+		md("done", 3, 3, 3, 3),
+		md("surging", 3, 1, 3, 4),
+		md("draining", 3, 3, 3, 4),
+	})
+
+	if len(progress) != 2 || progress[0].Name != "surging" || progress[1].Name != "draining" {
+		t.Fatalf("Expected the surging and draining MachineDeployments, got %+v.", progress)
+	}
+
+	if progress[0].UpdatedReplicas != 1 || progress[0].Replicas != 3 {
+		t.Fatalf("Expected 1 of 3 replicas to be updated, got %+v.", progress[0])
+	}
+}
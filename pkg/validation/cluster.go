@@ -37,12 +37,16 @@ import (
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/provider/cloud/gcp"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/util/hibernation"
+	"k8c.io/kubermatic/v2/pkg/util/maintenancewindow"
 	"k8c.io/kubermatic/v2/pkg/version"
 	clusterversion "k8c.io/kubermatic/v2/pkg/version/cluster"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	kubenetutil "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -140,6 +144,18 @@ func ValidateClusterSpec(spec *kubermaticv1.ClusterSpec, dc *kubermaticv1.Datace
 	allErrs = append(allErrs, ValidateLeaderElectionSettings(&spec.ComponentsOverride.ControllerManager.LeaderElectionSettings, parentFieldPath.Child("componentsOverride", "controllerManager", "leaderElection"))...)
 	allErrs = append(allErrs, ValidateLeaderElectionSettings(&spec.ComponentsOverride.Scheduler.LeaderElectionSettings, parentFieldPath.Child("componentsOverride", "scheduler", "leaderElection"))...)
 
+	if spec.MaintenanceWindow != nil {
+		allErrs = append(allErrs, ValidateMaintenanceWindow(spec.MaintenanceWindow, parentFieldPath.Child("maintenanceWindow"))...)
+	}
+
+	if spec.NodeUpgradePolicy != nil {
+		allErrs = append(allErrs, ValidateNodeUpgradePolicy(spec.NodeUpgradePolicy, parentFieldPath.Child("nodeUpgradePolicy"))...)
+	}
+
+	if spec.Hibernation != nil && spec.Hibernation.Schedule != nil {
+		allErrs = append(allErrs, ValidateHibernationSchedule(spec.Hibernation.Schedule, parentFieldPath.Child("hibernation", "schedule"))...)
+	}
+
 	externalCCM := false
 	if val, ok := spec.Features[kubermaticv1.ClusterFeatureExternalCloudProvider]; ok {
 		externalCCM = val
@@ -345,7 +361,8 @@ func ValidateClusterUpdate(ctx context.Context, newCluster, oldCluster *kubermat
 		path := field.NewPath("cluster", "spec", "enableUserSSHKeyAgent")
 		allErrs = append(allErrs, field.Invalid(path, *newCluster.Spec.EnableUserSSHKeyAgent, "UserSSHKey agent is enabled by default for user clusters created prior KKP 2.16 version"))
 	}
-	allErrs = append(allErrs, validateClusterNetworkingConfigUpdateImmutability(&newCluster.Spec.ClusterNetwork, &oldCluster.Spec.ClusterNetwork, newCluster.Labels, specPath.Child("clusterNetwork"))...)
+	cniMigration := isInPlaceCNIMigration(newCluster, oldCluster)
+	allErrs = append(allErrs, validateClusterNetworkingConfigUpdateImmutability(&newCluster.Spec.ClusterNetwork, &oldCluster.Spec.ClusterNetwork, newCluster.Labels, cniMigration, specPath.Child("clusterNetwork"))...)
 	allErrs = append(allErrs, validateKubeLBUpdate(oldCluster, newCluster, dc, seed, specPath)...)
 
 	// even though ErrorList later in ToAggregate() will filter out nil errors, it does so by
@@ -355,6 +372,8 @@ func ValidateClusterUpdate(ctx context.Context, newCluster, oldCluster *kubermat
 		allErrs = append(allErrs, err)
 	}
 
+	allErrs = append(allErrs, validateCNIMigration(newCluster, oldCluster, cniMigration, specPath)...)
+
 	if errs := validateEncryptionUpdate(newCluster, oldCluster); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
@@ -1213,6 +1232,76 @@ func ValidateUpdateWindow(updateWindow *kubermaticv1.UpdateWindow) error {
 	return nil
 }
 
+func ValidateMaintenanceWindow(window *kubermaticv1.MaintenanceWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if _, err := maintenancewindow.Parse(window); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, *window, err.Error()))
+	}
+
+	return allErrs
+}
+
+func ValidateHibernationSchedule(schedule *kubermaticv1.ClusterHibernationSchedule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if _, err := hibernation.ParseSchedule(schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, *schedule, err.Error()))
+	}
+
+	return allErrs
+}
+
+func ValidateNodeUpgradePolicy(policy *kubermaticv1.NodeUpgradePolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	surge, surgeErrs := validateIntOrPercent(policy.MaxSurge, fldPath.Child("maxSurge"))
+	allErrs = append(allErrs, surgeErrs...)
+
+	unavailable, unavailableErrs := validateIntOrPercent(policy.MaxUnavailable, fldPath.Child("maxUnavailable"))
+	allErrs = append(allErrs, unavailableErrs...)
+
+	if policy.MaxSurge != nil && policy.MaxUnavailable != nil && surge == 0 && unavailable == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), policy.MaxUnavailable.String(), "must not be 0 when maxSurge is 0"))
+	}
+
+	if policy.DrainTimeout != nil {
+		if policy.DrainTimeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("drainTimeout"), policy.DrainTimeout.Duration.String(), "must be greater than 0"))
+		}
+
+		if policy.GetPodDisruptionBudgetPolicy() == kubermaticv1.PodDisruptionBudgetPolicyRespect {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("drainTimeout"), "drain timeout cannot be used when PodDisruptionBudgets are respected"))
+		}
+	}
+
+	for i, selector := range policy.SkipDrainNodeSelectors {
+		if _, err := metav1.LabelSelectorAsSelector(&selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("skipDrainNodeSelectors").Index(i), selector, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateIntOrPercent validates a non-negative number or percentage and returns its value.
+func validateIntOrPercent(value *intstr.IntOrString, fldPath *field.Path) (int, field.ErrorList) {
+	if value == nil {
+		return 0, nil
+	}
+
+	scaled, err := intstr.GetScaledValueFromIntOrPercent(value, 100, true)
+	if err != nil {
+		return 0, field.ErrorList{field.Invalid(fldPath, value.String(), err.Error())}
+	}
+
+	if scaled < 0 {
+		return 0, field.ErrorList{field.Invalid(fldPath, value.String(), "must not be negative")}
+	}
+
+	return scaled, nil
+}
+
 func ValidateContainerRuntime(spec *kubermaticv1.ClusterSpec) error {
 	if !sets.New("containerd").Has(spec.ContainerRuntime) {
 		return fmt.Errorf("container runtime not supported: %s", spec.ContainerRuntime)
@@ -1260,7 +1349,7 @@ func ValidateNodePortRange(nodePortRange string, fldPath *field.Path) *field.Err
 	return nil
 }
 
-func validateClusterNetworkingConfigUpdateImmutability(c, oldC *kubermaticv1.ClusterNetworkingConfig, labels map[string]string, fldPath *field.Path) field.ErrorList {
+func validateClusterNetworkingConfigUpdateImmutability(c, oldC *kubermaticv1.ClusterNetworkingConfig, labels map[string]string, cniMigration bool, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if oldC.IPFamily != "" {
@@ -1271,7 +1360,8 @@ func validateClusterNetworkingConfigUpdateImmutability(c, oldC *kubermaticv1.Clu
 		)...)
 	}
 
-	if len(oldC.Pods.CIDRBlocks) != 0 {
+	// an in-place CNI migration requires a new pods CIDR, see validateCNIMigration
+	if len(oldC.Pods.CIDRBlocks) != 0 && !cniMigration {
 		allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(
 			c.Pods.CIDRBlocks,
 			oldC.Pods.CIDRBlocks,
@@ -1338,7 +1428,11 @@ func validateCNIUpdate(newCni *kubermaticv1.CNIPluginSettings, oldCni *kubermati
 			return nil // allowed for CNI type migration path
 		}
 
-		return field.Forbidden(basePath.Child("type"), fmt.Sprintf("cannot change CNI plugin type, unless %s label is present", UnsafeCNIMigrationLabel))
+		if cni.IsInPlaceMigration(oldCni, newCni) {
+			return nil // allowed for in-place CNI migration, see validateCNIMigration
+		}
+
+		return field.Forbidden(basePath.Child("type"), fmt.Sprintf("cannot change CNI plugin type, unless %s label is present or it is migrated in place from %s to %s", UnsafeCNIMigrationLabel, kubermaticv1.CNIPluginTypeCanal, kubermaticv1.CNIPluginTypeCilium))
 	}
 
 	if newCni.Version != oldCni.Version {
@@ -1378,6 +1472,57 @@ func validateCNIUpdate(newCni *kubermaticv1.CNIPluginSettings, oldCni *kubermati
 	return nil
 }
 
+// isInPlaceCNIMigration returns true if the update starts an in-place migration of the CNI
+// plugin. The unsafe CNI migration label opts out of the in-place migration.
+func isInPlaceCNIMigration(newCluster, oldCluster *kubermaticv1.Cluster) bool {
+	if _, ok := newCluster.Labels[UnsafeCNIMigrationLabel]; ok {
+		return false
+	}
+
+	return cni.IsInPlaceMigration(oldCluster.Spec.CNIPlugin, newCluster.Spec.CNIPlugin)
+}
+
+func validateCNIMigration(newCluster, oldCluster *kubermaticv1.Cluster, cniMigration bool, specPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldCluster.Status.CNIMigration.InProgress() || metav1.HasAnnotation(oldCluster.ObjectMeta, kubermaticv1.CNIMigrationSourceAnnotation) {
+		if !equality.Semantic.DeepEqual(newCluster.Spec.CNIPlugin, oldCluster.Spec.CNIPlugin) {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("cniPlugin"), "CNI plugin settings cannot be changed while a CNI migration is in progress"))
+		}
+	}
+
+	if !cniMigration {
+		return allErrs
+	}
+
+	// Cilium needs its own pod network while both CNI plugins run side by side.
+	podsPath := specPath.Child("clusterNetwork", "pods", "cidrBlocks")
+	newPods := newCluster.Spec.ClusterNetwork.Pods.CIDRBlocks
+	oldPods := oldCluster.Spec.ClusterNetwork.Pods.CIDRBlocks
+
+	if !newCluster.IsIPv4Only() || len(newPods) != 1 {
+		return append(allErrs, field.Invalid(podsPath, newPods, "in-place CNI migration requires exactly one IPv4 pods CIDR"))
+	}
+
+	_, newNet, err := net.ParseCIDR(newPods[0])
+	if err != nil {
+		return append(allErrs, field.Invalid(podsPath, newPods, fmt.Sprintf("invalid pods CIDR: %v", err)))
+	}
+
+	for _, cidr := range append(slices.Clone(oldPods), newCluster.Spec.ClusterNetwork.Services.CIDRBlocks...) {
+		_, otherNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+
+		if newNet.Contains(otherNet.IP) || otherNet.Contains(newNet.IP) {
+			allErrs = append(allErrs, field.Invalid(podsPath, newPods, fmt.Sprintf("in-place CNI migration requires a new pods CIDR that does not overlap with %s", cidr)))
+		}
+	}
+
+	return allErrs
+}
+
 func checkVersionConstraint(version *semverlib.Version, constraint string) bool {
 	if constraint == "" {
 		return true // if constraint is not set, assume it is satisfied
@@ -23,6 +23,7 @@ import (
 	"net"
 	"strings"
 	"testing"
+	"time"
 
 	semverlib "github.com/Masterminds/semver/v3"
 	"github.com/stretchr/testify/assert"
@@ -33,6 +34,8 @@ import (
 	"k8c.io/kubermatic/v2/pkg/features"
 	"k8c.io/kubermatic/v2/pkg/version"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
@@ -306,6 +309,156 @@ func TestValidateCloudSpec(t *testing.T) {
 	}
 }
 
+func TestValidateMaintenanceWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  kubermaticv1.MaintenanceWindow
+		wantErr bool
+	}{
+		{
+			name: "valid weekly window",
+			window: kubermaticv1.MaintenanceWindow{
+				Start:    "Sat 22:00",
+				Length:   "6h",
+				Timezone: "Europe/Berlin",
+			},
+		},
+		{
+			name: "invalid timezone",
+			window: kubermaticv1.MaintenanceWindow{
+				Start:    "22:00",
+				Length:   "6h",
+				Timezone: "Berlin",
+			},
+			wantErr: true,
+		},
+		{
+			name: "daily window longer than a day",
+			window: kubermaticv1.MaintenanceWindow{
+				Start:  "22:00",
+				Length: "36h",
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := ValidateMaintenanceWindow(&test.window, field.NewPath("spec", "maintenanceWindow"))
+			if (len(errs) > 0) != test.wantErr {
+				t.Errorf("Expected error = %v, got %v", test.wantErr, errs)
+			}
+		})
+	}
+}
+
+func TestValidateHibernationSchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule kubermaticv1.ClusterHibernationSchedule
+		wantErr  bool
+	}{
+		{
+			name: "valid schedule",
+			schedule: kubermaticv1.ClusterHibernationSchedule{
+				Hibernate: "0 20 * * 1-5",
+				WakeUp:    "0 7 * * 1-5",
+				Timezone:  "Europe/Berlin",
+			},
+		},
+		{
+			name: "missing wake up",
+			schedule: kubermaticv1.ClusterHibernationSchedule{
+				Hibernate: "@daily",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid timezone",
+			schedule: kubermaticv1.ClusterHibernationSchedule{
+				Hibernate: "0 20 * * *",
+				WakeUp:    "0 7 * * *",
+				Timezone:  "Berlin",
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := ValidateHibernationSchedule(&test.schedule, field.NewPath("spec", "hibernation", "schedule"))
+			if (len(errs) > 0) != test.wantErr {
+				t.Errorf("Expected error = %v, got %v", test.wantErr, errs)
+			}
+		})
+	}
+}
+
+func TestValidateNodeUpgradePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  kubermaticv1.NodeUpgradePolicy
+		wantErr bool
+	}{
+		{
+			name: "valid policy",
+			policy: kubermaticv1.NodeUpgradePolicy{
+				MaxSurge:       ptr.To(intstr.FromString("25%")),
+				MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+				DrainTimeout:   &metav1.Duration{Duration: 30 * time.Minute},
+				SkipDrainNodeSelectors: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"workload": "stateless"}},
+				},
+			},
+		},
+		{
+			name: "surge and unavailable both 0",
+			policy: kubermaticv1.NodeUpgradePolicy{
+				MaxSurge:       ptr.To(intstr.FromString("0%")),
+				MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative surge",
+			policy: kubermaticv1.NodeUpgradePolicy{
+				MaxSurge: ptr.To(intstr.FromInt32(-1)),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid percentage",
+			policy: kubermaticv1.NodeUpgradePolicy{
+				MaxUnavailable: ptr.To(intstr.FromString("one")),
+			},
+			wantErr: true,
+		},
+		{
+			name: "drain timeout while respecting PodDisruptionBudgets",
+			policy: kubermaticv1.NodeUpgradePolicy{
+				DrainTimeout:              &metav1.Duration{Duration: time.Hour},
+				PodDisruptionBudgetPolicy: kubermaticv1.PodDisruptionBudgetPolicyRespect,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid skip-drain selector",
+			policy: kubermaticv1.NodeUpgradePolicy{
+				SkipDrainNodeSelectors: []metav1.LabelSelector{
+					{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "workload", Operator: "Matches"}}},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := ValidateNodeUpgradePolicy(&test.policy, field.NewPath("spec", "nodeUpgradePolicy"))
+			if (len(errs) > 0) != test.wantErr {
+				t.Errorf("Expected error = %v, got %v", test.wantErr, errs)
+			}
+		})
+	}
+}
+
 func TestValidateUpdateWindow(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -822,6 +975,42 @@ func TestValidateCNIUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "allow in-place migration from canal to cilium",
+			old: &kubermaticv1.CNIPluginSettings{
+				Type:    kubermaticv1.CNIPluginTypeCanal,
+				Version: "v3.31",
+			},
+			new: &kubermaticv1.CNIPluginSettings{
+				Type:    kubermaticv1.CNIPluginTypeCilium,
+				Version: "1.19.4",
+			},
+			wantErr: false,
+		},
+		{
+			name: "forbid migration from canal to legacy cilium",
+			old: &kubermaticv1.CNIPluginSettings{
+				Type:    kubermaticv1.CNIPluginTypeCanal,
+				Version: "v3.31",
+			},
+			new: &kubermaticv1.CNIPluginSettings{
+				Type:    kubermaticv1.CNIPluginTypeCilium,
+				Version: "v1.12",
+			},
+			wantErr: true,
+		},
+		{
+			name: "forbid migration from cilium to canal",
+			old: &kubermaticv1.CNIPluginSettings{
+				Type:    kubermaticv1.CNIPluginTypeCilium,
+				Version: "1.19.4",
+			},
+			new: &kubermaticv1.CNIPluginSettings{
+				Type:    kubermaticv1.CNIPluginTypeCanal,
+				Version: "v3.31",
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -834,6 +1023,89 @@ func TestValidateCNIUpdate(t *testing.T) {
 	}
 }
 
+func TestValidateCNIMigration(t *testing.T) {
+	genMigrationCluster := func(cniType kubermaticv1.CNIPluginType, version string, pods ...string) *kubermaticv1.Cluster {
+		return &kubermaticv1.Cluster{
+			Spec: kubermaticv1.ClusterSpec{
+				CNIPlugin: &kubermaticv1.CNIPluginSettings{
+					Type:    cniType,
+					Version: version,
+				},
+				ClusterNetwork: kubermaticv1.ClusterNetworkingConfig{
+					IPFamily: kubermaticv1.IPFamilyIPv4,
+					Pods:     kubermaticv1.NetworkRanges{CIDRBlocks: pods},
+					Services: kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.16.0/20"}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		old        *kubermaticv1.Cluster
+		new        *kubermaticv1.Cluster
+		wantErrors int
+	}{
+		{
+			name: "migration with a new pods CIDR",
+			old:  genMigrationCluster(kubermaticv1.CNIPluginTypeCanal, "v3.31", "172.25.0.0/16"),
+			new:  genMigrationCluster(kubermaticv1.CNIPluginTypeCilium, "1.19.4", "172.26.0.0/16"),
+		},
+		{
+			name:       "migration without a new pods CIDR",
+			old:        genMigrationCluster(kubermaticv1.CNIPluginTypeCanal, "v3.31", "172.25.0.0/16"),
+			new:        genMigrationCluster(kubermaticv1.CNIPluginTypeCilium, "1.19.4", "172.25.0.0/16"),
+			wantErrors: 1,
+		},
+		{
+			name:       "migration with a pods CIDR overlapping the old one",
+			old:        genMigrationCluster(kubermaticv1.CNIPluginTypeCanal, "v3.31", "172.25.0.0/16"),
+			new:        genMigrationCluster(kubermaticv1.CNIPluginTypeCilium, "1.19.4", "172.25.128.0/17"),
+			wantErrors: 1,
+		},
+		{
+			name:       "migration with a pods CIDR overlapping the services",
+			old:        genMigrationCluster(kubermaticv1.CNIPluginTypeCanal, "v3.31", "172.25.0.0/16"),
+			new:        genMigrationCluster(kubermaticv1.CNIPluginTypeCilium, "1.19.4", "10.240.0.0/16"),
+			wantErrors: 1,
+		},
+		{
+			name:       "migration with multiple pods CIDRs",
+			old:        genMigrationCluster(kubermaticv1.CNIPluginTypeCanal, "v3.31", "172.25.0.0/16"),
+			new:        genMigrationCluster(kubermaticv1.CNIPluginTypeCilium, "1.19.4", "172.26.0.0/16", "172.27.0.0/16"),
+			wantErrors: 1,
+		},
+		{
+			name: "change of the CNI plugin while a migration is in progress",
+			old: func() *kubermaticv1.Cluster {
+				c := genMigrationCluster(kubermaticv1.CNIPluginTypeCilium, "1.19.4", "172.26.0.0/16")
+				c.Status.CNIMigration = &kubermaticv1.CNIMigrationStatus{Phase: kubermaticv1.CNIMigrationPhaseMigratingNodes}
+				return c
+			}(),
+			new:        genMigrationCluster(kubermaticv1.CNIPluginTypeCilium, "1.18.10", "172.26.0.0/16"),
+			wantErrors: 1,
+		},
+		{
+			name: "change of the CNI plugin after a migration has completed",
+			old: func() *kubermaticv1.Cluster {
+				c := genMigrationCluster(kubermaticv1.CNIPluginTypeCilium, "1.19.4", "172.26.0.0/16")
+				c.Status.CNIMigration = &kubermaticv1.CNIMigrationStatus{Phase: kubermaticv1.CNIMigrationPhaseCompleted}
+				return c
+			}(),
+			new: genMigrationCluster(kubermaticv1.CNIPluginTypeCilium, "1.18.10", "172.26.0.0/16"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validateCNIMigration(test.new, test.old, isInPlaceCNIMigration(test.new, test.old), field.NewPath("spec"))
+			if len(errs) != test.wantErrors {
+				t.Errorf("Expected %d errors, but got: %v", test.wantErrors, errs)
+			}
+		})
+	}
+}
+
 func TestValidateGCPCloudSpec(t *testing.T) {
 	testCases := []struct {
 		name              string
@@ -19,6 +19,8 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -49,6 +51,47 @@ func ValidateKubermaticConfigurationSpec(spec *kubermaticv1.KubermaticConfigurat
 
 	allErrs = append(allErrs, ValidateExternalGatewayConfiguration(spec)...)
 	allErrs = append(allErrs, validateGatewayTLSConfiguration(spec)...)
+	allErrs = append(allErrs, ValidatePlatformAuditConfiguration(spec.MasterController.PlatformAudit, field.NewPath("spec", "masterController", "platformAudit"))...)
+
+	return allErrs
+}
+
+// ValidatePlatformAuditConfiguration validates spec.masterController.platformAudit.
+func ValidatePlatformAuditConfiguration(cfg kubermaticv1.KubermaticPlatformAuditConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch cfg.Sink {
+	case "":
+		// platform audit is disabled
+
+	case kubermaticv1.PlatformAuditFileSink:
+		if cfg.FilePath == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("filePath"), "must be set when using the file sink"))
+		} else if !filepath.IsAbs(cfg.FilePath) || filepath.Dir(cfg.FilePath) == "/" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("filePath"), cfg.FilePath, "must be an absolute path to a file in a subdirectory, e.g. /var/log/kubermatic/audit.log"))
+		}
+
+	case kubermaticv1.PlatformAuditWebhookSink:
+		if cfg.WebhookURL == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("webhookURL"), "must be set when using the webhook sink"))
+		} else if u, err := url.Parse(cfg.WebhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("webhookURL"), cfg.WebhookURL, "must be a valid http:// or https:// URL"))
+		}
+
+	case kubermaticv1.PlatformAuditSyslogSink:
+		if cfg.SyslogAddress != "" {
+			if u, err := url.Parse(cfg.SyslogAddress); err != nil || (u.Scheme != "tcp" && u.Scheme != "udp") || u.Host == "" {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("syslogAddress"), cfg.SyslogAddress, "must be in the form tcp://host:port or udp://host:port"))
+			}
+		}
+
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("sink"), cfg.Sink, []kubermaticv1.PlatformAuditSinkType{
+			kubermaticv1.PlatformAuditFileSink,
+			kubermaticv1.PlatformAuditWebhookSink,
+			kubermaticv1.PlatformAuditSyslogSink,
+		}))
+	}
 
 	return allErrs
 }
@@ -281,6 +281,106 @@ func TestValidateMirrorImages(t *testing.T) {
 	}
 }
 
+func TestValidatePlatformAuditConfiguration(t *testing.T) {
+	testcases := []struct {
+		name  string
+		audit kubermaticv1.KubermaticPlatformAuditConfiguration
+		valid bool
+	}{
+		{
+			name:  "platform audit disabled",
+			valid: true,
+		},
+		{
+			name: "file sink",
+			audit: kubermaticv1.KubermaticPlatformAuditConfiguration{
+				Sink:     kubermaticv1.PlatformAuditFileSink,
+				FilePath: "/var/log/kubermatic/audit.log",
+			},
+			valid: true,
+		},
+		{
+			name: "file sink without path",
+			audit: kubermaticv1.KubermaticPlatformAuditConfiguration{
+				Sink: kubermaticv1.PlatformAuditFileSink,
+			},
+			valid: false,
+		},
+		{
+			name: "file sink with relative path",
+			audit: kubermaticv1.KubermaticPlatformAuditConfiguration{
+				Sink:     kubermaticv1.PlatformAuditFileSink,
+				FilePath: "audit.log",
+			},
+			valid: false,
+		},
+		{
+			name: "file sink in root directory",
+			audit: kubermaticv1.KubermaticPlatformAuditConfiguration{
+				Sink:     kubermaticv1.PlatformAuditFileSink,
+				FilePath: "/audit.log",
+			},
+			valid: false,
+		},
+		{
+			name: "webhook sink",
+			audit: kubermaticv1.KubermaticPlatformAuditConfiguration{
+				Sink:       kubermaticv1.PlatformAuditWebhookSink,
+				WebhookURL: "https://siem.example.com/ingest",
+			},
+			valid: true,
+		},
+		{
+			name: "webhook sink with invalid URL",
+			audit: kubermaticv1.KubermaticPlatformAuditConfiguration{
+				Sink:       kubermaticv1.PlatformAuditWebhookSink,
+				WebhookURL: "siem.example.com",
+			},
+			valid: false,
+		},
+		{
+			name: "syslog sink using local daemon",
+			audit: kubermaticv1.KubermaticPlatformAuditConfiguration{
+				Sink: kubermaticv1.PlatformAuditSyslogSink,
+			},
+			valid: true,
+		},
+		{
+			name: "syslog sink with remote server",
+			audit: kubermaticv1.KubermaticPlatformAuditConfiguration{
+				Sink:          kubermaticv1.PlatformAuditSyslogSink,
+				SyslogAddress: "udp://syslog.example.com:514",
+			},
+			valid: true,
+		},
+		{
+			name: "syslog sink without network",
+			audit: kubermaticv1.KubermaticPlatformAuditConfiguration{
+				Sink:          kubermaticv1.PlatformAuditSyslogSink,
+				SyslogAddress: "syslog.example.com:514",
+			},
+			valid: false,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := newValidKubermaticConfigurationSpec()
+			spec.MasterController.PlatformAudit = tt.audit
+			errs := ValidateKubermaticConfigurationSpec(spec)
+			if tt.valid {
+				if len(errs) > 0 {
+					t.Fatalf("Expected configuration to be valid, but got errors: %v", errs.ToAggregate())
+				}
+			} else {
+				if len(errs) == 0 {
+					t.Fatal("Expected configuration to be invalid, but it was accepted.")
+				}
+			}
+		})
+	}
+}
+
 func newValidKubermaticConfigurationSpec() *kubermaticv1.KubermaticConfigurationSpec {
 	spec := &kubermaticv1.KubermaticConfigurationSpec{
 		Ingress: kubermaticv1.KubermaticIngressConfiguration{
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"slices"
+
+	semverlib "github.com/Masterminds/semver/v3"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateProjectCreate validates the restrictions of a new project.
+func ValidateProjectCreate(project *kubermaticv1.Project) field.ErrorList {
+	return validateProjectRestrictionsSpec(&project.Spec, nil, field.NewPath("spec"))
+}
+
+// ValidateProjectUpdate validates the restrictions of an updated project. Only restrictions
+// that have changed are validated, so that existing projects can still be updated.
+func ValidateProjectUpdate(oldProject, newProject *kubermaticv1.Project) field.ErrorList {
+	return validateProjectRestrictionsSpec(&newProject.Spec, &oldProject.Spec, field.NewPath("spec"))
+}
+
+func validateProjectRestrictionsSpec(spec, oldSpec *kubermaticv1.ProjectSpec, specPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if constraint := spec.KubernetesVersionConstraint; constraint != "" && (oldSpec == nil || oldSpec.KubernetesVersionConstraint != constraint) {
+		if _, err := semverlib.NewConstraint(constraint); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("kubernetesVersionConstraint"), constraint, err.Error()))
+		}
+	}
+
+	supported := make([]string, 0, len(kubermaticv1.SupportedProviders))
+	for _, provider := range kubermaticv1.SupportedProviders {
+		supported = append(supported, string(provider))
+	}
+
+	for i, provider := range spec.AllowedProviders {
+		if oldSpec != nil && slices.Contains(oldSpec.AllowedProviders, provider) {
+			continue
+		}
+
+		if !slices.Contains(supported, provider) {
+			allErrs = append(allErrs, field.NotSupported(specPath.Child("allowedProviders").Index(i), provider, supported))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateProjectRestrictions validates a cluster spec against the restrictions of its project.
+// When updating a cluster, oldSpec must be given and only fields that have changed are validated,
+// so that restrictions added to a project later do not block updates of existing clusters.
+func ValidateProjectRestrictions(project *kubermaticv1.Project, spec, oldSpec *kubermaticv1.ClusterSpec, seedName string, parentFieldPath *field.Path) field.ErrorList {
+	allErrs := validateProjectSuspension(project, spec, oldSpec, parentFieldPath)
+	restrictions := project.Spec
+	isUpdate := oldSpec != nil
+
+	if len(restrictions.AllowedSeeds) > 0 && !isUpdate && !slices.Contains(restrictions.AllowedSeeds, seedName) {
+		allErrs = append(allErrs, field.Forbidden(parentFieldPath.Child("cloud", "dc"), fmt.Sprintf("project %s does not allow clusters on seed %q", project.Name, seedName)))
+	}
+
+	datacenter := spec.Cloud.DatacenterName
+	if len(restrictions.AllowedDatacenters) > 0 && (!isUpdate || oldSpec.Cloud.DatacenterName != datacenter) && !slices.Contains(restrictions.AllowedDatacenters, datacenter) {
+		allErrs = append(allErrs, field.NotSupported(parentFieldPath.Child("cloud", "dc"), datacenter, restrictions.AllowedDatacenters))
+	}
+
+	if len(restrictions.AllowedProviders) > 0 && !isUpdate {
+		providerName, err := kubermaticv1helper.ClusterCloudProviderName(spec.Cloud)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(parentFieldPath.Child("cloud"), "<redacted>", err.Error()))
+		} else if !slices.Contains(restrictions.AllowedProviders, providerName) {
+			allErrs = append(allErrs, field.NotSupported(parentFieldPath.Child("cloud"), providerName, restrictions.AllowedProviders))
+		}
+	}
+
+	if restrictions.KubernetesVersionConstraint != "" && (!isUpdate || !spec.Version.Equal(&oldSpec.Version)) {
+		versionPath := parentFieldPath.Child("version")
+
+		constraint, err := semverlib.NewConstraint(restrictions.KubernetesVersionConstraint)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(versionPath, fmt.Errorf("project %s has an invalid Kubernetes version constraint: %w", project.Name, err)))
+		} else if version := spec.Version.Semver(); version == nil || !constraint.Check(version) {
+			allErrs = append(allErrs, field.Forbidden(versionPath, fmt.Sprintf("project %s only allows Kubernetes versions %s", project.Name, restrictions.KubernetesVersionConstraint)))
+		}
+	}
+
+	// an empty expose strategy is defaulted later on and validated then
+	exposeStrategy := spec.ExposeStrategy
+	if len(restrictions.AllowedExposeStrategies) > 0 && exposeStrategy != "" && (!isUpdate || oldSpec.ExposeStrategy != exposeStrategy) && !slices.Contains(restrictions.AllowedExposeStrategies, exposeStrategy) {
+		allowed := make([]string, 0, len(restrictions.AllowedExposeStrategies))
+		for _, strategy := range restrictions.AllowedExposeStrategies {
+			allowed = append(allowed, string(strategy))
+		}
+
+		allErrs = append(allErrs, field.NotSupported(parentFieldPath.Child("exposeStrategy"), exposeStrategy, allowed))
+	}
+
+	return allErrs
+}
+
+// validateProjectSuspension prevents creating clusters in suspended projects and resuming
+// clusters that were paused or hibernated while their project is suspended.
+func validateProjectSuspension(project *kubermaticv1.Project, spec, oldSpec *kubermaticv1.ClusterSpec, parentFieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !project.IsSuspended() {
+		return allErrs
+	}
+
+	message := fmt.Sprintf("project %s is suspended: %s", project.Name, project.Spec.Suspension.Reason)
+
+	if oldSpec == nil {
+		return append(allErrs, field.Forbidden(parentFieldPath, message))
+	}
+
+	if oldSpec.Pause && !spec.Pause {
+		allErrs = append(allErrs, field.Forbidden(parentFieldPath.Child("pause"), message))
+	}
+
+	wasHibernated := oldSpec.Hibernation != nil && oldSpec.Hibernation.Hibernated
+	isHibernated := spec.Hibernation != nil && spec.Hibernation.Hibernated
+	if wasHibernated && !isHibernated {
+		allErrs = append(allErrs, field.Forbidden(parentFieldPath.Child("hibernation", "hibernated"), message))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateProjectRestrictions(t *testing.T) {
+	restrictedProject := &kubermaticv1.Project{
+		Spec: kubermaticv1.ProjectSpec{
+			Name:                        "restricted",
+			AllowedSeeds:                []string{"europe"},
+			AllowedDatacenters:          []string{"fra", "ams"},
+			AllowedProviders:            []string{string(kubermaticv1.HetznerCloudProvider)},
+			KubernetesVersionConstraint: ">= 1.31",
+			AllowedExposeStrategies:     []kubermaticv1.ExposeStrategy{kubermaticv1.ExposeStrategyTunneling},
+		},
+	}
+
+	suspendedProject := &kubermaticv1.Project{
+		Spec: kubermaticv1.ProjectSpec{
+			Name:       "suspended",
+			Suspension: &kubermaticv1.ProjectSuspension{Reason: "budget exhausted"},
+		},
+	}
+
+	validSpec := func() *kubermaticv1.ClusterSpec {
+		return &kubermaticv1.ClusterSpec{
+			Cloud: kubermaticv1.CloudSpec{
+				DatacenterName: "fra",
+				ProviderName:   string(kubermaticv1.HetznerCloudProvider),
+				Hetzner:        &kubermaticv1.HetznerCloudSpec{},
+			},
+			Version:        *semver.NewSemverOrDie("1.32.1"),
+			ExposeStrategy: kubermaticv1.ExposeStrategyTunneling,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		project  *kubermaticv1.Project
+		spec     func() *kubermaticv1.ClusterSpec
+		oldSpec  func() *kubermaticv1.ClusterSpec
+		seedName string
+		valid    bool
+	}{
+		{
+			name:     "unrestricted project allows everything",
+			project:  &kubermaticv1.Project{},
+			spec:     validSpec,
+			seedName: "asia",
+			valid:    true,
+		},
+		{
+			name:     "cluster matching all restrictions",
+			project:  restrictedProject,
+			spec:     validSpec,
+			seedName: "europe",
+			valid:    true,
+		},
+		{
+			name:     "forbidden seed",
+			project:  restrictedProject,
+			spec:     validSpec,
+			seedName: "asia",
+			valid:    false,
+		},
+		{
+			name:    "forbidden datacenter",
+			project: restrictedProject,
+			spec: func() *kubermaticv1.ClusterSpec {
+				spec := validSpec()
+				spec.Cloud.DatacenterName = "nbg"
+				return spec
+			},
+			seedName: "europe",
+			valid:    false,
+		},
+		{
+			name:    "forbidden provider",
+			project: restrictedProject,
+			spec: func() *kubermaticv1.ClusterSpec {
+				spec := validSpec()
+				spec.Cloud.ProviderName = string(kubermaticv1.AWSCloudProvider)
+				spec.Cloud.Hetzner = nil
+				spec.Cloud.AWS = &kubermaticv1.AWSCloudSpec{}
+				return spec
+			},
+			seedName: "europe",
+			valid:    false,
+		},
+		{
+			name:    "forbidden version",
+			project: restrictedProject,
+			spec: func() *kubermaticv1.ClusterSpec {
+				spec := validSpec()
+				spec.Version = *semver.NewSemverOrDie("1.30.5")
+				return spec
+			},
+			seedName: "europe",
+			valid:    false,
+		},
+		{
+			name:    "forbidden expose strategy",
+			project: restrictedProject,
+			spec: func() *kubermaticv1.ClusterSpec {
+				spec := validSpec()
+				spec.ExposeStrategy = kubermaticv1.ExposeStrategyNodePort
+				return spec
+			},
+			seedName: "europe",
+			valid:    false,
+		},
+		{
+			name:    "existing cluster violating new restrictions can still be updated",
+			project: restrictedProject,
+			spec: func() *kubermaticv1.ClusterSpec {
+				spec := validSpec()
+				spec.Cloud.DatacenterName = "nbg"
+				spec.Version = *semver.NewSemverOrDie("1.30.5")
+				spec.ExposeStrategy = kubermaticv1.ExposeStrategyNodePort
+				spec.Pause = true
+				return spec
+			},
+			oldSpec: func() *kubermaticv1.ClusterSpec {
+				spec := validSpec()
+				spec.Cloud.DatacenterName = "nbg"
+				spec.Version = *semver.NewSemverOrDie("1.30.5")
+				spec.ExposeStrategy = kubermaticv1.ExposeStrategyNodePort
+				return spec
+			},
+			seedName: "asia",
+			valid:    true,
+		},
+		{
+			name:    "updating to a forbidden version",
+			project: restrictedProject,
+			spec: func() *kubermaticv1.ClusterSpec {
+				spec := validSpec()
+				spec.Version = *semver.NewSemverOrDie("1.30.5")
+				return spec
+			},
+			oldSpec:  validSpec,
+			seedName: "europe",
+			valid:    false,
+		},
+		{
+			name:     "creating a cluster in a suspended project",
+			project:  suspendedProject,
+			spec:     validSpec,
+			seedName: "europe",
+			valid:    false,
+		},
+		{
+			name:    "updating a paused cluster in a suspended project",
+			project: suspendedProject,
+			spec: func() *kubermaticv1.ClusterSpec {
+				spec := validSpec()
+				spec.Pause = true
+				spec.PauseReason = "updated reason"
+				return spec
+			},
+			oldSpec: func() *kubermaticv1.ClusterSpec {
+				spec := validSpec()
+				spec.Pause = true
+				return spec
+			},
+			seedName: "europe",
+			valid:    true,
+		},
+		{
+			name:    "unpausing a cluster in a suspended project",
+			project: suspendedProject,
+			spec:    validSpec,
+			oldSpec: func() *kubermaticv1.ClusterSpec {
+				spec := validSpec()
+				spec.Pause = true
+				return spec
+			},
+			seedName: "europe",
+			valid:    false,
+		},
+		{
+			name:    "waking up a cluster in a suspended project",
+			project: suspendedProject,
+			spec:    validSpec,
+			oldSpec: func() *kubermaticv1.ClusterSpec {
+				spec := validSpec()
+				spec.Hibernation = &kubermaticv1.ClusterHibernationSettings{Hibernated: true}
+				return spec
+			},
+			seedName: "europe",
+			valid:    false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var oldSpec *kubermaticv1.ClusterSpec
+			if test.oldSpec != nil {
+				oldSpec = test.oldSpec()
+			}
+
+			errs := ValidateProjectRestrictions(test.project, test.spec(), oldSpec, test.seedName, field.NewPath("spec"))
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid=%v, got %v", test.valid, errs.ToAggregate())
+			}
+		})
+	}
+}
+
+func TestValidateProject(t *testing.T) {
+	genProject := func(constraint string, providers ...string) *kubermaticv1.Project {
+		return &kubermaticv1.Project{
+			Spec: kubermaticv1.ProjectSpec{
+				Name:                        "restricted",
+				AllowedProviders:            providers,
+				KubernetesVersionConstraint: constraint,
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		project    *kubermaticv1.Project
+		oldProject *kubermaticv1.Project
+		valid      bool
+	}{
+		{
+			name:    "unrestricted project",
+			project: genProject(""),
+			valid:   true,
+		},
+		{
+			name:    "valid restrictions",
+			project: genProject(">= 1.31, < 1.34", string(kubermaticv1.AWSCloudProvider), string(kubermaticv1.EdgeCloudProvider)),
+			valid:   true,
+		},
+		{
+			name:    "invalid version constraint",
+			project: genProject("newest"),
+			valid:   false,
+		},
+		{
+			name:    "unknown provider",
+			project: genProject("", string(kubermaticv1.AWSCloudProvider), "mainframe"),
+			valid:   false,
+		},
+		{
+			name:       "changing to an invalid version constraint",
+			project:    genProject("newest"),
+			oldProject: genProject(">= 1.31"),
+			valid:      false,
+		},
+		{
+			name:       "adding an unknown provider",
+			project:    genProject("", "mainframe"),
+			oldProject: genProject(""),
+			valid:      false,
+		},
+		{
+			name:       "unchanged invalid restrictions do not block updates",
+			project:    genProject("newest", "mainframe"),
+			oldProject: genProject("newest", "mainframe"),
+			valid:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var errs field.ErrorList
+			if test.oldProject == nil {
+				errs = ValidateProjectCreate(test.project)
+			} else {
+				errs = ValidateProjectUpdate(test.oldProject, test.project)
+			}
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid=%v, got %v", test.valid, errs.ToAggregate())
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -92,3 +93,59 @@ func validateAcceleratorQuotaEntry(
 
 	return allErrs
 }
+
+// ValidateObjectCountQuota validates the object count dimensions of a quota, which must be
+// non-negative whole numbers if set.
+func ValidateObjectCountQuota(resourceDetails kubermaticv1.ResourceDetails) error {
+	allErrs := field.ErrorList{}
+
+	allErrs = validateObjectCount(allErrs, resourceDetails.Clusters, field.NewPath("clusters"))
+	allErrs = validateObjectCount(allErrs, resourceDetails.Nodes, field.NewPath("nodes"))
+	allErrs = validateObjectCount(allErrs, resourceDetails.LoadBalancers, field.NewPath("loadBalancers"))
+	allErrs = validateObjectCount(allErrs, resourceDetails.PersistentVolumeClaims, field.NewPath("persistentVolumeClaims"))
+
+	return allErrs.ToAggregate()
+}
+
+func validateObjectCount(allErrs field.ErrorList, quantity *resource.Quantity, fieldPath *field.Path) field.ErrorList {
+	if quantity == nil {
+		return allErrs
+	}
+
+	if quantity.Sign() < 0 {
+		allErrs = append(allErrs, field.Invalid(fieldPath, quantity.String(), "object count must not be negative"))
+	}
+
+	if _, exact := quantity.AsScale(0); !exact {
+		allErrs = append(allErrs, field.Invalid(fieldPath, quantity.String(), "object count must be a whole number"))
+	}
+
+	return allErrs
+}
+
+// ValidateQuotaLimits validates the soft limit and burst allowance of a resource quota.
+func ValidateQuotaLimits(spec kubermaticv1.ResourceQuotaSpec) error {
+	allErrs := field.ErrorList{}
+
+	if spec.SoftLimit != nil {
+		allErrs = validatePercentage(allErrs, spec.SoftLimit.Percentage, field.NewPath("softLimit", "percentage"))
+	}
+
+	if spec.Burst != nil {
+		burstPath := field.NewPath("burst")
+		allErrs = validatePercentage(allErrs, spec.Burst.Percentage, burstPath.Child("percentage"))
+		if spec.Burst.Duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(burstPath.Child("duration"), spec.Burst.Duration.Duration.String(), "burst duration must be positive"))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validatePercentage(allErrs field.ErrorList, percentage int32, fieldPath *field.Path) field.ErrorList {
+	if percentage < 1 || percentage > 100 {
+		allErrs = append(allErrs, field.Invalid(fieldPath, percentage, "percentage must be between 1 and 100"))
+	}
+
+	return allErrs
+}
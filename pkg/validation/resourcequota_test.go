@@ -20,12 +20,14 @@ import (
 	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/validation"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestValidateAcceleratorQuota(t *testing.T) {
@@ -272,3 +274,128 @@ func TestValidateAcceleratorQuotaAggregatesErrorsDeterministically(t *testing.T)
 		}
 	}
 }
+
+func TestValidateObjectCountQuota(t *testing.T) {
+	quantity := func(value string) *resource.Quantity {
+		q := resource.MustParse(value)
+		return &q
+	}
+
+	testCases := []struct {
+		name             string
+		resourceDetails  kubermaticv1.ResourceDetails
+		expectedMessages []string
+	}{
+		{name: "omitted object counts"},
+		{
+			name: "valid object counts",
+			resourceDetails: kubermaticv1.ResourceDetails{
+				Clusters:               quantity("40"),
+				Nodes:                  quantity("0"),
+				LoadBalancers:          quantity("1k"),
+				PersistentVolumeClaims: quantity("100"),
+			},
+		},
+		{
+			name: "negative object count",
+			resourceDetails: kubermaticv1.ResourceDetails{
+				Clusters: quantity("-1"),
+			},
+			expectedMessages: []string{`clusters: Invalid value: "-1": object count must not be negative`},
+		},
+		{
+			name: "fractional object counts",
+			resourceDetails: kubermaticv1.ResourceDetails{
+				LoadBalancers:          quantity("500m"),
+				PersistentVolumeClaims: quantity("1.5"),
+			},
+			expectedMessages: []string{
+				`loadBalancers: Invalid value: "500m": object count must be a whole number`,
+				`persistentVolumeClaims: Invalid value: "1500m": object count must be a whole number`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validation.ValidateObjectCountQuota(tc.resourceDetails)
+			if len(tc.expectedMessages) == 0 {
+				if err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			for _, expectedMessage := range tc.expectedMessages {
+				if !strings.Contains(err.Error(), expectedMessage) {
+					t.Errorf("expected error %q to contain %q", err, expectedMessage)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateQuotaLimits(t *testing.T) {
+	testCases := []struct {
+		name             string
+		spec             kubermaticv1.ResourceQuotaSpec
+		expectedMessages []string
+	}{
+		{name: "no limits"},
+		{
+			name: "valid limits",
+			spec: kubermaticv1.ResourceQuotaSpec{
+				SoftLimit: &kubermaticv1.ResourceQuotaSoftLimit{Percentage: 80},
+				Burst: &kubermaticv1.ResourceQuotaBurst{
+					Percentage: 20,
+					Duration:   metav1.Duration{Duration: 4 * time.Hour},
+				},
+			},
+		},
+		{
+			name: "invalid percentages",
+			spec: kubermaticv1.ResourceQuotaSpec{
+				SoftLimit: &kubermaticv1.ResourceQuotaSoftLimit{Percentage: 0},
+				Burst: &kubermaticv1.ResourceQuotaBurst{
+					Percentage: 101,
+					Duration:   metav1.Duration{Duration: time.Hour},
+				},
+			},
+			expectedMessages: []string{
+				`softLimit.percentage: Invalid value: 0: percentage must be between 1 and 100`,
+				`burst.percentage: Invalid value: 101: percentage must be between 1 and 100`,
+			},
+		},
+		{
+			name: "missing burst duration",
+			spec: kubermaticv1.ResourceQuotaSpec{
+				Burst: &kubermaticv1.ResourceQuotaBurst{Percentage: 10},
+			},
+			expectedMessages: []string{`burst.duration: Invalid value: "0s": burst duration must be positive`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validation.ValidateQuotaLimits(tc.spec)
+			if len(tc.expectedMessages) == 0 {
+				if err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			for _, expectedMessage := range tc.expectedMessages {
+				if !strings.Contains(err.Error(), expectedMessage) {
+					t.Errorf("expected error %q to contain %q", err, expectedMessage)
+				}
+			}
+		})
+	}
+}
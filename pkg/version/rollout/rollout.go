@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rollout assigns clusters to the waves of the rollout of automatic updates and
+// decides whether a cluster may currently receive an automatic update.
+package rollout
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+const (
+	// DefaultHealthTimeout is how long a cluster of a started wave may be unhealthy before
+	// the rollout is halted, if no timeout is configured.
+	DefaultHealthTimeout = 30 * time.Minute
+)
+
+// HealthTimeout returns the configured health timeout or the default.
+func HealthTimeout(cfg *kubermaticv1.UpdateRolloutConfiguration) time.Duration {
+	if cfg.HealthTimeout != nil && cfg.HealthTimeout.Duration > 0 {
+		return cfg.HealthTimeout.Duration
+	}
+
+	return DefaultHealthTimeout
+}
+
+// Wave returns the name of the wave the cluster belongs to. An empty string is the final
+// wave of all clusters that are not assigned to any other wave.
+func Wave(cfg *kubermaticv1.UpdateRolloutConfiguration, cluster *kubermaticv1.Cluster, seedName string) string {
+	if cfg.Strategy == kubermaticv1.UpdateRolloutStrategySeed {
+		if slices.Contains(cfg.Seeds, seedName) {
+			return seedName
+		}
+
+		return ""
+	}
+
+	label := cfg.WaveLabel
+	if label == "" {
+		label = kubermaticv1.DefaultUpdateWaveLabel
+	}
+
+	return strings.TrimSpace(cluster.Labels[label])
+}
+
+// CompareWaves returns a negative number if wave a is rolled out before wave b, a positive
+// number if it is rolled out after b and 0 if both are the same wave.
+func CompareWaves(cfg *kubermaticv1.UpdateRolloutConfiguration, a, b string) int {
+	if a == b {
+		return 0
+	}
+
+	// the final wave always comes last
+	if a == "" {
+		return 1
+	}
+	if b == "" {
+		return -1
+	}
+
+	if cfg.Strategy == kubermaticv1.UpdateRolloutStrategySeed {
+		return slices.Index(cfg.Seeds, a) - slices.Index(cfg.Seeds, b)
+	}
+
+	// numeric waves are ordered numerically and before all others
+	aNum, aErr := strconv.ParseInt(a, 10, 64)
+	bNum, bErr := strconv.ParseInt(b, 10, 64)
+
+	switch {
+	case aErr == nil && bErr == nil:
+		if aNum != bNum {
+			if aNum < bNum {
+				return -1
+			}
+			return 1
+		}
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+
+	return strings.Compare(a, b)
+}
+
+// SortWaves sorts the given waves in rollout order.
+func SortWaves(cfg *kubermaticv1.UpdateRolloutConfiguration, waves []string) {
+	slices.SortFunc(waves, func(a, b string) int {
+		return CompareWaves(cfg, a, b)
+	})
+}
+
+// Allowed returns whether a cluster in the given wave may receive automatic updates according
+// to the current rollout status. If not, the returned string describes why.
+func Allowed(cfg *kubermaticv1.UpdateRolloutConfiguration, rollout *kubermaticv1.UpdateRollout, wave string) (bool, string) {
+	if rollout == nil {
+		return false, "the update rollout has not been computed yet"
+	}
+
+	if rollout.Status.Phase == kubermaticv1.UpdateRolloutPhaseHalted {
+		return false, fmt.Sprintf("the update rollout is halted: %s", rollout.Status.Message)
+	}
+
+	// Before the rollout status is computed for the first time, no wave is active.
+	if rollout.Status.Phase == "" {
+		return false, "the update rollout has not been computed yet"
+	}
+
+	if CompareWaves(cfg, wave, rollout.Status.ActiveWave) > 0 {
+		return false, fmt.Sprintf("waiting for wave %s to be rolled out", WaveName(rollout.Status.ActiveWave))
+	}
+
+	return true, ""
+}
+
+// WaveName returns a human-readable name of the wave for messages.
+func WaveName(wave string) string {
+	if wave == "" {
+		return `"" (final wave)`
+	}
+
+	return strconv.Quote(wave)
+}
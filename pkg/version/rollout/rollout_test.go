@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWave(t *testing.T) {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				kubermaticv1.DefaultUpdateWaveLabel: "2",
+				"custom":                            "canary",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		cfg      kubermaticv1.UpdateRolloutConfiguration
+		seed     string
+		expected string
+	}{
+		{
+			name:     "default label",
+			cfg:      kubermaticv1.UpdateRolloutConfiguration{},
+			expected: "2",
+		},
+		{
+			name:     "custom label",
+			cfg:      kubermaticv1.UpdateRolloutConfiguration{WaveLabel: "custom"},
+			expected: "canary",
+		},
+		{
+			name:     "missing label",
+			cfg:      kubermaticv1.UpdateRolloutConfiguration{WaveLabel: "missing"},
+			expected: "",
+		},
+		{
+			name:     "listed seed",
+			cfg:      kubermaticv1.UpdateRolloutConfiguration{Strategy: kubermaticv1.UpdateRolloutStrategySeed, Seeds: []string{"europe", "asia"}},
+			seed:     "asia",
+			expected: "asia",
+		},
+		{
+			name:     "unlisted seed",
+			cfg:      kubermaticv1.UpdateRolloutConfiguration{Strategy: kubermaticv1.UpdateRolloutStrategySeed, Seeds: []string{"europe", "asia"}},
+			seed:     "usa",
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if wave := Wave(&tc.cfg, cluster, tc.seed); wave != tc.expected {
+				t.Fatalf("Expected wave %q, got %q.", tc.expected, wave)
+			}
+		})
+	}
+}
+
+func TestSortWaves(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cfg      kubermaticv1.UpdateRolloutConfiguration
+		waves    []string
+		expected []string
+	}{
+		{
+			name:     "numeric waves",
+			waves:    []string{"", "10", "2", "1"},
+			expected: []string{"1", "2", "10", ""},
+		},
+		{
+			name:     "mixed waves",
+			waves:    []string{"stable", "", "3", "canary", "1"},
+			expected: []string{"1", "3", "canary", "stable", ""},
+		},
+		{
+			name:     "seed waves",
+			cfg:      kubermaticv1.UpdateRolloutConfiguration{Strategy: kubermaticv1.UpdateRolloutStrategySeed, Seeds: []string{"europe", "asia", "usa"}},
+			waves:    []string{"", "usa", "europe", "asia"},
+			expected: []string{"europe", "asia", "usa", ""},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			SortWaves(&tc.cfg, tc.waves)
+
+			if len(tc.waves) != len(tc.expected) {
+				t.Fatalf("Expected %v, got %v.", tc.expected, tc.waves)
+			}
+			for i := range tc.waves {
+				if tc.waves[i] != tc.expected[i] {
+					t.Fatalf("Expected %v, got %v.", tc.expected, tc.waves)
+				}
+			}
+		})
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	cfg := &kubermaticv1.UpdateRolloutConfiguration{}
+
+	rollout := func(phase kubermaticv1.UpdateRolloutPhase, activeWave string) *kubermaticv1.UpdateRollout {
+		return &kubermaticv1.UpdateRollout{
+			Status: kubermaticv1.UpdateRolloutStatus{
+				Phase:      phase,
+				ActiveWave: activeWave,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		rollout  *kubermaticv1.UpdateRollout
+		wave     string
+		expected bool
+	}{
+		{
+			name:     "no rollout status yet",
+			rollout:  nil,
+			wave:     "1",
+			expected: false,
+		},
+		{
+			name:     "rollout status not computed yet",
+			rollout:  rollout("", ""),
+			wave:     "1",
+			expected: false,
+		},
+		{
+			name:     "cluster in active wave",
+			rollout:  rollout(kubermaticv1.UpdateRolloutPhaseProgressing, "2"),
+			wave:     "2",
+			expected: true,
+		},
+		{
+			name:     "cluster in earlier wave",
+			rollout:  rollout(kubermaticv1.UpdateRolloutPhaseProgressing, "2"),
+			wave:     "1",
+			expected: true,
+		},
+		{
+			name:     "cluster in later wave",
+			rollout:  rollout(kubermaticv1.UpdateRolloutPhaseProgressing, "2"),
+			wave:     "10",
+			expected: false,
+		},
+		{
+			name:     "cluster in final wave",
+			rollout:  rollout(kubermaticv1.UpdateRolloutPhaseProgressing, "2"),
+			wave:     "",
+			expected: false,
+		},
+		{
+			name:     "final wave is active",
+			rollout:  rollout(kubermaticv1.UpdateRolloutPhaseProgressing, ""),
+			wave:     "",
+			expected: true,
+		},
+		{
+			name:     "idle rollout only allows the first wave",
+			rollout:  rollout(kubermaticv1.UpdateRolloutPhaseIdle, "1"),
+			wave:     "2",
+			expected: false,
+		},
+		{
+			name:     "halted rollout",
+			rollout:  rollout(kubermaticv1.UpdateRolloutPhaseHalted, "2"),
+			wave:     "1",
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			allowed, reason := Allowed(cfg, tc.rollout, tc.wave)
+			if allowed != tc.expected {
+				t.Fatalf("Expected allowed=%v, got %v (%s).", tc.expected, allowed, reason)
+			}
+			if !allowed && reason == "" {
+				t.Fatal("Expected a reason why the update is not allowed.")
+			}
+		})
+	}
+}
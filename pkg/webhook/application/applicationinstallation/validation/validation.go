@@ -25,6 +25,7 @@ import (
 
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/validation"
+	webhookutil "k8c.io/kubermatic/v2/pkg/webhook/util"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -41,15 +42,17 @@ type AdmissionHandler struct {
 	decoder     admission.Decoder
 	client      ctrlruntimeclient.Client
 	clusterName string
+	projectID   string
 }
 
 // NewAdmissionHandler returns a new validation AdmissionHandler.
-func NewAdmissionHandler(log *zap.SugaredLogger, scheme *runtime.Scheme, client ctrlruntimeclient.Client, clusterName string) *AdmissionHandler {
+func NewAdmissionHandler(log *zap.SugaredLogger, scheme *runtime.Scheme, client ctrlruntimeclient.Client, clusterName, projectID string) *AdmissionHandler {
 	return &AdmissionHandler{
 		log:         log,
 		decoder:     admission.NewDecoder(scheme),
 		client:      client,
 		clusterName: clusterName,
+		projectID:   projectID,
 	}
 }
 
@@ -67,6 +70,11 @@ func (h *AdmissionHandler) Handle(ctx context.Context, req webhook.AdmissionRequ
 		if err := h.decoder.Decode(req, ad); err != nil {
 			return webhook.Errored(http.StatusBadRequest, err)
 		}
+		if h.projectID != "" {
+			if err := webhookutil.CheckProjectIsNotSuspended(ctx, h.client, h.projectID); err != nil {
+				return webhook.Denied(err.Error())
+			}
+		}
 		allErrs = append(allErrs, validation.ValidateApplicationInstallationSpec(ctx, h.client, *ad)...)
 
 	case admissionv1.Update:
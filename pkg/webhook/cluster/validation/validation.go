@@ -95,6 +95,13 @@ func (v *validator) ValidateCreate(ctx context.Context, cluster *kubermaticv1.Cl
 		errs = append(errs, err)
 	}
 
+	errs = append(errs, v.validateProjectRestrictions(ctx, cluster, nil, seed)...)
+
+	warnings, quotaErr := validateClusterQuota(ctx, v.client, cluster)
+	if quotaErr != nil {
+		errs = append(errs, quotaErr)
+	}
+
 	if err := v.validateKyvernoEnforcement(cluster, nil, datacenter, seed, config); err != nil {
 		errs = append(errs, err)
 	}
@@ -103,7 +110,7 @@ func (v *validator) ValidateCreate(ctx context.Context, cluster *kubermaticv1.Cl
 		errs = append(errs, err)
 	}
 
-	return nil, errs.ToAggregate()
+	return warnings, errs.ToAggregate()
 }
 
 func (v *validator) ValidateUpdate(ctx context.Context, oldCluster, newCluster *kubermaticv1.Cluster) (admission.Warnings, error) {
@@ -125,6 +132,8 @@ func (v *validator) ValidateUpdate(ctx context.Context, oldCluster, newCluster *
 		errs = append(errs, err)
 	}
 
+	errs = append(errs, v.validateProjectRestrictions(ctx, newCluster, oldCluster, seed)...)
+
 	if err := v.validateKyvernoEnforcement(newCluster, oldCluster, datacenter, seed, config); err != nil {
 		errs = append(errs, err)
 	}
@@ -211,6 +220,34 @@ func (v *validator) validateProjectRelation(ctx context.Context, cluster *kuberm
 	return nil
 }
 
+// validateProjectRestrictions ensures that clusters adhere to the allowed datacenters, seeds, providers,
+// Kubernetes versions and expose strategies of their project and are neither created nor resumed
+// while their project is suspended.
+func (v *validator) validateProjectRestrictions(ctx context.Context, cluster, oldCluster *kubermaticv1.Cluster, seed *kubermaticv1.Seed) field.ErrorList {
+	projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+	if projectID == "" {
+		// a missing label is already reported by validateProjectRelation
+		return nil
+	}
+
+	project := &kubermaticv1.Project{}
+	if err := v.client.Get(ctx, types.NamespacedName{Name: projectID}, project); err != nil {
+		// a missing project is already reported by validateProjectRelation
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return field.ErrorList{field.InternalError(field.NewPath("metadata", "labels"), fmt.Errorf("failed to get project: %w", err))}
+	}
+
+	var oldSpec *kubermaticv1.ClusterSpec
+	if oldCluster != nil {
+		oldSpec = &oldCluster.Spec
+	}
+
+	return validation.ValidateProjectRestrictions(project, &cluster.Spec, oldSpec, seed.Name, field.NewPath("spec"))
+}
+
 // validateKyvernoEnforcement ensures users cannot override enforced Kyverno settings through Cluster spec.
 func (v *validator) validateKyvernoEnforcement(
 	newCluster, oldCluster *kubermaticv1.Cluster,
@@ -0,0 +1,62 @@
+//go:build ee
+
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	eeresourcequotavalidation "k8c.io/kubermatic/v2/pkg/ee/validation/resourcequota"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// validateClusterQuota ensures that creating the cluster does not exceed the cluster
+// count quota of its project.
+func validateClusterQuota(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (admission.Warnings, *field.Error) {
+	projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+	if projectID == "" {
+		return nil, nil
+	}
+
+	quotaList := &kubermaticv1.ResourceQuotaList{}
+	if err := client.List(ctx, quotaList, ctrlruntimeclient.MatchingLabels{
+		kubermaticv1.ResourceQuotaSubjectNameLabelKey: projectID,
+		kubermaticv1.ResourceQuotaSubjectKindLabelKey: kubermaticv1.ProjectSubjectKind,
+	}); err != nil {
+		return nil, field.InternalError(nil, fmt.Errorf("failed to list resource quotas: %w", err))
+	}
+
+	var warnings admission.Warnings
+	for _, quota := range quotaList.Items {
+		warning, err := eeresourcequotavalidation.ValidateObjectCount(&quota, "clusters", quota.Spec.Quota.Clusters, quota.Status.GlobalUsage.Clusters, 1, time.Now())
+		if err != nil {
+			return nil, field.Forbidden(field.NewPath("metadata", "labels").Key(kubermaticv1.ProjectIDLabelKey), err.Error())
+		}
+		if warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	return warnings, nil
+}
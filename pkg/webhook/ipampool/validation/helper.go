@@ -21,6 +21,8 @@ import (
 	"strings"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/api/equality"
 )
 
 func getSliceAdditions(previousSlice, currentSlice []string) []string {
@@ -40,6 +42,32 @@ func getSliceAdditions(previousSlice, currentSlice []string) []string {
 	return additions
 }
 
+// getAddedReservations returns all reservations of currentReservations that
+// are new or have been changed compared to previousReservations.
+func getAddedReservations(previousReservations, currentReservations []kubermaticv1.IPAMPoolReservation) []kubermaticv1.IPAMPoolReservation {
+	var additions []kubermaticv1.IPAMPoolReservation
+
+	for _, current := range currentReservations {
+		existedBefore := false
+		for _, previous := range previousReservations {
+			if equality.Semantic.DeepEqual(current, previous) {
+				existedBefore = true
+				break
+			}
+		}
+
+		if !existedBefore {
+			additions = append(additions, current)
+		}
+	}
+
+	return additions
+}
+
+func subnetsOverlap(first, second *net.IPNet) bool {
+	return first.Contains(second.IP) || second.Contains(first.IP)
+}
+
 func subnetCIDRSliceToStringSlice(s []kubermaticv1.SubnetCIDR) []string {
 	convertedSlice := make([]string, len(s))
 
@@ -23,11 +23,15 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"net/url"
 	"strings"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/provider"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
@@ -57,6 +61,10 @@ func (v *validator) ValidateUpdate(ctx context.Context, oldIPAMPool, newIPAMPool
 		return nil, err
 	}
 
+	if (oldIPAMPool.Spec.Backend == nil) != (newIPAMPool.Spec.Backend == nil) {
+		return nil, errors.New("it's not allowed to add or remove the backend of an IPAM pool")
+	}
+
 	// loop old IPAMPool datacenters
 	for dc, dcOldConfig := range oldIPAMPool.Spec.Datacenters {
 		dcNewConfig, dcExistsInNewPool := newIPAMPool.Spec.Datacenters[dc]
@@ -93,6 +101,12 @@ func (v *validator) ValidateUpdate(ctx context.Context, oldIPAMPool, newIPAMPool
 		}
 	}
 
+	for _, reservation := range getAddedReservations(oldIPAMPool.Spec.Reservations, newIPAMPool.Spec.Reservations) {
+		if err := v.checkReservationNotAllocated(ctx, reservation, newIPAMPool); err != nil {
+			return nil, err
+		}
+	}
+
 	return nil, nil
 }
 
@@ -102,6 +116,18 @@ func (v *validator) ValidateDelete(_ context.Context, _ *kubermaticv1.IPAMPool)
 }
 
 func (v *validator) validate(ctx context.Context, ipamPool *kubermaticv1.IPAMPool) error {
+	if ipamPool.Spec.ClusterSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(ipamPool.Spec.ClusterSelector); err != nil {
+			return fmt.Errorf("invalid cluster selector: %w", err)
+		}
+	}
+
+	if ipamPool.Spec.Backend != nil {
+		if err := validateBackend(ipamPool); err != nil {
+			return err
+		}
+	}
+
 	for _, dcConfig := range ipamPool.Spec.Datacenters {
 		_, poolSubnet, err := net.ParseCIDR(string(dcConfig.PoolCIDR))
 		if err != nil {
@@ -155,6 +181,158 @@ func (v *validator) validate(ctx context.Context, ipamPool *kubermaticv1.IPAMPoo
 		}
 	}
 
+	return validateReservations(ipamPool)
+}
+
+func validateBackend(ipamPool *kubermaticv1.IPAMPool) error {
+	netbox := ipamPool.Spec.Backend.NetBox
+	if netbox == nil {
+		return errors.New("no IPAM backend configured")
+	}
+
+	parsed, err := url.Parse(netbox.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid NetBox URL \"%s\": must be an http(s) URL", netbox.URL)
+	}
+
+	tokenRef := netbox.TokenReference
+	if tokenRef.Name == "" || tokenRef.Namespace == "" || tokenRef.Key == "" {
+		return errors.New("NetBox token reference must specify name, namespace and key")
+	}
+
+	if len(ipamPool.Spec.Reservations) > 0 {
+		return errors.New("reservations are not supported for pools with an IPAM backend, reserve the prefixes or addresses in the backend instead")
+	}
+
+	return nil
+}
+
+func validateReservations(ipamPool *kubermaticv1.IPAMPool) error {
+	// reservations that have been validated so far, per datacenter
+	validated := map[string][]kubermaticv1.IPAMPoolReservation{}
+
+	for _, reservation := range ipamPool.Spec.Reservations {
+		if reservation.Cluster == "" {
+			return errors.New("reservation is missing the cluster name")
+		}
+
+		dcConfig, exists := ipamPool.Spec.Datacenters[reservation.Datacenter]
+		if !exists {
+			return fmt.Errorf("reservation for cluster \"%s\" refers to datacenter \"%s\", which is not configured in the pool", reservation.Cluster, reservation.Datacenter)
+		}
+
+		_, poolSubnet, err := net.ParseCIDR(string(dcConfig.PoolCIDR))
+		if err != nil {
+			return err
+		}
+
+		for _, other := range validated[reservation.Datacenter] {
+			if other.Cluster == reservation.Cluster {
+				return fmt.Errorf("duplicate reservation for cluster \"%s\" in datacenter \"%s\"", reservation.Cluster, reservation.Datacenter)
+			}
+		}
+
+		switch dcConfig.Type {
+		case kubermaticv1.IPAMPoolAllocationTypeRange:
+			if err := validateRangeReservation(reservation, dcConfig, poolSubnet, validated[reservation.Datacenter]); err != nil {
+				return err
+			}
+		case kubermaticv1.IPAMPoolAllocationTypePrefix:
+			if err := validatePrefixReservation(reservation, dcConfig, poolSubnet, validated[reservation.Datacenter]); err != nil {
+				return err
+			}
+		}
+
+		validated[reservation.Datacenter] = append(validated[reservation.Datacenter], reservation)
+	}
+
+	return nil
+}
+
+func validateRangeReservation(reservation kubermaticv1.IPAMPoolReservation, dcConfig kubermaticv1.IPAMPoolDatacenterSettings, poolSubnet *net.IPNet, others []kubermaticv1.IPAMPoolReservation) error {
+	if reservation.CIDR != "" || len(reservation.Addresses) == 0 {
+		return fmt.Errorf("reservation for cluster \"%s\" must specify addresses (and no CIDR) for a range pool", reservation.Cluster)
+	}
+
+	numberOfIPs := 0
+	for _, addressRange := range reservation.Addresses {
+		if err := validateRange(addressRange); err != nil {
+			return err
+		}
+
+		splitRange := strings.Split(addressRange, "-")
+		firstIP := net.ParseIP(splitRange[0])
+		lastIP := net.ParseIP(splitRange[len(splitRange)-1])
+		if !poolSubnet.Contains(firstIP) || !poolSubnet.Contains(lastIP) {
+			return fmt.Errorf("reserved range \"%s\" for cluster \"%s\" is not part of the pool CIDR", addressRange, reservation.Cluster)
+		}
+
+		for ip := checkIPv4(firstIP); !ip.Equal(lastIP); ip = incIP(ip) {
+			numberOfIPs++
+		}
+		numberOfIPs++
+	}
+
+	if numberOfIPs != dcConfig.AllocationRange {
+		return fmt.Errorf("reservation for cluster \"%s\" contains %d IPs, but must contain as many IPs as the pool allocation range (%d)", reservation.Cluster, numberOfIPs, dcConfig.AllocationRange)
+	}
+
+	if addressRangesConflict(reservation.Addresses, dcConfig.ExcludeRanges) {
+		return fmt.Errorf("reservation for cluster \"%s\" overlaps with the excluded ranges", reservation.Cluster)
+	}
+
+	for _, other := range others {
+		if addressRangesConflict(reservation.Addresses, other.Addresses) {
+			return fmt.Errorf("reservation for cluster \"%s\" overlaps with the reservation for cluster \"%s\"", reservation.Cluster, other.Cluster)
+		}
+	}
+
+	return nil
+}
+
+func validatePrefixReservation(reservation kubermaticv1.IPAMPoolReservation, dcConfig kubermaticv1.IPAMPoolDatacenterSettings, poolSubnet *net.IPNet, others []kubermaticv1.IPAMPoolReservation) error {
+	if reservation.CIDR == "" || len(reservation.Addresses) > 0 {
+		return fmt.Errorf("reservation for cluster \"%s\" must specify a CIDR (and no addresses) for a prefix pool", reservation.Cluster)
+	}
+
+	_, subnet, err := net.ParseCIDR(string(reservation.CIDR))
+	if err != nil {
+		return fmt.Errorf("invalid CIDR for reservation of cluster \"%s\": %w", reservation.Cluster, err)
+	}
+
+	if subnet.String() != string(reservation.CIDR) {
+		return fmt.Errorf("invalid CIDR for reservation of cluster \"%s\": must be the network address \"%s\"", reservation.Cluster, subnet)
+	}
+
+	subnetPrefix, _ := subnet.Mask.Size()
+	if subnetPrefix != dcConfig.AllocationPrefix {
+		return fmt.Errorf("invalid length for reservation of cluster \"%s\": must be the same as the pool allocation prefix (%d)", reservation.Cluster, dcConfig.AllocationPrefix)
+	}
+
+	if !poolSubnet.Contains(subnet.IP) {
+		return fmt.Errorf("reserved CIDR \"%s\" for cluster \"%s\" is not part of the pool CIDR", reservation.CIDR, reservation.Cluster)
+	}
+
+	for _, excluded := range dcConfig.ExcludePrefixes {
+		_, excludedSubnet, err := net.ParseCIDR(string(excluded))
+		if err != nil {
+			return err
+		}
+		if subnetsOverlap(subnet, excludedSubnet) {
+			return fmt.Errorf("reservation for cluster \"%s\" overlaps with the excluded prefix \"%s\"", reservation.Cluster, excluded)
+		}
+	}
+
+	for _, other := range others {
+		_, otherSubnet, err := net.ParseCIDR(string(other.CIDR))
+		if err != nil {
+			return err
+		}
+		if subnetsOverlap(subnet, otherSubnet) {
+			return fmt.Errorf("reservation for cluster \"%s\" overlaps with the reservation for cluster \"%s\"", reservation.Cluster, other.Cluster)
+		}
+	}
+
 	return nil
 }
 
@@ -250,3 +428,61 @@ func (v *validator) checkExclusionsNotAllocated(ctx context.Context, exclusions
 
 	return nil
 }
+
+// checkReservationNotAllocated ensures that the reserved prefix or addresses are
+// not already allocated to a cluster other than the one the reservation is for.
+func (v *validator) checkReservationNotAllocated(ctx context.Context, reservation kubermaticv1.IPAMPoolReservation, ipamPool *kubermaticv1.IPAMPool) error {
+	seedClient, err := v.getSeedClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the reserved cluster might not exist yet
+	reservedClusterNamespace := ""
+	cluster := &kubermaticv1.Cluster{}
+	if err := seedClient.Get(ctx, types.NamespacedName{Name: reservation.Cluster}, cluster); err == nil {
+		reservedClusterNamespace = cluster.Status.NamespaceName
+	} else if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	ipamAllocationList := &kubermaticv1.IPAMAllocationList{}
+	if err := seedClient.List(ctx, ipamAllocationList); err != nil {
+		return fmt.Errorf("failed to list IPAM allocations: %w", err)
+	}
+
+	for _, ipamAllocation := range ipamAllocationList.Items {
+		if ipamAllocation.Name != ipamPool.Name || ipamAllocation.Spec.DC != reservation.Datacenter {
+			continue
+		}
+		if reservedClusterNamespace != "" && ipamAllocation.Namespace == reservedClusterNamespace {
+			continue
+		}
+
+		errReservationConflict := fmt.Errorf("failed to add reservation for cluster \"%s\": there is a conflicting allocation in IPAM pool \"%s\" and datacenter \"%s\"", reservation.Cluster, ipamPool.Name, reservation.Datacenter)
+
+		switch ipamAllocation.Spec.Type {
+		case kubermaticv1.IPAMPoolAllocationTypeRange:
+			if addressRangesConflict(ipamAllocation.Spec.Addresses, reservation.Addresses) {
+				return errReservationConflict
+			}
+		case kubermaticv1.IPAMPoolAllocationTypePrefix:
+			if ipamAllocation.Spec.CIDR == "" {
+				continue
+			}
+			_, allocatedSubnet, err := net.ParseCIDR(string(ipamAllocation.Spec.CIDR))
+			if err != nil {
+				return err
+			}
+			_, reservedSubnet, err := net.ParseCIDR(string(reservation.CIDR))
+			if err != nil {
+				return err
+			}
+			if subnetsOverlap(allocatedSubnet, reservedSubnet) {
+				return errReservationConflict
+			}
+		}
+	}
+
+	return nil
+}
@@ -27,8 +27,10 @@ import (
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/machine-controller/sdk/providerconfig"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -38,6 +40,11 @@ var (
 )
 
 func TestValidator(t *testing.T) {
+	invalidClusterSelector := &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: "Foo"}},
+	}
+	_, invalidClusterSelectorErr := metav1.LabelSelectorAsSelector(invalidClusterSelector)
+
 	testCases := []struct {
 		name          string
 		op            admissionv1.Operation
@@ -1018,6 +1025,675 @@ func TestValidator(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("it's not allowed to update the allocation prefix for a datacenter"),
 		},
+		{
+			name: "cluster selector: valid",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"192.168.1.12/30"},
+						},
+					},
+					ClusterSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"env": "prod"},
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "cluster selector: invalid",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"192.168.1.12/30"},
+						},
+					},
+					ClusterSelector: invalidClusterSelector,
+				},
+			},
+			expectedError: fmt.Errorf("invalid cluster selector: %w", invalidClusterSelectorErr),
+		},
+		{
+			name: "prefix reservation: valid",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"192.168.1.12/30"},
+						},
+					},
+					Reservations: []kubermaticv1.IPAMPoolReservation{
+						{Cluster: "cluster-a", Datacenter: "dc", CIDR: "192.168.1.0/30"},
+						{Cluster: "cluster-b", Datacenter: "dc", CIDR: "192.168.1.4/30"},
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "reservation: unknown datacenter",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"192.168.1.12/30"},
+						},
+					},
+					Reservations: []kubermaticv1.IPAMPoolReservation{
+						{Cluster: "cluster-a", Datacenter: "other-dc", CIDR: "192.168.1.0/30"},
+					},
+				},
+			},
+			expectedError: errors.New("reservation for cluster \"cluster-a\" refers to datacenter \"other-dc\", which is not configured in the pool"),
+		},
+		{
+			name: "reservation: duplicate cluster",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"192.168.1.12/30"},
+						},
+					},
+					Reservations: []kubermaticv1.IPAMPoolReservation{
+						{Cluster: "cluster-a", Datacenter: "dc", CIDR: "192.168.1.0/30"},
+						{Cluster: "cluster-a", Datacenter: "dc", CIDR: "192.168.1.4/30"},
+					},
+				},
+			},
+			expectedError: errors.New("duplicate reservation for cluster \"cluster-a\" in datacenter \"dc\""),
+		},
+		{
+			name: "prefix reservation: invalid length",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"192.168.1.12/30"},
+						},
+					},
+					Reservations: []kubermaticv1.IPAMPoolReservation{
+						{Cluster: "cluster-a", Datacenter: "dc", CIDR: "192.168.1.0/29"},
+					},
+				},
+			},
+			expectedError: errors.New("invalid length for reservation of cluster \"cluster-a\": must be the same as the pool allocation prefix (30)"),
+		},
+		{
+			name: "prefix reservation: outside of pool",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"192.168.1.12/30"},
+						},
+					},
+					Reservations: []kubermaticv1.IPAMPoolReservation{
+						{Cluster: "cluster-a", Datacenter: "dc", CIDR: "192.168.2.0/30"},
+					},
+				},
+			},
+			expectedError: errors.New("reserved CIDR \"192.168.2.0/30\" for cluster \"cluster-a\" is not part of the pool CIDR"),
+		},
+		{
+			name: "prefix reservation: overlaps with exclusion",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"192.168.1.12/30"},
+						},
+					},
+					Reservations: []kubermaticv1.IPAMPoolReservation{
+						{Cluster: "cluster-a", Datacenter: "dc", CIDR: "192.168.1.12/30"},
+					},
+				},
+			},
+			expectedError: errors.New("reservation for cluster \"cluster-a\" overlaps with the excluded prefix \"192.168.1.12/30\""),
+		},
+		{
+			name: "prefix reservation: overlaps with other reservation",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"192.168.1.12/30"},
+						},
+					},
+					Reservations: []kubermaticv1.IPAMPoolReservation{
+						{Cluster: "cluster-a", Datacenter: "dc", CIDR: "192.168.1.0/30"},
+						{Cluster: "cluster-b", Datacenter: "dc", CIDR: "192.168.1.0/30"},
+					},
+				},
+			},
+			expectedError: errors.New("reservation for cluster \"cluster-b\" overlaps with the reservation for cluster \"cluster-a\""),
+		},
+		{
+			name: "range reservation: valid",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:            kubermaticv1.IPAMPoolAllocationTypeRange,
+							PoolCIDR:        "192.168.1.0/28",
+							AllocationRange: 4,
+							ExcludeRanges:   []string{"192.168.1.15"},
+						},
+					},
+					Reservations: []kubermaticv1.IPAMPoolReservation{
+						{Cluster: "cluster-a", Datacenter: "dc", Addresses: []string{"192.168.1.0-192.168.1.3"}},
+						{Cluster: "cluster-b", Datacenter: "dc", Addresses: []string{"192.168.1.4-192.168.1.5", "192.168.1.8", "192.168.1.10"}},
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "range reservation: CIDR instead of addresses",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:            kubermaticv1.IPAMPoolAllocationTypeRange,
+							PoolCIDR:        "192.168.1.0/28",
+							AllocationRange: 4,
+							ExcludeRanges:   []string{"192.168.1.15"},
+						},
+					},
+					Reservations: []kubermaticv1.IPAMPoolReservation{
+						{Cluster: "cluster-a", Datacenter: "dc", CIDR: "192.168.1.0/30"},
+					},
+				},
+			},
+			expectedError: errors.New("reservation for cluster \"cluster-a\" must specify addresses (and no CIDR) for a range pool"),
+		},
+		{
+			name: "range reservation: wrong number of IPs",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:            kubermaticv1.IPAMPoolAllocationTypeRange,
+							PoolCIDR:        "192.168.1.0/28",
+							AllocationRange: 4,
+							ExcludeRanges:   []string{"192.168.1.15"},
+						},
+					},
+					Reservations: []kubermaticv1.IPAMPoolReservation{
+						{Cluster: "cluster-a", Datacenter: "dc", Addresses: []string{"192.168.1.0-192.168.1.2"}},
+					},
+				},
+			},
+			expectedError: errors.New("reservation for cluster \"cluster-a\" contains 3 IPs, but must contain as many IPs as the pool allocation range (4)"),
+		},
+		{
+			name: "range reservation: overlaps with exclusion",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:            kubermaticv1.IPAMPoolAllocationTypeRange,
+							PoolCIDR:        "192.168.1.0/28",
+							AllocationRange: 4,
+							ExcludeRanges:   []string{"192.168.1.15"},
+						},
+					},
+					Reservations: []kubermaticv1.IPAMPoolReservation{
+						{Cluster: "cluster-a", Datacenter: "dc", Addresses: []string{"192.168.1.12-192.168.1.15"}},
+					},
+				},
+			},
+			expectedError: errors.New("reservation for cluster \"cluster-a\" overlaps with the excluded ranges"),
+		},
+		{
+			name: "added prefix reservation: conflict with allocation of other cluster",
+			op:   admissionv1.Update,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"192.168.1.12/30"},
+						},
+					},
+					Reservations: []kubermaticv1.IPAMPoolReservation{
+						{Cluster: "cluster-a", Datacenter: "dc", CIDR: "192.168.1.4/30"},
+					},
+				},
+			},
+			oldIPAMPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"192.168.1.12/30"},
+						},
+					},
+				},
+			},
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster-a",
+					},
+					Status: kubermaticv1.ClusterStatus{NamespaceName: "cluster-cluster-a"},
+				},
+				&kubermaticv1.IPAMAllocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "test-pool",
+						Namespace:       "cluster-cluster-a",
+						ResourceVersion: "1",
+					},
+					Spec: kubermaticv1.IPAMAllocationSpec{
+						Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+						DC:   "dc",
+						CIDR: "192.168.1.0/30",
+					},
+				},
+				&kubermaticv1.IPAMAllocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "test-pool",
+						Namespace:       "cluster-cluster-b",
+						ResourceVersion: "1",
+					},
+					Spec: kubermaticv1.IPAMAllocationSpec{
+						Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+						DC:   "dc",
+						CIDR: "192.168.1.4/30",
+					},
+				},
+			},
+			expectedError: errors.New("failed to add reservation for cluster \"cluster-a\": there is a conflicting allocation in IPAM pool \"test-pool\" and datacenter \"dc\""),
+		},
+		{
+			name: "added prefix reservation: allocation of reserved cluster",
+			op:   admissionv1.Update,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"192.168.1.12/30"},
+						},
+					},
+					Reservations: []kubermaticv1.IPAMPoolReservation{
+						{Cluster: "cluster-a", Datacenter: "dc", CIDR: "192.168.1.0/30"},
+					},
+				},
+			},
+			oldIPAMPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"192.168.1.12/30"},
+						},
+					},
+				},
+			},
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster-a",
+					},
+					Status: kubermaticv1.ClusterStatus{NamespaceName: "cluster-cluster-a"},
+				},
+				&kubermaticv1.IPAMAllocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "test-pool",
+						Namespace:       "cluster-cluster-a",
+						ResourceVersion: "1",
+					},
+					Spec: kubermaticv1.IPAMAllocationSpec{
+						Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+						DC:   "dc",
+						CIDR: "192.168.1.0/30",
+					},
+				},
+				&kubermaticv1.IPAMAllocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "test-pool",
+						Namespace:       "cluster-cluster-b",
+						ResourceVersion: "1",
+					},
+					Spec: kubermaticv1.IPAMAllocationSpec{
+						Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+						DC:   "dc",
+						CIDR: "192.168.1.4/30",
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "backend: valid NetBox backend",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+						},
+					},
+					Backend: &kubermaticv1.IPAMPoolBackend{
+						NetBox: &kubermaticv1.IPAMPoolNetBoxBackend{
+							URL: "https://netbox.example.com",
+							TokenReference: providerconfig.GlobalSecretKeySelector{
+								ObjectReference: corev1.ObjectReference{Name: "netbox", Namespace: "kubermatic"},
+								Key:             "token",
+							},
+						},
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "backend: missing backend configuration",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+						},
+					},
+					Backend: &kubermaticv1.IPAMPoolBackend{},
+				},
+			},
+			expectedError: errors.New("no IPAM backend configured"),
+		},
+		{
+			name: "backend: invalid NetBox URL",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+						},
+					},
+					Backend: &kubermaticv1.IPAMPoolBackend{
+						NetBox: &kubermaticv1.IPAMPoolNetBoxBackend{
+							URL: "netbox.example.com",
+							TokenReference: providerconfig.GlobalSecretKeySelector{
+								ObjectReference: corev1.ObjectReference{Name: "netbox", Namespace: "kubermatic"},
+								Key:             "token",
+							},
+						},
+					},
+				},
+			},
+			expectedError: errors.New("invalid NetBox URL \"netbox.example.com\": must be an http(s) URL"),
+		},
+		{
+			name: "backend: incomplete token reference",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+						},
+					},
+					Backend: &kubermaticv1.IPAMPoolBackend{
+						NetBox: &kubermaticv1.IPAMPoolNetBoxBackend{
+							URL: "https://netbox.example.com",
+							TokenReference: providerconfig.GlobalSecretKeySelector{
+								ObjectReference: corev1.ObjectReference{Name: "netbox", Namespace: "kubermatic"},
+								Key:             "",
+							},
+						},
+					},
+				},
+			},
+			expectedError: errors.New("NetBox token reference must specify name, namespace and key"),
+		},
+		{
+			name: "backend: reservations are not supported",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+						},
+					},
+					Backend: &kubermaticv1.IPAMPoolBackend{
+						NetBox: &kubermaticv1.IPAMPoolNetBoxBackend{
+							URL: "https://netbox.example.com",
+							TokenReference: providerconfig.GlobalSecretKeySelector{
+								ObjectReference: corev1.ObjectReference{Name: "netbox", Namespace: "kubermatic"},
+								Key:             "token",
+							},
+						},
+					},
+					Reservations: []kubermaticv1.IPAMPoolReservation{
+						{Cluster: "cluster-a", Datacenter: "dc", CIDR: "192.168.1.0/30"},
+					},
+				},
+			},
+			expectedError: errors.New("reservations are not supported for pools with an IPAM backend, reserve the prefixes or addresses in the backend instead"),
+		},
+		{
+			name: "backend: not allowed to add a backend",
+			op:   admissionv1.Update,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+						},
+					},
+					Backend: &kubermaticv1.IPAMPoolBackend{
+						NetBox: &kubermaticv1.IPAMPoolNetBoxBackend{
+							URL: "https://netbox.example.com",
+							TokenReference: providerconfig.GlobalSecretKeySelector{
+								ObjectReference: corev1.ObjectReference{Name: "netbox", Namespace: "kubermatic"},
+								Key:             "token",
+							},
+						},
+					},
+				},
+			},
+			oldIPAMPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+						},
+					},
+				},
+			},
+			expectedError: errors.New("it's not allowed to add or remove the backend of an IPAM pool"),
+		},
+		{
+			name: "backend: allowed to update the backend",
+			op:   admissionv1.Update,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+						},
+					},
+					Backend: &kubermaticv1.IPAMPoolBackend{
+						NetBox: &kubermaticv1.IPAMPoolNetBoxBackend{
+							URL: "https://netbox.example.com",
+							TokenReference: providerconfig.GlobalSecretKeySelector{
+								ObjectReference: corev1.ObjectReference{Name: "netbox", Namespace: "kubermatic"},
+								Key:             "token",
+							},
+						},
+					},
+				},
+			},
+			oldIPAMPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+						},
+					},
+					Backend: &kubermaticv1.IPAMPoolBackend{
+						NetBox: &kubermaticv1.IPAMPoolNetBoxBackend{
+							URL: "https://netbox-old.example.com",
+							TokenReference: providerconfig.GlobalSecretKeySelector{
+								ObjectReference: corev1.ObjectReference{Name: "netbox", Namespace: "kubermatic"},
+								Key:             "token",
+							},
+						},
+					},
+				},
+			},
+			expectedError: nil,
+		},
 	}
 
 	for _, tc := range testCases {
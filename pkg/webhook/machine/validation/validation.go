@@ -24,6 +24,7 @@ import (
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
+	webhookutil "k8c.io/kubermatic/v2/pkg/webhook/util"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
 	"k8s.io/apimachinery/pkg/labels"
@@ -39,6 +40,7 @@ type validator struct {
 	userClient             ctrlruntimeclient.Client
 	caBundle               *certificates.CABundle
 	subjectSelector        labels.Selector
+	projectID              string
 	kubeVirtInfraNamespace string
 }
 
@@ -61,6 +63,7 @@ func NewValidator(seedClient, userClient ctrlruntimeclient.Client, log *zap.Suga
 		userClient:             userClient,
 		caBundle:               caBundle,
 		subjectSelector:        subjectSelector,
+		projectID:              projectID,
 		kubeVirtInfraNamespace: kubeVirtInfraNamespace,
 	}, nil
 }
@@ -71,12 +74,17 @@ func (v *validator) ValidateCreate(ctx context.Context, machine *clusterv1alpha1
 	log := v.log.With("machine", machine.Name)
 	log.Debug("validating create")
 
+	// suspended projects must not get new machines, which also prevents scaling up MachineDeployments
+	if err := webhookutil.CheckProjectIsNotSuspended(ctx, v.seedClient, v.projectID); err != nil {
+		return nil, err
+	}
+
 	quota, err := getResourceQuota(ctx, v.seedClient, v.subjectSelector)
 	if err != nil {
 		return nil, err
 	}
 	if quota != nil {
-		return nil, validateQuota(ctx, log, v.userClient, v.kubeVirtInfraNamespace, machine, v.caBundle, quota)
+		return validateQuota(ctx, log, v.userClient, v.kubeVirtInfraNamespace, machine, v.caBundle, quota)
 	}
 	return nil, nil
 }
@@ -29,11 +29,12 @@ import (
 
 	"k8s.io/apimachinery/pkg/labels"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 func validateQuota(_ context.Context, _ *zap.SugaredLogger, _ ctrlruntimeclient.Client, _ string, _ *clusterv1alpha1.Machine,
-	_ *certificates.CABundle, _ *kubermaticv1.ResourceQuota) error {
-	return nil
+	_ *certificates.CABundle, _ *kubermaticv1.ResourceQuota) (admission.Warnings, error) {
+	return nil, nil
 }
 
 // Resource Quotas are an EE feature
@@ -31,10 +31,11 @@ import (
 
 	"k8s.io/apimachinery/pkg/labels"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 func validateQuota(ctx context.Context, log *zap.SugaredLogger, userClient ctrlruntimeclient.Client, kubeVirtInfraNamespace string,
-	machine *clusterv1alpha1.Machine, caBundle *certificates.CABundle, resourceQuota *kubermaticv1.ResourceQuota) error {
+	machine *clusterv1alpha1.Machine, caBundle *certificates.CABundle, resourceQuota *kubermaticv1.ResourceQuota) (admission.Warnings, error) {
 	return eemachinevalidation.ValidateQuota(ctx, log, userClient, kubeVirtInfraNamespace, machine, caBundle, resourceQuota)
 }
 
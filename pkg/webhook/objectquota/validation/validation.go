@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// serviceValidator validates that new LoadBalancer Services fit in the quota of the clusters project.
+type serviceValidator struct {
+	log             *zap.SugaredLogger
+	seedClient      ctrlruntimeclient.Client
+	subjectSelector labels.Selector
+}
+
+// NewServiceValidator returns a new Service quota validator.
+func NewServiceValidator(seedClient ctrlruntimeclient.Client, log *zap.SugaredLogger, projectID string) (*serviceValidator, error) {
+	subjectSelector, err := projectSubjectSelector(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serviceValidator{
+		log:             log,
+		seedClient:      seedClient,
+		subjectSelector: subjectSelector,
+	}, nil
+}
+
+var _ admission.Validator[*corev1.Service] = &serviceValidator{}
+
+func (v *serviceValidator) ValidateCreate(ctx context.Context, service *corev1.Service) (admission.Warnings, error) {
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil, nil
+	}
+
+	return v.validate(ctx, service)
+}
+
+// ValidateUpdate validates Service updates, as changing the type of an existing Service
+// to LoadBalancer creates a new load balancer.
+func (v *serviceValidator) ValidateUpdate(ctx context.Context, oldService, newService *corev1.Service) (admission.Warnings, error) {
+	if oldService.Spec.Type == corev1.ServiceTypeLoadBalancer || newService.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil, nil
+	}
+
+	return v.validate(ctx, newService)
+}
+
+func (v *serviceValidator) ValidateDelete(_ context.Context, _ *corev1.Service) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *serviceValidator) validate(ctx context.Context, service *corev1.Service) (admission.Warnings, error) {
+	log := v.log.With("service", ctrlruntimeclient.ObjectKeyFromObject(service))
+	log.Debug("validating LoadBalancer quota")
+
+	quota, err := getResourceQuota(ctx, v.seedClient, v.subjectSelector)
+	if err != nil {
+		return nil, err
+	}
+	if quota != nil {
+		return validateLoadBalancerQuota(quota)
+	}
+	return nil, nil
+}
+
+// persistentVolumeClaimValidator validates that new PersistentVolumeClaims fit in the quota of the clusters project.
+type persistentVolumeClaimValidator struct {
+	log             *zap.SugaredLogger
+	seedClient      ctrlruntimeclient.Client
+	subjectSelector labels.Selector
+}
+
+// NewPersistentVolumeClaimValidator returns a new PersistentVolumeClaim quota validator.
+func NewPersistentVolumeClaimValidator(seedClient ctrlruntimeclient.Client, log *zap.SugaredLogger, projectID string) (*persistentVolumeClaimValidator, error) {
+	subjectSelector, err := projectSubjectSelector(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &persistentVolumeClaimValidator{
+		log:             log,
+		seedClient:      seedClient,
+		subjectSelector: subjectSelector,
+	}, nil
+}
+
+var _ admission.Validator[*corev1.PersistentVolumeClaim] = &persistentVolumeClaimValidator{}
+
+func (v *persistentVolumeClaimValidator) ValidateCreate(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (admission.Warnings, error) {
+	log := v.log.With("persistentvolumeclaim", ctrlruntimeclient.ObjectKeyFromObject(pvc))
+	log.Debug("validating PersistentVolumeClaim quota")
+
+	quota, err := getResourceQuota(ctx, v.seedClient, v.subjectSelector)
+	if err != nil {
+		return nil, err
+	}
+	if quota != nil {
+		return validatePersistentVolumeClaimQuota(quota)
+	}
+	return nil, nil
+}
+
+func (v *persistentVolumeClaimValidator) ValidateUpdate(_ context.Context, _, _ *corev1.PersistentVolumeClaim) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *persistentVolumeClaimValidator) ValidateDelete(_ context.Context, _ *corev1.PersistentVolumeClaim) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func projectSubjectSelector(projectID string) (labels.Selector, error) {
+	subjectNameReq, err := labels.NewRequirement(kubermaticv1.ResourceQuotaSubjectNameLabelKey, selection.Equals, []string{projectID})
+	if err != nil {
+		return nil, fmt.Errorf("error creating resource quota subject name requirement: %w", err)
+	}
+	subjectKindReq, err := labels.NewRequirement(kubermaticv1.ResourceQuotaSubjectKindLabelKey, selection.Equals, []string{kubermaticv1.ProjectSubjectKind})
+	if err != nil {
+		return nil, fmt.Errorf("error creating resource quota subject kind requirement: %w", err)
+	}
+
+	return labels.NewSelector().Add(*subjectNameReq, *subjectKindReq), nil
+}
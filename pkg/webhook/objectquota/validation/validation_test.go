@@ -0,0 +1,214 @@
+//go:build ee
+
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const projectID = "my-project"
+
+func TestServiceValidator(t *testing.T) {
+	testCases := []struct {
+		name        string
+		quota       *kubermaticv1.ResourceQuota
+		oldService  *corev1.Service
+		newService  *corev1.Service
+		expectedErr bool
+	}{
+		{
+			name:       "no quota",
+			newService: genService(corev1.ServiceTypeLoadBalancer),
+		},
+		{
+			name:       "LoadBalancer fits in quota",
+			quota:      genResourceQuota(projectID, "loadBalancers", "3", "2"),
+			newService: genService(corev1.ServiceTypeLoadBalancer),
+		},
+		{
+			name:        "LoadBalancer exceeds quota",
+			quota:       genResourceQuota(projectID, "loadBalancers", "2", "2"),
+			newService:  genService(corev1.ServiceTypeLoadBalancer),
+			expectedErr: true,
+		},
+		{
+			name:       "quota of another project is ignored",
+			quota:      genResourceQuota("other-project", "loadBalancers", "2", "2"),
+			newService: genService(corev1.ServiceTypeLoadBalancer),
+		},
+		{
+			name:       "ClusterIP Service is not limited",
+			quota:      genResourceQuota(projectID, "loadBalancers", "0", "0"),
+			newService: genService(corev1.ServiceTypeClusterIP),
+		},
+		{
+			name:        "changing type to LoadBalancer exceeds quota",
+			quota:       genResourceQuota(projectID, "loadBalancers", "2", "2"),
+			oldService:  genService(corev1.ServiceTypeClusterIP),
+			newService:  genService(corev1.ServiceTypeLoadBalancer),
+			expectedErr: true,
+		},
+		{
+			name:       "updating existing LoadBalancer is allowed",
+			quota:      genResourceQuota(projectID, "loadBalancers", "2", "2"),
+			oldService: genService(corev1.ServiceTypeLoadBalancer),
+			newService: genService(corev1.ServiceTypeLoadBalancer),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			validator, err := NewServiceValidator(genSeedClient(tc.quota), kubermaticlog.Logger, projectID)
+			if err != nil {
+				t.Fatalf("failed to create validator: %v", err)
+			}
+
+			if tc.oldService == nil {
+				_, err = validator.ValidateCreate(context.Background(), tc.newService)
+			} else {
+				_, err = validator.ValidateUpdate(context.Background(), tc.oldService, tc.newService)
+			}
+
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("Expected err: %t, but got err: %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestPersistentVolumeClaimValidator(t *testing.T) {
+	testCases := []struct {
+		name            string
+		quota           *kubermaticv1.ResourceQuota
+		expectedErr     bool
+		expectedWarning bool
+	}{
+		{
+			name: "no quota",
+		},
+		{
+			name:  "quota without PersistentVolumeClaim limit",
+			quota: genResourceQuota(projectID, "loadBalancers", "0", "0"),
+		},
+		{
+			name:  "PersistentVolumeClaim fits in quota",
+			quota: genResourceQuota(projectID, "persistentVolumeClaims", "5", "4"),
+		},
+		{
+			name:        "PersistentVolumeClaim exceeds quota",
+			quota:       genResourceQuota(projectID, "persistentVolumeClaims", "5", "5"),
+			expectedErr: true,
+		},
+		{
+			name:            "PersistentVolumeClaim exceeds soft limit",
+			quota:           withSoftLimit(genResourceQuota(projectID, "persistentVolumeClaims", "10", "8"), 80),
+			expectedWarning: true,
+		},
+		{
+			name:            "PersistentVolumeClaim admitted by burst allowance",
+			quota:           withBurst(genResourceQuota(projectID, "persistentVolumeClaims", "10", "10"), 10),
+			expectedWarning: true,
+		},
+		{
+			name:        "PersistentVolumeClaim exceeds burst allowance",
+			quota:       withBurst(genResourceQuota(projectID, "persistentVolumeClaims", "10", "11"), 10),
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			validator, err := NewPersistentVolumeClaimValidator(genSeedClient(tc.quota), kubermaticlog.Logger, projectID)
+			if err != nil {
+				t.Fatalf("failed to create validator: %v", err)
+			}
+
+			pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default"}}
+			warnings, err := validator.ValidateCreate(context.Background(), pvc)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("Expected err: %t, but got err: %v", tc.expectedErr, err)
+			}
+			if (len(warnings) > 0) != tc.expectedWarning {
+				t.Fatalf("Expected warning: %t, but got warnings: %v", tc.expectedWarning, warnings)
+			}
+		})
+	}
+}
+
+func genSeedClient(quota *kubermaticv1.ResourceQuota) ctrlruntimeclient.Client {
+	builder := fake.NewClientBuilder()
+	if quota != nil {
+		builder.WithObjects(quota)
+	}
+	return builder.Build()
+}
+
+func genService(serviceType corev1.ServiceType) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "service", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Type: serviceType},
+	}
+}
+
+func genResourceQuota(project, dimension, quota, used string) *kubermaticv1.ResourceQuota {
+	quotaQuantity := resource.MustParse(quota)
+	usedQuantity := resource.MustParse(used)
+
+	rq := &kubermaticv1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "project-" + project,
+			Labels: map[string]string{
+				kubermaticv1.ResourceQuotaSubjectNameLabelKey: project,
+				kubermaticv1.ResourceQuotaSubjectKindLabelKey: kubermaticv1.ProjectSubjectKind,
+			},
+		},
+	}
+
+	switch dimension {
+	case "loadBalancers":
+		rq.Spec.Quota.LoadBalancers = &quotaQuantity
+		rq.Status.GlobalUsage.LoadBalancers = &usedQuantity
+	case "persistentVolumeClaims":
+		rq.Spec.Quota.PersistentVolumeClaims = &quotaQuantity
+		rq.Status.GlobalUsage.PersistentVolumeClaims = &usedQuantity
+	}
+
+	return rq
+}
+
+func withSoftLimit(rq *kubermaticv1.ResourceQuota, percentage int32) *kubermaticv1.ResourceQuota {
+	rq.Spec.SoftLimit = &kubermaticv1.ResourceQuotaSoftLimit{Percentage: percentage}
+	return rq
+}
+
+func withBurst(rq *kubermaticv1.ResourceQuota, percentage int32) *kubermaticv1.ResourceQuota {
+	rq.Spec.Burst = &kubermaticv1.ResourceQuotaBurst{Percentage: percentage, Duration: metav1.Duration{Duration: time.Hour}}
+	return rq
+}
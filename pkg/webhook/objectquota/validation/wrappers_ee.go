@@ -0,0 +1,65 @@
+//go:build ee
+
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	eeresourcequotavalidation "k8c.io/kubermatic/v2/pkg/ee/validation/resourcequota"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func validateLoadBalancerQuota(resourceQuota *kubermaticv1.ResourceQuota) (admission.Warnings, error) {
+	return validateObjectCount(resourceQuota, "LoadBalancer Services", resourceQuota.Spec.Quota.LoadBalancers, resourceQuota.Status.GlobalUsage.LoadBalancers)
+}
+
+func validatePersistentVolumeClaimQuota(resourceQuota *kubermaticv1.ResourceQuota) (admission.Warnings, error) {
+	return validateObjectCount(resourceQuota, "PersistentVolumeClaims", resourceQuota.Spec.Quota.PersistentVolumeClaims, resourceQuota.Status.GlobalUsage.PersistentVolumeClaims)
+}
+
+func validateObjectCount(resourceQuota *kubermaticv1.ResourceQuota, kind string, quota, used *resource.Quantity) (admission.Warnings, error) {
+	warning, err := eeresourcequotavalidation.ValidateObjectCount(resourceQuota, kind, quota, used, 1, time.Now())
+	if err != nil || warning == "" {
+		return nil, err
+	}
+
+	return admission.Warnings{warning}, nil
+}
+
+func getResourceQuota(ctx context.Context, seedClient ctrlruntimeclient.Client, subjectSelector labels.Selector) (*kubermaticv1.ResourceQuota, error) {
+	quotaList := &kubermaticv1.ResourceQuotaList{}
+	if err := seedClient.List(ctx, quotaList, &ctrlruntimeclient.ListOptions{
+		LabelSelector: subjectSelector,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list resource quotas: %w", err)
+	}
+
+	if len(quotaList.Items) == 0 {
+		return nil, nil
+	}
+
+	return &quotaList.Items[0], nil
+}
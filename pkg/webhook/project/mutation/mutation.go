@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// AdmissionHandler for mutating Kubermatic Project CRD.
+type AdmissionHandler struct {
+	log     *zap.SugaredLogger
+	decoder admission.Decoder
+}
+
+// NewAdmissionHandler returns a new Project AdmissionHandler.
+func NewAdmissionHandler(log *zap.SugaredLogger, scheme *runtime.Scheme) *AdmissionHandler {
+	return &AdmissionHandler{
+		log:     log,
+		decoder: admission.NewDecoder(scheme),
+	}
+}
+
+func (h *AdmissionHandler) SetupWebhookWithManager(mgr ctrlruntime.Manager) {
+	mgr.GetWebhookServer().Register("/mutate-kubermatic-k8c-io-v1-project", &webhook.Admission{Handler: h})
+}
+
+func (h *AdmissionHandler) Handle(ctx context.Context, req webhook.AdmissionRequest) webhook.AdmissionResponse {
+	project := &kubermaticv1.Project{}
+	oldProject := &kubermaticv1.Project{}
+
+	switch req.Operation {
+	case admissionv1.Create:
+		if err := h.decoder.Decode(req, project); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		recordSuspendingUser(project, nil, req.UserInfo.Username)
+
+	case admissionv1.Update:
+		if err := h.decoder.Decode(req, project); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if err := h.decoder.DecodeRaw(req.OldObject, oldProject); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		recordSuspendingUser(project, oldProject, req.UserInfo.Username)
+
+	case admissionv1.Delete:
+		return webhook.Allowed(fmt.Sprintf("no mutation done for request %s", req.UID))
+
+	default:
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("%s not supported on project resources", req.Operation))
+	}
+
+	mutatedProject, err := json.Marshal(project)
+	if err != nil {
+		return webhook.Errored(http.StatusInternalServerError, fmt.Errorf("marshaling project object failed: %w", err))
+	}
+
+	return admission.PatchResponseFromRaw(req.Object.Raw, mutatedProject)
+}
+
+// recordSuspendingUser sets the user who suspended the project. Once recorded, the user can
+// only change when the suspension itself is changed, i.e. users cannot forge who suspended
+// a project.
+func recordSuspendingUser(project, oldProject *kubermaticv1.Project, username string) {
+	suspension := project.Spec.Suspension
+	if suspension == nil {
+		return
+	}
+
+	if oldProject != nil && oldProject.Spec.Suspension != nil {
+		oldSuspension := oldProject.Spec.Suspension
+		if oldSuspension.Reason == suspension.Reason && oldSuspension.HibernateClusters == suspension.HibernateClusters {
+			suspension.SuspendedBy = oldSuspension.SuspendedBy
+			return
+		}
+	}
+
+	suspension.SuspendedBy = username
+}
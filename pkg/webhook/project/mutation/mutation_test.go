@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutation
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+func TestRecordSuspendingUser(t *testing.T) {
+	suspendedProject := func(reason, suspendedBy string) *kubermaticv1.Project {
+		return &kubermaticv1.Project{
+			Spec: kubermaticv1.ProjectSpec{
+				Suspension: &kubermaticv1.ProjectSuspension{
+					Reason:      reason,
+					SuspendedBy: suspendedBy,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		project     *kubermaticv1.Project
+		oldProject  *kubermaticv1.Project
+		expectedBy  string
+		unsuspended bool
+	}{
+		{
+			name:        "project that is not suspended is left alone",
+			project:     &kubermaticv1.Project{},
+			unsuspended: true,
+		},
+		{
+			name:       "suspending a project on creation records the user",
+			project:    suspendedProject("budget exhausted", ""),
+			expectedBy: "admin@example.com",
+		},
+		{
+			name:       "suspending an existing project records the user",
+			project:    suspendedProject("budget exhausted", ""),
+			oldProject: &kubermaticv1.Project{},
+			expectedBy: "admin@example.com",
+		},
+		{
+			name:       "user-provided value is overwritten",
+			project:    suspendedProject("budget exhausted", "someone-else@example.com"),
+			oldProject: &kubermaticv1.Project{},
+			expectedBy: "admin@example.com",
+		},
+		{
+			name:       "unchanged suspension keeps the original user",
+			project:    suspendedProject("budget exhausted", "someone-else@example.com"),
+			oldProject: suspendedProject("budget exhausted", "security@example.com"),
+			expectedBy: "security@example.com",
+		},
+		{
+			name:       "changing the suspension records the new user",
+			project:    suspendedProject("security incident", "security@example.com"),
+			oldProject: suspendedProject("budget exhausted", "security@example.com"),
+			expectedBy: "admin@example.com",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recordSuspendingUser(tc.project, tc.oldProject, "admin@example.com")
+
+			if tc.unsuspended {
+				if tc.project.Spec.Suspension != nil {
+					t.Fatalf("Expected project to not be suspended, but got %+v", tc.project.Spec.Suspension)
+				}
+				return
+			}
+
+			if suspendedBy := tc.project.Spec.Suspension.SuspendedBy; suspendedBy != tc.expectedBy {
+				t.Fatalf("Expected project to be suspended by %q, but got %q", tc.expectedBy, suspendedBy)
+			}
+		})
+	}
+}
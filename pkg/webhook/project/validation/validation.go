@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/validation"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// validator for validating Kubermatic Project CRD.
+type validator struct{}
+
+// NewValidator returns a new Project validator.
+func NewValidator() *validator {
+	return &validator{}
+}
+
+var _ admission.Validator[*kubermaticv1.Project] = &validator{}
+
+func (v *validator) ValidateCreate(_ context.Context, project *kubermaticv1.Project) (admission.Warnings, error) {
+	return nil, validation.ValidateProjectCreate(project).ToAggregate()
+}
+
+func (v *validator) ValidateUpdate(_ context.Context, oldProject, newProject *kubermaticv1.Project) (admission.Warnings, error) {
+	return nil, validation.ValidateProjectUpdate(oldProject, newProject).ToAggregate()
+}
+
+func (v *validator) ValidateDelete(_ context.Context, _ *kubermaticv1.Project) (admission.Warnings, error) {
+	return nil, nil
+}
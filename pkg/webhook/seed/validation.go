@@ -36,6 +36,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
@@ -167,6 +168,13 @@ func (v *validator) validate(ctx context.Context, subject *kubermaticv1.Seed, is
 			}
 		}
 
+		if dc.Spec.DefaultMaintenanceWindow != nil {
+			fldPath := field.NewPath("spec", "datacenters").Key(dcName).Child("spec", "defaultMaintenanceWindow")
+			if errs := validation.ValidateMaintenanceWindow(dc.Spec.DefaultMaintenanceWindow, fldPath); len(errs) > 0 {
+				return errs.ToAggregate()
+			}
+		}
+
 		if existingSeed == nil {
 			continue
 		}
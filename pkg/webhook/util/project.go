@@ -55,3 +55,22 @@ func OptimisticallyCheckIfProjectIsValid(ctx context.Context, client ctrlruntime
 
 	return nil
 }
+
+// CheckProjectIsNotSuspended returns an error if the given project is suspended. Projects
+// that do not exist (yet) are not considered to be suspended.
+func CheckProjectIsNotSuspended(ctx context.Context, client ctrlruntimeclient.Client, projectName string) error {
+	project := &kubermaticv1.Project{}
+	if err := client.Get(ctx, types.NamespacedName{Name: projectName}, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	if project.IsSuspended() {
+		return fmt.Errorf("project %s is suspended: %s", project.Name, project.Spec.Suspension.Reason)
+	}
+
+	return nil
+}
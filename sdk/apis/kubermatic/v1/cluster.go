@@ -56,6 +56,11 @@ const (
 const (
 	CCMMigrationNeededAnnotation = "ccm-migration.k8c.io/migration-needed"
 	CSIMigrationNeededAnnotation = "csi-migration.k8c.io/migration-needed"
+
+	// CNIMigrationSourceAnnotation is set when the CNI plugin of a cluster is changed from Canal
+	// to Cilium and contains the JSON-encoded CNIMigrationSource. It is replaced by the
+	// `status.cniMigration` once the migration has been started.
+	CNIMigrationSourceAnnotation = "cni-migration.k8c.io/source"
 )
 
 const (
@@ -197,6 +202,23 @@ type ClusterSpec struct {
 	// applying OS updates to nodes. This is only respected on Flatcar nodes currently.
 	UpdateWindow *UpdateWindow `json:"updateWindow,omitempty"`
 
+	// Optional: MaintenanceWindow restricts automatic control plane and node updates as well as
+	// the individual steps of control plane updates to a recurring time window. If not set, the
+	// default maintenance window of the datacenter is used. If neither is set, updates are applied
+	// as soon as they are available.
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// Optional: UpgradePreflight configures the check for APIs that are removed in the target Kubernetes
+	// version, which runs before the control plane is updated to a new minor version.
+	UpgradePreflight *UpgradePreflightSettings `json:"upgradePreflight,omitempty"`
+
+	// Optional: NodeUpgradePolicy configures the surge, drain timeout and PodDisruptionBudget handling
+	// that is used when the nodes of MachineDeployments are replaced during an upgrade.
+	NodeUpgradePolicy *NodeUpgradePolicy `json:"nodeUpgradePolicy,omitempty"`
+
+	// Optional: Hibernation scales the cluster down to zero, either on demand or on a schedule.
+	Hibernation *ClusterHibernationSettings `json:"hibernation,omitempty"`
+
 	// Enables the admission plugin `PodSecurityPolicy`. This plugin is deprecated by Kubernetes.
 	UsePodSecurityPolicyAdmissionPlugin bool `json:"usePodSecurityPolicyAdmissionPlugin,omitempty"`
 	// Enables the admission plugin `PodNodeSelector`. Needs additional configuration via the `podNodeSelectorAdmissionPluginConfig` field.
@@ -421,6 +443,83 @@ type UpdateWindow struct {
 	Length string `json:"length,omitempty"`
 }
 
+// MaintenanceWindow defines a recurring time window in which KKP is allowed to update a cluster.
+type MaintenanceWindow struct {
+	// Start is the start time of the window. This can be a time of day in 24h format, e.g. `22:30`,
+	// to open the window every day, or a day of week plus a time of day, for example `Sat 21:00`, to
+	// open it once a week. Only short names for week days are supported, i.e. `Mon`, `Tue`, `Wed`,
+	// `Thu`, `Fri`, `Sat` and `Sun`.
+	Start string `json:"start"`
+	// Length is the length of the window beginning with the start time. This needs to be a valid
+	// duration as parsed by Go's time.ParseDuration (https://pkg.go.dev/time#ParseDuration), e.g. `4h`.
+	// Daily windows can be at most 24h long, weekly windows at most 168h.
+	Length string `json:"length"`
+	// Optional: Timezone is the IANA time zone the start time refers to, e.g. `Europe/Berlin`.
+	// Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// PendingUpdateType describes which kind of update is held back until the next maintenance window.
+type PendingUpdateType string
+
+const (
+	// PendingUpdateTypeControlPlane is an automatic update of the cluster version.
+	PendingUpdateTypeControlPlane PendingUpdateType = "ControlPlane"
+	// PendingUpdateTypeApiserver is the next step of an ongoing control plane update,
+	// i.e. updating the apiserver to the next minor version.
+	PendingUpdateTypeApiserver PendingUpdateType = "Apiserver"
+	// PendingUpdateTypeMachineDeployment is an automatic update of the kubelet version
+	// of a MachineDeployment.
+	PendingUpdateTypeMachineDeployment PendingUpdateType = "MachineDeployment"
+)
+
+// PendingUpdateKey returns the key of a pending update in the cluster status.
+func PendingUpdateKey(updateType PendingUpdateType, name string) string {
+	if name == "" {
+		return string(updateType)
+	}
+
+	return fmt.Sprintf("%s/%s", updateType, name)
+}
+
+// PendingUpdate is an update that is held back until the next maintenance window opens.
+type PendingUpdate struct {
+	// Type is the kind of update.
+	Type PendingUpdateType `json:"type"`
+	// Name is the name of the updated object, for MachineDeployments this is the name of
+	// the MachineDeployment in the kube-system namespace.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// From is the current version.
+	// +optional
+	From string `json:"from,omitempty"`
+	// To is the version the update will be applied to.
+	To string `json:"to"`
+	// NextWindowStart is the start of the next maintenance window, in which the update
+	// will be applied.
+	NextWindowStart metav1.Time `json:"nextWindowStart"`
+}
+
+// PreUpdateEtcdBackupStatus describes the etcd backup that was taken right before the
+// apiserver of the cluster was last updated.
+type PreUpdateEtcdBackupStatus struct {
+	// BackupConfigName is the name of the one-off EtcdBackupConfig in the cluster namespace
+	// that performed the backup.
+	BackupConfigName string `json:"backupConfigName"`
+	// BackupName is the name of the backup; it can be used as `spec.backupName` of an EtcdRestore.
+	BackupName string `json:"backupName"`
+	// Destination is the backup destination the backup was stored in; it can be used as
+	// `spec.destination` of an EtcdRestore.
+	Destination string `json:"destination"`
+	// From is the apiserver version the backup was taken at.
+	From string `json:"from"`
+	// To is the apiserver version the cluster was updated to after the backup.
+	To string `json:"to"`
+	// FinishedTime is the time the backup was completed.
+	// +optional
+	FinishedTime metav1.Time `json:"finishedTime,omitempty"`
+}
+
 // EncryptionConfiguration configures encryption-at-rest for Kubernetes API data.
 type EncryptionConfiguration struct {
 	// Enables encryption-at-rest on this cluster.
@@ -602,6 +701,7 @@ const (
 	ClusterConditionEncryptionControllerReconcilingSuccess                       ClusterConditionType = "EncryptionControllerReconciledSuccessfully"
 	ClusterConditionClusterInitialized                                           ClusterConditionType = "ClusterInitialized"
 	ClusterConditionIPAMControllerReconcilingSuccess                             ClusterConditionType = "IPAMControllerReconciledSuccessfully"
+	ClusterConditionIPAMAllocationsReady                                         ClusterConditionType = "IPAMAllocationsReady"
 	ClusterConditionKubeVirtNetworkControllerSuccess                             ClusterConditionType = "KubeVirtNetworkControllerReconciledSuccessfully"
 	ClusterConditionClusterBackupControllerReconcilingSuccess                    ClusterConditionType = "ClusterBackupControllerReconciledSuccessfully"
 	ClusterConditionKyvernoControllerReconcilingSuccess                          ClusterConditionType = "KyvernoControllerReconciledSuccessfully"
@@ -625,10 +725,25 @@ const (
 	// This helps in ascertaining if the CSI addon can be removed from the cluster or not.
 	ClusterConditionCSIAddonInUse ClusterConditionType = "CSIAddonInUse"
 
+	// This condition indicates whether APIs that are removed in the Kubernetes version the
+	// cluster is being updated to are still in use.
+	ClusterConditionUpgradePreflightPassed ClusterConditionType = "UpgradePreflightPassed"
+
+	// This condition indicates whether the etcd database is far enough from its backend quota.
+	// It is only set if the automatic etcd maintenance is enabled.
+	ClusterConditionEtcdQuotaSufficient ClusterConditionType = "EtcdQuotaSufficient"
+
 	ReasonClusterUpdateSuccessful             = "ClusterUpdateSuccessful"
 	ReasonClusterUpdateInProgress             = "ClusterUpdateInProgress"
 	ReasonClusterCSIKubeletMigrationCompleted = "CSIKubeletMigrationSuccess"
 	ReasonClusterCCMMigrationInProgress       = "CSIKubeletMigrationInProgress"
+	ReasonClusterIPAMPoolExhausted            = "IPAMPoolExhausted"
+	ReasonClusterIPAMPoolIncompatible         = "IPAMPoolIncompatible"
+	ReasonClusterIPAMBackendError             = "IPAMBackendError"
+	ReasonClusterNoRemovedAPIsInUse           = "NoRemovedAPIsInUse"
+	ReasonClusterRemovedAPIsInUse             = "RemovedAPIsInUse"
+	ReasonClusterEtcdQuotaSufficient          = "EtcdQuotaSufficient"
+	ReasonClusterEtcdQuotaAlmostExhausted     = "EtcdQuotaAlmostExhausted"
 )
 
 var AllClusterConditionTypes = []ClusterConditionType{
@@ -658,7 +773,7 @@ type ClusterCondition struct {
 	Message string `json:"message,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=Creating;Updating;Running;Terminating
+// +kubebuilder:validation:Enum=Creating;Updating;Running;Hibernated;Terminating
 
 type ClusterPhase string
 
@@ -667,6 +782,7 @@ const (
 	ClusterCreating    ClusterPhase = "Creating"
 	ClusterUpdating    ClusterPhase = "Updating"
 	ClusterRunning     ClusterPhase = "Running"
+	ClusterHibernated  ClusterPhase = "Hibernated"
 	ClusterTerminating ClusterPhase = "Terminating"
 )
 
@@ -740,6 +856,64 @@ type ClusterStatus struct {
 	// with accelerator accounting activated.
 	// +optional
 	AcceleratorAccounting *ClusterAcceleratorAccountingStatus `json:"acceleratorAccounting,omitempty"`
+
+	// BackupSchedules reflects the Velero Schedules synced into this cluster from ClusterBackupPolicies.
+	// Only available in Enterprise Edition.
+	// +optional
+	// +listType=map
+	// +listMapKey=policy
+	BackupSchedules []ClusterBackupPolicySchedule `json:"backupSchedules,omitempty"`
+
+	// PendingUpdates contains the updates that are held back until the next maintenance window,
+	// keyed by the type and name of the update.
+	// +optional
+	PendingUpdates map[string]PendingUpdate `json:"pendingUpdates,omitempty"`
+
+	// UpgradePreflight contains the result of the check for removed APIs that runs before
+	// the control plane is updated to a new minor version.
+	// +optional
+	UpgradePreflight *UpgradePreflightStatus `json:"upgradePreflight,omitempty"`
+
+	// PreUpdateEtcdBackup is the etcd backup that was taken before the apiserver was last updated,
+	// if etcd backups are configured for the seed.
+	// +optional
+	PreUpdateEtcdBackup *PreUpdateEtcdBackupStatus `json:"preUpdateEtcdBackup,omitempty"`
+
+	// NodeUpgrade reports the progress of MachineDeployments whose machines are currently
+	// being replaced.
+	// +optional
+	NodeUpgrade *NodeUpgradeStatus `json:"nodeUpgrade,omitempty"`
+
+	// Hibernation is the state of the hibernation of the cluster.
+	// +optional
+	Hibernation *ClusterHibernationStatus `json:"hibernation,omitempty"`
+
+	// Staleness is the state of the cluster regarding the stale cluster policy of the
+	// global settings.
+	// +optional
+	Staleness *ClusterStalenessStatus `json:"staleness,omitempty"`
+
+	// EtcdStorageMigration reports the progress of the last migration of the etcd volumes to a
+	// new StorageClass or disk size.
+	// +optional
+	EtcdStorageMigration *EtcdStorageMigrationStatus `json:"etcdStorageMigration,omitempty"`
+
+	// EtcdMaintenance reports the database usage of the etcd members, if the automatic etcd
+	// maintenance is enabled.
+	// +optional
+	EtcdMaintenance *EtcdMaintenanceStatus `json:"etcdMaintenance,omitempty"`
+
+	// CNIMigration reports the progress of the last in-place migration of the CNI plugin.
+	// +optional
+	CNIMigration *CNIMigrationStatus `json:"cniMigration,omitempty"`
+}
+
+// ClusterBackupPolicySchedule is the status of the Velero Schedule synced from a ClusterBackupPolicy.
+type ClusterBackupPolicySchedule struct {
+	// Policy is the name of the ClusterBackupPolicy.
+	Policy string `json:"policy"`
+
+	ClusterBackupScheduleStatus `json:",inline"`
 }
 
 // ClusterAcceleratorAccountingStatus contains one KubeVirt cluster's accelerator
@@ -1078,11 +1252,13 @@ type EtcdStatefulSetSettings struct {
 	ClusterSize *int32 `json:"clusterSize,omitempty"`
 	// StorageClass is the Kubernetes StorageClass used for persistent storage
 	// which stores the etcd WAL and other data persisted across restarts. Defaults to
-	// `kubermatic-fast` (the global default).
+	// `kubermatic-fast` (the global default). Changing it on an existing cluster that
+	// uses the etcd-launcher migrates all etcd members to new volumes one by one.
 	StorageClass string `json:"storageClass,omitempty"`
 	// DiskSize is the volume size used when creating persistent storage from
 	// the configured StorageClass. This is inherited from KubermaticConfiguration
-	// if not set. Defaults to 5Gi.
+	// if not set. Defaults to 5Gi. Changing it on an existing cluster that uses the
+	// etcd-launcher migrates all etcd members to new volumes one by one.
 	DiskSize *resource.Quantity `json:"diskSize,omitempty"`
 	// Resources allows to override the resource requirements for etcd Pods.
 	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
@@ -1102,6 +1278,8 @@ type EtcdStatefulSetSettings struct {
 	//
 	// For more details, please see https://etcd.io/docs/v3.5/op-guide/maintenance/
 	QuotaBackendGB *int64 `json:"quotaBackendGb,omitempty"`
+	// Maintenance configures automatic defragmentation and quota management of etcd.
+	Maintenance *EtcdMaintenanceSettings `json:"maintenance,omitempty"`
 }
 
 type LeaderElectionSettings struct {
@@ -19,6 +19,7 @@ package v1
 import (
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -57,3 +58,151 @@ type ClusterBackupStorageLocationList struct {
 	// Items is a list of EtcdBackupConfig objects.
 	Items []ClusterBackupStorageLocation `json:"items"`
 }
+
+const (
+	// ClusterBackupPolicyKind represents "Kind" defined in Kubernetes.
+	ClusterBackupPolicyKind = "ClusterBackupPolicy"
+)
+
+// +kubebuilder:object:generate=true
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=cbp
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule"
+// +kubebuilder:printcolumn:name="Clusters",type="integer",JSONPath=".status.matchingClusters"
+// +kubebuilder:printcolumn:name="Last Backup",type="date",JSONPath=".status.lastBackupTime"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ClusterBackupPolicy schedules recurring Velero backups for all user clusters matching
+// its cluster selector. It lives in the kubermatic namespace of a seed cluster and applies to
+// all clusters on that seed, unless it is labelled with a project ID, in which case it only
+// applies to the clusters of that project.
+type ClusterBackupPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterBackupPolicySpec   `json:"spec,omitempty"`
+	Status ClusterBackupPolicyStatus `json:"status,omitempty"`
+}
+
+// ClusterBackupPolicySpec describes the backups to schedule in the selected user clusters.
+type ClusterBackupPolicySpec struct {
+	// ClusterSelector selects the user clusters the policy applies to, based on their labels.
+	// If omitted, all clusters with cluster backups enabled are selected.
+	//
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// Schedule is a cron expression defining when to run the backups.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// IncludedNamespaces is a list of namespaces to include in the backups.
+	// If omitted, all namespaces are included.
+	//
+	// +optional
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// ExcludedNamespaces is a list of namespaces to exclude from the backups.
+	//
+	// +optional
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// TTL is the duration for which the backups are retained. If omitted, the Velero
+	// default of 30 days applies.
+	//
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// StorageLocation restricts the policy to clusters that store their backups in the
+	// referenced ClusterBackupStorageLocation. If omitted, the backups are stored in the
+	// storage location configured for each cluster.
+	//
+	// +optional
+	StorageLocation *corev1.LocalObjectReference `json:"storageLocation,omitempty"`
+}
+
+// ClusterBackupPolicyStatus reports the backups scheduled by a ClusterBackupPolicy.
+type ClusterBackupPolicyStatus struct {
+	// MatchingClusters is the number of user clusters the policy is synced to.
+	MatchingClusters int32 `json:"matchingClusters"`
+
+	// FailedClusters is the number of user clusters whose schedule is invalid or
+	// whose last backup did not complete successfully.
+	FailedClusters int32 `json:"failedClusters"`
+
+	// LastBackupTime is the most recent time a backup was started in any of the clusters.
+	//
+	// +optional
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
+
+	// Clusters contains the backup status of every user cluster the policy is synced to.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Clusters []ClusterBackupPolicyClusterStatus `json:"clusters,omitempty"`
+}
+
+// ClusterBackupPolicyClusterStatus is the backup status of a single user cluster.
+type ClusterBackupPolicyClusterStatus struct {
+	// Name is the name of the user cluster.
+	Name string `json:"name"`
+
+	ClusterBackupScheduleStatus `json:",inline"`
+}
+
+// ClusterBackupScheduleStatus reflects the status of a Velero Schedule in a user cluster.
+type ClusterBackupScheduleStatus struct {
+	// Phase is the phase of the Velero Schedule.
+	//
+	// +optional
+	Phase velerov1.SchedulePhase `json:"phase,omitempty"`
+
+	// ValidationErrors are the errors reported by Velero for an invalid schedule.
+	//
+	// +optional
+	ValidationErrors []string `json:"validationErrors,omitempty"`
+
+	// LastBackupTime is the time the last backup of the schedule was started.
+	//
+	// +optional
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
+
+	// LastBackupName is the name of the last Velero Backup of the schedule.
+	//
+	// +optional
+	LastBackupName string `json:"lastBackupName,omitempty"`
+
+	// LastBackupPhase is the phase of the last Velero Backup of the schedule.
+	//
+	// +optional
+	LastBackupPhase velerov1.BackupPhase `json:"lastBackupPhase,omitempty"`
+}
+
+// Failed returns true if the schedule is invalid or its last backup did not complete successfully.
+func (s ClusterBackupScheduleStatus) Failed() bool {
+	if s.Phase == velerov1.SchedulePhaseFailedValidation {
+		return true
+	}
+
+	switch s.LastBackupPhase {
+	case velerov1.BackupPhaseFailed, velerov1.BackupPhasePartiallyFailed, velerov1.BackupPhaseFailedValidation:
+		return true
+	}
+
+	return false
+}
+
+// +kubebuilder:object:generate=true
+// +kubebuilder:object:root=true
+
+// ClusterBackupPolicyList is a list of ClusterBackupPolicies.
+type ClusterBackupPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of ClusterBackupPolicy objects.
+	Items []ClusterBackupPolicy `json:"items"`
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterHibernationSettings configures the hibernation of a cluster. A hibernated cluster has
+// all its MachineDeployments and its control plane scaled down to zero, while its volumes are kept.
+type ClusterHibernationSettings struct {
+	// Hibernated scales the cluster down to zero. Setting it back to false wakes the cluster up
+	// again and restores the previous number of replicas of all MachineDeployments.
+	Hibernated bool `json:"hibernated,omitempty"`
+	// Optional: Schedule hibernates and wakes up the cluster at recurring times by setting
+	// `hibernated` accordingly. The cluster can still be woken up or hibernated manually in
+	// between, the next scheduled transition overrides this again.
+	Schedule *ClusterHibernationSchedule `json:"schedule,omitempty"`
+}
+
+// ClusterHibernationSchedule defines when a cluster is hibernated and woken up.
+type ClusterHibernationSchedule struct {
+	// Hibernate is a cron expression defining when the cluster is hibernated, e.g. `0 20 * * 1-5`
+	// for every weekday at 20:00.
+	Hibernate string `json:"hibernate"`
+	// WakeUp is a cron expression defining when the cluster is woken up, e.g. `0 7 * * 1-5`
+	// for every weekday at 07:00.
+	WakeUp string `json:"wakeUp"`
+	// Optional: Timezone is the IANA time zone the cron expressions refer to, e.g. `Europe/Berlin`.
+	// Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Hibernating;Hibernated;WakingUp
+
+// ClusterHibernationState is the state of a hibernation or wake up of a cluster.
+type ClusterHibernationState string
+
+const (
+	// ClusterHibernationStateHibernating means the MachineDeployments are being scaled down.
+	ClusterHibernationStateHibernating ClusterHibernationState = "Hibernating"
+	// ClusterHibernationStateHibernated means the control plane is scaled down as well. Controllers
+	// do not reconcile the cluster in this state.
+	ClusterHibernationStateHibernated ClusterHibernationState = "Hibernated"
+	// ClusterHibernationStateWakingUp means the control plane is being restored, after which the
+	// MachineDeployments are scaled up again.
+	ClusterHibernationStateWakingUp ClusterHibernationState = "WakingUp"
+)
+
+// ClusterHibernationStatus is the hibernation state of a cluster.
+type ClusterHibernationStatus struct {
+	// State is the state of an ongoing or completed hibernation. It is empty while the
+	// cluster is awake.
+	// +optional
+	State ClusterHibernationState `json:"state,omitempty"`
+	// MachineDeploymentReplicas are the numbers of replicas of the MachineDeployments in the
+	// kube-system namespace before the cluster was hibernated, keyed by their name.
+	// +optional
+	MachineDeploymentReplicas map[string]int32 `json:"machineDeploymentReplicas,omitempty"`
+	// ControlPlaneReplicas are the numbers of replicas of the Deployments and StatefulSets in the
+	// cluster namespace before the control plane was scaled down, keyed by their kind and name
+	// (e.g. `StatefulSet/etcd`). They are restored at once when the cluster is woken up, as etcd
+	// cannot regain its quorum when it is scaled up one member at a time.
+	// +optional
+	ControlPlaneReplicas map[string]int32 `json:"controlPlaneReplicas,omitempty"`
+	// LastTransitionTime is the time the state last changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// LastScheduledTransition is the time of the last transition of the hibernation schedule
+	// that was applied to the cluster.
+	// +optional
+	LastScheduledTransition *metav1.Time `json:"lastScheduledTransition,omitempty"`
+}
+
+// IsHibernated returns true if the control plane of the cluster is scaled down.
+func (s *ClusterStatus) IsHibernated() bool {
+	return s.Hibernation != nil && s.Hibernation.State == ClusterHibernationStateHibernated
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ClusterRestoreKind represents "Kind" defined in Kubernetes.
+	ClusterRestoreKind = "ClusterRestore"
+
+	// ClusterRestoreCleanupFinalizer indicates that the Velero resources created for a
+	// ClusterRestore in the target user cluster need cleanup.
+	ClusterRestoreCleanupFinalizer = "kubermatic.k8c.io/cleanup-cluster-restore"
+)
+
+// ClusterRestorePhase is the phase of a ClusterRestore.
+//
+// +kubebuilder:validation:Enum=Pending;InProgress;Completed;PartiallyFailed;Failed
+type ClusterRestorePhase string
+
+const (
+	// ClusterRestorePending means the backup is not yet available in the target cluster.
+	ClusterRestorePending ClusterRestorePhase = "Pending"
+	// ClusterRestoreInProgress means the Velero Restore is running in the target cluster.
+	ClusterRestoreInProgress ClusterRestorePhase = "InProgress"
+	// ClusterRestoreCompleted means the restore has run successfully without errors.
+	ClusterRestoreCompleted ClusterRestorePhase = "Completed"
+	// ClusterRestorePartiallyFailed means the restore has run to completion, but failed to restore some items.
+	ClusterRestorePartiallyFailed ClusterRestorePhase = "PartiallyFailed"
+	// ClusterRestoreFailed means the restore could not be executed.
+	ClusterRestoreFailed ClusterRestorePhase = "Failed"
+)
+
+// Finished returns true if the restore reached a terminal phase.
+func (p ClusterRestorePhase) Finished() bool {
+	return p == ClusterRestoreCompleted || p == ClusterRestorePartiallyFailed || p == ClusterRestoreFailed
+}
+
+// +kubebuilder:object:generate=true
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=crs
+// +kubebuilder:printcolumn:name="Source",type="string",JSONPath=".spec.sourceCluster"
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.backupName"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ClusterRestore restores a Velero backup of one user cluster into another user cluster,
+// for example to migrate workloads between datacenters or providers. It is created in the
+// namespace of the target cluster on its seed.
+type ClusterRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterRestoreSpec   `json:"spec,omitempty"`
+	Status ClusterRestoreStatus `json:"status,omitempty"`
+}
+
+// ClusterRestoreSpec describes which backup to restore into the target cluster.
+//
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ClusterRestore spec is immutable"
+type ClusterRestoreSpec struct {
+	// SourceCluster is the name of the cluster whose backup is restored. The source cluster
+	// must belong to the same project as the target cluster and must have stored its backups
+	// in the ClusterBackupStorageLocation the target cluster uses. It does not need to exist anymore.
+	//
+	// +kubebuilder:validation:MinLength=1
+	SourceCluster string `json:"sourceCluster"`
+
+	// BackupName is the name of the Velero Backup of the source cluster to restore.
+	//
+	// +kubebuilder:validation:MinLength=1
+	BackupName string `json:"backupName"`
+
+	// IncludedNamespaces is a list of namespaces to restore. If omitted, all namespaces
+	// in the backup are restored.
+	//
+	// +optional
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// ExcludedNamespaces is a list of namespaces to exclude from the restore.
+	//
+	// +optional
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// NamespaceMapping maps namespaces of the source cluster to different namespaces
+	// in the target cluster.
+	//
+	// +optional
+	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
+}
+
+// ClusterRestoreStatus reports the progress of a ClusterRestore.
+type ClusterRestoreStatus struct {
+	// Phase is the current phase of the restore.
+	//
+	// +optional
+	Phase ClusterRestorePhase `json:"phase,omitempty"`
+
+	// Message describes why the restore is pending or failed.
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// RestoreName is the name of the Velero Restore in the target cluster.
+	//
+	// +optional
+	RestoreName string `json:"restoreName,omitempty"`
+
+	// StartTime is the time the Velero Restore was started.
+	//
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time the Velero Restore finished.
+	//
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Progress contains the number of items restored so far.
+	//
+	// +optional
+	Progress *velerov1.RestoreProgress `json:"progress,omitempty"`
+
+	// Warnings is the number of warnings encountered during the restore.
+	//
+	// +optional
+	Warnings int `json:"warnings,omitempty"`
+
+	// Errors is the number of errors encountered during the restore.
+	//
+	// +optional
+	Errors int `json:"errors,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+// +kubebuilder:object:root=true
+
+// ClusterRestoreList is a list of ClusterRestores.
+type ClusterRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of ClusterRestore objects.
+	Items []ClusterRestore `json:"items"`
+}
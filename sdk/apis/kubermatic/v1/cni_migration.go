@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=InstallingCilium;MigratingNodes;RemovingCanal;Completed
+
+// CNIMigrationPhase is the phase of an in-place migration from Canal to Cilium.
+type CNIMigrationPhase string
+
+const (
+	// CNIMigrationPhaseInstallingCilium means Cilium is installed alongside Canal, without
+	// taking over the networking of any node yet.
+	CNIMigrationPhaseInstallingCilium CNIMigrationPhase = "InstallingCilium"
+	// CNIMigrationPhaseMigratingNodes means the nodes are migrated to Cilium one at a time.
+	CNIMigrationPhaseMigratingNodes CNIMigrationPhase = "MigratingNodes"
+	// CNIMigrationPhaseRemovingCanal means all nodes use Cilium and Canal is being removed.
+	CNIMigrationPhaseRemovingCanal CNIMigrationPhase = "RemovingCanal"
+	// CNIMigrationPhaseCompleted means Cilium is the only CNI plugin in the cluster.
+	CNIMigrationPhaseCompleted CNIMigrationPhase = "Completed"
+)
+
+// +kubebuilder:validation:Enum=Pending;Migrating;RestartingPods;Migrated
+
+// CNIMigrationNodePhase is the phase of a single node during an in-place CNI migration.
+type CNIMigrationNodePhase string
+
+const (
+	// CNIMigrationNodePhasePending means the node still uses Canal.
+	CNIMigrationNodePhasePending CNIMigrationNodePhase = "Pending"
+	// CNIMigrationNodePhaseMigrating means the node has been cordoned and relabeled, and
+	// the Cilium agent on the node is restarted to take over its networking.
+	CNIMigrationNodePhaseMigrating CNIMigrationNodePhase = "Migrating"
+	// CNIMigrationNodePhaseRestartingPods means the pods on the node are restarted, so that
+	// they get their network from Cilium.
+	CNIMigrationNodePhaseRestartingPods CNIMigrationNodePhase = "RestartingPods"
+	// CNIMigrationNodePhaseMigrated means the node uses Cilium and has been uncordoned.
+	CNIMigrationNodePhaseMigrated CNIMigrationNodePhase = "Migrated"
+)
+
+// CNIMigrationSource describes the CNI plugin a cluster is migrated away from.
+type CNIMigrationSource struct {
+	// Type is the type of the previous CNI plugin.
+	Type CNIPluginType `json:"type"`
+	// Version is the version of the previous CNI plugin.
+	Version string `json:"version"`
+	// PodsCIDRBlocks are the pod networks used by the previous CNI plugin.
+	// +optional
+	PodsCIDRBlocks []string `json:"podsCIDRBlocks,omitempty"`
+}
+
+// CNIMigrationStatus reports the progress of an in-place migration from Canal to Cilium. A
+// migration is started when `spec.cniPlugin.type` is changed from `canal` to `cilium`, together
+// with a new pods CIDR in `spec.clusterNetwork.pods`. Canal keeps serving all nodes that have
+// not been migrated yet, until the last node uses Cilium.
+type CNIMigrationStatus struct {
+	// Phase is the current phase of the migration.
+	Phase CNIMigrationPhase `json:"phase"`
+	// Source is the CNI plugin the cluster is migrated away from.
+	Source CNIMigrationSource `json:"source"`
+	// Nodes reports the progress of each node.
+	// +optional
+	Nodes []CNIMigrationNodeStatus `json:"nodes,omitempty"`
+	// Message describes what the migration is currently waiting for.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// StartTime is the time the migration was started.
+	StartTime metav1.Time `json:"startTime"`
+	// LastTransitionTime is the time the phase last changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// CNIMigrationNodeStatus reports the progress of a single node during an in-place CNI migration.
+type CNIMigrationNodeStatus struct {
+	// Name is the name of the node.
+	Name string `json:"name"`
+	// Phase is the current phase of the node.
+	Phase CNIMigrationNodePhase `json:"phase"`
+	// LastTransitionTime is the time the phase of the node last changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// InProgress returns true if the migration has been started and not yet completed.
+func (s *CNIMigrationStatus) InProgress() bool {
+	return s != nil && s.Phase != "" && s.Phase != CNIMigrationPhaseCompleted
+}
+
+// SourceRequired returns true if the previous CNI plugin must keep running, because not
+// all nodes have been migrated yet.
+func (s *CNIMigrationStatus) SourceRequired() bool {
+	return s.InProgress() && s.Phase != CNIMigrationPhaseRemovingCanal
+}
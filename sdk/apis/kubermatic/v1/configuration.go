@@ -599,6 +599,10 @@ type KubermaticMasterControllerConfiguration struct {
 	DebugLog bool `json:"debugLog,omitempty"`
 	// Replicas sets the number of pod replicas for the master-controller-manager.
 	Replicas *int32 `json:"replicas,omitempty"`
+	// PlatformAudit configures the stream of normalized platform events (clusters being
+	// created or upgraded, project members being added, presets being changed, ...) that
+	// the master-controller-manager emits.
+	PlatformAudit KubermaticPlatformAuditConfiguration `json:"platformAudit,omitempty"`
 	// Pod scheduling configuration for this component.
 	// +optional
 	PodSchedulingConfigurations `json:",inline"`
@@ -610,6 +614,34 @@ type KubermaticProjectsMigratorConfiguration struct {
 	DryRun bool `json:"dryRun,omitempty"`
 }
 
+// +kubebuilder:validation:Enum="";file;webhook;syslog
+
+// PlatformAuditSinkType is the type of destination platform audit events are written to.
+type PlatformAuditSinkType string
+
+const (
+	// PlatformAuditFileSink appends events as JSON lines to a local file.
+	PlatformAuditFileSink PlatformAuditSinkType = "file"
+	// PlatformAuditWebhookSink POSTs each event as a JSON document to an HTTP endpoint.
+	PlatformAuditWebhookSink PlatformAuditSinkType = "webhook"
+	// PlatformAuditSyslogSink sends events as JSON-encoded syslog messages.
+	PlatformAuditSyslogSink PlatformAuditSinkType = "syslog"
+)
+
+// KubermaticPlatformAuditConfiguration configures the platform audit event stream.
+type KubermaticPlatformAuditConfiguration struct {
+	// Sink is the destination for platform audit events, one of "file", "webhook" or
+	// "syslog". Leaving this empty disables the event stream.
+	Sink PlatformAuditSinkType `json:"sink,omitempty"`
+	// FilePath is the path of the file events are appended to when using the "file" sink.
+	FilePath string `json:"filePath,omitempty"`
+	// WebhookURL is the HTTP(S) endpoint events are sent to when using the "webhook" sink.
+	WebhookURL string `json:"webhookURL,omitempty"`
+	// SyslogAddress is the address of the syslog server when using the "syslog" sink,
+	// for example "udp://syslog.example.com:514". If empty, the local syslog daemon is used.
+	SyslogAddress string `json:"syslogAddress,omitempty"`
+}
+
 // KubermaticVersioningConfiguration configures the available and default Kubernetes versions.
 type KubermaticVersioningConfiguration struct {
 	// Versions lists the available versions.
@@ -633,6 +665,45 @@ type KubermaticVersioningConfiguration struct {
 
 	// ExternalClusters contains the available and default Kubernetes versions and updates for ExternalClusters.
 	ExternalClusters map[ExternalClusterProviderType]ExternalClusterProviderVersioningConfiguration `json:"externalClusters,omitempty"`
+
+	// UpdateRollout optionally rolls out automatic updates to the clusters of all seeds in waves,
+	// instead of updating all matching clusters at once. The state of the rollout is reported
+	// in the UpdateRollout object on the master cluster.
+	UpdateRollout *UpdateRolloutConfiguration `json:"updateRollout,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Label;Seed
+
+// UpdateRolloutStrategy defines how clusters are assigned to rollout waves.
+type UpdateRolloutStrategy string
+
+const (
+	// UpdateRolloutStrategyLabel orders clusters by the value of a cluster label.
+	UpdateRolloutStrategyLabel UpdateRolloutStrategy = "Label"
+	// UpdateRolloutStrategySeed orders clusters by the seed they are running on.
+	UpdateRolloutStrategySeed UpdateRolloutStrategy = "Seed"
+
+	// DefaultUpdateWaveLabel is the label used to assign clusters to rollout waves.
+	DefaultUpdateWaveLabel = "kkp.k8c.io/update-wave"
+)
+
+// UpdateRolloutConfiguration configures the rollout of automatic updates in waves. Each wave
+// only starts once all clusters of the previous waves have been updated and are healthy.
+type UpdateRolloutConfiguration struct {
+	// Strategy defines how clusters are assigned to waves. With `Label`, the value of the
+	// wave label is the name of the wave; numeric wave names are ordered numerically and before
+	// all other names, which are ordered alphabetically. With `Seed`, every listed seed is a wave
+	// and the waves are ordered like the `seeds` list. Defaults to `Label`.
+	Strategy UpdateRolloutStrategy `json:"strategy,omitempty"`
+	// WaveLabel is the cluster label used by the `Label` strategy. Defaults to `kkp.k8c.io/update-wave`.
+	// Clusters without the label are updated in a final wave.
+	WaveLabel string `json:"waveLabel,omitempty"`
+	// Seeds is the order of seeds for the `Seed` strategy. Seeds that are not listed are
+	// updated in a final wave.
+	Seeds []string `json:"seeds,omitempty"`
+	// HealthTimeout is how long a cluster of a started wave may be unhealthy before the whole
+	// rollout is halted. The rollout continues once the cluster is healthy again. Defaults to 30m.
+	HealthTimeout *metav1.Duration `json:"healthTimeout,omitempty"`
 }
 
 // ExternalClusterProviderType is used to indicate ExternalCluster Provider Types.
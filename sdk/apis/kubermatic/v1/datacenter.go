@@ -612,6 +612,10 @@ type DatacenterSpec struct {
 	// in this datacenter.
 	// +optional
 	Kyverno *KyvernoConfigurations `json:"kyverno,omitempty"`
+
+	// Optional: DefaultMaintenanceWindow is the maintenance window used for all clusters in this
+	// datacenter that do not configure their own maintenance window.
+	DefaultMaintenanceWindow *MaintenanceWindow `json:"defaultMaintenanceWindow,omitempty"`
 }
 
 // knownIPv6CloudProviders configures which providers have IPv6 and if it's enabled for all datacenters.
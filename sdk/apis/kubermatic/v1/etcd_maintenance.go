@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultEtcdDefragmentationThreshold is the default percentage of unused space in the etcd
+	// database at which a member is defragmented.
+	DefaultEtcdDefragmentationThreshold = 50
+	// DefaultEtcdQuotaWarningThreshold is the default percentage of the backend quota that can be
+	// in use before the EtcdQuotaSufficient condition is set to false.
+	DefaultEtcdQuotaWarningThreshold = 75
+	// DefaultEtcdQuotaBackendGB is the backend quota used by etcd if none is configured.
+	DefaultEtcdQuotaBackendGB = 2
+)
+
+// EtcdMaintenanceSettings configures automatic defragmentation and quota management of etcd.
+type EtcdMaintenanceSettings struct {
+	// Enabled enables the automatic maintenance. The etcd members are then defragmented one at
+	// a time inside the cluster's maintenance window, instead of periodically by the
+	// etcd-defragger CronJob.
+	Enabled bool `json:"enabled,omitempty"`
+	// Optional: DefragmentationThreshold is the percentage of an etcd member's database that needs
+	// to be unused before the member is defragmented. Defaults to 50.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	DefragmentationThreshold *int32 `json:"defragmentationThreshold,omitempty"`
+	// Optional: QuotaWarningThreshold is the percentage of the backend quota that can be in use
+	// before the EtcdQuotaSufficient condition is set to false. If a member's database exceeds
+	// this threshold including its unused space, it is defragmented even outside the maintenance
+	// window. Defaults to 75.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	QuotaWarningThreshold *int32 `json:"quotaWarningThreshold,omitempty"`
+	// Optional: MaxQuotaBackendGB enables doubling QuotaBackendGB inside the maintenance window,
+	// up to this value, whenever the quota warning threshold is exceeded. The etcd volumes are
+	// migrated to larger disks first if they are smaller than twice the new quota, which requires
+	// the etcd-launcher.
+	// +kubebuilder:validation:Minimum=1
+	MaxQuotaBackendGB *int64 `json:"maxQuotaBackendGb,omitempty"`
+}
+
+// IsEnabled returns true if the automatic etcd maintenance is enabled.
+func (s *EtcdMaintenanceSettings) IsEnabled() bool {
+	return s != nil && s.Enabled
+}
+
+// EtcdMaintenanceStatus reports the database usage of the etcd members.
+type EtcdMaintenanceStatus struct {
+	// QuotaBackendBytes is the backend quota of the etcd members.
+	QuotaBackendBytes resource.Quantity `json:"quotaBackendBytes"`
+	// Members are the database usages of the etcd members.
+	// +optional
+	Members []EtcdMemberDatabaseStatus `json:"members,omitempty"`
+	// LastCheckTime is the time the database usage was last checked.
+	LastCheckTime metav1.Time `json:"lastCheckTime"`
+}
+
+// EtcdMemberDatabaseStatus is the database usage of a single etcd member.
+type EtcdMemberDatabaseStatus struct {
+	// Name is the name of the etcd member.
+	Name string `json:"name"`
+	// DatabaseSize is the size of the database file, including unused space.
+	DatabaseSize resource.Quantity `json:"databaseSize"`
+	// DatabaseSizeInUse is the part of the database that is in use.
+	DatabaseSizeInUse resource.Quantity `json:"databaseSizeInUse"`
+	// LastDefragmentationTime is the time the member was last defragmented.
+	// +optional
+	LastDefragmentationTime *metav1.Time `json:"lastDefragmentationTime,omitempty"`
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=RecreatingStatefulSet;AddingMember;ReplacingMembers;RemovingMember;Completed
+
+// EtcdStorageMigrationPhase is the phase of a migration of the etcd volumes to a new
+// StorageClass or disk size.
+type EtcdStorageMigrationPhase string
+
+const (
+	// EtcdStorageMigrationPhaseRecreatingStatefulSet means the etcd StatefulSet is recreated with
+	// the new volume claim template, while its pods keep running.
+	EtcdStorageMigrationPhaseRecreatingStatefulSet EtcdStorageMigrationPhase = "RecreatingStatefulSet"
+	// EtcdStorageMigrationPhaseAddingMember means an additional etcd member is added on the new
+	// storage, so that the cluster keeps its fault tolerance while members are replaced.
+	EtcdStorageMigrationPhaseAddingMember EtcdStorageMigrationPhase = "AddingMember"
+	// EtcdStorageMigrationPhaseReplacingMembers means the original members are replaced one at a
+	// time by members on new volumes.
+	EtcdStorageMigrationPhaseReplacingMembers EtcdStorageMigrationPhase = "ReplacingMembers"
+	// EtcdStorageMigrationPhaseRemovingMember means the additional member is removed again.
+	EtcdStorageMigrationPhaseRemovingMember EtcdStorageMigrationPhase = "RemovingMember"
+	// EtcdStorageMigrationPhaseCompleted means all members use the new storage.
+	EtcdStorageMigrationPhaseCompleted EtcdStorageMigrationPhase = "Completed"
+)
+
+// EtcdStorageMigrationStatus reports the progress of a migration of the etcd volumes. A migration
+// is started whenever `spec.componentsOverride.etcd.storageClass` or `diskSize` is changed for a
+// cluster using the etcd-launcher. Changes to the etcd cluster size are deferred until the
+// migration has completed.
+type EtcdStorageMigrationStatus struct {
+	// Phase is the current phase of the migration.
+	Phase EtcdStorageMigrationPhase `json:"phase"`
+	// StorageClass is the StorageClass the etcd volumes are migrated to.
+	StorageClass string `json:"storageClass"`
+	// DiskSize is the size of the new etcd volumes.
+	DiskSize resource.Quantity `json:"diskSize"`
+	// ClusterSize is the number of etcd members when the migration was started.
+	ClusterSize int32 `json:"clusterSize"`
+	// MigratedMembers are the names of the original etcd members that have been replaced.
+	// +optional
+	MigratedMembers []string `json:"migratedMembers,omitempty"`
+	// CurrentMember is the name of the etcd member that is currently being replaced.
+	// +optional
+	CurrentMember string `json:"currentMember,omitempty"`
+	// Message describes what the migration is currently waiting for.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// StartTime is the time the migration was started.
+	StartTime metav1.Time `json:"startTime"`
+	// LastTransitionTime is the time the phase last changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// InProgress returns true if the migration has been started and not yet completed.
+func (s *EtcdStorageMigrationStatus) InProgress() bool {
+	return s != nil && s.Phase != "" && s.Phase != EtcdStorageMigrationPhaseCompleted
+}
+
+// HasAdditionalMember returns true if the migration currently requires an additional etcd member.
+func (s *EtcdStorageMigrationStatus) HasAdditionalMember() bool {
+	return s != nil && (s.Phase == EtcdStorageMigrationPhaseAddingMember || s.Phase == EtcdStorageMigrationPhaseReplacingMembers)
+}
@@ -44,6 +44,10 @@ const (
 
 	// KubeOne manifest secret prefixes.
 	KubeOneManifestSecretPrefix = "manifest-kubeone-external-cluster"
+
+	// ExternalClusterNamespacePrefix is the prefix of the namespace on the master cluster
+	// that holds the PolicyBindings of an external cluster.
+	ExternalClusterNamespacePrefix = "external-cluster"
 )
 
 // +kubebuilder:validation:Enum=aks;bringyourown;eks;gke;kubeone
@@ -85,6 +89,14 @@ type ExternalCluster struct {
 type ExternalClusterStatus struct {
 	// Conditions contains conditions an externalcluster is in, its primary use case is status signaling for controller
 	Condition ExternalClusterCondition `json:"condition,omitempty"`
+
+	// DefaultApplicationsCreated is set once the default ApplicationInstallations have been
+	// created in the cluster, so that default applications removed by the user are not recreated.
+	DefaultApplicationsCreated bool `json:"defaultApplicationsCreated,omitempty"`
+
+	// DefaultPolicyBindingsCreated is set once the default PolicyBindings have been created
+	// for the cluster, so that default policies removed by the user are not recreated.
+	DefaultPolicyBindingsCreated bool `json:"defaultPolicyBindingsCreated,omitempty"`
 }
 
 type ExternalClusterCondition struct {
@@ -147,6 +159,42 @@ type ExternalClusterSpec struct {
 	// PauseReason is the reason why the cluster is not being managed. This field is for informational
 	// purpose only and can be set by a user or a controller to communicate the reason for pausing the cluster.
 	PauseReason string `json:"pauseReason,omitempty"`
+
+	// Applications configures the installation of KKP Applications into the cluster.
+	// +optional
+	Applications *ExternalClusterApplicationSettings `json:"applications,omitempty"`
+
+	// Policies configures applying KKP policies (PolicyBindings) to the cluster.
+	// +optional
+	Policies *ExternalClusterPolicySettings `json:"policies,omitempty"`
+}
+
+// ExternalClusterApplicationSettings configures KKP Applications for an external cluster.
+type ExternalClusterApplicationSettings struct {
+	// Enabled installs the ApplicationInstallation CRD into the cluster and reconciles
+	// ApplicationInstallations as well as default and enforced ApplicationDefinitions
+	// from the master cluster, using the cluster's kubeconfig.
+	// Only ApplicationDefinitions without a datacenter selector are installed by default.
+	Enabled bool `json:"enabled"`
+}
+
+// ExternalClusterPolicySettings configures KKP policies for an external cluster.
+type ExternalClusterPolicySettings struct {
+	// Enabled applies the PolicyBindings of the cluster as Kyverno policies. The
+	// PolicyBindings are stored on the master cluster in the namespace
+	// "external-cluster-<cluster name>". KKP does not deploy Kyverno into external
+	// clusters, it must already be running in the cluster (for example as an Application).
+	Enabled bool `json:"enabled"`
+}
+
+// IsApplicationsEnabled returns whether KKP Applications are reconciled in the cluster.
+func (s ExternalClusterSpec) IsApplicationsEnabled() bool {
+	return s.Applications != nil && s.Applications.Enabled
+}
+
+// IsPoliciesEnabled returns whether KKP policies are applied to the cluster.
+func (s ExternalClusterSpec) IsPoliciesEnabled() bool {
+	return s.Policies != nil && s.Policies.Enabled
 }
 
 // ExternalClusterNetworkingConfig specifies the different networking
@@ -359,3 +407,9 @@ func (i *ExternalCluster) GetKubeOneManifestSecretName() string {
 func (i *ExternalCluster) GetKubeOneNamespaceName() string {
 	return fmt.Sprintf("%s-%s", KubeOneNamespacePrefix, i.Name)
 }
+
+// GetNamespaceName returns the namespace on the master cluster that holds the
+// PolicyBindings of the external cluster.
+func (i *ExternalCluster) GetNamespaceName() string {
+	return fmt.Sprintf("%s-%s", ExternalClusterNamespacePrefix, i.Name)
+}
@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 const (
 
@@ -39,6 +43,7 @@ const (
 // +kubebuilder:printcolumn:JSONPath=".spec.projectID",name="ProjectID",type="string"
 // +kubebuilder:printcolumn:JSONPath=".spec.group",name="Group",type="string"
 // +kubebuilder:printcolumn:JSONPath=".spec.role",name="Role",type="string"
+// +kubebuilder:printcolumn:JSONPath=".spec.expiresAt",name="ExpiresAt",type="date"
 // +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
 
 // GroupProjectBinding specifies a binding between a group and a project
@@ -70,6 +75,20 @@ type GroupProjectBindingSpec struct {
 	// "editors" - allowed to edit all project resources
 	// "owners" - same as editors, but also can manage users in the project
 	Role string `json:"role"`
+
+	// ExpiresAt optionally limits the binding to a point in time, after which the group loses
+	// access to the project again. Expired bindings are kept for auditing for a while
+	// before they are deleted.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+	// Reason optionally documents why the binding was created, e.g. an incident or ticket.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// IsExpired returns true if the binding has an expiry time that is not after now.
+func (s *GroupProjectBindingSpec) IsExpired(now time.Time) bool {
+	return s.ExpiresAt != nil && !s.ExpiresAt.After(now)
 }
 
 // +kubebuilder:object:generate=true
@@ -17,6 +17,8 @@ limitations under the License.
 package helper
 
 import (
+	"encoding/json"
+
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 
 	corev1 "k8s.io/api/core/v1"
@@ -32,3 +34,28 @@ func NeedCCMMigration(cluster *kubermaticv1.Cluster) bool {
 func CCMMigrationCompleted(cluster *kubermaticv1.Cluster) bool {
 	return cluster.Status.HasConditionValue(kubermaticv1.ClusterConditionCSIKubeletMigrationCompleted, corev1.ConditionTrue)
 }
+
+// CNIMigrationSource returns the CNI plugin the cluster is migrated away from, as long as it
+// still has to keep running next to the new CNI plugin. Before the migration controller has
+// picked up a migration, the source is taken from the CNIMigrationSourceAnnotation.
+func CNIMigrationSource(cluster *kubermaticv1.Cluster) *kubermaticv1.CNIMigrationSource {
+	if migration := cluster.Status.CNIMigration; migration.InProgress() {
+		if migration.SourceRequired() {
+			return &migration.Source
+		}
+
+		return nil
+	}
+
+	annotation, ok := cluster.Annotations[kubermaticv1.CNIMigrationSourceAnnotation]
+	if !ok {
+		return nil
+	}
+
+	source := &kubermaticv1.CNIMigrationSource{}
+	if err := json.Unmarshal([]byte(annotation), source); err != nil {
+		return nil
+	}
+
+	return source
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package v1
 
 import (
+	"k8c.io/machine-controller/sdk/providerconfig"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -31,6 +33,7 @@ const (
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:object:generate=true
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
 
 // IPAMPool is the object representing Multi-Cluster IP Address Management (IPAM)
@@ -41,6 +44,9 @@ type IPAMPool struct {
 
 	// Spec describes the Multi-Cluster IP Address Management (IPAM) configuration for KKP user clusters.
 	Spec IPAMPoolSpec `json:"spec,omitempty"`
+
+	// Status contains the current utilization of the pool.
+	Status IPAMPoolStatus `json:"status,omitempty"`
 }
 
 // IPAMPoolSpec specifies the  Multi-Cluster IP Address Management (IPAM)
@@ -48,6 +54,57 @@ type IPAMPool struct {
 type IPAMPoolSpec struct {
 	// Datacenters contains a map of datacenters (DCs) for the allocation.
 	Datacenters map[string]IPAMPoolDatacenterSettings `json:"datacenters"`
+
+	// Optional: ClusterSelector restricts the pool to clusters whose labels match the
+	// selector. If not set, the pool applies to all clusters in the configured datacenters.
+	// The selector is only evaluated when allocating; existing allocations are kept if
+	// a cluster stops matching, so that its network configuration does not change.
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// Optional: Reservations pins clusters to pre-defined prefixes or addresses of the pool.
+	// Reserved prefixes and addresses are never allocated to any other cluster.
+	// Reservations are honored regardless of the cluster selector.
+	Reservations []IPAMPoolReservation `json:"reservations,omitempty"`
+
+	// Optional: Backend configures an external IPAM system that prefixes and addresses
+	// are allocated from, instead of the built-in allocator. The pool CIDRs of all
+	// datacenters must exist in the external system.
+	Backend *IPAMPoolBackend `json:"backend,omitempty"`
+}
+
+// IPAMPoolBackend configures an external IPAM system. Exactly one backend must be configured.
+type IPAMPoolBackend struct {
+	// NetBox configures a NetBox instance as the IPAM backend.
+	NetBox *IPAMPoolNetBoxBackend `json:"netbox,omitempty"`
+}
+
+// IPAMPoolNetBoxBackend configures a NetBox instance as the IPAM backend. Allocations
+// are created as child prefixes ("type=prefix") or IP addresses ("type=range") of the
+// pool CIDR prefix in NetBox and are released when the cluster is deleted.
+type IPAMPoolNetBoxBackend struct {
+	// URL is the base URL of the NetBox instance, e.g. "https://netbox.example.com".
+	URL string `json:"url"`
+
+	// TokenReference references the secret key containing the NetBox API token.
+	TokenReference providerconfig.GlobalSecretKeySelector `json:"tokenReference"`
+}
+
+// IPAMPoolReservation is a static allocation of a pool for a single cluster.
+type IPAMPoolReservation struct {
+	// Cluster is the name of the cluster the reservation is for.
+	Cluster string `json:"cluster"`
+
+	// Datacenter is the datacenter of the pool the reservation is made in.
+	Datacenter string `json:"datacenter"`
+
+	// CIDR is the reserved subnet, its length must be the same as the allocationPrefix.
+	// Used when "type=prefix".
+	CIDR SubnetCIDR `json:"cidr,omitempty"`
+
+	// Addresses are the reserved IPs or IP ranges, their number must be the same as the allocationRange.
+	// Examples: "192.168.1.100-192.168.1.110", "192.168.1.255".
+	// Used when "type=range".
+	Addresses []string `json:"addresses,omitempty"`
 }
 
 // IPAMPoolDatacenterSettings contains IPAM Pool configuration for a datacenter.
@@ -78,8 +135,64 @@ type IPAMPoolDatacenterSettings struct {
 	// Examples: "192.168.1.100-192.168.1.110", "192.168.1.255".
 	// Can be used when "type=range".
 	ExcludeRanges []string `json:"excludeRanges,omitempty"`
+
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=100
+	// Optional: UtilizationThreshold is the percentage of the pool that can be allocated
+	// or excluded before the pool is considered to be running out of capacity. Crossing
+	// the threshold sets the "CapacityAvailable" condition of the datacenter to false
+	// and emits a warning event. Defaults to 90.
+	UtilizationThreshold int `json:"utilizationThreshold,omitempty"`
+}
+
+// IPAMPoolStatus contains the current utilization of an IPAM pool.
+type IPAMPoolStatus struct {
+	// Datacenters contains the utilization of the pool per datacenter.
+	// +optional
+	Datacenters map[string]IPAMPoolDatacenterStatus `json:"datacenters,omitempty"`
 }
 
+// IPAMPoolDatacenterStatus contains the utilization of an IPAM pool in a single
+// datacenter. Depending on the allocation type of the pool, all numbers are
+// either prefixes of the allocationPrefix size ("type=prefix") or single IP
+// addresses ("type=range"). Numbers that exceed the int64 range are capped.
+type IPAMPoolDatacenterStatus struct {
+	// Type is the allocation type the numbers refer to.
+	Type IPAMPoolAllocationType `json:"type"`
+	// Total is the number of prefixes or addresses in the pool CIDR.
+	Total int64 `json:"total"`
+	// Allocated is the number of prefixes or addresses allocated to clusters.
+	Allocated int64 `json:"allocated"`
+	// Excluded is the number of prefixes or addresses that are excluded from
+	// the allocation via excludePrefixes or excludeRanges, or that are reserved
+	// for clusters which do not have an allocation yet.
+	Excluded int64 `json:"excluded"`
+	// Free is the number of prefixes or addresses that are available for new allocations.
+	Free int64 `json:"free"`
+	// LargestFreeBlock is the largest contiguous block of free space in the pool,
+	// as a CIDR ("type=prefix") or address range ("type=range").
+	// +optional
+	LargestFreeBlock string `json:"largestFreeBlock,omitempty"`
+	// Conditions contains the conditions of the pool in this datacenter.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+const (
+	// IPAMPoolConditionCapacityAvailable is true as long as the utilization of the pool
+	// in a datacenter is below the configured utilization threshold.
+	IPAMPoolConditionCapacityAvailable = "CapacityAvailable"
+
+	// IPAMPoolReasonBelowThreshold is used when the utilization is below the threshold.
+	IPAMPoolReasonBelowThreshold = "BelowThreshold"
+	// IPAMPoolReasonThresholdExceeded is used when the utilization is above the threshold.
+	IPAMPoolReasonThresholdExceeded = "ThresholdExceeded"
+	// IPAMPoolReasonExhausted is used when there is no free space left in the pool.
+	IPAMPoolReasonExhausted = "Exhausted"
+)
+
 // +kubebuilder:validation:Pattern="((^((([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]))/([0-9]|[1-2][0-9]|3[0-2])$)|(^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:))/([0-9]|[0-9][0-9]|1[0-1][0-9]|12[0-8])$))"
 // SubnetCIDR is used to store IPv4/IPv6 CIDR.
 type SubnetCIDR string
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// PodDisruptionBudgetPolicy defines how node drains treat PodDisruptionBudgets that do not
+// allow a pod to be evicted.
+//
+// +kubebuilder:validation:Enum=Respect;IgnoreAfterTimeout
+type PodDisruptionBudgetPolicy string
+
+const (
+	// PodDisruptionBudgetPolicyRespect retries evictions until the PodDisruptionBudgets allow them,
+	// without any timeout. Drains of nodes with tight PodDisruptionBudgets can block an upgrade.
+	PodDisruptionBudgetPolicyRespect PodDisruptionBudgetPolicy = "Respect"
+	// PodDisruptionBudgetPolicyIgnoreAfterTimeout removes a node without evicting its remaining pods
+	// once the drain timeout has passed, violating their PodDisruptionBudgets.
+	PodDisruptionBudgetPolicyIgnoreAfterTimeout PodDisruptionBudgetPolicy = "IgnoreAfterTimeout"
+)
+
+// NodeUpgradePolicy configures how the nodes of a cluster are replaced when the kubelet version of
+// its MachineDeployments is updated.
+type NodeUpgradePolicy struct {
+	// Optional: MaxSurge is the number or percentage of machines that can be created above the desired
+	// number of replicas of a MachineDeployment during an upgrade. Defaults to the MachineDeployment's
+	// own setting.
+	// +kubebuilder:validation:XIntOrString
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+	// Optional: MaxUnavailable is the number or percentage of machines of a MachineDeployment that can
+	// be unavailable during an upgrade. Defaults to the MachineDeployment's own setting.
+	// +kubebuilder:validation:XIntOrString
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// Optional: DrainTimeout is how long pods are evicted from a node before it is removed anyway.
+	// Only used with the `IgnoreAfterTimeout` PodDisruptionBudget policy. Defaults to the
+	// machine-controller default of 2h.
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
+	// Optional: PodDisruptionBudgetPolicy defines whether drains give up on pods that are protected by
+	// PodDisruptionBudgets once the drain timeout has passed. Defaults to `IgnoreAfterTimeout`.
+	PodDisruptionBudgetPolicy PodDisruptionBudgetPolicy `json:"podDisruptionBudgetPolicy,omitempty"`
+	// Optional: SkipDrainNodeSelectors selects nodes that are removed without evicting their pods,
+	// e.g. nodes that only run stateless workloads. A node is skipped if it matches any selector.
+	SkipDrainNodeSelectors []metav1.LabelSelector `json:"skipDrainNodeSelectors,omitempty"`
+}
+
+// GetPodDisruptionBudgetPolicy returns the configured PodDisruptionBudget policy or the default.
+func (p *NodeUpgradePolicy) GetPodDisruptionBudgetPolicy() PodDisruptionBudgetPolicy {
+	if p == nil || p.PodDisruptionBudgetPolicy == "" {
+		return PodDisruptionBudgetPolicyIgnoreAfterTimeout
+	}
+
+	return p.PodDisruptionBudgetPolicy
+}
+
+// NodeUpgradeStatus reports the progress of ongoing node upgrades.
+type NodeUpgradeStatus struct {
+	// MachineDeployments lists the MachineDeployments whose machines are currently being replaced.
+	MachineDeployments []MachineDeploymentUpgradeStatus `json:"machineDeployments,omitempty"`
+	// LastUpdateTime is the time the progress last changed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// MachineDeploymentUpgradeStatus is the rollout progress of a single MachineDeployment.
+type MachineDeploymentUpgradeStatus struct {
+	// Name is the name of the MachineDeployment in the kube-system namespace.
+	Name string `json:"name"`
+	// KubeletVersion is the kubelet version the machines are upgraded to.
+	KubeletVersion string `json:"kubeletVersion"`
+	// Replicas is the desired number of machines.
+	Replicas int32 `json:"replicas"`
+	// UpdatedReplicas is the number of machines that already run the new machine template.
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// AvailableReplicas is the number of available machines.
+	AvailableReplicas int32 `json:"availableReplicas"`
+}
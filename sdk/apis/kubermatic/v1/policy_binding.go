@@ -17,6 +17,8 @@ limitations under the License.
 package v1
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -29,6 +31,12 @@ const (
 	PolicyBindingKindName = "PolicyBinding"
 )
 
+const (
+	// DefaultPolicyBindingPreviewObservationWindow is the observation window used for
+	// PolicyBinding previews that do not configure one.
+	DefaultPolicyBindingPreviewObservationWindow = 24 * time.Hour
+)
+
 const (
 	// PolicyBindingCleanupFinalizer indicates that generated Kyverno resources need cleanup.
 	PolicyBindingCleanupFinalizer = "kubermatic.k8c.io/cleanup-policy-binding"
@@ -74,6 +82,9 @@ const (
 
 	// PolicyBindingReasonPolicyNamespaceMissing indicates that a namespaced policy has no target namespace configured.
 	PolicyBindingReasonPolicyNamespaceMissing = "PolicyNamespaceMissing"
+
+	// PolicyBindingReasonPreview indicates the Kyverno policy was applied in Audit mode because the PolicyBinding is in preview.
+	PolicyBindingReasonPreview = "Preview"
 )
 
 // Annotation keys for PolicyBinding.
@@ -91,6 +102,7 @@ const (
 // +kubebuilder:printcolumn:name="Template",type=string,JSONPath=".spec.policyTemplateRef.name"
 // +kubebuilder:printcolumn:name="Enforced",type=boolean,JSONPath=".status.templateEnforced"
 // +kubebuilder:printcolumn:name="Active",type=string,JSONPath=".status.active"
+// +kubebuilder:printcolumn:name="Preview",type=string,JSONPath=".status.preview.phase"
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
@@ -118,6 +130,42 @@ type PolicyBindingSpec struct {
 	//
 	// +optional
 	KyvernoPolicyNamespace *KyvernoPolicyNamespace `json:"kyvernoPolicyNamespace,omitempty"`
+
+	// Preview deploys the policy in Kyverno Audit mode, regardless of the validation failure
+	// action configured in the PolicyTemplate, so that the would-be violations can be observed
+	// before the policy is enforced.
+	//
+	// +optional
+	Preview *PolicyBindingPreview `json:"preview,omitempty"`
+}
+
+// PolicyBindingPreview configures the audit-mode preview of a PolicyBinding.
+type PolicyBindingPreview struct {
+	// ObservationWindow is the duration for which the policy is observed in Audit mode
+	// before the would-be violations are reported. Defaults to 24h.
+	//
+	// +optional
+	ObservationWindow *metav1.Duration `json:"observationWindow,omitempty"`
+
+	// Promote ends the preview and deploys the policy with the validation failure
+	// action configured in the PolicyTemplate.
+	//
+	// +optional
+	Promote bool `json:"promote,omitempty"`
+}
+
+// GetObservationWindow returns the configured observation window or the default.
+func (p *PolicyBindingPreview) GetObservationWindow() time.Duration {
+	if p.ObservationWindow == nil || p.ObservationWindow.Duration <= 0 {
+		return DefaultPolicyBindingPreviewObservationWindow
+	}
+
+	return p.ObservationWindow.Duration
+}
+
+// InPreview returns true if the policy of this binding must be deployed in Audit mode.
+func (pb *PolicyBinding) InPreview() bool {
+	return pb.Spec.Preview != nil && !pb.Spec.Preview.Promote
 }
 
 // KyvernoPolicyNamespace specifies the namespace to deploy the Kyverno Policy into.
@@ -164,6 +212,85 @@ type PolicyBindingStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// PolicyReport summarizes the Kyverno PolicyReport and ClusterPolicyReport results of the
+	// policy in this User Cluster.
+	//
+	// +optional
+	PolicyReport *PolicyReportSummary `json:"policyReport,omitempty"`
+
+	// Preview reflects the progress of the audit-mode preview.
+	//
+	// +optional
+	Preview *PolicyBindingPreviewStatus `json:"preview,omitempty"`
+}
+
+// PolicyBindingPreviewPhase is the phase of the audit-mode preview of a PolicyBinding.
+//
+// +kubebuilder:validation:Enum=Observing;Completed;Promoted
+type PolicyBindingPreviewPhase string
+
+const (
+	// PolicyBindingPreviewObserving means the policy is deployed in Audit mode and the
+	// observation window has not yet elapsed.
+	PolicyBindingPreviewObserving PolicyBindingPreviewPhase = "Observing"
+
+	// PolicyBindingPreviewCompleted means the observation window has elapsed and the
+	// would-be violations have been reported. The policy stays in Audit mode until promoted.
+	PolicyBindingPreviewCompleted PolicyBindingPreviewPhase = "Completed"
+
+	// PolicyBindingPreviewPromoted means the preview was promoted and the policy is deployed
+	// with the validation failure action configured in the PolicyTemplate.
+	PolicyBindingPreviewPromoted PolicyBindingPreviewPhase = "Promoted"
+)
+
+// PolicyBindingPreviewStatus is the status of the audit-mode preview of a PolicyBinding.
+type PolicyBindingPreviewStatus struct {
+	// Phase is the current phase of the preview.
+	Phase PolicyBindingPreviewPhase `json:"phase"`
+
+	// StartTime is the time at which the policy was first deployed in Audit mode.
+	StartTime metav1.Time `json:"startTime"`
+
+	// CompletionTime is the time at which the observation window elapsed.
+	//
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Violations is the number of resources that failed the policy while it was observed
+	// in Audit mode, i.e. the number of resources that would have been blocked if the
+	// policy had been enforced. It is recorded once the observation window has elapsed.
+	//
+	// +optional
+	Violations *int32 `json:"violations,omitempty"`
+}
+
+// PolicyReportSummary counts the results of Kyverno policy reports.
+type PolicyReportSummary struct {
+	// Pass is the number of results where the policy requirements are met.
+	Pass int32 `json:"pass"`
+	// Fail is the number of results where the policy requirements are not met.
+	Fail int32 `json:"fail"`
+	// Warn is the number of results where the requirements of a policy in audit mode are not met.
+	Warn int32 `json:"warn"`
+	// Error is the number of results where the policy could not be evaluated.
+	Error int32 `json:"error"`
+	// Skip is the number of results where the policy was not applicable.
+	Skip int32 `json:"skip"`
+}
+
+// Add adds the results of another summary to this summary.
+func (s *PolicyReportSummary) Add(other PolicyReportSummary) {
+	s.Pass += other.Pass
+	s.Fail += other.Fail
+	s.Warn += other.Warn
+	s.Error += other.Error
+	s.Skip += other.Skip
+}
+
+// Compliant returns true if no result failed.
+func (s PolicyReportSummary) Compliant() bool {
+	return s.Fail == 0
 }
 
 // +kubebuilder:object:generate=true
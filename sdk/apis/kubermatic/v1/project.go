@@ -51,6 +51,7 @@ const (
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:JSONPath=".spec.name",name="HumanReadableName",type="string"
 // +kubebuilder:printcolumn:JSONPath=".status.phase",name="Status",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.suspension.suspendedAt",name="Suspended",type="date"
 // +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
 
 // Project is the type describing a project. A project is a collection of
@@ -72,6 +73,26 @@ type ProjectSpec struct {
 	Name string `json:"name"`
 	// AllowedOperatingSystems defines a map of operating systems that can be used for the machines inside this project.
 	AllowedOperatingSystems allowedOperatingSystems `json:"allowedOperatingSystems,omitempty"`
+	// Optional: AllowedDatacenters restricts the datacenters that clusters in this project can
+	// be created in. If empty, all datacenters are allowed.
+	AllowedDatacenters []string `json:"allowedDatacenters,omitempty"`
+	// Optional: AllowedSeeds restricts the seeds that clusters in this project can be created in.
+	// If empty, all seeds are allowed.
+	AllowedSeeds []string `json:"allowedSeeds,omitempty"`
+	// Optional: AllowedProviders restricts the cloud providers, e.g. `aws` or `edge`, that clusters
+	// in this project can use. If empty, all providers are allowed.
+	AllowedProviders []string `json:"allowedProviders,omitempty"`
+	// Optional: KubernetesVersionConstraint is a semver constraint, e.g. `>= 1.33`, that the
+	// Kubernetes version of all clusters in this project must satisfy when they are created or
+	// updated. If empty, all versions are allowed.
+	KubernetesVersionConstraint string `json:"kubernetesVersionConstraint,omitempty"`
+	// Optional: AllowedExposeStrategies restricts the expose strategies that clusters in this
+	// project can use. If empty, all expose strategies are allowed.
+	AllowedExposeStrategies []ExposeStrategy `json:"allowedExposeStrategies,omitempty"`
+	// Optional: Suspension suspends the project. All clusters in a suspended project are paused,
+	// no new clusters, machines or applications can be created in it and non-admin users lose
+	// access to it. Removing the suspension resumes the project.
+	Suspension *ProjectSuspension `json:"suspension,omitempty"`
 	// DefaultTenantSpec is an opaque KubeLB Tenant Spec passed through to the
 	// kubelb management cluster as-is. This can be used to override the default
 	// Tenant Spec that is used for all Tenants created for this project. This is useful for
@@ -89,6 +110,72 @@ type ProjectStatus struct {
 	// phase; after being reconciled they move to `Active` and during deletion
 	// they are `Terminating`.
 	Phase ProjectPhase `json:"phase"`
+
+	// Suspension describes the current suspension of the project, if it is suspended.
+	// +optional
+	Suspension *ProjectSuspensionStatus `json:"suspension,omitempty"`
+
+	// PolicyCompliance summarizes the Kyverno policy reports of all clusters in the project.
+	// +optional
+	PolicyCompliance *ProjectPolicyComplianceStatus `json:"policyCompliance,omitempty"`
+}
+
+// ProjectSuspension configures the suspension of a project.
+type ProjectSuspension struct {
+	// Reason is a human-readable explanation why the project was suspended, e.g. an exhausted budget.
+	Reason string `json:"reason"`
+	// HibernateClusters additionally hibernates all clusters in the project, scaling their
+	// worker nodes and control planes down, before they are paused.
+	// +optional
+	HibernateClusters bool `json:"hibernateClusters,omitempty"`
+	// SuspendedBy is the user who suspended the project. It is set by the admission webhook
+	// and cannot be changed by users.
+	// +optional
+	SuspendedBy string `json:"suspendedBy,omitempty"`
+}
+
+// ProjectSuspensionStatus records the suspension of a project.
+type ProjectSuspensionStatus struct {
+	// Reason is the reason given for the suspension.
+	Reason string `json:"reason"`
+	// SuspendedBy is the user who suspended the project.
+	// +optional
+	SuspendedBy string `json:"suspendedBy,omitempty"`
+	// SuspendedAt is the time the suspension became effective.
+	SuspendedAt metav1.Time `json:"suspendedAt"`
+}
+
+// IsSuspended returns true if the project is suspended.
+func (p *Project) IsSuspended() bool {
+	return p.Spec.Suspension != nil
+}
+
+// ProjectPolicyComplianceStatus is the project-wide roll-up of the policy report results
+// aggregated in the PolicyBindings of all clusters in the project.
+type ProjectPolicyComplianceStatus struct {
+	// Clusters is the number of clusters with at least one PolicyBinding that reports results.
+	Clusters int32 `json:"clusters"`
+	// NonCompliantClusters is the number of clusters with at least one failed policy result.
+	NonCompliantClusters int32 `json:"nonCompliantClusters"`
+	// Results sums up the policy report results of all clusters.
+	Results PolicyReportSummary `json:"results"`
+	// Templates contains the policy report results per PolicyTemplate, sorted by name.
+	// +optional
+	Templates []PolicyTemplateComplianceStatus `json:"templates,omitempty"`
+	// LastUpdateTime is the time the summary was last changed.
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// PolicyTemplateComplianceStatus summarizes the policy report results of a single
+// PolicyTemplate across all clusters of a project.
+type PolicyTemplateComplianceStatus struct {
+	// Name is the name of the PolicyTemplate.
+	Name string `json:"name"`
+	// NonCompliantClusters is the number of clusters with at least one failed result of the template.
+	NonCompliantClusters int32 `json:"nonCompliantClusters"`
+	// Results sums up the policy report results of the template in all clusters.
+	Results PolicyReportSummary `json:"results"`
 }
 
 // +kubebuilder:object:generate=true
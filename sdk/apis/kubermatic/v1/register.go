@@ -109,10 +109,16 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&GroupProjectBindingList{},
 		&ClusterBackupStorageLocation{},
 		&ClusterBackupStorageLocationList{},
+		&ClusterBackupPolicy{},
+		&ClusterBackupPolicyList{},
+		&ClusterRestore{},
+		&ClusterRestoreList{},
 		&PolicyTemplate{},
 		&PolicyTemplateList{},
 		&PolicyBinding{},
 		&PolicyBindingList{},
+		&UpdateRollout{},
+		&UpdateRolloutList{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
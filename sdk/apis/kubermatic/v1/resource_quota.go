@@ -22,6 +22,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"sort"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -63,6 +64,37 @@ type ResourceQuotaSpec struct {
 	Subject Subject `json:"subject"`
 	// Quota specifies the current maximum allowed usage of resources.
 	Quota ResourceDetails `json:"quota"`
+	// SoftLimit configures a threshold below the quota. Requests that push the usage above
+	// it are still admitted, but receive a warning and are reported via events and metrics.
+	// +optional
+	SoftLimit *ResourceQuotaSoftLimit `json:"softLimit,omitempty"`
+	// Burst configures a time-boxed allowance above the quota. Once the global usage exceeds
+	// the quota, the allowance stays available for the configured duration and expires
+	// automatically afterwards.
+	// +optional
+	Burst *ResourceQuotaBurst `json:"burst,omitempty"`
+}
+
+// ResourceQuotaSoftLimit describes the soft threshold of a resource quota. It applies to
+// the CPU, memory, storage and object count dimensions, but not to accelerators.
+type ResourceQuotaSoftLimit struct {
+	// Percentage of the quota above which the usage exceeds the soft limit.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Percentage int32 `json:"percentage"`
+}
+
+// ResourceQuotaBurst describes a temporary allowance above the quota. It applies to
+// the CPU, memory, storage and object count dimensions, but not to accelerators.
+type ResourceQuotaBurst struct {
+	// Percentage of the quota that may be used in addition to the quota while the burst
+	// allowance is active.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Percentage int32 `json:"percentage"`
+	// Duration is the time the burst allowance stays active after the global usage first
+	// exceeded the quota. A new burst can only start after the usage went back below the quota.
+	Duration metav1.Duration `json:"duration"`
 }
 
 // ResourceQuotaStatus describes the current state of a resource quota.
@@ -81,6 +113,25 @@ type ResourceQuotaStatus struct {
 	// accounting state. It is synchronized from the master to every Seed copy.
 	// +optional
 	GlobalAcceleratorAccounting *ResourceQuotaGlobalAcceleratorAccountingStatus `json:"globalAcceleratorAccounting,omitempty"`
+
+	// Burst tracks the burst allowance that started when the global usage exceeded the
+	// quota. It is owned by the master and synchronized to every Seed copy.
+	// +optional
+	Burst *ResourceQuotaBurstStatus `json:"burst,omitempty"`
+}
+
+// ResourceQuotaBurstStatus describes the time window of a started burst allowance.
+type ResourceQuotaBurstStatus struct {
+	// StartTime is the time the global usage first exceeded the quota.
+	StartTime metav1.Time `json:"startTime"`
+	// ExpirationTime is the time after which the burst allowance no longer admits requests
+	// above the quota.
+	ExpirationTime metav1.Time `json:"expirationTime"`
+}
+
+// Expired returns true if the burst allowance is no longer active at the given time.
+func (s *ResourceQuotaBurstStatus) Expired(now time.Time) bool {
+	return !now.Before(s.ExpirationTime.Time)
 }
 
 // AcceleratorAccountingRevision is an opaque master-issued identity for an accelerator
@@ -255,6 +306,15 @@ type ResourceDetails struct {
 	Memory *resource.Quantity `json:"memory,omitempty"`
 	// Storage represents the disk size. For the format, please check k8s.io/apimachinery/pkg/api/resource.Quantity.
 	Storage *resource.Quantity `json:"storage,omitempty"`
+	// Clusters is the number of user clusters. It is not part of the resource usage of a single cluster.
+	Clusters *resource.Quantity `json:"clusters,omitempty"`
+	// Nodes is the number of Machines, and thus worker nodes, across all user clusters.
+	Nodes *resource.Quantity `json:"nodes,omitempty"`
+	// LoadBalancers is the number of Services of type LoadBalancer across all user clusters.
+	// Every such Service usually consumes a cloud load balancer and a public IP address.
+	LoadBalancers *resource.Quantity `json:"loadBalancers,omitempty"`
+	// PersistentVolumeClaims is the number of PersistentVolumeClaims across all user clusters.
+	PersistentVolumeClaims *resource.Quantity `json:"persistentVolumeClaims,omitempty"`
 	// Accelerators holds provider-specific accelerator limits. An absent or empty list means
 	// that no accelerator limits are configured. A missing provider or provider/resource pair
 	// is unconstrained; this field is not an allowlist. The list is atomic so a future provider
@@ -267,7 +327,34 @@ type ResourceDetails struct {
 }
 
 func (r ResourceDetails) IsEmpty() bool {
-	return (r.CPU == nil || r.CPU.IsZero()) && (r.Memory == nil || r.Memory.IsZero()) && (r.Storage == nil || r.Storage.IsZero())
+	return (r.CPU == nil || r.CPU.IsZero()) && (r.Memory == nil || r.Memory.IsZero()) && (r.Storage == nil || r.Storage.IsZero()) &&
+		(r.Clusters == nil || r.Clusters.IsZero()) && (r.Nodes == nil || r.Nodes.IsZero()) &&
+		(r.LoadBalancers == nil || r.LoadBalancers.IsZero()) && (r.PersistentVolumeClaims == nil || r.PersistentVolumeClaims.IsZero())
+}
+
+// AddObjectCountUsage adds the object count dimensions (clusters, nodes, LoadBalancers and
+// PersistentVolumeClaims) of usage to target without retaining quantities owned by usage.
+// Dimensions that are not set in usage are not touched in target.
+func AddObjectCountUsage(target *ResourceDetails, usage ResourceDetails) {
+	if target == nil {
+		return
+	}
+
+	add := func(target **resource.Quantity, usage *resource.Quantity) {
+		if usage == nil {
+			return
+		}
+		sum := usage.DeepCopy()
+		if *target != nil {
+			sum.Add(**target)
+		}
+		*target = &sum
+	}
+
+	add(&target.Clusters, usage.Clusters)
+	add(&target.Nodes, usage.Nodes)
+	add(&target.LoadBalancers, usage.LoadBalancers)
+	add(&target.PersistentVolumeClaims, usage.PersistentVolumeClaims)
 }
 
 // +kubebuilder:object:generate=true
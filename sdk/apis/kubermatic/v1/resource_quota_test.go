@@ -156,6 +156,19 @@ func TestResourceDetailsIsEmpty(t *testing.T) {
 				CPU: quantityPtr("1"),
 			},
 		},
+		{
+			name: "zero object count",
+			resourceDetails: ResourceDetails{
+				Clusters: quantityPtr("0"),
+			},
+			expected: true,
+		},
+		{
+			name: "non-zero object count",
+			resourceDetails: ResourceDetails{
+				LoadBalancers: quantityPtr("10"),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -391,6 +404,34 @@ func TestAddAcceleratorUsage(t *testing.T) {
 	}
 }
 
+func TestAddObjectCountUsage(t *testing.T) {
+	target := ResourceDetails{
+		Clusters: quantityPtr("2"),
+		Nodes:    quantityPtr("5"),
+	}
+	usage := ResourceDetails{
+		Clusters:               quantityPtr("1"),
+		LoadBalancers:          quantityPtr("3"),
+		PersistentVolumeClaims: quantityPtr("0"),
+	}
+
+	AddObjectCountUsage(&target, usage)
+
+	assertQuantityEqual(t, target.Clusters, "3")
+	assertQuantityEqual(t, target.Nodes, "5")
+	assertQuantityEqual(t, target.LoadBalancers, "3")
+	assertQuantityEqual(t, target.PersistentVolumeClaims, "0")
+	if target.CPU != nil || target.Memory != nil || target.Storage != nil {
+		t.Fatalf("expected scalar resources to remain unset, got %#v", target)
+	}
+
+	// Mutating the usage after aggregation must not affect the result.
+	usage.LoadBalancers.Add(resource.MustParse("100"))
+	assertQuantityEqual(t, target.LoadBalancers, "3")
+
+	AddObjectCountUsage(nil, usage)
+}
+
 func TestAcceleratorAccountingStatusJSONRoundTrip(t *testing.T) {
 	observedAt := metav1.NewTime(time.Date(2026, time.August, 14, 12, 34, 56, 0, time.UTC))
 	clusterStatus := ClusterStatus{
@@ -109,6 +109,9 @@ type SettingSpec struct {
 	// CleanupOptions control what happens when a cluster is deleted via the dashboard.
 	// +optional
 	CleanupOptions CleanupOptions `json:"cleanupOptions,omitempty"`
+	// StaleClusterPolicy flags abandoned clusters and optionally hibernates or deletes them.
+	// +optional
+	StaleClusterPolicy *StaleClusterPolicy `json:"staleClusterPolicy,omitempty"`
 	// +optional
 	OpaOptions OpaOptions `json:"opaOptions,omitempty"`
 	// +optional
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// StaleClusterCleanupOptOutLabel excludes a cluster from the stale cluster policy when set
+	// to "true". Opted out clusters are never flagged, hibernated or deleted.
+	StaleClusterCleanupOptOutLabel = "kkp.k8c.io/skip-stale-cluster-cleanup"
+)
+
+// StaleClusterAction is the action taken on a stale cluster once its grace period has passed.
+//
+// +kubebuilder:validation:Enum=None;Hibernate;Delete
+type StaleClusterAction string
+
+const (
+	// StaleClusterActionNone only flags stale clusters and sends notifications.
+	StaleClusterActionNone StaleClusterAction = "None"
+	// StaleClusterActionHibernate hibernates stale clusters, keeping their volumes.
+	StaleClusterActionHibernate StaleClusterAction = "Hibernate"
+	// StaleClusterActionDelete deletes stale clusters. The cleanup of LoadBalancers and PVCs
+	// follows the enforced cleanup options of the global settings.
+	StaleClusterActionDelete StaleClusterAction = "Delete"
+)
+
+// StaleClusterPolicy configures how abandoned clusters are detected and cleaned up. A cluster
+// is stale if it is older than the minimum age and matches all configured criteria. At least
+// one of `maxNodes`, `idleDuration` or `ownerLeftProject` has to be configured.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.enabled) || !self.enabled || has(self.maxNodes) || has(self.idleDuration) || (has(self.ownerLeftProject) && self.ownerLeftProject)",message="at least one stale cluster criterion must be configured"
+type StaleClusterPolicy struct {
+	// Enabled enables the detection of stale clusters.
+	Enabled bool `json:"enabled,omitempty"`
+	// Optional: MinAge is the minimum age of a cluster before it can be considered stale.
+	// Defaults to 168h.
+	MinAge *metav1.Duration `json:"minAge,omitempty"`
+	// Optional: MaxNodes flags clusters that have at most this many nodes, e.g. 0 for clusters
+	// without any nodes.
+	// +kubebuilder:validation:Minimum=0
+	MaxNodes *int32 `json:"maxNodes,omitempty"`
+	// Optional: IdleDuration flags clusters that did not run any workloads for at least this
+	// long. Pods in system namespaces are not considered workloads.
+	IdleDuration *metav1.Duration `json:"idleDuration,omitempty"`
+	// Optional: OwnerLeftProject flags clusters whose owner is no longer a member of the
+	// cluster's project, neither directly nor through a group.
+	OwnerLeftProject bool `json:"ownerLeftProject,omitempty"`
+	// Optional: Action is taken once a cluster has been stale for the grace period.
+	// Defaults to `None`.
+	Action StaleClusterAction `json:"action,omitempty"`
+	// Optional: GracePeriod is the time between flagging a cluster as stale and taking the
+	// configured action. Defaults to 168h.
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+	// Optional: WebhookURL receives a JSON notification via HTTP POST whenever a cluster is
+	// flagged as stale, becomes active again or the configured action is taken.
+	WebhookURL string `json:"webhookURL,omitempty"`
+}
+
+// ClusterStalenessStatus is the state of a cluster regarding the stale cluster policy.
+type ClusterStalenessStatus struct {
+	// LastActivityTime is the last time the cluster was seen running workloads.
+	// +optional
+	LastActivityTime *metav1.Time `json:"lastActivityTime,omitempty"`
+	// StaleSince is the time the cluster was flagged as stale. It is empty while the
+	// cluster is not stale.
+	// +optional
+	StaleSince *metav1.Time `json:"staleSince,omitempty"`
+	// Reasons explain why the cluster is considered stale.
+	// +optional
+	Reasons []string `json:"reasons,omitempty"`
+	// ActionTaken is the action that was taken on the stale cluster, if any.
+	// +optional
+	ActionTaken StaleClusterAction `json:"actionTaken,omitempty"`
+}
+
+// IsStale returns true if the cluster is currently flagged as stale.
+func (s *ClusterStatus) IsStale() bool {
+	return s.Staleness != nil && s.Staleness.StaleSince != nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// UpdateRolloutResourceName represents "Resource" defined in Kubernetes.
+	UpdateRolloutResourceName = "updaterollouts"
+
+	// UpdateRolloutKindName represents "Kind" defined in Kubernetes.
+	UpdateRolloutKindName = "UpdateRollout"
+
+	// UpdateRolloutName is the name of the single UpdateRollout object, which is maintained on
+	// the master cluster and copied to all seed clusters.
+	UpdateRolloutName = "kubermatic"
+)
+
+// +kubebuilder:validation:Enum=Idle;Progressing;Halted
+
+// UpdateRolloutPhase is the phase of the rollout of automatic updates.
+type UpdateRolloutPhase string
+
+const (
+	// UpdateRolloutPhaseIdle means that all clusters are up-to-date.
+	UpdateRolloutPhaseIdle UpdateRolloutPhase = "Idle"
+	// UpdateRolloutPhaseProgressing means that the clusters of the active wave are being updated.
+	UpdateRolloutPhaseProgressing UpdateRolloutPhase = "Progressing"
+	// UpdateRolloutPhaseHalted means that no automatic updates are applied, because clusters of
+	// a started wave are unhealthy for longer than the configured timeout.
+	UpdateRolloutPhaseHalted UpdateRolloutPhase = "Halted"
+)
+
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:object:generate=true
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".status.phase",name="Phase",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.activeWave",name="Active Wave",type="string"
+// +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
+
+// UpdateRollout reports the state of the rollout of automatic updates in waves, as configured in
+// the KubermaticConfiguration. It is maintained by the master-controller-manager and copied to all
+// seeds, where the auto-update-controller only updates clusters of the active wave.
+type UpdateRollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status UpdateRolloutStatus `json:"status,omitempty"`
+}
+
+// UpdateRolloutStatus is the state of the rollout of automatic updates.
+type UpdateRolloutStatus struct {
+	// Phase is the phase of the rollout.
+	Phase UpdateRolloutPhase `json:"phase,omitempty"`
+	// ActiveWave is the first wave that still has clusters which are not updated or not healthy.
+	// Clusters of this and all previous waves receive automatic updates, clusters of later waves wait.
+	// While the rollout is idle, this is the first wave, which starts the next rollout.
+	// +optional
+	ActiveWave string `json:"activeWave,omitempty"`
+	// Waves lists all waves in rollout order.
+	// +optional
+	Waves []UpdateRolloutWave `json:"waves,omitempty"`
+	// UnhealthyClusters lists the clusters of started waves that are not healthy.
+	// +optional
+	UnhealthyClusters []UpdateRolloutCluster `json:"unhealthyClusters,omitempty"`
+	// Message describes why the rollout is halted.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// LastUpdateTime is the time the status last changed.
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// UpdateRolloutWave summarizes the clusters of a single wave.
+type UpdateRolloutWave struct {
+	// Name is the name of the wave, i.e. the label value or seed name. The final wave of
+	// clusters without a wave has an empty name.
+	Name string `json:"name"`
+	// Clusters is the number of clusters in the wave.
+	Clusters int `json:"clusters"`
+	// PendingClusters is the number of clusters that still have to be updated.
+	PendingClusters int `json:"pendingClusters"`
+	// UnhealthyClusters is the number of clusters that are not healthy.
+	UnhealthyClusters int `json:"unhealthyClusters"`
+}
+
+// UpdateRolloutCluster identifies an unhealthy cluster.
+type UpdateRolloutCluster struct {
+	// Seed is the name of the seed the cluster is running on.
+	Seed string `json:"seed"`
+	// Name is the name of the cluster.
+	Name string `json:"name"`
+	// Wave is the name of the wave of the cluster.
+	Wave string `json:"wave"`
+	// UnhealthySince is the time the cluster was first seen unhealthy.
+	UnhealthySince metav1.Time `json:"unhealthySince"`
+}
+
+// +kubebuilder:object:generate=true
+// +kubebuilder:object:root=true
+
+// UpdateRolloutList is a list of UpdateRollouts.
+type UpdateRolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of UpdateRollouts.
+	Items []UpdateRollout `json:"items"`
+}
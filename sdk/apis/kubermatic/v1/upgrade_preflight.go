@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpgradePreflightMode defines how removed APIs that are still in use affect a control plane update.
+//
+// +kubebuilder:validation:Enum=Block;Warn
+type UpgradePreflightMode string
+
+const (
+	// UpgradePreflightModeBlock holds back the control plane update while removed APIs are in use.
+	UpgradePreflightModeBlock UpgradePreflightMode = "Block"
+	// UpgradePreflightModeWarn only reports removed APIs that are in use.
+	UpgradePreflightModeWarn UpgradePreflightMode = "Warn"
+)
+
+// UpgradePreflightSettings configures the check for removed Kubernetes APIs that runs
+// before the control plane is updated to a new minor version.
+type UpgradePreflightSettings struct {
+	// Mode defines whether removed APIs that are still in use block the update (`Block`)
+	// or are only reported (`Warn`). Defaults to `Block`.
+	// +optional
+	Mode UpgradePreflightMode `json:"mode,omitempty"`
+
+	// OverrideVersion allows updating the cluster to exactly this Kubernetes version, even though
+	// the preflight check found removed APIs that are still in use. It serves as an explicit
+	// acknowledgement of the findings reported in the cluster status.
+	// +optional
+	OverrideVersion string `json:"overrideVersion,omitempty"`
+}
+
+// GetMode returns the configured mode, defaulting to Block.
+func (s *UpgradePreflightSettings) GetMode() UpgradePreflightMode {
+	if s == nil || s.Mode == "" {
+		return UpgradePreflightModeBlock
+	}
+
+	return s.Mode
+}
+
+// Overrides returns true if the findings of the preflight check are acknowledged for the given version.
+func (s *UpgradePreflightSettings) Overrides(version string) bool {
+	return s != nil && s.OverrideVersion != "" && s.OverrideVersion == version
+}
+
+// RemovedAPISource describes how a removed API was found to be in use.
+//
+// +kubebuilder:validation:Enum=Requests;StoredObjects;HelmReleases
+type RemovedAPISource string
+
+const (
+	// RemovedAPISourceRequests means that clients requested the API, as reported by the
+	// `apiserver_requested_deprecated_apis` metric of the user cluster apiserver.
+	RemovedAPISourceRequests RemovedAPISource = "Requests"
+	// RemovedAPISourceStoredObjects means that objects were last written using the API.
+	RemovedAPISourceStoredObjects RemovedAPISource = "StoredObjects"
+	// RemovedAPISourceHelmReleases means that manifests of deployed Helm releases use the API.
+	RemovedAPISourceHelmReleases RemovedAPISource = "HelmReleases"
+)
+
+// RemovedAPIUsage describes a use of an API that is removed in the target Kubernetes version.
+type RemovedAPIUsage struct {
+	// GroupVersion is the removed API group version, e.g. `flowcontrol.apiserver.k8s.io/v1beta3`.
+	GroupVersion string `json:"groupVersion"`
+	// Resource is the resource served by the removed API, e.g. `flowschemas`.
+	Resource string `json:"resource"`
+	// RemovedIn is the Kubernetes minor version that removes the API, e.g. `1.32`.
+	RemovedIn string `json:"removedIn"`
+	// Source describes how the usage was found.
+	Source RemovedAPISource `json:"source"`
+	// Objects lists the offending objects or Helm releases as `namespace/name`.
+	// +optional
+	Objects []string `json:"objects,omitempty"`
+	// Clients lists the field managers that wrote the offending objects using the removed API.
+	// +optional
+	Clients []string `json:"clients,omitempty"`
+}
+
+// UpgradePreflightStatus contains the result of the check for removed APIs before a control plane update.
+type UpgradePreflightStatus struct {
+	// TargetVersion is the Kubernetes version the check was performed for.
+	TargetVersion string `json:"targetVersion"`
+	// LastCheckTime is the time the check was last performed.
+	LastCheckTime metav1.Time `json:"lastCheckTime"`
+	// RemovedAPIs lists the APIs that are removed until the target version and are still in use.
+	// +optional
+	RemovedAPIs []RemovedAPIUsage `json:"removedAPIs,omitempty"`
+}
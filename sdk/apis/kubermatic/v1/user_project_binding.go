@@ -17,6 +17,8 @@ limitations under the License.
 package v1
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -34,6 +36,7 @@ const (
 // +kubebuilder:printcolumn:JSONPath=".spec.projectID",name="ProjectID",type="string"
 // +kubebuilder:printcolumn:JSONPath=".spec.group",name="Group",type="string"
 // +kubebuilder:printcolumn:JSONPath=".spec.userEmail",name="UserEmail",type="string"
+// +kubebuilder:printcolumn:JSONPath=".spec.expiresAt",name="ExpiresAt",type="date"
 // +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
 
 // UserProjectBinding specifies a binding between a user and a project
@@ -58,6 +61,20 @@ type UserProjectBindingSpec struct {
 	// Group is the user's group, determining their permissions within the project.
 	// Must be one of `owners`, `editors`, `viewers` or `projectmanagers`.
 	Group string `json:"group"`
+
+	// ExpiresAt optionally limits the binding to a point in time, after which the user loses
+	// access to the project again. Expired bindings are kept for auditing for a while
+	// before they are deleted.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+	// Reason optionally documents why the binding was created, e.g. an incident or ticket.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// IsExpired returns true if the binding has an expiry time that is not after now.
+func (s *UserProjectBindingSpec) IsExpired(now time.Time) bool {
+	return s.ExpiresAt != nil && !s.ExpiresAt.After(now)
 }
 
 // +kubebuilder:object:generate=true
@@ -23,12 +23,14 @@ package v1
 import (
 	"encoding/json"
 	templatesv1 "github.com/open-policy-agent/frameworks/constraint/pkg/apis/templates/v1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"k8c.io/kubermatic/sdk/v2/semver"
 	"k8c.io/machine-controller/sdk/providerconfig"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -1249,6 +1251,67 @@ func (in Bytes) DeepCopy() Bytes {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CNIMigrationNodeStatus) DeepCopyInto(out *CNIMigrationNodeStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIMigrationNodeStatus.
+func (in *CNIMigrationNodeStatus) DeepCopy() *CNIMigrationNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CNIMigrationNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CNIMigrationSource) DeepCopyInto(out *CNIMigrationSource) {
+	*out = *in
+	if in.PodsCIDRBlocks != nil {
+		in, out := &in.PodsCIDRBlocks, &out.PodsCIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIMigrationSource.
+func (in *CNIMigrationSource) DeepCopy() *CNIMigrationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CNIMigrationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CNIMigrationStatus) DeepCopyInto(out *CNIMigrationStatus) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]CNIMigrationNodeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIMigrationStatus.
+func (in *CNIMigrationStatus) DeepCopy() *CNIMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CNIMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CNIPluginSettings) DeepCopyInto(out *CNIPluginSettings) {
 	*out = *in
@@ -1531,6 +1594,187 @@ func (in *ClusterBackupOptions) DeepCopy() *ClusterBackupOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBackupPolicy) DeepCopyInto(out *ClusterBackupPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackupPolicy.
+func (in *ClusterBackupPolicy) DeepCopy() *ClusterBackupPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBackupPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterBackupPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBackupPolicyClusterStatus) DeepCopyInto(out *ClusterBackupPolicyClusterStatus) {
+	*out = *in
+	in.ClusterBackupScheduleStatus.DeepCopyInto(&out.ClusterBackupScheduleStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackupPolicyClusterStatus.
+func (in *ClusterBackupPolicyClusterStatus) DeepCopy() *ClusterBackupPolicyClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBackupPolicyClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBackupPolicyList) DeepCopyInto(out *ClusterBackupPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterBackupPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackupPolicyList.
+func (in *ClusterBackupPolicyList) DeepCopy() *ClusterBackupPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBackupPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterBackupPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBackupPolicySchedule) DeepCopyInto(out *ClusterBackupPolicySchedule) {
+	*out = *in
+	in.ClusterBackupScheduleStatus.DeepCopyInto(&out.ClusterBackupScheduleStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackupPolicySchedule.
+func (in *ClusterBackupPolicySchedule) DeepCopy() *ClusterBackupPolicySchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBackupPolicySchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBackupPolicySpec) DeepCopyInto(out *ClusterBackupPolicySpec) {
+	*out = *in
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IncludedNamespaces != nil {
+		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.StorageLocation != nil {
+		in, out := &in.StorageLocation, &out.StorageLocation
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackupPolicySpec.
+func (in *ClusterBackupPolicySpec) DeepCopy() *ClusterBackupPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBackupPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBackupPolicyStatus) DeepCopyInto(out *ClusterBackupPolicyStatus) {
+	*out = *in
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterBackupPolicyClusterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackupPolicyStatus.
+func (in *ClusterBackupPolicyStatus) DeepCopy() *ClusterBackupPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBackupPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBackupScheduleStatus) DeepCopyInto(out *ClusterBackupScheduleStatus) {
+	*out = *in
+	if in.ValidationErrors != nil {
+		in, out := &in.ValidationErrors, &out.ValidationErrors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackupScheduleStatus.
+func (in *ClusterBackupScheduleStatus) DeepCopy() *ClusterBackupScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBackupScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterBackupStorageLocation) DeepCopyInto(out *ClusterBackupStorageLocation) {
 	*out = *in
@@ -1627,6 +1871,75 @@ func (in *ClusterEncryptionStatus) DeepCopy() *ClusterEncryptionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHibernationSchedule) DeepCopyInto(out *ClusterHibernationSchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHibernationSchedule.
+func (in *ClusterHibernationSchedule) DeepCopy() *ClusterHibernationSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHibernationSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHibernationSettings) DeepCopyInto(out *ClusterHibernationSettings) {
+	*out = *in
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(ClusterHibernationSchedule)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHibernationSettings.
+func (in *ClusterHibernationSettings) DeepCopy() *ClusterHibernationSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHibernationSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHibernationStatus) DeepCopyInto(out *ClusterHibernationStatus) {
+	*out = *in
+	if in.MachineDeploymentReplicas != nil {
+		in, out := &in.MachineDeploymentReplicas, &out.MachineDeploymentReplicas
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ControlPlaneReplicas != nil {
+		in, out := &in.ControlPlaneReplicas, &out.ControlPlaneReplicas
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.LastScheduledTransition != nil {
+		in, out := &in.LastScheduledTransition, &out.LastScheduledTransition
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHibernationStatus.
+func (in *ClusterHibernationStatus) DeepCopy() *ClusterHibernationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHibernationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterList) DeepCopyInto(out *ClusterList) {
 	*out = *in
@@ -1706,6 +2019,125 @@ func (in *ClusterNetworkingConfig) DeepCopy() *ClusterNetworkingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRestore) DeepCopyInto(out *ClusterRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRestore.
+func (in *ClusterRestore) DeepCopy() *ClusterRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRestoreList) DeepCopyInto(out *ClusterRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRestoreList.
+func (in *ClusterRestoreList) DeepCopy() *ClusterRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRestoreSpec) DeepCopyInto(out *ClusterRestoreSpec) {
+	*out = *in
+	if in.IncludedNamespaces != nil {
+		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceMapping != nil {
+		in, out := &in.NamespaceMapping, &out.NamespaceMapping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRestoreSpec.
+func (in *ClusterRestoreSpec) DeepCopy() *ClusterRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRestoreStatus) DeepCopyInto(out *ClusterRestoreStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(velerov1.RestoreProgress)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRestoreStatus.
+func (in *ClusterRestoreStatus) DeepCopy() *ClusterRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 	*out = *in
@@ -1753,6 +2185,26 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(UpdateWindow)
 		**out = **in
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
+	if in.UpgradePreflight != nil {
+		in, out := &in.UpgradePreflight, &out.UpgradePreflight
+		*out = new(UpgradePreflightSettings)
+		**out = **in
+	}
+	if in.NodeUpgradePolicy != nil {
+		in, out := &in.NodeUpgradePolicy, &out.NodeUpgradePolicy
+		*out = new(NodeUpgradePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(ClusterHibernationSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdmissionPlugins != nil {
 		in, out := &in.AdmissionPlugins, &out.AdmissionPlugins
 		*out = make([]string, len(*in))
@@ -1852,6 +2304,34 @@ func (in *ClusterSpec) DeepCopy() *ClusterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStalenessStatus) DeepCopyInto(out *ClusterStalenessStatus) {
+	*out = *in
+	if in.LastActivityTime != nil {
+		in, out := &in.LastActivityTime, &out.LastActivityTime
+		*out = (*in).DeepCopy()
+	}
+	if in.StaleSince != nil {
+		in, out := &in.StaleSince, &out.StaleSince
+		*out = (*in).DeepCopy()
+	}
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStalenessStatus.
+func (in *ClusterStalenessStatus) DeepCopy() *ClusterStalenessStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStalenessStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 	*out = *in
@@ -1899,6 +2379,60 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 		*out = new(ClusterAcceleratorAccountingStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BackupSchedules != nil {
+		in, out := &in.BackupSchedules, &out.BackupSchedules
+		*out = make([]ClusterBackupPolicySchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PendingUpdates != nil {
+		in, out := &in.PendingUpdates, &out.PendingUpdates
+		*out = make(map[string]PendingUpdate, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.UpgradePreflight != nil {
+		in, out := &in.UpgradePreflight, &out.UpgradePreflight
+		*out = new(UpgradePreflightStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreUpdateEtcdBackup != nil {
+		in, out := &in.PreUpdateEtcdBackup, &out.PreUpdateEtcdBackup
+		*out = new(PreUpdateEtcdBackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeUpgrade != nil {
+		in, out := &in.NodeUpgrade, &out.NodeUpgrade
+		*out = new(NodeUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(ClusterHibernationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Staleness != nil {
+		in, out := &in.Staleness, &out.Staleness
+		*out = new(ClusterStalenessStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdStorageMigration != nil {
+		in, out := &in.EtcdStorageMigration, &out.EtcdStorageMigration
+		*out = new(EtcdStorageMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdMaintenance != nil {
+		in, out := &in.EtcdMaintenance, &out.EtcdMaintenance
+		*out = new(EtcdMaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CNIMigration != nil {
+		in, out := &in.CNIMigration, &out.CNIMigration
+		*out = new(CNIMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
@@ -2684,6 +3218,11 @@ func (in *DatacenterSpec) DeepCopyInto(out *DatacenterSpec) {
 		*out = new(KyvernoConfigurations)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DefaultMaintenanceWindow != nil {
+		in, out := &in.DefaultMaintenanceWindow, &out.DefaultMaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterSpec.
@@ -3526,6 +4065,81 @@ func (in *EtcdBackupRestore) DeepCopy() *EtcdBackupRestore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdMaintenanceSettings) DeepCopyInto(out *EtcdMaintenanceSettings) {
+	*out = *in
+	if in.DefragmentationThreshold != nil {
+		in, out := &in.DefragmentationThreshold, &out.DefragmentationThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.QuotaWarningThreshold != nil {
+		in, out := &in.QuotaWarningThreshold, &out.QuotaWarningThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxQuotaBackendGB != nil {
+		in, out := &in.MaxQuotaBackendGB, &out.MaxQuotaBackendGB
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdMaintenanceSettings.
+func (in *EtcdMaintenanceSettings) DeepCopy() *EtcdMaintenanceSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdMaintenanceSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdMaintenanceStatus) DeepCopyInto(out *EtcdMaintenanceStatus) {
+	*out = *in
+	out.QuotaBackendBytes = in.QuotaBackendBytes.DeepCopy()
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]EtcdMemberDatabaseStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastCheckTime.DeepCopyInto(&out.LastCheckTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdMaintenanceStatus.
+func (in *EtcdMaintenanceStatus) DeepCopy() *EtcdMaintenanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdMaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdMemberDatabaseStatus) DeepCopyInto(out *EtcdMemberDatabaseStatus) {
+	*out = *in
+	out.DatabaseSize = in.DatabaseSize.DeepCopy()
+	out.DatabaseSizeInUse = in.DatabaseSizeInUse.DeepCopy()
+	if in.LastDefragmentationTime != nil {
+		in, out := &in.LastDefragmentationTime, &out.LastDefragmentationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdMemberDatabaseStatus.
+func (in *EtcdMemberDatabaseStatus) DeepCopy() *EtcdMemberDatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdMemberDatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdRestore) DeepCopyInto(out *EtcdRestore) {
 	*out = *in
@@ -3654,6 +4268,11 @@ func (in *EtcdStatefulSetSettings) DeepCopyInto(out *EtcdStatefulSetSettings) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(EtcdMaintenanceSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdStatefulSetSettings.
@@ -3666,6 +4285,29 @@ func (in *EtcdStatefulSetSettings) DeepCopy() *EtcdStatefulSetSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdStorageMigrationStatus) DeepCopyInto(out *EtcdStorageMigrationStatus) {
+	*out = *in
+	out.DiskSize = in.DiskSize.DeepCopy()
+	if in.MigratedMembers != nil {
+		in, out := &in.MigratedMembers, &out.MigratedMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdStorageMigrationStatus.
+func (in *EtcdStorageMigrationStatus) DeepCopy() *EtcdStorageMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdStorageMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventRateLimitConfig) DeepCopyInto(out *EventRateLimitConfig) {
 	*out = *in
@@ -3879,6 +4521,21 @@ func (in *ExternalClusterAKSCloudSpec) DeepCopy() *ExternalClusterAKSCloudSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalClusterApplicationSettings) DeepCopyInto(out *ExternalClusterApplicationSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalClusterApplicationSettings.
+func (in *ExternalClusterApplicationSettings) DeepCopy() *ExternalClusterApplicationSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalClusterApplicationSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalClusterBringYourOwnCloudSpec) DeepCopyInto(out *ExternalClusterBringYourOwnCloudSpec) {
 	*out = *in
@@ -4098,6 +4755,21 @@ func (in *ExternalClusterNetworkingConfig) DeepCopy() *ExternalClusterNetworking
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalClusterPolicySettings) DeepCopyInto(out *ExternalClusterPolicySettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalClusterPolicySettings.
+func (in *ExternalClusterPolicySettings) DeepCopy() *ExternalClusterPolicySettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalClusterPolicySettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalClusterProviderVersioningConfiguration) DeepCopyInto(out *ExternalClusterProviderVersioningConfiguration) {
 	*out = *in
@@ -4143,6 +4815,16 @@ func (in *ExternalClusterSpec) DeepCopyInto(out *ExternalClusterSpec) {
 	out.Version = in.Version.DeepCopy()
 	in.CloudSpec.DeepCopyInto(&out.CloudSpec)
 	in.ClusterNetwork.DeepCopyInto(&out.ClusterNetwork)
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = new(ExternalClusterApplicationSettings)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = new(ExternalClusterPolicySettings)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalClusterSpec.
@@ -4264,7 +4946,7 @@ func (in *GroupProjectBinding) DeepCopyInto(out *GroupProjectBinding) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupProjectBinding.
@@ -4320,6 +5002,10 @@ func (in *GroupProjectBindingList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupProjectBindingSpec) DeepCopyInto(out *GroupProjectBindingSpec) {
 	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupProjectBindingSpec.
@@ -4467,6 +5153,7 @@ func (in *IPAMPool) DeepCopyInto(out *IPAMPool) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPool.
@@ -4484,7 +5171,27 @@ func (in *IPAMPool) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
-	return nil
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolBackend) DeepCopyInto(out *IPAMPoolBackend) {
+	*out = *in
+	if in.NetBox != nil {
+		in, out := &in.NetBox, &out.NetBox
+		*out = new(IPAMPoolNetBoxBackend)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolBackend.
+func (in *IPAMPoolBackend) DeepCopy() *IPAMPoolBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolBackend)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -4512,6 +5219,28 @@ func (in *IPAMPoolDatacenterSettings) DeepCopy() *IPAMPoolDatacenterSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolDatacenterStatus) DeepCopyInto(out *IPAMPoolDatacenterStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolDatacenterStatus.
+func (in *IPAMPoolDatacenterStatus) DeepCopy() *IPAMPoolDatacenterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolDatacenterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMPoolList) DeepCopyInto(out *IPAMPoolList) {
 	*out = *in
@@ -4544,6 +5273,42 @@ func (in *IPAMPoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolNetBoxBackend) DeepCopyInto(out *IPAMPoolNetBoxBackend) {
+	*out = *in
+	out.TokenReference = in.TokenReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolNetBoxBackend.
+func (in *IPAMPoolNetBoxBackend) DeepCopy() *IPAMPoolNetBoxBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolNetBoxBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolReservation) DeepCopyInto(out *IPAMPoolReservation) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolReservation.
+func (in *IPAMPoolReservation) DeepCopy() *IPAMPoolReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMPoolSpec) DeepCopyInto(out *IPAMPoolSpec) {
 	*out = *in
@@ -4554,6 +5319,23 @@ func (in *IPAMPoolSpec) DeepCopyInto(out *IPAMPoolSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reservations != nil {
+		in, out := &in.Reservations, &out.Reservations
+		*out = make([]IPAMPoolReservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(IPAMPoolBackend)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolSpec.
@@ -4566,6 +5348,28 @@ func (in *IPAMPoolSpec) DeepCopy() *IPAMPoolSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolStatus) DeepCopyInto(out *IPAMPoolStatus) {
+	*out = *in
+	if in.Datacenters != nil {
+		in, out := &in.Datacenters, &out.Datacenters
+		*out = make(map[string]IPAMPoolDatacenterStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolStatus.
+func (in *IPAMPoolStatus) DeepCopy() *IPAMPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPVSConfiguration) DeepCopyInto(out *IPVSConfiguration) {
 	*out = *in
@@ -5260,6 +6064,7 @@ func (in *KubermaticMasterControllerConfiguration) DeepCopyInto(out *KubermaticM
 		*out = new(int32)
 		**out = **in
 	}
+	out.PlatformAudit = in.PlatformAudit
 	in.PodSchedulingConfigurations.DeepCopyInto(&out.PodSchedulingConfigurations)
 }
 
@@ -5273,6 +6078,21 @@ func (in *KubermaticMasterControllerConfiguration) DeepCopy() *KubermaticMasterC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubermaticPlatformAuditConfiguration) DeepCopyInto(out *KubermaticPlatformAuditConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubermaticPlatformAuditConfiguration.
+func (in *KubermaticPlatformAuditConfiguration) DeepCopy() *KubermaticPlatformAuditConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KubermaticPlatformAuditConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubermaticProjectsMigratorConfiguration) DeepCopyInto(out *KubermaticProjectsMigratorConfiguration) {
 	*out = *in
@@ -5555,6 +6375,11 @@ func (in *KubermaticVersioningConfiguration) DeepCopyInto(out *KubermaticVersion
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.UpdateRollout != nil {
+		in, out := &in.UpdateRollout, &out.UpdateRollout
+		*out = new(UpdateRolloutConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubermaticVersioningConfiguration.
@@ -6004,6 +6829,21 @@ func (in *MachineDeploymentOptions) DeepCopy() *MachineDeploymentOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDeploymentUpgradeStatus) DeepCopyInto(out *MachineDeploymentUpgradeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentUpgradeStatus.
+func (in *MachineDeploymentUpgradeStatus) DeepCopy() *MachineDeploymentUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDeploymentUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineFlavorFilter) DeepCopyInto(out *MachineFlavorFilter) {
 	*out = *in
@@ -6039,6 +6879,21 @@ func (in *MachineNetworkingConfig) DeepCopy() *MachineNetworkingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagementProxySettings) DeepCopyInto(out *ManagementProxySettings) {
 	*out = *in
@@ -6282,6 +7137,64 @@ func (in *NodeSettings) DeepCopy() *NodeSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeUpgradePolicy) DeepCopyInto(out *NodeUpgradePolicy) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SkipDrainNodeSelectors != nil {
+		in, out := &in.SkipDrainNodeSelectors, &out.SkipDrainNodeSelectors
+		*out = make([]metav1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeUpgradePolicy.
+func (in *NodeUpgradePolicy) DeepCopy() *NodeUpgradePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeUpgradePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeUpgradeStatus) DeepCopyInto(out *NodeUpgradeStatus) {
+	*out = *in
+	if in.MachineDeployments != nil {
+		in, out := &in.MachineDeployments, &out.MachineDeployments
+		*out = make([]MachineDeploymentUpgradeStatus, len(*in))
+		copy(*out, *in)
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeUpgradeStatus.
+func (in *NodeUpgradeStatus) DeepCopy() *NodeUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeportProxyComponent) DeepCopyInto(out *NodeportProxyComponent) {
 	*out = *in
@@ -6734,6 +7647,22 @@ func (in Parameters) DeepCopy() Parameters {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingUpdate) DeepCopyInto(out *PendingUpdate) {
+	*out = *in
+	in.NextWindowStart.DeepCopyInto(&out.NextWindowStart)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingUpdate.
+func (in *PendingUpdate) DeepCopy() *PendingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSchedulingConfigurations) DeepCopyInto(out *PodSchedulingConfigurations) {
 	*out = *in
@@ -6834,6 +7763,51 @@ func (in *PolicyBindingList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBindingPreview) DeepCopyInto(out *PolicyBindingPreview) {
+	*out = *in
+	if in.ObservationWindow != nil {
+		in, out := &in.ObservationWindow, &out.ObservationWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBindingPreview.
+func (in *PolicyBindingPreview) DeepCopy() *PolicyBindingPreview {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBindingPreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBindingPreviewStatus) DeepCopyInto(out *PolicyBindingPreviewStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Violations != nil {
+		in, out := &in.Violations, &out.Violations
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBindingPreviewStatus.
+func (in *PolicyBindingPreviewStatus) DeepCopy() *PolicyBindingPreviewStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBindingPreviewStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyBindingSpec) DeepCopyInto(out *PolicyBindingSpec) {
 	*out = *in
@@ -6843,6 +7817,11 @@ func (in *PolicyBindingSpec) DeepCopyInto(out *PolicyBindingSpec) {
 		*out = new(KyvernoPolicyNamespace)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Preview != nil {
+		in, out := &in.Preview, &out.Preview
+		*out = new(PolicyBindingPreview)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBindingSpec.
@@ -6875,6 +7854,16 @@ func (in *PolicyBindingStatus) DeepCopyInto(out *PolicyBindingStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PolicyReport != nil {
+		in, out := &in.PolicyReport, &out.PolicyReport
+		*out = new(PolicyReportSummary)
+		**out = **in
+	}
+	if in.Preview != nil {
+		in, out := &in.Preview, &out.Preview
+		*out = new(PolicyBindingPreviewStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBindingStatus.
@@ -6887,6 +7876,21 @@ func (in *PolicyBindingStatus) DeepCopy() *PolicyBindingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyReportSummary) DeepCopyInto(out *PolicyReportSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyReportSummary.
+func (in *PolicyReportSummary) DeepCopy() *PolicyReportSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyReportSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyTemplate) DeepCopyInto(out *PolicyTemplate) {
 	*out = *in
@@ -6913,6 +7917,22 @@ func (in *PolicyTemplate) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTemplateComplianceStatus) DeepCopyInto(out *PolicyTemplateComplianceStatus) {
+	*out = *in
+	out.Results = in.Results
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTemplateComplianceStatus.
+func (in *PolicyTemplateComplianceStatus) DeepCopy() *PolicyTemplateComplianceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTemplateComplianceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyTemplateList) DeepCopyInto(out *PolicyTemplateList) {
 	*out = *in
@@ -7013,6 +8033,22 @@ func (in *PreAllocatedDataVolume) DeepCopy() *PreAllocatedDataVolume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreUpdateEtcdBackupStatus) DeepCopyInto(out *PreUpdateEtcdBackupStatus) {
+	*out = *in
+	in.FinishedTime.DeepCopyInto(&out.FinishedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreUpdateEtcdBackupStatus.
+func (in *PreUpdateEtcdBackupStatus) DeepCopy() *PreUpdateEtcdBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PreUpdateEtcdBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Preset) DeepCopyInto(out *Preset) {
 	*out = *in
@@ -7192,7 +8228,7 @@ func (in *Project) DeepCopyInto(out *Project) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
@@ -7260,6 +8296,28 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicyComplianceStatus) DeepCopyInto(out *ProjectPolicyComplianceStatus) {
+	*out = *in
+	out.Results = in.Results
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]PolicyTemplateComplianceStatus, len(*in))
+		copy(*out, *in)
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicyComplianceStatus.
+func (in *ProjectPolicyComplianceStatus) DeepCopy() *ProjectPolicyComplianceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicyComplianceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
@@ -7270,6 +8328,31 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.AllowedDatacenters != nil {
+		in, out := &in.AllowedDatacenters, &out.AllowedDatacenters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedSeeds != nil {
+		in, out := &in.AllowedSeeds, &out.AllowedSeeds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedProviders != nil {
+		in, out := &in.AllowedProviders, &out.AllowedProviders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedExposeStrategies != nil {
+		in, out := &in.AllowedExposeStrategies, &out.AllowedExposeStrategies
+		*out = make([]ExposeStrategy, len(*in))
+		copy(*out, *in)
+	}
+	if in.Suspension != nil {
+		in, out := &in.Suspension, &out.Suspension
+		*out = new(ProjectSuspension)
+		**out = **in
+	}
 	if in.DefaultTenantSpec != nil {
 		in, out := &in.DefaultTenantSpec, &out.DefaultTenantSpec
 		*out = new(runtime.RawExtension)
@@ -7290,6 +8373,16 @@ func (in *ProjectSpec) DeepCopy() *ProjectSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 	*out = *in
+	if in.Suspension != nil {
+		in, out := &in.Suspension, &out.Suspension
+		*out = new(ProjectSuspensionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicyCompliance != nil {
+		in, out := &in.PolicyCompliance, &out.PolicyCompliance
+		*out = new(ProjectPolicyComplianceStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
@@ -7302,6 +8395,37 @@ func (in *ProjectStatus) DeepCopy() *ProjectStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSuspension) DeepCopyInto(out *ProjectSuspension) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSuspension.
+func (in *ProjectSuspension) DeepCopy() *ProjectSuspension {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSuspension)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSuspensionStatus) DeepCopyInto(out *ProjectSuspensionStatus) {
+	*out = *in
+	in.SuspendedAt.DeepCopyInto(&out.SuspendedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSuspensionStatus.
+func (in *ProjectSuspensionStatus) DeepCopy() *ProjectSuspensionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSuspensionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 	*out = *in
@@ -7456,6 +8580,31 @@ func (in *RegistrySettings) DeepCopy() *RegistrySettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemovedAPIUsage) DeepCopyInto(out *RemovedAPIUsage) {
+	*out = *in
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Clients != nil {
+		in, out := &in.Clients, &out.Clients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemovedAPIUsage.
+func (in *RemovedAPIUsage) DeepCopy() *RemovedAPIUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(RemovedAPIUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceDetails) DeepCopyInto(out *ResourceDetails) {
 	*out = *in
@@ -7474,6 +8623,26 @@ func (in *ResourceDetails) DeepCopyInto(out *ResourceDetails) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.PersistentVolumeClaims != nil {
+		in, out := &in.PersistentVolumeClaims, &out.PersistentVolumeClaims
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	if in.Accelerators != nil {
 		in, out := &in.Accelerators, &out.Accelerators
 		*out = make([]AcceleratorQuota, len(*in))
@@ -7520,6 +8689,39 @@ func (in *ResourceQuota) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceQuotaBurst) DeepCopyInto(out *ResourceQuotaBurst) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaBurst.
+func (in *ResourceQuotaBurst) DeepCopy() *ResourceQuotaBurst {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuotaBurst)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceQuotaBurstStatus) DeepCopyInto(out *ResourceQuotaBurstStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.ExpirationTime.DeepCopyInto(&out.ExpirationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaBurstStatus.
+func (in *ResourceQuotaBurstStatus) DeepCopy() *ResourceQuotaBurstStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuotaBurstStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceQuotaGlobalAcceleratorAccountingStatus) DeepCopyInto(out *ResourceQuotaGlobalAcceleratorAccountingStatus) {
 	*out = *in
@@ -7594,11 +8796,36 @@ func (in *ResourceQuotaLocalAcceleratorAccountingStatus) DeepCopy() *ResourceQuo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceQuotaSoftLimit) DeepCopyInto(out *ResourceQuotaSoftLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaSoftLimit.
+func (in *ResourceQuotaSoftLimit) DeepCopy() *ResourceQuotaSoftLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuotaSoftLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceQuotaSpec) DeepCopyInto(out *ResourceQuotaSpec) {
 	*out = *in
 	out.Subject = in.Subject
 	in.Quota.DeepCopyInto(&out.Quota)
+	if in.SoftLimit != nil {
+		in, out := &in.SoftLimit, &out.SoftLimit
+		*out = new(ResourceQuotaSoftLimit)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(ResourceQuotaBurst)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaSpec.
@@ -7626,6 +8853,11 @@ func (in *ResourceQuotaStatus) DeepCopyInto(out *ResourceQuotaStatus) {
 		*out = new(ResourceQuotaGlobalAcceleratorAccountingStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(ResourceQuotaBurstStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaStatus.
@@ -8042,6 +9274,11 @@ func (in *SettingSpec) DeepCopyInto(out *SettingSpec) {
 		copy(*out, *in)
 	}
 	out.CleanupOptions = in.CleanupOptions
+	if in.StaleClusterPolicy != nil {
+		in, out := &in.StaleClusterPolicy, &out.StaleClusterPolicy
+		*out = new(StaleClusterPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	out.OpaOptions = in.OpaOptions
 	out.MlaOptions = in.MlaOptions
 	out.Notifications = in.Notifications
@@ -8101,6 +9338,41 @@ func (in *SettingSpec) DeepCopy() *SettingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaleClusterPolicy) DeepCopyInto(out *StaleClusterPolicy) {
+	*out = *in
+	if in.MinAge != nil {
+		in, out := &in.MinAge, &out.MinAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxNodes != nil {
+		in, out := &in.MaxNodes, &out.MaxNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IdleDuration != nil {
+		in, out := &in.IdleDuration, &out.IdleDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaleClusterPolicy.
+func (in *StaleClusterPolicy) DeepCopy() *StaleClusterPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(StaleClusterPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StatefulSetSettings) DeepCopyInto(out *StatefulSetSettings) {
 	*out = *in
@@ -8342,6 +9614,148 @@ func (in *Update) DeepCopy() *Update {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateRollout) DeepCopyInto(out *UpdateRollout) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateRollout.
+func (in *UpdateRollout) DeepCopy() *UpdateRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpdateRollout) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateRolloutCluster) DeepCopyInto(out *UpdateRolloutCluster) {
+	*out = *in
+	in.UnhealthySince.DeepCopyInto(&out.UnhealthySince)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateRolloutCluster.
+func (in *UpdateRolloutCluster) DeepCopy() *UpdateRolloutCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateRolloutCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateRolloutConfiguration) DeepCopyInto(out *UpdateRolloutConfiguration) {
+	*out = *in
+	if in.Seeds != nil {
+		in, out := &in.Seeds, &out.Seeds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthTimeout != nil {
+		in, out := &in.HealthTimeout, &out.HealthTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateRolloutConfiguration.
+func (in *UpdateRolloutConfiguration) DeepCopy() *UpdateRolloutConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateRolloutConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateRolloutList) DeepCopyInto(out *UpdateRolloutList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UpdateRollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateRolloutList.
+func (in *UpdateRolloutList) DeepCopy() *UpdateRolloutList {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateRolloutList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpdateRolloutList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateRolloutStatus) DeepCopyInto(out *UpdateRolloutStatus) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]UpdateRolloutWave, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnhealthyClusters != nil {
+		in, out := &in.UnhealthyClusters, &out.UnhealthyClusters
+		*out = make([]UpdateRolloutCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateRolloutStatus.
+func (in *UpdateRolloutStatus) DeepCopy() *UpdateRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateRolloutWave) DeepCopyInto(out *UpdateRolloutWave) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateRolloutWave.
+func (in *UpdateRolloutWave) DeepCopy() *UpdateRolloutWave {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateRolloutWave)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpdateWindow) DeepCopyInto(out *UpdateWindow) {
 	*out = *in
@@ -8357,6 +9771,44 @@ func (in *UpdateWindow) DeepCopy() *UpdateWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePreflightSettings) DeepCopyInto(out *UpgradePreflightSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePreflightSettings.
+func (in *UpgradePreflightSettings) DeepCopy() *UpgradePreflightSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePreflightSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePreflightStatus) DeepCopyInto(out *UpgradePreflightStatus) {
+	*out = *in
+	in.LastCheckTime.DeepCopyInto(&out.LastCheckTime)
+	if in.RemovedAPIs != nil {
+		in, out := &in.RemovedAPIs, &out.RemovedAPIs
+		*out = make([]RemovedAPIUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePreflightStatus.
+func (in *UpgradePreflightStatus) DeepCopy() *UpgradePreflightStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePreflightStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
@@ -8421,7 +9873,7 @@ func (in *UserProjectBinding) DeepCopyInto(out *UserProjectBinding) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserProjectBinding.
@@ -8477,6 +9929,10 @@ func (in *UserProjectBindingList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserProjectBindingSpec) DeepCopyInto(out *UserProjectBindingSpec) {
 	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserProjectBindingSpec.